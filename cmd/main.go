@@ -9,6 +9,7 @@ import (
 	"os"
 	"os/signal"
 	"strings"
+	"sync/atomic"
 	"syscall"
 	"time"
 
@@ -18,6 +19,7 @@ import (
 
 	"cityio/internal/cluster"
 	"cityio/internal/config"
+	"cityio/internal/constants"
 	"cityio/internal/database"
 	"cityio/internal/logger"
 	"cityio/internal/metrics"
@@ -26,6 +28,11 @@ import (
 	"cityio/internal/setup"
 )
 
+// exitSeasonEnded is the exit status after a season ends. The world rolls over
+// on the next start, so the process must run under a supervisor that restarts
+// it; a non-zero status makes sure on-failure policies do too.
+const exitSeasonEnded = 3
+
 func main() {
 	cfg, err := config.Load()
 	if err != nil {
@@ -42,6 +49,32 @@ func main() {
 	ctx := logger.With(context.Background(), "environment", cfg.Environment)
 	slog.InfoContext(ctx, "starting cityio backend")
 
+	snap, err := constants.LoadFiles(cfg.BalanceFile, cfg.BuildingsFile)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to load balance", "balance_file", cfg.BalanceFile, "buildings_file", cfg.BuildingsFile, "error", err)
+		os.Exit(1)
+	}
+	slog.InfoContext(ctx, "loaded balance", "version", snap.Version, "building_types", len(snap.Buildings.Buildings))
+
+	// reloadBalance rereads the balance and building definition files. Files
+	// that fail validation leave the current snapshot in place.
+	reloadBalance := func() (string, error) {
+		snap, err := constants.ReloadFiles(cfg.BalanceFile, cfg.BuildingsFile)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to reload balance", "error", err)
+			return "", err
+		}
+		slog.InfoContext(ctx, "reloaded balance", "version", snap.Version)
+		return snap.Version, nil
+	}
+	hupCh := make(chan os.Signal, 1)
+	signal.Notify(hupCh, syscall.SIGHUP)
+	go func() {
+		for range hupCh {
+			_, _ = reloadBalance()
+		}
+	}()
+
 	db := database.NewDB(ctx, cfg.DatabaseDSN())
 	store := persistence.New(db)
 	store.Start(ctx)
@@ -63,7 +96,23 @@ func main() {
 	// gauges.
 	metrics.StartSnapshot(shutdownCtx, store)
 
-	server := rpc.NewServer(shutdownCtx, cl, store, cfg.JWTSecret)
+	// Ending a season, by an admin or by it running out, shuts the server down
+	// through the same path as SIGTERM and exits with exitSeasonEnded; the
+	// world rolls over on the next start. Actors can't be torn down and
+	// respawned in place, so the rollover needs the restart.
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+	var seasonEnded atomic.Bool
+	endSeason := func() {
+		seasonEnded.Store(true)
+		select {
+		case sigCh <- syscall.SIGTERM:
+		default:
+		}
+	}
+	go setup.WatchSeason(shutdownCtx, store, endSeason)
+
+	server := rpc.NewServer(shutdownCtx, cl, store, cfg.JWTSecret, cfg.Admins, endSeason, reloadBalance)
 	handler := cors.New(cors.Options{
 		AllowOriginFunc: func(origin string) bool {
 			if origin == "http://localhost:5173" || origin == "http://localhost:4173" {
@@ -85,9 +134,9 @@ func main() {
 
 	// Catch SIGINT/SIGTERM, signal active streams to close, then drain HTTP
 	// and the persistence flush queue before exiting.
-	sigCh := make(chan os.Signal, 1)
-	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+	drained := make(chan struct{})
 	go func() {
+		defer close(drained)
 		sig := <-sigCh
 		slog.InfoContext(ctx, "shutdown signal received", "signal", sig.String())
 		cancelShutdown()
@@ -107,5 +156,10 @@ func main() {
 		slog.ErrorContext(ctx, "rpc server stopped", "error", err)
 		os.Exit(1)
 	}
+	<-drained
+	if seasonEnded.Load() {
+		slog.ErrorContext(ctx, "season ended; restart the server to generate the next season", "exit_code", exitSeasonEnded)
+		os.Exit(exitSeasonEnded)
+	}
 	slog.InfoContext(ctx, "rpc server stopped cleanly")
 }
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE cities ADD COLUMN troops BIGINT NOT NULL DEFAULT 0 CHECK (troops >= 0);
+
+CREATE TABLE armies (
+    army_id      VARCHAR(36) PRIMARY KEY,
+    owner        VARCHAR(36) NOT NULL,
+    city_id      VARCHAR(36) NOT NULL,
+    troops       BIGINT NOT NULL CHECK (troops >= 0),
+    coords       COORDINATES NOT NULL,
+    destination  COORDINATES NOT NULL,
+    created_at   TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at   TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT armies_owner_fk
+        FOREIGN KEY (owner) REFERENCES users (user_id)
+        ON DELETE CASCADE
+);
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE armies;
+ALTER TABLE cities DROP COLUMN troops;
+-- +goose StatementEnd
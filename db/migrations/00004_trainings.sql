@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE trainings (
+    training_id     VARCHAR(36) PRIMARY KEY,
+    barracks_id     VARCHAR(36) NOT NULL,
+    troops          BIGINT NOT NULL CHECK (troops > 0),
+    training_start  TIMESTAMP NULL,
+    training_end    TIMESTAMP NULL,
+    created_at      TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at      TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT trainings_barracks_fk
+        FOREIGN KEY (barracks_id) REFERENCES buildings (building_id)
+        ON DELETE CASCADE
+);
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE trainings;
+-- +goose StatementEnd
@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE battle_reports (
+    report_id          VARCHAR(36) PRIMARY KEY,
+    seed               BIGINT NOT NULL,
+    army_id            VARCHAR(36) NOT NULL,
+    attacker           VARCHAR(36) NOT NULL,
+    defender           VARCHAR(36) NULL,
+    city_id            VARCHAR(36) NOT NULL,
+    coords             COORDINATES NOT NULL,
+    city_center_level  INTEGER NOT NULL DEFAULT 0,
+    attacker_troops    BIGINT NOT NULL,
+    defender_troops    BIGINT NOT NULL,
+    attacker_losses    BIGINT NOT NULL,
+    defender_losses    BIGINT NOT NULL,
+    outcome            VARCHAR(32) NOT NULL,
+    rounds             INTEGER NOT NULL,
+    fought_at          TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT battle_reports_attacker_fk
+        FOREIGN KEY (attacker) REFERENCES users (user_id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT battle_reports_defender_fk
+        FOREIGN KEY (defender) REFERENCES users (user_id)
+        ON DELETE SET NULL
+);
+
+CREATE INDEX battle_reports_attacker_idx ON battle_reports (attacker, fought_at DESC);
+CREATE INDEX battle_reports_defender_idx ON battle_reports (defender, fought_at DESC);
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE battle_reports;
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users ADD COLUMN food_policy VARCHAR(32) NOT NULL DEFAULT 'capital_first';
+ALTER TABLE cities ADD COLUMN import_priority INTEGER NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE cities DROP COLUMN import_priority;
+ALTER TABLE users DROP COLUMN food_policy;
+-- +goose StatementEnd
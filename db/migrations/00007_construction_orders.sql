@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE construction_orders (
+    order_id       VARCHAR(36) PRIMARY KEY,
+    city_id        VARCHAR(36) NOT NULL,
+    building_id    VARCHAR(36) NULL,
+    building_type  VARCHAR(100) NOT NULL,
+    coords         COORDINATES NOT NULL,
+    position       INTEGER NOT NULL,
+    paid           BIGINT NOT NULL DEFAULT 0 CHECK (paid >= 0),
+    created_at     TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at     TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT construction_orders_city_fk
+        FOREIGN KEY (city_id) REFERENCES cities (city_id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT construction_orders_building_fk
+        FOREIGN KEY (building_id) REFERENCES buildings (building_id)
+        ON DELETE CASCADE
+);
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE construction_orders;
+-- +goose StatementEnd
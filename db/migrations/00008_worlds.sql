@@ -0,0 +1,48 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE worlds (
+    world_id           INTEGER PRIMARY KEY DEFAULT 1 CHECK (world_id = 1),
+    seed               BIGINT NOT NULL,
+    season             INTEGER NOT NULL CHECK (season >= 1),
+    state              VARCHAR(32) NOT NULL DEFAULT 'active',
+    end_reason         VARCHAR(32) NULL,
+    season_started_at  TIMESTAMP NOT NULL DEFAULT NOW(),
+    season_ends_at     TIMESTAMP NOT NULL,
+    created_at         TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at         TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE seasons (
+    season      INTEGER PRIMARY KEY,
+    seed        BIGINT NOT NULL,
+    end_reason  VARCHAR(32) NOT NULL,
+    started_at  TIMESTAMP NOT NULL,
+    ended_at    TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE season_standings (
+    season      INTEGER NOT NULL,
+    rank        INTEGER NOT NULL,
+    user_id     VARCHAR(36) NOT NULL,
+    username    VARCHAR(100) NOT NULL,
+    cities      INTEGER NOT NULL,
+    population  DOUBLE PRECISION NOT NULL,
+    gold        BIGINT NOT NULL,
+
+    PRIMARY KEY (season, user_id),
+
+    CONSTRAINT season_standings_season_fk
+        FOREIGN KEY (season) REFERENCES seasons (season)
+        ON DELETE CASCADE
+);
+
+CREATE INDEX season_standings_rank_idx ON season_standings (season, rank);
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE season_standings;
+DROP TABLE seasons;
+DROP TABLE worlds;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE tiles (
+    coords   COORDINATES PRIMARY KEY,
+    terrain  VARCHAR(32) NOT NULL
+);
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE tiles;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE tiles ADD COLUMN deposit VARCHAR(32) NULL;
+ALTER TABLE tiles ADD COLUMN richness DOUBLE PRECISION NOT NULL DEFAULT 0;
+
+-- Farms and mines placed before deposits existed keep producing at the
+-- ordinary rate.
+UPDATE tiles
+SET
+    deposit = CASE b.type WHEN 'farm' THEN 'fertile_soil' ELSE 'gold_vein' END,
+    richness = 1
+FROM buildings b
+WHERE b.coords = tiles.coords
+  AND b.type IN ('farm', 'mine');
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE tiles DROP COLUMN richness;
+ALTER TABLE tiles DROP COLUMN deposit;
+-- +goose StatementEnd
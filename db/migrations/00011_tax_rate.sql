@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE cities ADD COLUMN tax_rate INTEGER NOT NULL DEFAULT 10 CHECK (tax_rate BETWEEN 0 AND 100);
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE cities DROP COLUMN tax_rate;
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE cities ADD COLUMN morale DOUBLE PRECISION NOT NULL DEFAULT 60;
+ALTER TABLE cities ADD COLUMN unrest_ticks INTEGER NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE cities DROP COLUMN unrest_ticks;
+ALTER TABLE cities DROP COLUMN morale;
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE research (
+    user_id         VARCHAR(36) NOT NULL,
+    tech            VARCHAR(64) NOT NULL,
+    research_start  TIMESTAMP NOT NULL,
+    research_end    TIMESTAMP NOT NULL,
+    completed       BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at      TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at      TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (user_id, tech),
+
+    CONSTRAINT research_user_fk
+        FOREIGN KEY (user_id) REFERENCES users (user_id)
+        ON DELETE CASCADE
+);
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE research;
+-- +goose StatementEnd
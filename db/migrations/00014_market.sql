@@ -0,0 +1,41 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE market_orders (
+    order_id    VARCHAR(36) PRIMARY KEY,
+    market_id   VARCHAR(32) NOT NULL,
+    user_id     VARCHAR(36) NOT NULL,
+    side        VARCHAR(4) NOT NULL,
+    price       BIGINT NOT NULL,
+    quantity    BIGINT NOT NULL,
+    remaining   BIGINT NOT NULL,
+    created_at  TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at  TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT market_orders_user_fk
+        FOREIGN KEY (user_id) REFERENCES users (user_id)
+        ON DELETE CASCADE
+);
+
+CREATE INDEX market_orders_market_idx ON market_orders (market_id, created_at);
+
+CREATE TABLE trades (
+    trade_id       VARCHAR(36) PRIMARY KEY,
+    market_id      VARCHAR(32) NOT NULL,
+    buy_order_id   VARCHAR(36) NOT NULL,
+    sell_order_id  VARCHAR(36) NOT NULL,
+    buyer          VARCHAR(36) NOT NULL,
+    seller         VARCHAR(36) NOT NULL,
+    price          BIGINT NOT NULL,
+    quantity       BIGINT NOT NULL,
+    executed_at    TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX trades_market_idx ON trades (market_id, executed_at DESC);
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE trades;
+DROP TABLE market_orders;
+-- +goose StatementEnd
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE cities ADD COLUMN food_store BIGINT NOT NULL DEFAULT 0 CHECK (food_store >= 0);
+
+CREATE TABLE caravans (
+    caravan_id          VARCHAR(36) PRIMARY KEY,
+    owner               VARCHAR(36) NOT NULL,
+    source_city_id      VARCHAR(36) NOT NULL,
+    destination_city_id VARCHAR(36) NOT NULL,
+    gold                BIGINT NOT NULL CHECK (gold >= 0),
+    food                BIGINT NOT NULL CHECK (food >= 0),
+    coords              COORDINATES NOT NULL,
+    destination         COORDINATES NOT NULL,
+    created_at          TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at          TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT caravans_owner_fk
+        FOREIGN KEY (owner) REFERENCES users (user_id)
+        ON DELETE CASCADE
+);
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE caravans;
+ALTER TABLE cities DROP COLUMN food_store;
+-- +goose StatementEnd
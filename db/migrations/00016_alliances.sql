@@ -0,0 +1,50 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE alliances (
+    alliance_id VARCHAR(36) PRIMARY KEY,
+    name        VARCHAR(32) NOT NULL UNIQUE,
+    created_at  TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- A player belongs to at most one alliance, hence the user_id key.
+CREATE TABLE alliance_members (
+    user_id     VARCHAR(36) PRIMARY KEY,
+    alliance_id VARCHAR(36) NOT NULL,
+    role        VARCHAR(16) NOT NULL,
+    joined_at   TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT alliance_members_user_fk
+        FOREIGN KEY (user_id) REFERENCES users (user_id)
+        ON DELETE CASCADE,
+    CONSTRAINT alliance_members_alliance_fk
+        FOREIGN KEY (alliance_id) REFERENCES alliances (alliance_id)
+        ON DELETE CASCADE
+);
+
+CREATE INDEX alliance_members_alliance_idx ON alliance_members (alliance_id);
+
+CREATE TABLE alliance_invites (
+    alliance_id VARCHAR(36) NOT NULL,
+    user_id     VARCHAR(36) NOT NULL,
+    invited_by  VARCHAR(36) NOT NULL,
+    created_at  TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (alliance_id, user_id),
+    CONSTRAINT alliance_invites_alliance_fk
+        FOREIGN KEY (alliance_id) REFERENCES alliances (alliance_id)
+        ON DELETE CASCADE,
+    CONSTRAINT alliance_invites_user_fk
+        FOREIGN KEY (user_id) REFERENCES users (user_id)
+        ON DELETE CASCADE
+);
+
+CREATE INDEX alliance_invites_user_idx ON alliance_invites (user_id);
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE alliance_invites;
+DROP TABLE alliance_members;
+DROP TABLE alliances;
+-- +goose StatementEnd
@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+-- One row per pair of players who have ever had dealings; a missing row is
+-- neutral. The pair is stored ordered so each has exactly one row.
+CREATE TABLE relations (
+    user_a         VARCHAR(36) NOT NULL,
+    user_b         VARCHAR(36) NOT NULL,
+    state          VARCHAR(16) NOT NULL DEFAULT 'neutral',
+    pending_state  VARCHAR(16),
+    effective_at   TIMESTAMP,
+    proposed_state VARCHAR(16),
+    proposed_by    VARCHAR(36),
+    updated_at     TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (user_a, user_b),
+    CONSTRAINT relations_pair_ordered CHECK (user_a < user_b),
+    CONSTRAINT relations_user_a_fk
+        FOREIGN KEY (user_a) REFERENCES users (user_id)
+        ON DELETE CASCADE,
+    CONSTRAINT relations_user_b_fk
+        FOREIGN KEY (user_b) REFERENCES users (user_id)
+        ON DELETE CASCADE
+);
+
+CREATE INDEX relations_user_b_idx ON relations (user_b);
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE relations;
+-- +goose StatementEnd
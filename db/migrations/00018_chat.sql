@@ -0,0 +1,38 @@
+-- +goose Up
+-- +goose StatementBegin
+-- channel is "global", "alliance:<alliance_id>" or "direct:<user_id>:<user_id>"
+-- with the two users ordered.
+CREATE TABLE chat_messages (
+    message_id VARCHAR(36) PRIMARY KEY,
+    channel    VARCHAR(80) NOT NULL,
+    sender     VARCHAR(36) NOT NULL,
+    body       VARCHAR(500) NOT NULL,
+    sent_at    TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT chat_messages_sender_fk
+        FOREIGN KEY (sender) REFERENCES users (user_id)
+        ON DELETE CASCADE
+);
+
+CREATE INDEX chat_messages_channel_idx ON chat_messages (channel, sent_at DESC, message_id DESC);
+
+-- A muted player cannot send chat messages until muted_until.
+CREATE TABLE chat_mutes (
+    user_id     VARCHAR(36) PRIMARY KEY,
+    muted_until TIMESTAMP NOT NULL,
+    reason      VARCHAR(200) NOT NULL DEFAULT '',
+    muted_by    VARCHAR(36) NOT NULL,
+    created_at  TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT chat_mutes_user_fk
+        FOREIGN KEY (user_id) REFERENCES users (user_id)
+        ON DELETE CASCADE
+);
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE chat_mutes;
+DROP TABLE chat_messages;
+-- +goose StatementEnd
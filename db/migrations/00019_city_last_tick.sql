@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- last_tick_at is when the city last ticked, in UTC, written by every flush.
+-- Offline catch-up replays from there. NULL until the city's first flush.
+ALTER TABLE cities ADD COLUMN last_tick_at TIMESTAMP;
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE cities DROP COLUMN last_tick_at;
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- terrain_defense is the defence multiplier of the tile the battle was fought
+-- on, kept so the report replays exactly after the balance changes.
+ALTER TABLE battle_reports ADD COLUMN terrain_defense DOUBLE PRECISION NOT NULL DEFAULT 1;
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE battle_reports DROP COLUMN terrain_defense;
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Season resets founded capitals with type 'capital', which nothing else
+-- recognises; they are ordinary cities.
+UPDATE cities SET type = 'city' WHERE type = 'capital';
+
+-- capital marks the city a player was founded with. It is cleared when the
+-- city changes hands, so a conquered capital is an ordinary city to its new
+-- owner. Existing worlds take each owner's oldest city.
+ALTER TABLE cities ADD COLUMN capital BOOLEAN NOT NULL DEFAULT FALSE;
+UPDATE cities c SET capital = TRUE
+WHERE c.city_id = (
+    SELECT f.city_id
+    FROM cities f
+    WHERE f.owner = c.owner AND f.type = 'city'
+    ORDER BY f.created_at, f.city_id
+    LIMIT 1
+);
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE cities DROP COLUMN capital;
+-- +goose StatementEnd
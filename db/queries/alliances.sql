@@ -0,0 +1,148 @@
+-- name: GetAlliance :one
+SELECT
+    alliance_id,
+    name,
+    created_at
+FROM alliances
+WHERE alliance_id = $1;
+
+-- name: AllianceNameTaken :one
+SELECT EXISTS (
+    SELECT 1 FROM alliances WHERE name = $1
+);
+
+-- name: GetAllianceMembers :many
+SELECT
+    m.alliance_id,
+    m.user_id,
+    u.username,
+    m.role,
+    m.joined_at
+FROM alliance_members m
+JOIN users u ON u.user_id = m.user_id
+WHERE m.alliance_id = $1
+ORDER BY m.joined_at, m.user_id;
+
+-- name: GetAllianceMembership :one
+SELECT
+    user_id,
+    alliance_id,
+    role,
+    joined_at
+FROM alliance_members
+WHERE user_id = $1;
+
+-- name: GetAllianceInvites :many
+SELECT
+    i.alliance_id,
+    a.name AS alliance_name,
+    i.user_id,
+    i.invited_by,
+    i.created_at
+FROM alliance_invites i
+JOIN alliances a ON a.alliance_id = i.alliance_id
+WHERE i.alliance_id = $1
+ORDER BY i.created_at, i.user_id;
+
+-- name: GetAllianceInvitesByUser :many
+SELECT
+    i.alliance_id,
+    a.name AS alliance_name,
+    i.user_id,
+    i.invited_by,
+    i.created_at
+FROM alliance_invites i
+JOIN alliances a ON a.alliance_id = i.alliance_id
+WHERE i.user_id = $1
+ORDER BY i.created_at, i.alliance_id;
+
+-- name: GetAllianceCities :many
+-- Every city owned by a member of the alliance, for shared vision.
+SELECT
+    city_id,
+    type,
+    owner,
+    name,
+    population,
+    population_cap,
+    (start_coords).x::int4 AS start_x,
+    (start_coords).y::int4 AS start_y,
+    size,
+    troops,
+    import_priority,
+    tax_rate,
+    morale,
+    unrest_ticks,
+    food_store,
+    capital,
+    created_at,
+    updated_at
+FROM cities
+WHERE owner IN (
+    SELECT user_id FROM alliance_members WHERE alliance_id = $1
+);
+
+-- name: CreateAlliance :exec
+INSERT INTO alliances (
+    alliance_id,
+    name,
+    created_at
+)
+VALUES (
+    sqlc.arg(alliance_id),
+    sqlc.arg(name),
+    sqlc.arg(created_at)
+);
+
+-- name: DeleteAlliance :exec
+DELETE FROM alliances
+WHERE alliance_id = $1;
+
+-- name: AddAllianceMember :exec
+INSERT INTO alliance_members (
+    user_id,
+    alliance_id,
+    role,
+    joined_at
+)
+VALUES (
+    sqlc.arg(user_id),
+    sqlc.arg(alliance_id),
+    sqlc.arg(role),
+    sqlc.arg(joined_at)
+);
+
+-- name: UpdateAllianceMemberRole :exec
+UPDATE alliance_members
+SET role = sqlc.arg(role)
+WHERE user_id = sqlc.arg(user_id);
+
+-- name: DeleteAllianceMember :exec
+DELETE FROM alliance_members
+WHERE user_id = $1;
+
+-- name: CreateAllianceInvite :exec
+-- Inviting a player again refreshes the invite rather than failing.
+INSERT INTO alliance_invites (
+    alliance_id,
+    user_id,
+    invited_by,
+    created_at
+)
+VALUES (
+    sqlc.arg(alliance_id),
+    sqlc.arg(user_id),
+    sqlc.arg(invited_by),
+    sqlc.arg(created_at)
+)
+ON CONFLICT (alliance_id, user_id) DO UPDATE
+SET invited_by = EXCLUDED.invited_by, created_at = EXCLUDED.created_at;
+
+-- name: DeleteAllianceInvite :exec
+DELETE FROM alliance_invites
+WHERE alliance_id = $1 AND user_id = $2;
+
+-- name: DeleteAllianceInvitesByUser :exec
+-- A player who joins an alliance drops every other invite they held.
+DELETE FROM alliance_invites
+WHERE user_id = $1;
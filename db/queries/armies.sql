@@ -0,0 +1,69 @@
+-- name: GetAllArmies :many
+SELECT
+    army_id,
+    owner,
+    city_id,
+    troops,
+    (coords).x::int4 AS x,
+    (coords).y::int4 AS y,
+    (destination).x::int4 AS destination_x,
+    (destination).y::int4 AS destination_y
+FROM armies;
+
+-- name: GetArmiesByOwner :many
+SELECT
+    army_id,
+    owner,
+    city_id,
+    troops,
+    (coords).x::int4 AS x,
+    (coords).y::int4 AS y,
+    (destination).x::int4 AS destination_x,
+    (destination).y::int4 AS destination_y
+FROM armies
+WHERE owner = $1;
+
+-- name: CreateArmy :exec
+INSERT INTO armies (
+    army_id,
+    owner,
+    city_id,
+    troops,
+    coords,
+    destination
+)
+VALUES (
+    sqlc.arg(army_id),
+    sqlc.arg(owner),
+    sqlc.arg(city_id),
+    sqlc.arg(troops),
+    ROW(sqlc.arg(x)::int4, sqlc.arg(y)::int4)::coordinates,
+    ROW(sqlc.arg(destination_x)::int4, sqlc.arg(destination_y)::int4)::coordinates
+);
+
+-- name: DeleteArmy :exec
+DELETE FROM armies
+WHERE army_id = $1;
+
+-- name: DeleteAllArmies :exec
+DELETE FROM armies;
+
+-- name: BatchUpdateArmies :exec
+UPDATE armies AS a
+SET
+    city_id     = v.city_id,
+    troops      = v.troops,
+    coords      = ROW(v.x, v.y)::coordinates,
+    destination = ROW(v.destination_x, v.destination_y)::coordinates,
+    updated_at  = NOW()
+FROM (
+    SELECT
+        UNNEST(sqlc.arg(army_ids)::text[])      AS army_id,
+        UNNEST(sqlc.arg(city_ids)::text[])      AS city_id,
+        UNNEST(sqlc.arg(troops)::int8[])        AS troops,
+        UNNEST(sqlc.arg(xs)::int[])             AS x,
+        UNNEST(sqlc.arg(ys)::int[])             AS y,
+        UNNEST(sqlc.arg(destination_xs)::int[]) AS destination_x,
+        UNNEST(sqlc.arg(destination_ys)::int[]) AS destination_y
+) AS v
+WHERE a.army_id = v.army_id;
@@ -0,0 +1,86 @@
+-- name: GetBattleReport :one
+SELECT
+    report_id,
+    seed,
+    army_id,
+    attacker,
+    defender,
+    city_id,
+    (coords).x::int4 AS x,
+    (coords).y::int4 AS y,
+    city_center_level,
+    terrain_defense,
+    attacker_troops,
+    defender_troops,
+    attacker_losses,
+    defender_losses,
+    outcome,
+    rounds,
+    fought_at
+FROM battle_reports
+WHERE report_id = $1;
+
+-- name: GetBattleReportsByUser :many
+SELECT
+    report_id,
+    seed,
+    army_id,
+    attacker,
+    defender,
+    city_id,
+    (coords).x::int4 AS x,
+    (coords).y::int4 AS y,
+    city_center_level,
+    terrain_defense,
+    attacker_troops,
+    defender_troops,
+    attacker_losses,
+    defender_losses,
+    outcome,
+    rounds,
+    fought_at
+FROM battle_reports
+WHERE attacker = sqlc.arg(user_id) OR defender = sqlc.arg(user_id)
+ORDER BY fought_at DESC
+LIMIT sqlc.arg(max_reports);
+
+-- name: CreateBattleReport :exec
+INSERT INTO battle_reports (
+    report_id,
+    seed,
+    army_id,
+    attacker,
+    defender,
+    city_id,
+    coords,
+    city_center_level,
+    terrain_defense,
+    attacker_troops,
+    defender_troops,
+    attacker_losses,
+    defender_losses,
+    outcome,
+    rounds,
+    fought_at
+)
+VALUES (
+    sqlc.arg(report_id),
+    sqlc.arg(seed),
+    sqlc.arg(army_id),
+    sqlc.arg(attacker),
+    sqlc.arg(defender),
+    sqlc.arg(city_id),
+    ROW(sqlc.arg(x)::int4, sqlc.arg(y)::int4)::coordinates,
+    sqlc.arg(city_center_level),
+    sqlc.arg(terrain_defense),
+    sqlc.arg(attacker_troops),
+    sqlc.arg(defender_troops),
+    sqlc.arg(attacker_losses),
+    sqlc.arg(defender_losses),
+    sqlc.arg(outcome),
+    sqlc.arg(rounds),
+    sqlc.arg(fought_at)
+);
+
+-- name: DeleteAllBattleReports :exec
+DELETE FROM battle_reports;
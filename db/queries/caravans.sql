@@ -0,0 +1,72 @@
+-- name: GetAllCaravans :many
+SELECT
+    caravan_id,
+    owner,
+    source_city_id,
+    destination_city_id,
+    gold,
+    food,
+    (coords).x::int4 AS x,
+    (coords).y::int4 AS y,
+    (destination).x::int4 AS destination_x,
+    (destination).y::int4 AS destination_y
+FROM caravans;
+
+-- name: GetCaravansByOwner :many
+SELECT
+    caravan_id,
+    owner,
+    source_city_id,
+    destination_city_id,
+    gold,
+    food,
+    (coords).x::int4 AS x,
+    (coords).y::int4 AS y,
+    (destination).x::int4 AS destination_x,
+    (destination).y::int4 AS destination_y
+FROM caravans
+WHERE owner = $1;
+
+-- name: CreateCaravan :exec
+INSERT INTO caravans (
+    caravan_id,
+    owner,
+    source_city_id,
+    destination_city_id,
+    gold,
+    food,
+    coords,
+    destination
+)
+VALUES (
+    sqlc.arg(caravan_id),
+    sqlc.arg(owner),
+    sqlc.arg(source_city_id),
+    sqlc.arg(destination_city_id),
+    sqlc.arg(gold),
+    sqlc.arg(food),
+    ROW(sqlc.arg(x)::int4, sqlc.arg(y)::int4)::coordinates,
+    ROW(sqlc.arg(destination_x)::int4, sqlc.arg(destination_y)::int4)::coordinates
+);
+
+-- name: DeleteCaravan :exec
+DELETE FROM caravans
+WHERE caravan_id = $1;
+
+-- name: DeleteAllCaravans :exec
+DELETE FROM caravans;
+
+-- name: BatchUpdateCaravans :exec
+-- Only the position changes in transit: cargo and destination are fixed when
+-- the caravan sets out.
+UPDATE caravans AS c
+SET
+    coords     = ROW(v.x, v.y)::coordinates,
+    updated_at = NOW()
+FROM (
+    SELECT
+        UNNEST(sqlc.arg(caravan_ids)::text[]) AS caravan_id,
+        UNNEST(sqlc.arg(xs)::int[])           AS x,
+        UNNEST(sqlc.arg(ys)::int[])           AS y
+) AS v
+WHERE c.caravan_id = v.caravan_id;
@@ -0,0 +1,74 @@
+-- name: GetChatMessages :many
+-- A page of a channel's history, newest first. Passing the ID of the oldest
+-- message of the previous page as before_id continues from there; an empty
+-- before_id starts at the newest.
+SELECT
+    m.message_id,
+    m.channel,
+    m.sender,
+    u.username AS sender_name,
+    m.body,
+    m.sent_at
+FROM chat_messages m
+JOIN users u ON u.user_id = m.sender
+WHERE m.channel = sqlc.arg(channel)
+  AND (
+    sqlc.arg(before_id)::varchar = ''
+    OR (m.sent_at, m.message_id) < (
+        SELECT c.sent_at, c.message_id FROM chat_messages c WHERE c.message_id = sqlc.arg(before_id)
+    )
+  )
+ORDER BY m.sent_at DESC, m.message_id DESC
+LIMIT sqlc.arg(max_messages);
+
+-- name: CreateChatMessage :exec
+INSERT INTO chat_messages (
+    message_id,
+    channel,
+    sender,
+    body,
+    sent_at
+)
+VALUES (
+    sqlc.arg(message_id),
+    sqlc.arg(channel),
+    sqlc.arg(sender),
+    sqlc.arg(body),
+    sqlc.arg(sent_at)
+);
+
+-- name: GetChatMute :one
+SELECT
+    user_id,
+    muted_until,
+    reason,
+    muted_by,
+    created_at
+FROM chat_mutes
+WHERE user_id = $1;
+
+-- name: UpsertChatMute :exec
+-- Muting a muted player replaces their mute.
+INSERT INTO chat_mutes (
+    user_id,
+    muted_until,
+    reason,
+    muted_by,
+    created_at
+)
+VALUES (
+    sqlc.arg(user_id),
+    sqlc.arg(muted_until),
+    sqlc.arg(reason),
+    sqlc.arg(muted_by),
+    sqlc.arg(created_at)
+)
+ON CONFLICT (user_id) DO UPDATE
+SET muted_until = EXCLUDED.muted_until,
+    reason = EXCLUDED.reason,
+    muted_by = EXCLUDED.muted_by,
+    created_at = EXCLUDED.created_at;
+
+-- name: DeleteChatMute :exec
+DELETE FROM chat_mutes
+WHERE user_id = $1;
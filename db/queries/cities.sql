@@ -9,6 +9,14 @@ SELECT
     (start_coords).x::int4 AS start_x,
     (start_coords).y::int4 AS start_y,
     size,
+    troops,
+    import_priority,
+    tax_rate,
+    morale,
+    unrest_ticks,
+    food_store,
+    last_tick_at,
+    capital,
     created_at,
     updated_at
 FROM cities;
@@ -22,7 +30,8 @@ INSERT INTO cities (
     population,
     population_cap,
     start_coords,
-    size
+    size,
+    capital
 )
 VALUES (
     sqlc.arg(city_id),
@@ -32,13 +41,18 @@ VALUES (
     sqlc.arg(population),
     sqlc.arg(population_cap),
     ROW(sqlc.arg(start_x)::int4, sqlc.arg(start_y)::int4)::coordinates,
-    sqlc.arg(size)
+    sqlc.arg(size),
+    sqlc.arg(capital)
 );
 
 -- name: DeleteCity :exec
 DELETE FROM cities
 WHERE city_id = $1;
 
+-- name: DeleteAllCities :exec
+-- Cascades to every building, training and construction order.
+DELETE FROM cities;
+
 -- name: UpdateCity :exec
 UPDATE cities
 SET
@@ -52,11 +66,22 @@ SET
     updated_at      = NOW()
 WHERE city_id = sqlc.arg(city_id);
 
+-- name: UpdateCityOwner :exec
+-- A city that changes hands is no longer anyone's capital.
+UPDATE cities
+SET
+    owner      = sqlc.arg(owner),
+    capital    = FALSE,
+    updated_at = NOW()
+WHERE city_id = sqlc.arg(city_id);
+
 -- name: FindEmptyCityBlock :one
 -- Picks a uniformly random empty (size × size) block, enforcing a 1-tile gap
 -- from the map boundary on every side as well as from every other city.
 -- Range [1, mapWidth - size - 1] guarantees the block's footprint never
--- touches the map edge.
+-- touches the map edge. Blocks covering any water are skipped, as are blocks
+-- without the starter farm's deposit off the center tile (unless the farm
+-- needs none or the world predates generated tiles).
 SELECT
   x::int4 AS x,
   y::int4 AS y
@@ -73,6 +98,27 @@ WHERE NOT EXISTS (
     AND (c.start_coords).y + c.size >= y
     AND (c.start_coords).y <= y + sqlc.arg(size)::int4
 )
+AND NOT EXISTS (
+  SELECT 1
+  FROM tiles t
+  WHERE
+    t.terrain = 'water'
+    AND (t.coords).x BETWEEN x AND x + sqlc.arg(size)::int4 - 1
+    AND (t.coords).y BETWEEN y AND y + sqlc.arg(size)::int4 - 1
+)
+AND (
+  sqlc.arg(starter_deposit)::varchar = ''
+  OR NOT EXISTS (SELECT 1 FROM tiles)
+  OR EXISTS (
+    SELECT 1
+    FROM tiles t
+    WHERE
+      t.deposit = sqlc.arg(starter_deposit)::varchar
+      AND (t.coords).x BETWEEN x AND x + sqlc.arg(size)::int4 - 1
+      AND (t.coords).y BETWEEN y AND y + sqlc.arg(size)::int4 - 1
+      AND NOT ((t.coords).x = x + sqlc.arg(size)::int4 / 2 AND (t.coords).y = y + sqlc.arg(size)::int4 / 2)
+  )
+)
 ORDER BY random()
 LIMIT 1;
 
@@ -120,6 +166,13 @@ SELECT
     (start_coords).x::int4 AS start_x,
     (start_coords).y::int4 AS start_y,
     size,
+    troops,
+    import_priority,
+    tax_rate,
+    morale,
+    unrest_ticks,
+    food_store,
+    capital,
     created_at,
     updated_at
 FROM cities
@@ -134,17 +187,32 @@ SET
     population      = v.population,
     population_cap  = v.population_cap,
     start_coords    = ROW(v.start_x, v.start_y)::coordinates,
-    size            = v.size
+    size            = v.size,
+    troops          = v.troops,
+    import_priority = v.import_priority,
+    tax_rate        = v.tax_rate,
+    morale          = v.morale,
+    unrest_ticks    = v.unrest_ticks,
+    food_store      = v.food_store,
+    last_tick_at    = v.last_tick_at,
+    updated_at      = NOW()
 FROM (
     SELECT
-        UNNEST(sqlc.arg(city_ids)::text[])          AS city_id,
-        UNNEST(sqlc.arg(types)::text[])             AS type,
-        UNNEST(sqlc.arg(owners)::text[])            AS owner,
-        UNNEST(sqlc.arg(names)::text[])             AS name,
-        UNNEST(sqlc.arg(populations)::float8[])     AS population,
-        UNNEST(sqlc.arg(population_caps)::float8[]) AS population_cap,
-        UNNEST(sqlc.arg(start_xs)::int[])           AS start_x,
-        UNNEST(sqlc.arg(start_ys)::int[])           AS start_y,
-        UNNEST(sqlc.arg(sizes)::int[])              AS size
+        UNNEST(sqlc.arg(city_ids)::text[])           AS city_id,
+        UNNEST(sqlc.arg(types)::text[])              AS type,
+        UNNEST(sqlc.arg(owners)::text[])             AS owner,
+        UNNEST(sqlc.arg(names)::text[])              AS name,
+        UNNEST(sqlc.arg(populations)::float8[])      AS population,
+        UNNEST(sqlc.arg(population_caps)::float8[])  AS population_cap,
+        UNNEST(sqlc.arg(start_xs)::int[])            AS start_x,
+        UNNEST(sqlc.arg(start_ys)::int[])            AS start_y,
+        UNNEST(sqlc.arg(sizes)::int[])               AS size,
+        UNNEST(sqlc.arg(troops)::int8[])             AS troops,
+        UNNEST(sqlc.arg(import_priorities)::int[])   AS import_priority,
+        UNNEST(sqlc.arg(tax_rates)::int[])           AS tax_rate,
+        UNNEST(sqlc.arg(morales)::float8[])          AS morale,
+        UNNEST(sqlc.arg(unrest_ticks)::int[])        AS unrest_ticks,
+        UNNEST(sqlc.arg(food_stores)::int8[])        AS food_store,
+        UNNEST(sqlc.arg(last_tick_ats)::timestamp[]) AS last_tick_at
 ) AS v
 WHERE c.city_id = v.city_id;
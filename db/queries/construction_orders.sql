@@ -0,0 +1,49 @@
+-- name: GetConstructionOrdersByCity :many
+SELECT
+    order_id,
+    city_id,
+    building_id,
+    building_type,
+    (coords).x::int4 AS x,
+    (coords).y::int4 AS y,
+    position,
+    paid
+FROM construction_orders
+WHERE city_id = $1
+ORDER BY position, created_at, order_id;
+
+-- name: CreateConstructionOrder :exec
+INSERT INTO construction_orders (
+    order_id,
+    city_id,
+    building_id,
+    building_type,
+    coords,
+    position,
+    paid
+)
+VALUES (
+    sqlc.arg(order_id),
+    sqlc.arg(city_id),
+    sqlc.arg(building_id),
+    sqlc.arg(building_type),
+    ROW(sqlc.arg(x)::int4, sqlc.arg(y)::int4)::coordinates,
+    sqlc.arg(position),
+    sqlc.arg(paid)
+);
+
+-- name: DeleteConstructionOrder :exec
+DELETE FROM construction_orders
+WHERE order_id = $1;
+
+-- name: BatchUpdateConstructionOrders :exec
+UPDATE construction_orders AS o
+SET
+    position   = v.position,
+    updated_at = NOW()
+FROM (
+    SELECT
+        UNNEST(sqlc.arg(order_ids)::text[]) AS order_id,
+        UNNEST(sqlc.arg(positions)::int[])  AS position
+) AS v
+WHERE o.order_id = v.order_id;
@@ -0,0 +1,96 @@
+-- name: GetMarketOrders :many
+SELECT
+    order_id,
+    market_id,
+    user_id,
+    side,
+    price,
+    quantity,
+    remaining,
+    created_at
+FROM market_orders
+WHERE market_id = $1 AND remaining > 0
+ORDER BY created_at, order_id;
+
+-- name: CreateMarketOrder :exec
+INSERT INTO market_orders (
+    order_id,
+    market_id,
+    user_id,
+    side,
+    price,
+    quantity,
+    remaining,
+    created_at
+)
+VALUES (
+    sqlc.arg(order_id),
+    sqlc.arg(market_id),
+    sqlc.arg(user_id),
+    sqlc.arg(side),
+    sqlc.arg(price),
+    sqlc.arg(quantity),
+    sqlc.arg(quantity),
+    sqlc.arg(created_at)
+);
+
+-- name: DeleteMarketOrder :exec
+DELETE FROM market_orders
+WHERE order_id = $1;
+
+-- name: RecordTrade :exec
+-- One statement, so the trade and the fills of both orders land together.
+WITH buy AS (
+    UPDATE market_orders
+    SET remaining = remaining - sqlc.arg(quantity), updated_at = NOW()
+    WHERE order_id = sqlc.arg(buy_order_id)
+), sell AS (
+    UPDATE market_orders
+    SET remaining = remaining - sqlc.arg(quantity), updated_at = NOW()
+    WHERE order_id = sqlc.arg(sell_order_id)
+)
+INSERT INTO trades (
+    trade_id,
+    market_id,
+    buy_order_id,
+    sell_order_id,
+    buyer,
+    seller,
+    price,
+    quantity,
+    executed_at
+)
+VALUES (
+    sqlc.arg(trade_id),
+    sqlc.arg(market_id),
+    sqlc.arg(buy_order_id),
+    sqlc.arg(sell_order_id),
+    sqlc.arg(buyer),
+    sqlc.arg(seller),
+    sqlc.arg(price),
+    sqlc.arg(quantity),
+    sqlc.arg(executed_at)
+);
+
+-- name: GetRecentTrades :many
+SELECT
+    trade_id,
+    market_id,
+    buy_order_id,
+    sell_order_id,
+    buyer,
+    seller,
+    price,
+    quantity,
+    executed_at
+FROM trades
+WHERE market_id = sqlc.arg(market_id)
+ORDER BY executed_at DESC, trade_id
+LIMIT sqlc.arg(max_trades);
+
+-- name: DeleteAllMarketOrders :exec
+-- Escrowed funds belong to the season's users, so open orders go with it.
+DELETE FROM market_orders;
+
+-- name: DeleteAllTrades :exec
+DELETE FROM trades;
@@ -0,0 +1,74 @@
+-- name: GetRelation :one
+SELECT
+    user_a,
+    user_b,
+    state,
+    pending_state,
+    effective_at,
+    proposed_state,
+    proposed_by,
+    updated_at
+FROM relations
+WHERE user_a = $1 AND user_b = $2;
+
+-- name: GetRelationsByUser :many
+SELECT
+    user_a,
+    user_b,
+    state,
+    pending_state,
+    effective_at,
+    proposed_state,
+    proposed_by,
+    updated_at
+FROM relations
+WHERE user_a = $1 OR user_b = $1
+ORDER BY updated_at DESC;
+
+-- name: GetPendingRelations :many
+-- Relations with a transition still to come, whose timers are re-armed on
+-- boot.
+SELECT
+    user_a,
+    user_b,
+    state,
+    pending_state,
+    effective_at,
+    proposed_state,
+    proposed_by,
+    updated_at
+FROM relations
+WHERE effective_at IS NOT NULL;
+
+-- name: UpsertRelation :exec
+INSERT INTO relations (
+    user_a,
+    user_b,
+    state,
+    pending_state,
+    effective_at,
+    proposed_state,
+    proposed_by,
+    updated_at
+)
+VALUES (
+    sqlc.arg(user_a),
+    sqlc.arg(user_b),
+    sqlc.arg(state),
+    sqlc.arg(pending_state),
+    sqlc.arg(effective_at),
+    sqlc.arg(proposed_state),
+    sqlc.arg(proposed_by),
+    sqlc.arg(updated_at)
+)
+ON CONFLICT (user_a, user_b) DO UPDATE
+SET state = EXCLUDED.state,
+    pending_state = EXCLUDED.pending_state,
+    effective_at = EXCLUDED.effective_at,
+    proposed_state = EXCLUDED.proposed_state,
+    proposed_by = EXCLUDED.proposed_by,
+    updated_at = EXCLUDED.updated_at;
+
+-- name: DeleteAllRelations :exec
+-- Every season starts at peace with nobody and at war with nobody.
+DELETE FROM relations;
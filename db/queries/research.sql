@@ -0,0 +1,39 @@
+-- name: GetResearchByUser :many
+SELECT
+    user_id,
+    tech,
+    research_start,
+    research_end,
+    completed
+FROM research
+WHERE user_id = $1
+ORDER BY research_end, tech;
+
+-- name: CreateResearch :exec
+INSERT INTO research (
+    user_id,
+    tech,
+    research_start,
+    research_end
+)
+VALUES (
+    sqlc.arg(user_id),
+    sqlc.arg(tech),
+    sqlc.arg(research_start),
+    sqlc.arg(research_end)
+);
+
+-- name: CompleteResearch :exec
+UPDATE research
+SET
+    completed  = TRUE,
+    updated_at = NOW()
+WHERE user_id = $1 AND tech = $2;
+
+-- name: DeleteResearch :exec
+DELETE FROM research
+WHERE user_id = $1 AND tech = $2;
+
+-- name: DeleteAllResearch :exec
+-- Research is per season; accounts carry over but start the tree afresh.
+DELETE FROM research;
@@ -0,0 +1,32 @@
+-- name: GetAllTiles :many
+SELECT
+    (coords).x::int4 AS x,
+    (coords).y::int4 AS y,
+    terrain,
+    deposit,
+    richness
+FROM tiles;
+
+-- name: BatchCreateTiles :exec
+INSERT INTO tiles (
+    coords,
+    terrain,
+    deposit,
+    richness
+)
+SELECT
+    ROW(v.x, v.y)::coordinates,
+    v.terrain,
+    NULLIF(v.deposit, ''),
+    v.richness
+FROM (
+    SELECT
+        UNNEST(sqlc.arg(xs)::int[])            AS x,
+        UNNEST(sqlc.arg(ys)::int[])            AS y,
+        UNNEST(sqlc.arg(terrains)::text[])     AS terrain,
+        UNNEST(sqlc.arg(deposits)::text[])     AS deposit,
+        UNNEST(sqlc.arg(richnesses)::float8[]) AS richness
+) AS v;
+
+-- name: DeleteAllTiles :exec
+DELETE FROM tiles;
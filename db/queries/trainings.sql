@@ -0,0 +1,44 @@
+-- name: GetTrainingsByBarracks :many
+SELECT
+    training_id,
+    barracks_id,
+    troops,
+    training_start,
+    training_end
+FROM trainings
+WHERE barracks_id = $1
+ORDER BY created_at, training_id;
+
+-- name: CreateTraining :exec
+INSERT INTO trainings (
+    training_id,
+    barracks_id,
+    troops,
+    training_start,
+    training_end
+)
+VALUES (
+    sqlc.arg(training_id),
+    sqlc.arg(barracks_id),
+    sqlc.arg(troops),
+    sqlc.arg(training_start),
+    sqlc.arg(training_end)
+);
+
+-- name: DeleteTraining :exec
+DELETE FROM trainings
+WHERE training_id = $1;
+
+-- name: BatchUpdateTrainings :exec
+UPDATE trainings AS t
+SET
+    training_start = v.training_start,
+    training_end   = v.training_end,
+    updated_at     = NOW()
+FROM (
+    SELECT
+        UNNEST(sqlc.arg(training_ids)::text[])         AS training_id,
+        UNNEST(sqlc.arg(training_starts)::timestamp[]) AS training_start,
+        UNNEST(sqlc.arg(training_ends)::timestamp[])   AS training_end
+) AS v
+WHERE t.training_id = v.training_id;
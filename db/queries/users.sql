@@ -25,6 +25,13 @@ SET
     updated_at = NOW()
 WHERE user_id = $1;
 
+-- name: ResetUserStats :exec
+UPDATE users
+SET
+    gold       = $1,
+    food       = $2,
+    updated_at = NOW();
+
 -- name: UpdateUser :exec
 UPDATE users
 SET
@@ -37,12 +44,14 @@ WHERE user_id = $1;
 -- name: BatchUpdateUsers :exec
 UPDATE users AS u
 SET
-    gold = v.gold,
-    food = v.food
+    gold        = v.gold,
+    food        = v.food,
+    food_policy = v.food_policy
 FROM (
     SELECT
-        UNNEST(sqlc.arg(user_ids)::text[])  AS user_id,
-        UNNEST(sqlc.arg(golds)::int8[])     AS gold,
-        UNNEST(sqlc.arg(foods)::int8[])     AS food
+        UNNEST(sqlc.arg(user_ids)::text[])      AS user_id,
+        UNNEST(sqlc.arg(golds)::int8[])         AS gold,
+        UNNEST(sqlc.arg(foods)::int8[])         AS food,
+        UNNEST(sqlc.arg(food_policies)::text[]) AS food_policy
 ) AS v
 WHERE u.user_id = v.user_id;
\ No newline at end of file
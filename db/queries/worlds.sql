@@ -0,0 +1,68 @@
+-- name: GetWorld :one
+SELECT * FROM worlds
+WHERE world_id = 1;
+
+-- name: StartSeason :exec
+INSERT INTO worlds (
+    world_id, seed, season, state, season_ends_at
+)
+VALUES (
+    1, $1, $2, 'active', $3
+)
+ON CONFLICT (world_id) DO UPDATE
+SET
+    seed              = EXCLUDED.seed,
+    season            = EXCLUDED.season,
+    state             = EXCLUDED.state,
+    end_reason        = NULL,
+    season_started_at = NOW(),
+    season_ends_at    = EXCLUDED.season_ends_at,
+    updated_at        = NOW();
+
+-- name: EndSeason :execrows
+UPDATE worlds
+SET
+    state      = 'ending',
+    end_reason = $1,
+    updated_at = NOW()
+WHERE world_id = 1 AND state = 'active';
+
+-- name: ArchiveSeason :exec
+INSERT INTO seasons (
+    season, seed, end_reason, started_at
+)
+SELECT season, seed, COALESCE(end_reason, 'admin'), season_started_at
+FROM worlds
+WHERE world_id = 1
+ON CONFLICT (season) DO NOTHING;
+
+-- name: ArchiveSeasonStandings :exec
+-- Ranks every user by the population they hold at season end, gold breaking
+-- ties, and snapshots the result under the world's current season.
+INSERT INTO season_standings (
+    season, rank, user_id, username, cities, population, gold
+)
+SELECT
+    w.season,
+    RANK() OVER (ORDER BY COALESCE(SUM(c.population), 0) DESC, u.gold DESC)::int4,
+    u.user_id,
+    u.username,
+    COUNT(c.city_id)::int4,
+    COALESCE(SUM(c.population), 0)::float8,
+    u.gold
+FROM users u
+CROSS JOIN worlds w
+LEFT JOIN cities c ON c.owner = u.user_id
+WHERE w.world_id = 1
+GROUP BY w.season, u.user_id, u.username, u.gold
+ON CONFLICT (season, user_id) DO NOTHING;
+
+-- name: GetSeasons :many
+SELECT * FROM seasons
+ORDER BY season DESC;
+
+-- name: GetSeasonStandings :many
+SELECT * FROM season_standings
+WHERE season = $1
+ORDER BY rank, username
+LIMIT $2;
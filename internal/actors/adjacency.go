@@ -0,0 +1,25 @@
+package actors
+
+import (
+	"log/slog"
+	"slices"
+
+	"cityio/internal/constants"
+	"cityio/internal/messages"
+)
+
+// applyAdjacency re-evaluates the adjacency rules over the city's layout and
+// tells every building whose bonuses changed its new set. Buildings report
+// the set back through BuildingStateChangedMessage, so one that missed an
+// update, or was restored without one, is corrected on its next report.
+func (state *cityActor) applyAdjacency() {
+	for id, b := range state.buildings {
+		bonuses := constants.AdjacencyBonuses(b, state.buildings)
+		if slices.Equal(bonuses, b.Bonuses) {
+			continue
+		}
+		if err := state.Cluster.Tell("building", id, messages.SetAdjacencyBonusesMessage{Bonuses: bonuses}); err != nil {
+			slog.ErrorContext(state.Ctx(), "failed to send adjacency bonuses to building", "building_id", id, "error", err)
+		}
+	}
+}
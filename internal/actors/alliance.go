@@ -0,0 +1,420 @@
+package actors
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/cluster"
+
+	"cityio/internal/constants"
+	"cityio/internal/domain"
+	"cityio/internal/messages"
+	"cityio/internal/metrics"
+	"cityio/internal/persistence"
+	"cityio/internal/stream"
+)
+
+// allianceActor serializes the membership changes of one alliance,
+// identified by its alliance ID. Unlike most state, membership is written
+// through as it changes rather than enqueued: shared vision and caravan
+// deliveries are decided from the database, so it must never lag behind the
+// actor. Every change is pushed to all members' streams.
+type allianceActor struct {
+	baseActor
+	allianceID string
+	// alliance.Members is replaced rather than edited in place on every
+	// change, since responses and published snapshots share it.
+	alliance domain.Alliance
+	// invites holds the standing invites, keyed by invitee.
+	invites map[string]domain.AllianceInvite
+}
+
+func NewAllianceActor() BaseActorInterface {
+	return &allianceActor{invites: make(map[string]domain.AllianceInvite)}
+}
+
+func (state *allianceActor) ActorType() string {
+	return "alliance"
+}
+
+func (state *allianceActor) Receive(ctx actor.Context) {
+	switch msg := ctx.Message().(type) {
+	case *cluster.ClusterInit:
+		// Like markets, alliances aren't restored by setup: the first message
+		// activates one and it loads itself here. A new alliance finds
+		// nothing and waits for CreateAllianceMessage.
+		state.allianceID = msg.Identity.Identity
+		state.restore()
+
+	case messages.CreateAllianceMessage:
+		if err := state.create(msg.Name, msg.LeaderID); err != nil {
+			ctx.Respond(err)
+			return
+		}
+		ctx.Respond(&messages.CreateAllianceResponse{Alliance: state.alliance})
+
+	case messages.GetAllianceMessage:
+		if !state.exists() {
+			ctx.Respond(&messages.UnknownAllianceError{AllianceID: state.allianceID})
+			return
+		}
+		invites := make([]domain.AllianceInvite, 0, len(state.invites))
+		for _, i := range state.invites {
+			invites = append(invites, i)
+		}
+		slices.SortFunc(invites, func(a, b domain.AllianceInvite) int { return a.CreatedAt.Compare(b.CreatedAt) })
+		ctx.Respond(&messages.GetAllianceResponse{Alliance: state.alliance, Invites: invites})
+
+	case messages.InviteAllianceMemberMessage:
+		invite, err := state.invite(msg.UserID, msg.InviteeID)
+		if err != nil {
+			ctx.Respond(err)
+			return
+		}
+		ctx.Respond(&messages.InviteAllianceMemberResponse{Invite: invite})
+
+	case messages.AcceptAllianceInviteMessage:
+		if err := state.accept(msg.UserID); err != nil {
+			ctx.Respond(err)
+			return
+		}
+		ctx.Respond(&messages.AcceptAllianceInviteResponse{Alliance: state.alliance})
+
+	case messages.LeaveAllianceMessage:
+		respondAllianceResult(ctx, state.leave(msg.UserID))
+
+	case messages.KickAllianceMemberMessage:
+		respondAllianceResult(ctx, state.kick(msg.UserID, msg.MemberID))
+
+	case messages.SetAllianceRoleMessage:
+		respondAllianceResult(ctx, state.setRole(msg.UserID, msg.MemberID, msg.Role))
+	}
+}
+
+func respondAllianceResult(ctx actor.Context, err error) {
+	if err != nil {
+		ctx.Respond(err)
+		return
+	}
+	ctx.Respond(messages.Ack{})
+}
+
+// restore reloads the alliance and its standing invites from the database.
+// It runs on activation and again after every change that adds a member, so
+// the roster carries the usernames joined in by the query.
+func (state *allianceActor) restore() {
+	alliance, err := state.Store.GetAlliance(state.Ctx(), state.allianceID)
+	if errors.Is(err, persistence.ErrNotFound) {
+		state.alliance = domain.Alliance{}
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to load alliance", "alliance_id", state.allianceID, "error", err)
+		return
+	}
+	state.alliance = *alliance
+
+	invites, err := state.Store.GetAllianceInvites(state.Ctx(), state.allianceID)
+	if err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to load alliance invites", "alliance_id", state.allianceID, "error", err)
+		return
+	}
+	clear(state.invites)
+	for _, i := range invites {
+		state.invites[i.UserID] = i
+	}
+}
+
+// exists reports whether the alliance has been founded and not disbanded.
+func (state *allianceActor) exists() bool {
+	return len(state.alliance.Members) > 0
+}
+
+func (state *allianceActor) create(name, leaderID string) error {
+	if state.exists() {
+		return &messages.InvalidAllianceError{Reason: "alliance already exists"}
+	}
+	name = strings.TrimSpace(name)
+	if n := utf8.RuneCountInString(name); n < constants.MinAllianceNameLength || n > constants.MaxAllianceNameLength {
+		return &messages.InvalidAllianceError{Reason: fmt.Sprintf("name must be between %d and %d characters", constants.MinAllianceNameLength, constants.MaxAllianceNameLength)}
+	}
+	if err := state.checkUnaffiliated(leaderID); err != nil {
+		return err
+	}
+	taken, err := state.Store.AllianceNameTaken(state.Ctx(), name)
+	if err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to check alliance name", "alliance_id", state.allianceID, "error", err)
+		return &messages.InternalError{}
+	}
+	if taken {
+		return &messages.AllianceNameTakenError{Name: name}
+	}
+
+	now := time.Now()
+	if err := state.Store.CreateAlliance(state.Ctx(), domain.Alliance{AllianceID: state.allianceID, Name: name, CreatedAt: now}); err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to persist alliance", "alliance_id", state.allianceID, "error", err)
+		return &messages.InternalError{}
+	}
+	if err := state.addMember(leaderID, domain.AllianceRoleLeader, now); err != nil {
+		if err := state.Store.DeleteAlliance(state.Ctx(), state.allianceID); err != nil {
+			slog.ErrorContext(state.Ctx(), "failed to remove leaderless alliance", "alliance_id", state.allianceID, "error", err)
+		}
+		return err
+	}
+	metrics.AllianceMembershipChangesTotal.WithLabelValues("founded").Inc()
+	slog.InfoContext(state.Ctx(), "alliance founded", "alliance_id", state.allianceID, "name", name, "leader", leaderID)
+	state.restore()
+	state.publish()
+	return nil
+}
+
+func (state *allianceActor) invite(userID, inviteeID string) (domain.AllianceInvite, error) {
+	inviter, err := state.member(userID)
+	if err != nil {
+		return domain.AllianceInvite{}, err
+	}
+	if !inviter.Role.CanInvite() {
+		return domain.AllianceInvite{}, &messages.AlliancePermissionError{Role: inviter.Role}
+	}
+	if _, ok := state.alliance.Member(inviteeID); ok {
+		return domain.AllianceInvite{}, &messages.AlreadyInAllianceError{UserID: inviteeID}
+	}
+	if len(state.alliance.Members) >= constants.MaxAllianceMembers {
+		return domain.AllianceInvite{}, &messages.AllianceFullError{Limit: constants.MaxAllianceMembers}
+	}
+
+	invite := domain.AllianceInvite{
+		AllianceID:   state.allianceID,
+		AllianceName: state.alliance.Name,
+		UserID:       inviteeID,
+		InvitedBy:    userID,
+		CreatedAt:    time.Now(),
+	}
+	if err := state.Store.CreateAllianceInvite(state.Ctx(), invite); err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to persist alliance invite", "alliance_id", state.allianceID, "user_id", inviteeID, "error", err)
+		return domain.AllianceInvite{}, &messages.InternalError{}
+	}
+	state.invites[inviteeID] = invite
+	stream.Publish(inviteeID, stream.StateUpdate{AllianceInvite: &invite})
+	return invite, nil
+}
+
+func (state *allianceActor) accept(userID string) error {
+	if !state.exists() {
+		return &messages.UnknownAllianceError{AllianceID: state.allianceID}
+	}
+	if _, ok := state.invites[userID]; !ok {
+		return &messages.AllianceInviteNotFoundError{AllianceID: state.allianceID}
+	}
+	if len(state.alliance.Members) >= constants.MaxAllianceMembers {
+		return &messages.AllianceFullError{Limit: constants.MaxAllianceMembers}
+	}
+	if err := state.checkUnaffiliated(userID); err != nil {
+		return err
+	}
+	if err := state.addMember(userID, domain.AllianceRoleMember, time.Now()); err != nil {
+		return err
+	}
+	metrics.AllianceMembershipChangesTotal.WithLabelValues("joined").Inc()
+	slog.InfoContext(state.Ctx(), "player joined alliance", "alliance_id", state.allianceID, "user_id", userID)
+	state.restore()
+	state.publish()
+	return nil
+}
+
+func (state *allianceActor) leave(userID string) error {
+	leaving, err := state.member(userID)
+	if err != nil {
+		return err
+	}
+	if leaving.Role == domain.AllianceRoleLeader {
+		if successor, ok := state.alliance.Successor(); ok {
+			if err := state.updateRole(successor.UserID, domain.AllianceRoleLeader); err != nil {
+				return err
+			}
+		} else {
+			return state.disband()
+		}
+	}
+	if err := state.removeMember(userID); err != nil {
+		return err
+	}
+	metrics.AllianceMembershipChangesTotal.WithLabelValues("left").Inc()
+	slog.InfoContext(state.Ctx(), "player left alliance", "alliance_id", state.allianceID, "user_id", userID)
+	state.publish()
+	return nil
+}
+
+func (state *allianceActor) kick(userID, memberID string) error {
+	kicker, err := state.member(userID)
+	if err != nil {
+		return err
+	}
+	target, err := state.member(memberID)
+	if err != nil {
+		return err
+	}
+	if !kicker.Role.CanKick(target.Role) {
+		return &messages.AlliancePermissionError{Role: kicker.Role}
+	}
+	if err := state.removeMember(memberID); err != nil {
+		return err
+	}
+	metrics.AllianceMembershipChangesTotal.WithLabelValues("kicked").Inc()
+	slog.InfoContext(state.Ctx(), "player kicked from alliance", "alliance_id", state.allianceID, "user_id", memberID, "by", userID)
+	state.publish()
+	return nil
+}
+
+func (state *allianceActor) setRole(userID, memberID string, role domain.AllianceRole) error {
+	if !role.Valid() {
+		return &messages.InvalidAllianceError{Reason: fmt.Sprintf("unknown role %q", role)}
+	}
+	leader, err := state.member(userID)
+	if err != nil {
+		return err
+	}
+	if leader.Role != domain.AllianceRoleLeader {
+		return &messages.AlliancePermissionError{Role: leader.Role}
+	}
+	if memberID == userID {
+		return &messages.InvalidAllianceError{Reason: "the leader hands over by promoting another member"}
+	}
+	if _, err := state.member(memberID); err != nil {
+		return err
+	}
+	if role == domain.AllianceRoleLeader {
+		if err := state.updateRole(userID, domain.AllianceRoleOfficer); err != nil {
+			return err
+		}
+	}
+	if err := state.updateRole(memberID, role); err != nil {
+		return err
+	}
+	state.publish()
+	return nil
+}
+
+// disband deletes the alliance once its last member leaves. Members and
+// invites go with it by cascade.
+func (state *allianceActor) disband() error {
+	if err := state.Store.DeleteAlliance(state.Ctx(), state.allianceID); err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to delete alliance", "alliance_id", state.allianceID, "error", err)
+		return &messages.InternalError{}
+	}
+	for _, m := range state.alliance.Members {
+		state.publishDeparture(m.UserID)
+	}
+	metrics.AllianceMembershipChangesTotal.WithLabelValues("disbanded").Inc()
+	slog.InfoContext(state.Ctx(), "alliance disbanded", "alliance_id", state.allianceID)
+	state.alliance = domain.Alliance{}
+	clear(state.invites)
+	return nil
+}
+
+// checkUnaffiliated fails unless the player is in no alliance. It asks the
+// database, since the player may be a member of another alliance's actor.
+func (state *allianceActor) checkUnaffiliated(userID string) error {
+	_, err := state.Store.GetAllianceMembership(state.Ctx(), userID)
+	if errors.Is(err, persistence.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to look up alliance membership", "user_id", userID, "error", err)
+		return &messages.InternalError{}
+	}
+	return &messages.AlreadyInAllianceError{UserID: userID}
+}
+
+// member looks up a member of this alliance, failing if there is none.
+func (state *allianceActor) member(userID string) (domain.AllianceMember, error) {
+	if !state.exists() {
+		return domain.AllianceMember{}, &messages.UnknownAllianceError{AllianceID: state.allianceID}
+	}
+	m, ok := state.alliance.Member(userID)
+	if !ok {
+		return domain.AllianceMember{}, &messages.NotAllianceMemberError{UserID: userID}
+	}
+	return *m, nil
+}
+
+func (state *allianceActor) addMember(userID string, role domain.AllianceRole, joinedAt time.Time) error {
+	if err := state.Store.AddAllianceMember(state.Ctx(), domain.AllianceMember{
+		AllianceID: state.allianceID,
+		UserID:     userID,
+		Role:       role,
+		JoinedAt:   joinedAt,
+	}); err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to persist alliance member", "alliance_id", state.allianceID, "user_id", userID, "error", err)
+		return &messages.InternalError{}
+	}
+	if err := state.Store.DeleteAllianceInvitesByUser(state.Ctx(), userID); err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to drop invites of new member", "user_id", userID, "error", err)
+	}
+	delete(state.invites, userID)
+	return nil
+}
+
+// removeMember takes a member off the roster and tells them they are out.
+func (state *allianceActor) removeMember(userID string) error {
+	if err := state.Store.DeleteAllianceMember(state.Ctx(), userID); err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to delete alliance member", "alliance_id", state.allianceID, "user_id", userID, "error", err)
+		return &messages.InternalError{}
+	}
+	state.alliance.Members = slices.DeleteFunc(slices.Clone(state.alliance.Members), func(m domain.AllianceMember) bool {
+		return m.UserID == userID
+	})
+	state.publishDeparture(userID)
+	return nil
+}
+
+func (state *allianceActor) updateRole(userID string, role domain.AllianceRole) error {
+	if err := state.Store.UpdateAllianceMemberRole(state.Ctx(), userID, role); err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to persist alliance role", "alliance_id", state.allianceID, "user_id", userID, "error", err)
+		return &messages.InternalError{}
+	}
+	state.alliance.Members = slices.Clone(state.alliance.Members)
+	if m, ok := state.alliance.Member(userID); ok {
+		m.Role = role
+	}
+	return nil
+}
+
+// publish pushes the roster to every member's stream subscribers.
+func (state *allianceActor) publish() {
+	a := state.alliance
+	for _, m := range a.Members {
+		stream.Publish(m.UserID, stream.StateUpdate{Alliance: &a})
+	}
+}
+
+func (state *allianceActor) publishDeparture(userID string) {
+	id := state.allianceID
+	stream.Publish(userID, stream.StateUpdate{LeftAllianceID: &id})
+}
+
+// allied reports whether two players are members of the same alliance. It
+// reads the written-through membership, so any actor can ask.
+func (b *baseActor) allied(userID, otherID string) bool {
+	a, err := b.Store.GetAllianceMembership(b.Ctx(), userID)
+	if err != nil {
+		if !errors.Is(err, persistence.ErrNotFound) {
+			slog.ErrorContext(b.Ctx(), "failed to look up alliance membership", "user_id", userID, "error", err)
+		}
+		return false
+	}
+	o, err := b.Store.GetAllianceMembership(b.Ctx(), otherID)
+	if err != nil {
+		if !errors.Is(err, persistence.ErrNotFound) {
+			slog.ErrorContext(b.Ctx(), "failed to look up alliance membership", "user_id", otherID, "error", err)
+		}
+		return false
+	}
+	return a.AllianceID == o.AllianceID
+}
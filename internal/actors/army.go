@@ -1 +1,405 @@
 package actors
+
+import (
+	"fmt"
+	"log/slog"
+	"math/rand"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/google/uuid"
+
+	"cityio/internal/constants"
+	"cityio/internal/domain"
+	"cityio/internal/domain/combat"
+	"cityio/internal/messages"
+	"cityio/internal/metrics"
+	"cityio/internal/stream"
+	"cityio/internal/utils"
+)
+
+type armyActor struct {
+	baseActor
+	Army domain.Army
+
+	// stepsSinceBackup counts tiles crossed since the last enqueue. Position
+	// is persisted every TroopMovementBackupFrequency steps rather than per
+	// tile; arrival and re-routing always persist.
+	stepsSinceBackup int
+
+	// stepTimer fires a PeriodicOperationMessage when the army is due to
+	// enter its next tile. See scheduleNextStep.
+	stepTimer *time.Timer
+}
+
+func NewArmyActor() BaseActorInterface {
+	return &armyActor{}
+}
+
+func (state *armyActor) ActorType() string {
+	return "army"
+}
+
+func (state *armyActor) Receive(ctx actor.Context) {
+	switch msg := ctx.Message().(type) {
+
+	case *messages.CreateArmyMessage:
+		state.Army = msg.Army
+		if !msg.Restore {
+			if err := state.Store.CreateArmy(state.Ctx(), state.Army); err != nil {
+				slog.ErrorContext(state.Ctx(), "failed to persist army create", "army_id", state.Army.ArmyID, "error", err)
+			}
+		}
+		state.updateTile(state.Army.X, state.Army.Y, true)
+		state.scheduleNextStep(ctx)
+		state.publish()
+		ctx.Respond(messages.Ack{})
+
+	case messages.MoveArmyMessage:
+		if msg.X < 0 || msg.Y < 0 || msg.X >= constants.MapSize || msg.Y >= constants.MapSize {
+			ctx.Respond(&messages.OutOfBoundsError{X: msg.X, Y: msg.Y})
+			return
+		}
+		state.Army.DestinationX = msg.X
+		state.Army.DestinationY = msg.Y
+		state.Store.EnqueueArmy(state.Army)
+		// Keep an in-flight step rather than restarting it, so re-routing
+		// mid-march never makes the army cross a tile faster or slower.
+		if state.stepTimer == nil {
+			state.scheduleNextStep(ctx)
+		}
+		state.publish()
+		ctx.Respond(messages.Ack{})
+
+	case messages.GetArmyMessage:
+		ctx.Respond(&messages.GetArmyResponseMessage{
+			Army: state.Army,
+		})
+
+	case messages.DeleteArmyMessage:
+		state.disband(ctx)
+
+	case messages.ReconcileTilesMessage:
+		state.updateTile(state.Army.X, state.Army.Y, true)
+
+	case messages.PeriodicOperationMessage:
+		state.step(ctx)
+	}
+}
+
+// step moves the army one tile toward its destination once its step is due.
+// It is idempotent: a stray or early PeriodicOperationMessage is a no-op.
+func (state *armyActor) step(ctx actor.Context) {
+	state.stepTimer = nil
+	if !state.Army.Moving() {
+		return
+	}
+	if due := state.Army.NextStepAt.Time; due != nil && time.Now().Before(*due) {
+		state.armStepTimer(ctx, time.Until(*due))
+		return
+	}
+
+	x, y := state.Army.NextStep()
+	state.updateTile(state.Army.X, state.Army.Y, false)
+	state.Army.X = x
+	state.Army.Y = y
+	state.updateTile(x, y, true)
+	state.interceptCaravans(x, y)
+	metrics.ArmyStepsTotal.Inc()
+
+	state.stepsSinceBackup++
+	if state.stepsSinceBackup >= constants.TroopMovementBackupFrequency {
+		state.stepsSinceBackup = 0
+		state.Store.EnqueueArmy(state.Army)
+	}
+
+	if !state.Army.Moving() {
+		state.Army.NextStepAt = domain.NullTime{}
+		state.arrive(ctx)
+		return
+	}
+	state.scheduleNextStep(ctx)
+	state.publish()
+}
+
+// arrive runs when the army reaches its destination. Troops arriving at one
+// of their owner's cities fold back into its garrison and the army disbands;
+// arriving at a neutral town, or a city of a player the owner is at war
+// with, means battle with its garrison. On open ground, or at the city of a
+// player the owner isn't at war with, the army holds position until it is
+// given a new destination.
+func (state *armyActor) arrive(ctx actor.Context) {
+	state.stepsSinceBackup = 0
+	state.Store.EnqueueArmy(state.Army)
+	slog.DebugContext(state.Ctx(), "army arrived",
+		"army_id", state.Army.ArmyID,
+		"x", state.Army.X,
+		"y", state.Army.Y,
+	)
+
+	city, err := state.tileCity(state.Army.X, state.Army.Y)
+	if err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to resolve city at army destination", "army_id", state.Army.ArmyID, "error", err)
+		state.publish()
+		return
+	}
+	if city == nil {
+		state.publish()
+		return
+	}
+	if city.Owner == nil || state.atWar(state.Army.Owner, *city.Owner) {
+		state.fight(ctx, *city)
+		return
+	}
+	if *city.Owner != state.Army.Owner {
+		slog.InfoContext(state.Ctx(), "army holding outside city not at war", "army_id", state.Army.ArmyID, "city_id", city.CityID)
+		state.publish()
+		return
+	}
+
+	if _, err := state.Cluster.Request("city", city.CityID, messages.DepositTroopsMessage{Amount: state.Army.Troops}); err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to return troops to garrison", "army_id", state.Army.ArmyID, "city_id", city.CityID, "error", err)
+		state.publish()
+		return
+	}
+	state.disband(ctx)
+}
+
+// fight resolves a battle against the garrison of an enemy (or neutral)
+// city, applies both sides' losses, and reports the result to both players.
+// An army wiped out in the attack disbands. A victorious army on the city's
+// center captures it; otherwise survivors hold the tile.
+func (state *armyActor) fight(ctx actor.Context, city domain.City) {
+	centerLevel := state.cityCenterLevel(city.CityID)
+	terrainDefense := constants.GetTerrainDefense(state.tileTerrain(state.Army.X, state.Army.Y))
+	battle := combat.Battle{
+		Seed:      rand.Int63(),
+		Attackers: []combat.Stack{{Unit: combat.Infantry, Count: state.Army.Troops}},
+		Defenders: []combat.Stack{{Unit: combat.Infantry, Count: city.Troops}},
+		Modifiers: combat.Modifiers{CityCenterLevel: centerLevel, TerrainDefense: terrainDefense},
+	}
+	result := combat.Resolve(battle)
+	attackerLosses := combat.Total(result.AttackerLosses)
+	defenderLosses := combat.Total(result.DefenderLosses)
+
+	if defenderLosses > 0 {
+		if _, err := state.Cluster.Request("city", city.CityID, messages.GarrisonCasualtiesMessage{Amount: defenderLosses}); err != nil {
+			slog.ErrorContext(state.Ctx(), "failed to apply garrison casualties", "city_id", city.CityID, "error", err)
+		}
+	}
+
+	report := domain.BattleReport{
+		ReportID:        uuid.New().String(),
+		Seed:            battle.Seed,
+		ArmyID:          state.Army.ArmyID,
+		Attacker:        state.Army.Owner,
+		Defender:        city.Owner,
+		CityID:          city.CityID,
+		X:               state.Army.X,
+		Y:               state.Army.Y,
+		CityCenterLevel: centerLevel,
+		TerrainDefense:  terrainDefense,
+		AttackerTroops:  state.Army.Troops,
+		DefenderTroops:  city.Troops,
+		AttackerLosses:  attackerLosses,
+		DefenderLosses:  defenderLosses,
+		Outcome:         string(result.Outcome),
+		Rounds:          result.Rounds,
+		FoughtAt:        time.Now(),
+	}
+	if err := state.Store.CreateBattleReport(state.Ctx(), report); err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to persist battle report", "report_id", report.ReportID, "error", err)
+	}
+	stream.Publish(report.Attacker, stream.StateUpdate{BattleReport: &report})
+	if report.Defender != nil {
+		stream.Publish(*report.Defender, stream.StateUpdate{BattleReport: &report})
+	}
+	metrics.BattlesFoughtTotal.WithLabelValues(report.Outcome).Inc()
+	slog.InfoContext(state.Ctx(), "battle resolved",
+		"army_id", state.Army.ArmyID,
+		"city_id", city.CityID,
+		"outcome", report.Outcome,
+		"attacker_losses", attackerLosses,
+		"defender_losses", defenderLosses,
+	)
+
+	state.Army.Troops -= attackerLosses
+	if state.Army.Troops <= 0 {
+		state.disband(ctx)
+		return
+	}
+	if result.Outcome == combat.OutcomeAttackerVictory && state.Army.X == city.StartX+city.Size/2 && state.Army.Y == city.StartY+city.Size/2 {
+		state.capture(ctx, city)
+		return
+	}
+	state.Store.EnqueueArmy(state.Army)
+	state.publish()
+}
+
+// capture takes a city whose garrison the army has just wiped out while
+// standing on its center. Ownership passes to the army's owner and the
+// survivors garrison the city.
+func (state *armyActor) capture(ctx actor.Context, city domain.City) {
+	owner := state.Army.Owner
+	if _, err := state.Cluster.Request("city", city.CityID, messages.UpdateCityOwnerMessage{Owner: &owner}); err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to transfer city ownership", "army_id", state.Army.ArmyID, "city_id", city.CityID, "error", err)
+		state.Store.EnqueueArmy(state.Army)
+		state.publish()
+		return
+	}
+	slog.InfoContext(state.Ctx(), "city captured", "army_id", state.Army.ArmyID, "city_id", city.CityID, "owner", owner)
+	if _, err := state.Cluster.Request("city", city.CityID, messages.DepositTroopsMessage{Amount: state.Army.Troops}); err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to garrison captured city", "army_id", state.Army.ArmyID, "city_id", city.CityID, "error", err)
+		state.Store.EnqueueArmy(state.Army)
+		state.publish()
+		return
+	}
+	state.disband(ctx)
+}
+
+// cityCenterLevel returns the level of the city's center building, or 0 if
+// it cannot be found. The store locates the building; its actor has the live
+// level.
+func (state *armyActor) cityCenterLevel(cityID string) int {
+	buildings, err := state.Store.GetBuildingsByCity(state.Ctx(), cityID)
+	if err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to list city buildings", "city_id", cityID, "error", err)
+		return 0
+	}
+	for _, b := range buildings {
+		if !constants.IsCenter(b.BuildingType()) {
+			continue
+		}
+		res, err := state.Cluster.Request("building", b.BuildingID, messages.GetBuildingMessage{})
+		if err != nil {
+			return b.Level
+		}
+		if resp, ok := res.(*messages.GetBuildingResponseMessage); ok {
+			return resp.Building.Level
+		}
+		return b.Level
+	}
+	return 0
+}
+
+// tileTerrain returns the terrain of (x, y). A tile that can't be read counts
+// as grassland, open ground.
+func (state *armyActor) tileTerrain(x, y int) domain.Terrain {
+	res, err := state.Cluster.Request("tile", utils.GetTileIndex(x, y), messages.GetTileMessage{})
+	if err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to read battle tile", "army_id", state.Army.ArmyID, "x", x, "y", y, "error", err)
+		return domain.TerrainGrassland
+	}
+	tile, ok := res.(messages.GetTileResponseMessage)
+	if !ok {
+		return domain.TerrainGrassland
+	}
+	return tile.Terrain
+}
+
+// tileCity resolves the city standing on (x, y), or nil on open ground.
+func (state *armyActor) tileCity(x, y int) (*domain.City, error) {
+	res, err := state.Cluster.Request("tile", utils.GetTileIndex(x, y), messages.GetTileMessage{})
+	if err != nil {
+		return nil, err
+	}
+	tile, ok := res.(messages.GetTileResponseMessage)
+	if !ok {
+		return nil, fmt.Errorf("unexpected tile response: %T", res)
+	}
+	if tile.CityID == nil {
+		return nil, nil
+	}
+	res, err = state.Cluster.Request("city", *tile.CityID, messages.GetCityMessage{})
+	if err != nil {
+		return nil, err
+	}
+	city, ok := res.(*messages.GetCityResponseMessage)
+	if !ok {
+		return nil, fmt.Errorf("unexpected city response: %T", res)
+	}
+	return &city.City, nil
+}
+
+// interceptCaravans tells every caravan on (x, y) that the army has entered
+// its tile. Each caravan decides whether the army is hostile to it.
+func (state *armyActor) interceptCaravans(x, y int) {
+	res, err := state.Cluster.Request("tile", utils.GetTileIndex(x, y), messages.GetTileMessage{})
+	if err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to read army tile", "army_id", state.Army.ArmyID, "error", err)
+		return
+	}
+	tile, ok := res.(messages.GetTileResponseMessage)
+	if !ok {
+		return
+	}
+	for _, caravanID := range tile.CaravanIDs {
+		if err := state.Cluster.Tell("caravan", caravanID, messages.InterceptCaravanMessage{
+			ArmyID: state.Army.ArmyID,
+			Owner:  state.Army.Owner,
+			X:      x,
+			Y:      y,
+		}); err != nil {
+			slog.ErrorContext(state.Ctx(), "failed to intercept caravan", "army_id", state.Army.ArmyID, "caravan_id", caravanID, "error", err)
+		}
+	}
+}
+
+// disband removes the army from the map and persistence, tells the owner's
+// stream, and stops the actor.
+func (state *armyActor) disband(ctx actor.Context) {
+	if state.stepTimer != nil {
+		state.stepTimer.Stop()
+		state.stepTimer = nil
+	}
+	state.updateTile(state.Army.X, state.Army.Y, false)
+	if err := state.Store.DeleteArmy(state.Ctx(), state.Army.ArmyID); err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to delete army", "army_id", state.Army.ArmyID, "error", err)
+	}
+	id := state.Army.ArmyID
+	stream.Publish(state.Army.Owner, stream.StateUpdate{DeletedArmyID: &id})
+	slog.DebugContext(state.Ctx(), "shutting down ArmyActor", "army_id", state.Army.ArmyID)
+	ctx.Stop(ctx.Self())
+}
+
+// updateTile tells the tile at (x, y) that this army entered or left it.
+func (state *armyActor) updateTile(x, y int, present bool) {
+	if err := state.Cluster.Tell("tile", utils.GetTileIndex(x, y), messages.UpdateTileArmyMessage{
+		ArmyID:  state.Army.ArmyID,
+		Present: present,
+	}); err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to update army tile index", "army_id", state.Army.ArmyID, "error", err)
+	}
+}
+
+// scheduleNextStep stamps when the army enters its next tile and arms the
+// one-shot that delivers it, following the scheduleConstructionComplete
+// pattern: the timer only sends a PeriodicOperationMessage and step does the
+// work.
+func (state *armyActor) scheduleNextStep(ctx actor.Context) {
+	if !state.Army.Moving() {
+		state.Army.NextStepAt = domain.NullTime{}
+		return
+	}
+	next := time.Now().Add(time.Duration(constants.GetBalance().Troops.MovementSeconds) * time.Second)
+	state.Army.NextStepAt = domain.NullTime{Time: &next}
+	state.armStepTimer(ctx, time.Until(next))
+}
+
+func (state *armyActor) armStepTimer(ctx actor.Context, delay time.Duration) {
+	if state.stepTimer != nil {
+		state.stepTimer.Stop()
+	}
+	pid := ctx.Self()
+	system := ctx.ActorSystem()
+	state.stepTimer = time.AfterFunc(delay, func() {
+		system.Root.Send(pid, messages.PeriodicOperationMessage{})
+	})
+}
+
+// publish pushes the army's current state to its owner's StreamState
+// subscribers.
+func (state *armyActor) publish() {
+	a := state.Army
+	stream.Publish(state.Army.Owner, stream.StateUpdate{Army: &a})
+}
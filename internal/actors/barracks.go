@@ -1,15 +1,209 @@
 package actors
 
 import (
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
 	"github.com/asynkron/protoactor-go/actor"
+	"github.com/google/uuid"
+
+	"cityio/internal/constants"
+	"cityio/internal/domain"
+	"cityio/internal/messages"
+	"cityio/internal/metrics"
 )
 
-type barracksImpl struct{}
+// barracksImpl trains troops for its city's garrison on behalf of any
+// building defined with the trains flag. Orders wait in
+// Building.TrainingQueue in FIFO order; up to GetTrainingSlots(type, level)
+// batches train at once, and finished batches are deposited into the city.
+type barracksImpl struct {
+	// trainingTimer fires a PeriodicOperationMessage when the earliest
+	// training batch finishes. Same one-shot pattern as
+	// scheduleConstructionComplete.
+	trainingTimer *time.Timer
+}
+
+func (b *barracksImpl) Create(ctx actor.Context, state *buildingActor) {
+	trainings, err := state.Store.GetTrainingsByBarracks(state.Ctx(), state.Building.BuildingID)
+	if err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to load training queue", "building_id", state.Building.BuildingID, "error", err)
+	}
+	state.Building.TrainingQueue = trainings
+	b.startWaiting(state)
+	b.scheduleTrainingComplete(ctx, state)
+}
+
+func (b *barracksImpl) Destroy(ctx actor.Context, state *buildingActor) {
+	// Queued batches go with the building (trainings cascade on delete).
+	if b.trainingTimer != nil {
+		b.trainingTimer.Stop()
+		b.trainingTimer = nil
+	}
+}
+
+func (b *barracksImpl) Handle(ctx actor.Context, state *buildingActor) {
+	switch msg := ctx.Message().(type) {
 
-func newBarracksImpl() buildingActorImpl {
-	return &barracksImpl{}
+	case messages.TrainTroopsMessage:
+		if err := b.train(ctx, state, msg.Troops); err != nil {
+			ctx.Respond(err)
+			return
+		}
+		ctx.Respond(messages.Ack{})
+
+	case messages.PeriodicOperationMessage:
+		completed := b.completeTraining(state)
+		started := b.startWaiting(state)
+		if completed || started {
+			state.notifyStateChanged()
+			b.scheduleTrainingComplete(ctx, state)
+		}
+	}
 }
 
-func (b *barracksImpl) Create(ctx actor.Context, state *buildingActor)  {}
-func (b *barracksImpl) Destroy(ctx actor.Context, state *buildingActor) {}
-func (b *barracksImpl) Handle(ctx actor.Context, state *buildingActor)  {}
+func (b *barracksImpl) train(ctx actor.Context, state *buildingActor, troops int64) error {
+	if state.Building.Level < 1 {
+		return &messages.BuildingNotReadyError{BuildingID: state.Building.BuildingID}
+	}
+	if len(state.Building.TrainingQueue) >= constants.MaxTrainingQueue {
+		return &messages.TrainingQueueFullError{BarracksID: state.Building.BuildingID}
+	}
+
+	cost := constants.GetBalance().Troops
+	res, err := state.Cluster.Request("city", state.Building.CityID, messages.DeductOwnerGoldMessage{
+		Amount: troops * cost.GoldCost,
+		Food:   troops * cost.FoodCost,
+	})
+	if err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to charge for training", "error", err)
+		return err
+	}
+	switch msg := res.(type) {
+	case messages.Ack:
+		// continue training
+	case messages.InsufficientGoldError:
+		slog.WarnContext(state.Ctx(), "not enough gold", "needed", msg.Missing)
+		return &msg
+	case messages.InsufficientFoodError:
+		slog.WarnContext(state.Ctx(), "not enough food", "needed", msg.Missing)
+		return &msg
+	default:
+		slog.ErrorContext(state.Ctx(), "unexpected response type from user actor", "type", fmt.Sprintf("%T", res))
+		return fmt.Errorf("unexpected response type: %T", res)
+	}
+
+	training := domain.Training{
+		TrainingID: uuid.New().String(),
+		BarracksID: state.Building.BuildingID,
+		Troops:     troops,
+	}
+	if err := state.Store.CreateTraining(state.Ctx(), training); err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to persist training create", "training_id", training.TrainingID, "error", err)
+	}
+	state.Building.TrainingQueue = append(state.Building.TrainingQueue, training)
+	b.startWaiting(state)
+	state.notifyStateChanged()
+	b.scheduleTrainingComplete(ctx, state)
+	return nil
+}
+
+// completeTraining deposits every finished batch into the city's garrison.
+// A batch is only dropped from the queue once the city acks, so a failed
+// deposit is retried on the next tick.
+func (b *barracksImpl) completeTraining(state *buildingActor) bool {
+	now := time.Now()
+	remaining := make([]domain.Training, 0, len(state.Building.TrainingQueue))
+	completed := false
+	for _, t := range state.Building.TrainingQueue {
+		if !t.Active() || now.Before(*t.TrainingEnd.Time) {
+			remaining = append(remaining, t)
+			continue
+		}
+		res, err := state.Cluster.Request("city", state.Building.CityID, messages.DepositTroopsMessage{Amount: t.Troops})
+		if _, ok := res.(messages.Ack); err != nil || !ok {
+			slog.ErrorContext(state.Ctx(), "failed to deposit trained troops", "training_id", t.TrainingID, "error", err)
+			remaining = append(remaining, t)
+			continue
+		}
+		if err := state.Store.DeleteTraining(state.Ctx(), t.TrainingID); err != nil {
+			slog.ErrorContext(state.Ctx(), "failed to delete training", "training_id", t.TrainingID, "error", err)
+		}
+		metrics.TroopsTrainedTotal.Add(float64(t.Troops))
+		slog.InfoContext(state.Ctx(), "training complete",
+			"building_id", state.Building.BuildingID,
+			"training_id", t.TrainingID,
+			"troops", t.Troops,
+		)
+		completed = true
+	}
+	state.Building.TrainingQueue = remaining
+	return completed
+}
+
+// startWaiting moves waiting batches, oldest first, into any free training
+// slots. Training time is fixed by the barracks level when the batch starts.
+func (b *barracksImpl) startWaiting(state *buildingActor) bool {
+	if state.Building.Level < 1 {
+		return false
+	}
+	slots := constants.GetTrainingSlots(state.Building.BuildingType(), state.Building.Level)
+	active := 0
+	for _, t := range state.Building.TrainingQueue {
+		if t.Active() {
+			active++
+		}
+	}
+
+	// Work on a copy: earlier snapshots of the queue may still be in flight to
+	// the stream.
+	queue := slices.Clone(state.Building.TrainingQueue)
+	started := false
+	for i := range queue {
+		if active >= slots {
+			break
+		}
+		t := &queue[i]
+		if t.Active() {
+			continue
+		}
+		now := time.Now()
+		end := now.Add(time.Duration(t.Troops) * constants.GetTroopTrainingTime(state.Building.BuildingType(), state.Building.Level))
+		t.TrainingStart = domain.NullTime{Time: &now}
+		t.TrainingEnd = domain.NullTime{Time: &end}
+		state.Store.EnqueueTraining(*t)
+		active++
+		started = true
+	}
+	state.Building.TrainingQueue = queue
+	return started
+}
+
+// scheduleTrainingComplete arms a one-shot timer for the earliest training
+// end. The periodic tick remains the safety net.
+func (b *barracksImpl) scheduleTrainingComplete(ctx actor.Context, state *buildingActor) {
+	if b.trainingTimer != nil {
+		b.trainingTimer.Stop()
+		b.trainingTimer = nil
+	}
+	var next *time.Time
+	for _, t := range state.Building.TrainingQueue {
+		if t.Active() && (next == nil || t.TrainingEnd.Time.Before(*next)) {
+			next = t.TrainingEnd.Time
+		}
+	}
+	if next == nil {
+		return
+	}
+	delay := time.Until(*next)
+	if delay <= 0 {
+		return
+	}
+	pid := ctx.Self()
+	system := ctx.ActorSystem()
+	b.trainingTimer = time.AfterFunc(delay, func() {
+		system.Root.Send(pid, messages.PeriodicOperationMessage{})
+	})
+}
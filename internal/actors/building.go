@@ -39,6 +39,10 @@ type buildingActor struct {
 	// construction finishes, so completion is detected without waiting for the
 	// next BuildingTickInterval poll. See scheduleConstructionComplete.
 	constructionTimer *time.Timer
+
+	// techs are the owner's researched techs, passed on by the city. They
+	// scale this building's costs, construction times and production.
+	techs []domain.TechID
 }
 
 func NewBuildingActor() BaseActorInterface {
@@ -53,6 +57,7 @@ func (state *buildingActor) Receive(ctx actor.Context) {
 	switch msg := ctx.Message().(type) {
 	case *messages.CreateBuildingMessage:
 		state.Building = msg.Building
+		state.techs = msg.Techs
 		if !msg.Restore {
 			if msg.Construct {
 				now := time.Now()
@@ -60,6 +65,7 @@ func (state *buildingActor) Receive(ctx actor.Context) {
 					time.Duration(constants.GetBuildingConstructionTime(
 						state.Building.BuildingType(),
 						1,
+						state.techs,
 					)) * time.Second,
 				)
 				state.Building.ConstructionStart = domain.NullTime{Time: &now}
@@ -71,22 +77,15 @@ func (state *buildingActor) Receive(ctx actor.Context) {
 			if err := state.Store.CreateBuilding(state.Ctx(), state.Building); err != nil {
 				slog.ErrorContext(state.Ctx(), "failed to persist building create", "building_id", state.Building.BuildingID, "error", err)
 			}
+		} else {
+			// Construction that ended while the server was down completes now,
+			// before the ticker starts, so the building comes back at its new
+			// level and the city's catch-up sees it.
+			state.checkConstructionComplete()
 		}
-		switch state.Building.BuildingType() {
-		case domain.BuildingTypeCityCenter:
-			state.Impl = newCityCenterImpl()
-		case domain.BuildingTypeTownCenter:
-			state.Impl = newTownCenterImpl()
-		case domain.BuildingTypeMine:
-			state.Impl = newMineImpl()
-		case domain.BuildingTypeFarm:
-			state.Impl = newFarmImpl()
-		case domain.BuildingTypeHouse:
-			state.Impl = newHouseImpl()
-		case domain.BuildingTypeBarracks:
-			state.Impl = newBarracksImpl()
-		}
+		state.Impl = newDefinedImpl(state)
 
+		state.Building.Yield = state.depositYield()
 		state.Impl.Create(ctx, state)
 		_, err := state.Cluster.Request("tile", utils.GetTileIndex(state.Building.X, state.Building.Y), messages.UpdateTileBuildingMessage{
 			BuildingID: &state.Building.BuildingID,
@@ -94,37 +93,69 @@ func (state *buildingActor) Receive(ctx actor.Context) {
 		if err != nil {
 			slog.ErrorContext(state.Ctx(), "failed to signal tiles of building existence", "error", err)
 		}
-		if !msg.Restore {
-			state.notifyStateChanged()
-		}
+		// Restored buildings report too: the city rebuilds its view of its
+		// buildings (center level, active constructions) from these.
+		state.notifyStateChanged()
 		state.startPeriodicOperation(ctx)
 		state.scheduleConstructionComplete(ctx)
 		ctx.Respond(messages.Ack{})
 
 	case messages.UpgradeBuildingMessage:
-		if err := state.upgrade(ctx); err != nil {
-			ctx.Respond(err)
+		if err := state.upgrade(ctx, msg.Prepaid); err != nil {
+			if msg.Prepaid > 0 {
+				if tellErr := state.Cluster.Tell("city", state.Building.CityID, messages.ConstructionOrderRejectedMessage{
+					BuildingID: state.Building.BuildingID,
+					Refund:     msg.Prepaid,
+				}); tellErr != nil {
+					slog.ErrorContext(state.Ctx(), "failed to return prepaid upgrade to city", "error", tellErr)
+				}
+			}
+			if ctx.Sender() != nil {
+				ctx.Respond(err)
+			}
 			return
 		}
-		ctx.Respond(messages.Ack{})
+		if ctx.Sender() != nil {
+			ctx.Respond(messages.Ack{})
+		}
+
+	case messages.SetTechsMessage:
+		state.techs = msg.Techs
+
+	case messages.SetAdjacencyBonusesMessage:
+		state.Building.Bonuses = msg.Bonuses
+		state.notifyStateChanged()
 
 	case messages.GetBuildingMessage:
 		ctx.Respond(&messages.GetBuildingResponseMessage{
 			Building: state.Building,
 		})
 
+	case messages.CancelConstructionMessage:
+		res, err := state.cancelConstruction(ctx)
+		if err != nil {
+			ctx.Respond(err)
+			return
+		}
+		ctx.Respond(res)
+
+	case messages.SpeedUpConstructionMessage:
+		res, err := state.speedUpConstruction(ctx, msg.Skip)
+		if err != nil {
+			ctx.Respond(err)
+			return
+		}
+		ctx.Respond(res)
+
 	case messages.DeleteBuildingMessage:
-		state.Impl.Destroy(ctx, state)
-		state.stopPeriodicOperation()
-		state.reportPopulation(0)
-		state.Cluster.Tell("city", state.Building.CityID, messages.BuildingDestroyedMessage{
-			BuildingID: state.Building.BuildingID,
-		})
-		state.destroy(ctx)
+		state.remove(ctx)
 
 	case messages.ReconcileTilesMessage:
 		state.reaffirmTile()
 
+	case messages.RepublishBuildingMessage:
+		state.notifyStateChanged()
+
 	case messages.PeriodicOperationMessage:
 		state.reaffirmTile()
 		state.checkConstructionComplete()
@@ -158,6 +189,23 @@ func (state *buildingActor) reaffirmTile() {
 	}
 }
 
+// depositYield asks the building's tile for the deposit under it and returns
+// the multiplier it gives this building's production. Tiles of a world
+// generated before terrain know of no deposits; buildings there produce at
+// the ordinary rate.
+func (state *buildingActor) depositYield() float64 {
+	res, err := state.Cluster.Request("tile", utils.GetTileIndex(state.Building.X, state.Building.Y), messages.GetTileMessage{})
+	if err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to read deposit under building", "building_id", state.Building.BuildingID, "error", err)
+		return 1
+	}
+	tile, ok := res.(messages.GetTileResponseMessage)
+	if !ok || tile.Terrain == "" {
+		return 1
+	}
+	return constants.GetDepositYield(state.Building.BuildingType(), tile.Deposit)
+}
+
 func (state *buildingActor) checkConstructionComplete() {
 	if !state.constructionActive() {
 		return
@@ -187,31 +235,40 @@ func (state *buildingActor) constructionActive() bool {
 	return (state.Building.Level != state.Building.TargetLevel) || (state.Building.ConstructionStart.Time != nil && state.Building.ConstructionEnd.Time != nil)
 }
 
-func (state *buildingActor) upgrade(ctx actor.Context) error {
+// upgrade starts construction of the next level. Unless prepaid covers it (a
+// queued order the city already charged and found a slot for), the city checks
+// its rules and slots and charges the owner first.
+func (state *buildingActor) upgrade(ctx actor.Context, prepaid int64) error {
 	if state.constructionActive() {
 		return &messages.ConstructionInProgressError{BuildingID: state.Building.BuildingID}
 	}
 	buildingType := state.Building.BuildingType()
-	if state.Building.Level >= constants.MAX_BUILDING_LEVEL {
+	if state.Building.Level >= constants.GetMaxBuildingLevel(buildingType, state.techs) {
 		return &messages.MaxLevelReachedError{BuildingID: state.Building.BuildingID}
 	}
 
-	res, err := state.Cluster.Request("city", state.Building.CityID, messages.DeductOwnerGoldMessage{
-		Amount: constants.GetBuildingCost(buildingType, state.Building.Level+1),
-	})
-	if err != nil {
-		slog.ErrorContext(state.Ctx(), "failed to deduct gold for upgrade", "error", err)
-		return err
-	}
-	switch msg := res.(type) {
-	case messages.Ack:
-		// continue upgrade
-	case messages.InsufficientGoldError:
-		slog.WarnContext(state.Ctx(), "not enough gold", "needed", msg.Missing)
-		return &msg
-	default:
-		slog.ErrorContext(state.Ctx(), "unexpected response type from user actor", "type", fmt.Sprintf("%T", res))
-		return fmt.Errorf("unexpected response type: %T", res)
+	if prepaid == 0 {
+		res, err := state.Cluster.Request("city", state.Building.CityID, messages.StartUpgradeMessage{
+			BuildingID:   state.Building.BuildingID,
+			BuildingType: buildingType,
+			Level:        state.Building.Level + 1,
+		})
+		if err != nil {
+			slog.ErrorContext(state.Ctx(), "failed to start upgrade through city", "error", err)
+			return err
+		}
+		switch msg := res.(type) {
+		case *messages.StartUpgradeResponseMessage:
+			// continue upgrade
+		case *messages.InsufficientGoldError:
+			slog.WarnContext(state.Ctx(), "not enough gold", "needed", msg.Missing)
+			return msg
+		case error:
+			return msg
+		default:
+			slog.ErrorContext(state.Ctx(), "unexpected response type from city actor", "type", fmt.Sprintf("%T", res))
+			return fmt.Errorf("unexpected response type: %T", res)
+		}
 	}
 
 	targetLevel := state.Building.Level + 1
@@ -220,6 +277,7 @@ func (state *buildingActor) upgrade(ctx actor.Context) error {
 		time.Duration(constants.GetBuildingConstructionTime(
 			buildingType,
 			targetLevel,
+			state.techs,
 		)) * time.Second,
 	)
 	state.Building.TargetLevel = targetLevel
@@ -233,6 +291,113 @@ func (state *buildingActor) upgrade(ctx actor.Context) error {
 	return nil
 }
 
+// cancelConstruction abandons the active construction and refunds the owner
+// GetBalance().Construction.CancelRefund of its cost, prorated by the time
+// remaining. A
+// building that was never finished is removed outright. A construction whose
+// end has passed is finished, not cancelled, even if its completion tick
+// hasn't run yet.
+func (state *buildingActor) cancelConstruction(ctx actor.Context) (*messages.CancelConstructionResponseMessage, error) {
+	if !state.constructionActive() {
+		return nil, &messages.NoConstructionInProgressError{BuildingID: state.Building.BuildingID}
+	}
+	if end := state.Building.ConstructionEnd.Time; end != nil && !time.Now().Before(*end) {
+		state.checkConstructionComplete()
+		return nil, &messages.NoConstructionInProgressError{BuildingID: state.Building.BuildingID}
+	}
+	buildingType := state.Building.BuildingType()
+	cost := constants.GetBuildingCost(buildingType, state.Building.TargetLevel, state.techs)
+	remaining := 1.0
+	if start, end := state.Building.ConstructionStart.Time, state.Building.ConstructionEnd.Time; start != nil && end != nil {
+		if total := end.Sub(*start); total > 0 {
+			remaining = min(max(time.Until(*end).Seconds()/total.Seconds(), 0), 1)
+		}
+	}
+	refund := int64(float64(cost) * constants.GetBalance().Construction.CancelRefund * remaining)
+	if refund > 0 {
+		if err := state.Cluster.Tell("city", state.Building.CityID, messages.RefundOwnerGoldMessage{Amount: refund}); err != nil {
+			slog.ErrorContext(state.Ctx(), "failed to refund cancelled construction", "error", err)
+		}
+	}
+	metrics.ConstructionCancelsTotal.WithLabelValues(string(buildingType)).Inc()
+
+	if state.Building.Level == 0 {
+		state.remove(ctx)
+		return &messages.CancelConstructionResponseMessage{Refund: refund, Removed: true}, nil
+	}
+
+	if state.constructionTimer != nil {
+		state.constructionTimer.Stop()
+		state.constructionTimer = nil
+	}
+	state.Building.TargetLevel = state.Building.Level
+	state.Building.ConstructionStart = domain.NullTime{}
+	state.Building.ConstructionEnd = domain.NullTime{}
+	state.Store.EnqueueBuilding(state.Building)
+	state.notifyStateChanged()
+	return &messages.CancelConstructionResponseMessage{Refund: refund}, nil
+}
+
+// speedUpConstruction charges the owner for skipping construction time and
+// pulls ConstructionEnd forward. Completion still goes through
+// checkConstructionComplete: the one-shot timer is rearmed for the new end, or
+// a tick is sent straight away when nothing remains.
+func (state *buildingActor) speedUpConstruction(ctx actor.Context, skip time.Duration) (*messages.SpeedUpConstructionResponseMessage, error) {
+	end := state.Building.ConstructionEnd.Time
+	if !state.constructionActive() || end == nil {
+		return nil, &messages.NoConstructionInProgressError{BuildingID: state.Building.BuildingID}
+	}
+	remaining := time.Until(*end)
+	if remaining <= 0 {
+		return nil, &messages.NoConstructionInProgressError{BuildingID: state.Building.BuildingID}
+	}
+	if skip <= 0 || skip > remaining {
+		skip = remaining
+	}
+
+	cost := constants.GetSpeedUpCost(skip)
+	res, err := state.Cluster.Request("city", state.Building.CityID, messages.DeductOwnerGoldMessage{Amount: cost})
+	if err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to deduct gold for speed-up", "error", err)
+		return nil, err
+	}
+	switch msg := res.(type) {
+	case messages.Ack:
+	case messages.InsufficientGoldError:
+		return nil, &msg
+	default:
+		return nil, fmt.Errorf("unexpected response type: %T", res)
+	}
+
+	newEnd := end.Add(-skip)
+	state.Building.ConstructionEnd = domain.NullTime{Time: &newEnd}
+	state.Store.EnqueueBuilding(state.Building)
+	metrics.ConstructionSpeedUpGoldTotal.WithLabelValues(string(state.Building.BuildingType())).Add(float64(cost))
+
+	if skip == remaining {
+		if state.constructionTimer != nil {
+			state.constructionTimer.Stop()
+			state.constructionTimer = nil
+		}
+		ctx.Send(ctx.Self(), messages.PeriodicOperationMessage{})
+	} else {
+		state.scheduleConstructionComplete(ctx)
+		state.notifyStateChanged()
+	}
+	return &messages.SpeedUpConstructionResponseMessage{Cost: cost, Building: state.Building}, nil
+}
+
+// remove tears the building down: its city forgets it and the actor stops.
+func (state *buildingActor) remove(ctx actor.Context) {
+	state.Impl.Destroy(ctx, state)
+	state.stopPeriodicOperation()
+	state.reportPopulation(0)
+	state.Cluster.Tell("city", state.Building.CityID, messages.BuildingDestroyedMessage{
+		BuildingID: state.Building.BuildingID,
+	})
+	state.destroy(ctx)
+}
+
 func (state *buildingActor) destroy(ctx actor.Context) {
 	if err := state.Store.DeleteBuilding(state.Ctx(), state.Building.BuildingID); err != nil {
 		slog.ErrorContext(state.Ctx(), "failed to delete building", "building_id", state.Building.BuildingID, "error", err)
@@ -247,11 +412,12 @@ func (state *buildingActor) destroy(ctx actor.Context) {
 }
 
 // creditProduction accumulates produced resources and forwards them to the
-// city, which credits its owner. The pending total is only cleared once the
-// city acks, so a dropped or failed tick is retried on the next one.
+// city, which credits its owner. Amounts are scaled by the building's
+// adjacency bonuses. The pending total is only cleared once the city acks, so
+// a dropped or failed tick is retried on the next one.
 func (state *buildingActor) creditProduction(gold, food int64) {
-	state.pendingGold += gold
-	state.pendingFood += food
+	state.pendingGold += state.Building.ApplyBonus("gold", gold)
+	state.pendingFood += state.Building.ApplyBonus("food", food)
 	if state.pendingGold == 0 && state.pendingFood == 0 {
 		return
 	}
@@ -281,12 +447,13 @@ func (state *buildingActor) populationLevel() int {
 }
 
 // reportPopulation tells the city this building's absolute contribution to the
-// population cap. It is idempotent (keyed by building) and fire-and-forget to
-// avoid deadlocking against a city that is mid-create awaiting this building.
+// population cap, scaled by its adjacency bonuses. It is idempotent (keyed by
+// building) and fire-and-forget to avoid deadlocking against a city that is
+// mid-create awaiting this building.
 func (state *buildingActor) reportPopulation(population float64) {
 	if err := state.Cluster.Tell("city", state.Building.CityID, messages.SetBuildingPopulationMessage{
 		BuildingID: state.Building.BuildingID,
-		Population: population,
+		Population: population * state.Building.BonusMultiplier("population"),
 	}); err != nil {
 		slog.ErrorContext(state.Ctx(), "failed to report building population to city", "error", err)
 	}
@@ -0,0 +1,301 @@
+package actors
+
+import (
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+
+	"cityio/internal/constants"
+	"cityio/internal/domain"
+	"cityio/internal/messages"
+	"cityio/internal/metrics"
+	"cityio/internal/stream"
+	"cityio/internal/utils"
+)
+
+// caravanActor moves a caravan across the map the way armyActor moves an
+// army. Its cargo was loaded at the source city when it set out; it ends up
+// in the destination city, with the army that intercepts it, or back at the
+// source if the destination turned hostile on the way.
+type caravanActor struct {
+	baseActor
+	Caravan domain.Caravan
+
+	// stepsSinceBackup counts tiles crossed since the last enqueue, as for
+	// armies.
+	stepsSinceBackup int
+
+	stepTimer *time.Timer
+}
+
+func NewCaravanActor() BaseActorInterface {
+	return &caravanActor{}
+}
+
+func (state *caravanActor) ActorType() string {
+	return "caravan"
+}
+
+func (state *caravanActor) Receive(ctx actor.Context) {
+	switch msg := ctx.Message().(type) {
+
+	case *messages.CreateCaravanMessage:
+		state.Caravan = msg.Caravan
+		if !msg.Restore {
+			if err := state.Store.CreateCaravan(state.Ctx(), state.Caravan); err != nil {
+				slog.ErrorContext(state.Ctx(), "failed to persist caravan create", "caravan_id", state.Caravan.CaravanID, "error", err)
+			}
+		}
+		state.updateTile(state.Caravan.X, state.Caravan.Y, true)
+		state.scheduleNextStep(ctx)
+		state.publish()
+		ctx.Respond(messages.Ack{})
+
+	case messages.GetCaravanMessage:
+		ctx.Respond(&messages.GetCaravanResponseMessage{
+			Caravan: state.Caravan,
+		})
+
+	case messages.InterceptCaravanMessage:
+		if state.Caravan.CaravanID == "" || state.Caravan.X != msg.X || state.Caravan.Y != msg.Y {
+			return
+		}
+		if !state.hostileTo(msg.Owner) {
+			return
+		}
+		state.intercepted(ctx, msg.ArmyID, msg.Owner)
+
+	case messages.ReconcileTilesMessage:
+		state.updateTile(state.Caravan.X, state.Caravan.Y, true)
+
+	case messages.PeriodicOperationMessage:
+		state.step(ctx)
+	}
+}
+
+// step moves the caravan one tile toward its destination once its step is
+// due, then checks the tile for hostile armies. Like armyActor.step it is
+// idempotent.
+func (state *caravanActor) step(ctx actor.Context) {
+	state.stepTimer = nil
+	if !state.Caravan.Moving() {
+		return
+	}
+	if due := state.Caravan.NextStepAt.Time; due != nil && time.Now().Before(*due) {
+		state.armStepTimer(ctx, time.Until(*due))
+		return
+	}
+
+	x, y := state.Caravan.NextStep()
+	state.updateTile(state.Caravan.X, state.Caravan.Y, false)
+	state.Caravan.X = x
+	state.Caravan.Y = y
+	state.updateTile(x, y, true)
+	metrics.CaravanStepsTotal.Inc()
+
+	if armyID, owner, ok := state.hostileArmyAt(x, y); ok {
+		state.intercepted(ctx, armyID, owner)
+		return
+	}
+
+	state.stepsSinceBackup++
+	if state.stepsSinceBackup >= constants.TroopMovementBackupFrequency {
+		state.stepsSinceBackup = 0
+		state.Store.EnqueueCaravan(state.Caravan)
+	}
+
+	if !state.Caravan.Moving() {
+		state.Caravan.NextStepAt = domain.NullTime{}
+		state.arrive(ctx)
+		return
+	}
+	state.scheduleNextStep(ctx)
+	state.publish()
+}
+
+// arrive unloads the caravan into its destination city. A destination that
+// no longer accepts the caravan — captured or gone neutral on the way — gets
+// nothing, and the cargo goes back to the source city.
+func (state *caravanActor) arrive(ctx actor.Context) {
+	city, err := state.getCity(state.Caravan.DestinationCityID)
+	if err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to resolve caravan destination", "caravan_id", state.Caravan.CaravanID, "error", err)
+		state.returnCargo(ctx)
+		return
+	}
+	if !state.acceptsCaravan(*city) {
+		slog.InfoContext(state.Ctx(), "caravan destination turned hostile", "caravan_id", state.Caravan.CaravanID, "city_id", city.CityID)
+		state.returnCargo(ctx)
+		return
+	}
+	if _, err := state.Cluster.Request("city", city.CityID, messages.UnloadCaravanMessage{
+		CaravanID: state.Caravan.CaravanID,
+		Gold:      state.Caravan.Gold,
+		Food:      state.Caravan.Food,
+	}); err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to unload caravan", "caravan_id", state.Caravan.CaravanID, "city_id", city.CityID, "error", err)
+		state.returnCargo(ctx)
+		return
+	}
+	slog.DebugContext(state.Ctx(), "caravan delivered", "caravan_id", state.Caravan.CaravanID, "city_id", city.CityID)
+	metrics.CaravansFinishedTotal.WithLabelValues("delivered").Inc()
+	state.disband(ctx)
+}
+
+// intercepted hands the cargo to the owner of the army that caught the
+// caravan and removes it from the map.
+func (state *caravanActor) intercepted(ctx actor.Context, armyID, owner string) {
+	if err := state.Cluster.Tell("user", owner, messages.CreditUserMessage{
+		Gold: state.Caravan.Gold,
+		Food: state.Caravan.Food,
+	}); err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to credit intercepted cargo", "caravan_id", state.Caravan.CaravanID, "army_id", armyID, "error", err)
+	}
+	slog.InfoContext(state.Ctx(), "caravan intercepted",
+		"caravan_id", state.Caravan.CaravanID,
+		"army_id", armyID,
+		"gold", state.Caravan.Gold,
+		"food", state.Caravan.Food,
+	)
+	metrics.CaravansFinishedTotal.WithLabelValues("intercepted").Inc()
+	state.disband(ctx)
+}
+
+// returnCargo unloads the cargo back at the source city and removes the
+// caravan. A source the owner has since lost can't take it, so the cargo goes
+// to the owner's pool instead.
+func (state *caravanActor) returnCargo(ctx actor.Context) {
+	if city, err := state.getCity(state.Caravan.SourceCityID); err == nil && city.Owner != nil && *city.Owner == state.Caravan.Owner {
+		if _, err := state.Cluster.Request("city", city.CityID, messages.UnloadCaravanMessage{
+			CaravanID: state.Caravan.CaravanID,
+			Gold:      state.Caravan.Gold,
+			Food:      state.Caravan.Food,
+		}); err == nil {
+			metrics.CaravansFinishedTotal.WithLabelValues("returned").Inc()
+			state.disband(ctx)
+			return
+		}
+	}
+	if err := state.Cluster.Tell("user", state.Caravan.Owner, messages.CreditUserMessage{
+		Gold: state.Caravan.Gold,
+		Food: state.Caravan.Food,
+	}); err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to return caravan cargo", "caravan_id", state.Caravan.CaravanID, "error", err)
+	}
+	metrics.CaravansFinishedTotal.WithLabelValues("returned").Inc()
+	state.disband(ctx)
+}
+
+// hostileArmyAt finds an army on (x, y) whose owner is hostile to the
+// caravan's.
+func (state *caravanActor) hostileArmyAt(x, y int) (string, string, bool) {
+	res, err := state.Cluster.Request("tile", utils.GetTileIndex(x, y), messages.GetTileMessage{})
+	if err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to read caravan tile", "caravan_id", state.Caravan.CaravanID, "error", err)
+		return "", "", false
+	}
+	tile, ok := res.(messages.GetTileResponseMessage)
+	if !ok {
+		return "", "", false
+	}
+	for _, armyID := range tile.ArmyIDs {
+		res, err := state.Cluster.Request("army", armyID, messages.GetArmyMessage{})
+		if err != nil {
+			continue
+		}
+		army, ok := res.(*messages.GetArmyResponseMessage)
+		if !ok || army.Army.ArmyID == "" || army.Army.Troops <= 0 {
+			continue
+		}
+		if state.hostileTo(army.Army.Owner) {
+			return army.Army.ArmyID, army.Army.Owner, true
+		}
+	}
+	return "", "", false
+}
+
+func (state *caravanActor) getCity(cityID string) (*domain.City, error) {
+	res, err := state.Cluster.Request("city", cityID, messages.GetCityMessage{})
+	if err != nil {
+		return nil, err
+	}
+	city, ok := res.(*messages.GetCityResponseMessage)
+	if !ok {
+		return nil, fmt.Errorf("unexpected city response: %T", res)
+	}
+	return &city.City, nil
+}
+
+// disband removes the caravan from the map and persistence, tells the
+// owner's stream, and stops the actor.
+func (state *caravanActor) disband(ctx actor.Context) {
+	if state.stepTimer != nil {
+		state.stepTimer.Stop()
+		state.stepTimer = nil
+	}
+	state.updateTile(state.Caravan.X, state.Caravan.Y, false)
+	if err := state.Store.DeleteCaravan(state.Ctx(), state.Caravan.CaravanID); err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to delete caravan", "caravan_id", state.Caravan.CaravanID, "error", err)
+	}
+	id := state.Caravan.CaravanID
+	stream.Publish(state.Caravan.Owner, stream.StateUpdate{DeletedCaravanID: &id})
+	slog.DebugContext(state.Ctx(), "shutting down CaravanActor", "caravan_id", state.Caravan.CaravanID)
+	ctx.Stop(ctx.Self())
+}
+
+// updateTile tells the tile at (x, y) that this caravan entered or left it.
+func (state *caravanActor) updateTile(x, y int, present bool) {
+	if err := state.Cluster.Tell("tile", utils.GetTileIndex(x, y), messages.UpdateTileCaravanMessage{
+		CaravanID: state.Caravan.CaravanID,
+		Present:   present,
+	}); err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to update caravan tile index", "caravan_id", state.Caravan.CaravanID, "error", err)
+	}
+}
+
+// scheduleNextStep stamps when the caravan enters its next tile and arms the
+// one-shot that delivers it. See armyActor.scheduleNextStep.
+func (state *caravanActor) scheduleNextStep(ctx actor.Context) {
+	if !state.Caravan.Moving() {
+		state.Caravan.NextStepAt = domain.NullTime{}
+		return
+	}
+	next := time.Now().Add(time.Duration(constants.GetBalance().Caravans.MovementSeconds) * time.Second)
+	state.Caravan.NextStepAt = domain.NullTime{Time: &next}
+	state.armStepTimer(ctx, time.Until(next))
+}
+
+func (state *caravanActor) armStepTimer(ctx actor.Context, delay time.Duration) {
+	if state.stepTimer != nil {
+		state.stepTimer.Stop()
+	}
+	pid := ctx.Self()
+	system := ctx.ActorSystem()
+	state.stepTimer = time.AfterFunc(delay, func() {
+		system.Root.Send(pid, messages.PeriodicOperationMessage{})
+	})
+}
+
+// publish pushes the caravan's current state to its owner's StreamState
+// subscribers.
+func (state *caravanActor) publish() {
+	c := state.Caravan
+	stream.Publish(state.Caravan.Owner, stream.StateUpdate{Caravan: &c})
+}
+
+// hostileTo reports whether armies of other may intercept the caravan: only
+// those of players at war with its owner.
+func (state *caravanActor) hostileTo(other string) bool {
+	return state.atWar(state.Caravan.Owner, other)
+}
+
+// acceptsCaravan reports whether city takes the caravan's delivery: the
+// owner's own cities do, and so do their allies'.
+func (state *caravanActor) acceptsCaravan(city domain.City) bool {
+	if city.Owner == nil {
+		return false
+	}
+	return *city.Owner == state.Caravan.Owner || state.allied(state.Caravan.Owner, *city.Owner)
+}
@@ -0,0 +1,163 @@
+package actors
+
+import (
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"cityio/internal/constants"
+	"cityio/internal/domain"
+	"cityio/internal/messages"
+	"cityio/internal/metrics"
+	"cityio/internal/persistence"
+	"cityio/internal/stream"
+)
+
+// Chat goes out through the sender's user actor, which checks their mute and
+// rate limit before the message is stored and fanned out to its audience.
+// The rate limit is a token bucket of ChatBurst messages refilled one every
+// ChatRefillSeconds; it lives in memory only, so a restart forgives it.
+
+// restoreChatMute loads the user's mute after a restart.
+func (state *userActor) restoreChatMute() {
+	mute, err := state.Store.GetChatMute(state.Ctx(), state.User.UserID)
+	if errors.Is(err, persistence.ErrNotFound) {
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to load chat mute", "user_id", state.User.UserID, "error", err)
+		return
+	}
+	state.chatMute = mute
+}
+
+func (state *userActor) sendChat(channel domain.ChatChannel, body string) (*domain.ChatMessage, error) {
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return nil, &messages.InvalidChatMessageError{Reason: "message is empty"}
+	}
+	if utf8.RuneCountInString(body) > constants.MaxChatMessageLength {
+		return nil, &messages.InvalidChatMessageError{Reason: "message is too long"}
+	}
+
+	now := time.Now()
+	if state.chatMute != nil && state.chatMute.Active(now) {
+		metrics.ChatMessagesRejectedTotal.WithLabelValues("muted").Inc()
+		return nil, &messages.ChatMutedError{Until: state.chatMute.MutedUntil}
+	}
+	recipients, err := state.chatRecipients(channel)
+	if err != nil {
+		return nil, err
+	}
+	if wait, ok := state.takeChatToken(now); !ok {
+		metrics.ChatMessagesRejectedTotal.WithLabelValues("rate_limited").Inc()
+		return nil, &messages.ChatRateLimitedError{RetryAfter: wait}
+	}
+
+	message := domain.ChatMessage{
+		MessageID:  uuid.New().String(),
+		Channel:    channel,
+		Sender:     state.User.UserID,
+		SenderName: state.User.Username,
+		Body:       body,
+		SentAt:     now,
+	}
+	if err := state.Store.CreateChatMessage(state.Ctx(), message); err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to persist chat message", "user_id", state.User.UserID, "channel", channel.Key(), "error", err)
+		return nil, &messages.InternalError{}
+	}
+	if channel.Kind == domain.ChatChannelGlobal {
+		stream.PublishChatAll(message)
+	}
+	for _, userID := range recipients {
+		stream.PublishChat(userID, message)
+	}
+	metrics.ChatMessagesTotal.WithLabelValues(string(channel.Kind)).Inc()
+	return &message, nil
+}
+
+// chatRecipients returns the players a message on channel is delivered to,
+// after checking the user may post there. The global channel reaches every
+// subscriber, so it has none of its own.
+func (state *userActor) chatRecipients(channel domain.ChatChannel) ([]string, error) {
+	userID := state.User.UserID
+	switch channel.Kind {
+	case domain.ChatChannelGlobal:
+		return nil, nil
+
+	case domain.ChatChannelAlliance:
+		alliance, err := state.Store.GetAlliance(state.Ctx(), channel.ID)
+		if errors.Is(err, persistence.ErrNotFound) {
+			return nil, &messages.InvalidChatMessageError{Reason: "not a member of this alliance"}
+		}
+		if err != nil {
+			slog.ErrorContext(state.Ctx(), "failed to load alliance", "alliance_id", channel.ID, "error", err)
+			return nil, &messages.InternalError{}
+		}
+		if _, ok := alliance.Member(userID); !ok {
+			return nil, &messages.InvalidChatMessageError{Reason: "not a member of this alliance"}
+		}
+		recipients := make([]string, 0, len(alliance.Members))
+		for _, m := range alliance.Members {
+			recipients = append(recipients, m.UserID)
+		}
+		return recipients, nil
+
+	case domain.ChatChannelDirect:
+		a, b, ok := channel.Participants()
+		if !ok || (a != userID && b != userID) {
+			return nil, &messages.InvalidChatMessageError{Reason: "not a party to this conversation"}
+		}
+		return []string{a, b}, nil
+	}
+	return nil, &messages.InvalidChatMessageError{Reason: "unknown channel"}
+}
+
+// takeChatToken refills the user's bucket for the time since it was last
+// used and takes a token from it. When the bucket is empty it reports how
+// long, rounded up to the second, until the next token.
+func (state *userActor) takeChatToken(now time.Time) (time.Duration, bool) {
+	refill := time.Duration(constants.ChatRefillSeconds) * time.Second
+	if state.chatTokensAt.IsZero() {
+		state.chatTokens = constants.ChatBurst
+	} else {
+		state.chatTokens = min(constants.ChatBurst, state.chatTokens+float64(now.Sub(state.chatTokensAt))/float64(refill))
+	}
+	state.chatTokensAt = now
+	if state.chatTokens < 1 {
+		wait := time.Duration((1 - state.chatTokens) * float64(refill))
+		return (wait + time.Second - 1).Truncate(time.Second), false
+	}
+	state.chatTokens--
+	return 0, true
+}
+
+func (state *userActor) muteChat(msg messages.MuteUserMessage) error {
+	mute := domain.ChatMute{
+		UserID:     state.User.UserID,
+		MutedUntil: msg.Until,
+		Reason:     msg.Reason,
+		MutedBy:    msg.MutedBy,
+		CreatedAt:  time.Now(),
+	}
+	if err := state.Store.CreateChatMute(state.Ctx(), mute); err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to persist chat mute", "user_id", mute.UserID, "error", err)
+		return &messages.InternalError{}
+	}
+	state.chatMute = &mute
+	slog.InfoContext(state.Ctx(), "user muted", "user_id", mute.UserID, "muted_by", mute.MutedBy, "until", mute.MutedUntil)
+	return nil
+}
+
+func (state *userActor) unmuteChat() error {
+	if err := state.Store.DeleteChatMute(state.Ctx(), state.User.UserID); err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to delete chat mute", "user_id", state.User.UserID, "error", err)
+		return &messages.InternalError{}
+	}
+	state.chatMute = nil
+	return nil
+}
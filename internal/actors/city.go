@@ -1,9 +1,11 @@
 package actors
 
 import (
+	"fmt"
 	"log/slog"
 	"math"
 	"math/rand"
+	"slices"
 	"time"
 
 	"github.com/asynkron/protoactor-go/actor"
@@ -26,6 +28,12 @@ type cityActor struct {
 	// is idempotent under resends and fully rebuilt from buildings on restore.
 	populationContributions map[string]float64
 
+	// buildings is the latest state each building reported through
+	// BuildingStateChangedMessage. The build queue reads center level and
+	// active constructions from it; like populationContributions it is
+	// rebuilt from the buildings themselves on restore.
+	buildings map[string]domain.Building
+
 	// pendingFoodIncome holds food produced by this city's buildings since the
 	// last tick. It is consumed locally first; only the surplus is deposited to
 	// the user's pool.
@@ -40,6 +48,14 @@ type cityActor struct {
 	// drain exactly match the displayed FoodUpkeep.
 	demandRemainder int64
 
+	// taxRemainder carries the sub-tick part of the per-hour tax income into
+	// the next tick, as demandRemainder does for upkeep.
+	taxRemainder int64
+
+	// techs are the owner's researched techs, pushed by the user actor and
+	// passed on to the city's buildings.
+	techs []domain.TechID
+
 	ticker       *time.Ticker
 	stopTickerCh chan struct{}
 }
@@ -58,6 +74,9 @@ func (state *cityActor) Receive(ctx actor.Context) {
 	case *messages.CreateCityMessage:
 		state.City = msg.City
 		state.populationContributions = make(map[string]float64)
+		state.buildings = make(map[string]domain.Building)
+		state.City.ConstructionSlots = constants.GetConstructionSlots(0)
+		state.syncTechs()
 
 		if !msg.Restore {
 			if err := state.Store.CreateCity(state.Ctx(), msg.City); err != nil {
@@ -75,10 +94,15 @@ func (state *cityActor) Receive(ctx actor.Context) {
 			// initial population: pop=250 demands ~33 food/tick, one L1 farm
 			// produces ~33 food/tick. Towns don't need one (they're unowned).
 			if msg.City.Type == domain.CityTypeCity {
-				state.spawnInitialBuilding(domain.BuildingTypeFarm, msg.City.StartX+1, msg.City.StartY+1)
+				farmX, farmY := state.starterFarmTile(centerX, centerY)
+				state.spawnInitialBuilding(domain.BuildingTypeFarm, farmX, farmY)
 			}
+			state.startPeriodicOperation(ctx)
+		} else {
+			// A restored city's ticker starts after CatchUpMessage, once its
+			// buildings have reported in.
+			state.restoreConstructionQueue()
 		}
-		state.startPeriodicOperation(ctx)
 
 		startX := msg.City.StartX
 		startY := msg.City.StartY
@@ -97,35 +121,78 @@ func (state *cityActor) Receive(ctx actor.Context) {
 		}
 		ctx.Respond(messages.Ack{})
 
+	case messages.CatchUpMessage:
+		state.catchUp()
+		state.startPeriodicOperation(ctx)
+		ctx.Respond(messages.Ack{})
+
 	case messages.UpdateCityOwnerMessage:
 		// The city is the sole authority for ownership; buildings and tiles no
-		// longer cache it, so there is nothing to propagate.
-		state.City.Owner = msg.Owner
+		// longer cache it, so nothing needs to propagate beyond the store and
+		// the two owners' streams.
+		state.transferOwnership(msg.Owner)
+		if ctx.Sender() != nil {
+			ctx.Respond(messages.Ack{})
+		}
 
 	case messages.BuildingStateChangedMessage:
 		// Real state change (created, upgrade started, upgrade complete) — push
 		// the building proto and the city snapshot together so the player sees
 		// both the new level and the cap/food-rate fields it implies in the
 		// same emit.
+		state.trackBuilding(msg.Building)
 		if state.City.Owner != nil {
 			b := msg.Building
 			stream.Publish(*state.City.Owner, stream.StateUpdate{Building: &b})
 			state.publish()
 		}
+		state.applyAdjacency()
+		state.startQueuedConstruction()
+
+	case messages.FoodPoolGrantMessage:
+		// Starvation is already decided from local production; the grant only
+		// feeds the pool metrics.
+		metrics.FoodWithdrawnTotal.Add(float64(msg.Granted))
+		switch {
+		case msg.Granted >= msg.Requested:
+			metrics.FoodPoolGrantsTotal.WithLabelValues("full").Inc()
+		case msg.Granted > 0:
+			metrics.FoodPoolGrantsTotal.WithLabelValues("partial").Inc()
+		default:
+			metrics.FoodPoolGrantsTotal.WithLabelValues("empty").Inc()
+		}
+
+	case messages.SetImportPriorityMessage:
+		state.City.ImportPriority = msg.Priority
+		state.Store.EnqueueCity(state.City)
+		state.publish()
+		ctx.Respond(messages.Ack{})
+
+	case messages.SetTechsMessage:
+		state.techs = msg.Techs
+		for id := range state.buildings {
+			state.sendTechs(id)
+		}
+
+	case messages.SetTaxRateMessage:
+		state.City.TaxRate = msg.Rate
+		state.Store.EnqueueCity(state.City)
+		state.publish()
+		ctx.Respond(messages.Ack{})
 
 	case messages.BuildingDestroyedMessage:
 		delete(state.populationContributions, msg.BuildingID)
-		var cap float64
-		for _, p := range state.populationContributions {
-			cap += p
-		}
-		state.City.PopulationCap = cap
+		delete(state.buildings, msg.BuildingID)
+		state.dropOrdersForBuilding(msg.BuildingID)
+		state.refreshPopulationCap()
 		// Real state change — emit the deletion id and the updated city
 		// snapshot (with the lower cap) together.
 		if state.City.Owner != nil {
 			stream.Publish(*state.City.Owner, stream.StateUpdate{DeletedBuildingID: &msg.BuildingID})
 			state.publish()
 		}
+		state.applyAdjacency()
+		state.startQueuedConstruction()
 
 	case messages.SetBuildingPopulationMessage:
 		// Buildings re-report the same contribution on every periodic tick;
@@ -140,11 +207,7 @@ func (state *cityActor) Receive(ctx actor.Context) {
 			return
 		}
 		state.populationContributions[msg.BuildingID] = msg.Population
-		var cap float64
-		for _, p := range state.populationContributions {
-			cap += p
-		}
-		state.City.PopulationCap = cap
+		state.refreshPopulationCap()
 		state.publish()
 
 	case messages.CreditProductionMessage:
@@ -152,10 +215,11 @@ func (state *cityActor) Receive(ctx actor.Context) {
 			ctx.Respond(messages.Ack{})
 			return
 		}
-		state.pendingFoodIncome += msg.Food
-		if msg.Gold > 0 {
+		output := state.moraleOutput()
+		state.pendingFoodIncome += int64(math.Round(float64(msg.Food) * output))
+		if gold := int64(math.Round(float64(msg.Gold) * output)); gold > 0 {
 			if _, err := state.Cluster.Request("user", *state.City.Owner, messages.CreditUserMessage{
-				Gold: msg.Gold,
+				Gold: gold,
 			}); err != nil {
 				slog.ErrorContext(state.Ctx(), "failed to credit gold production to owner", "error", err)
 				ctx.Respond(&messages.InternalError{})
@@ -171,6 +235,7 @@ func (state *cityActor) Receive(ctx actor.Context) {
 		}
 		res, err := state.Cluster.Request("user", *state.City.Owner, messages.CheckAndDeductGoldMessage{
 			Amount: msg.Amount,
+			Food:   msg.Food,
 		})
 		if err != nil {
 			slog.ErrorContext(state.Ctx(), "failed to deduct gold from owner", "error", err)
@@ -179,6 +244,104 @@ func (state *cityActor) Receive(ctx actor.Context) {
 		}
 		ctx.Respond(res)
 
+	case messages.StartUpgradeMessage:
+		charged, err := state.startUpgrade(msg)
+		if err != nil {
+			ctx.Respond(err)
+			return
+		}
+		ctx.Respond(&messages.StartUpgradeResponseMessage{Charged: charged})
+
+	case messages.PlaceBuildingMessage:
+		building, err := state.placeBuilding(msg.Building, true)
+		if err != nil {
+			ctx.Respond(err)
+			return
+		}
+		ctx.Respond(&messages.PlaceBuildingResponseMessage{Building: building})
+
+	case messages.EnqueueConstructionMessage:
+		order, err := state.enqueueConstruction(msg)
+		if err != nil {
+			ctx.Respond(err)
+			return
+		}
+		ctx.Respond(&messages.EnqueueConstructionResponseMessage{Order: order})
+
+	case messages.ReorderConstructionMessage:
+		if err := state.reorderConstruction(msg.OrderIDs); err != nil {
+			ctx.Respond(err)
+			return
+		}
+		ctx.Respond(messages.Ack{})
+
+	case messages.CancelConstructionOrderMessage:
+		if err := state.cancelConstructionOrder(msg.OrderID); err != nil {
+			ctx.Respond(err)
+			return
+		}
+		ctx.Respond(messages.Ack{})
+
+	case messages.ConstructionOrderRejectedMessage:
+		// The optimistic start recorded in trackBuilding didn't happen; the
+		// building's next report restores its real state.
+		if b, ok := state.buildings[msg.BuildingID]; ok && b.TargetLevel > b.Level && b.ConstructionEnd.Time == nil {
+			b.TargetLevel = b.Level
+			state.buildings[msg.BuildingID] = b
+		}
+		state.refundOwner(msg.Refund)
+		metrics.ConstructionOrdersTotal.WithLabelValues("dropped").Inc()
+		state.startQueuedConstruction()
+
+	case messages.RefundOwnerGoldMessage:
+		state.refundOwner(msg.Amount)
+
+	case messages.WithdrawTroopsMessage:
+		if missing := msg.Amount - state.City.Troops; missing > 0 {
+			ctx.Respond(&messages.InsufficientTroopsError{Missing: missing})
+			return
+		}
+		state.City.Troops -= msg.Amount
+		state.publish()
+		ctx.Respond(messages.Ack{})
+
+	case messages.DepositTroopsMessage:
+		state.City.Troops += msg.Amount
+		state.publish()
+		if ctx.Sender() != nil {
+			ctx.Respond(messages.Ack{})
+		}
+
+	case messages.LoadCaravanMessage:
+		if err := state.loadCaravan(msg.Gold, msg.Food); err != nil {
+			ctx.Respond(err)
+			return
+		}
+		state.Store.EnqueueCity(state.City)
+		state.publish()
+		ctx.Respond(messages.Ack{})
+
+	case messages.UnloadCaravanMessage:
+		state.City.FoodStore += msg.Food
+		if msg.Gold > 0 && state.City.Owner != nil {
+			if err := state.Cluster.Tell("user", *state.City.Owner, messages.CreditUserMessage{Gold: msg.Gold}); err != nil {
+				slog.ErrorContext(state.Ctx(), "failed to credit caravan gold to owner", "caravan_id", msg.CaravanID, "error", err)
+			}
+		}
+		state.Store.EnqueueCity(state.City)
+		state.publish()
+		if ctx.Sender() != nil {
+			ctx.Respond(messages.Ack{})
+		}
+
+	case messages.GarrisonCasualtiesMessage:
+		state.City.Troops -= min(msg.Amount, state.City.Troops)
+		state.Store.EnqueueCity(state.City)
+		state.publish()
+		if ctx.Sender() != nil {
+			ctx.Respond(messages.Ack{})
+		}
+
 	case messages.ReconcileTilesMessage:
 		for dx := range state.City.Size {
 			for dy := range state.City.Size {
@@ -204,12 +367,199 @@ func (state *cityActor) Receive(ctx actor.Context) {
 		ctx.Stop(ctx.Self())
 
 	case messages.PeriodicOperationMessage:
+		// Retries a queue stalled on gold once income has come in.
+		state.startQueuedConstruction()
+		state.collectTax()
 		state.tickFoodAndPopulation()
+		state.stepMorale()
+		state.checkRevolt()
+		state.City.LastTickAt = time.Now().UTC()
 		state.Store.EnqueueCity(state.City)
 		state.publish()
 	}
 }
 
+// transferOwnership hands the city to a new owner (nil for neutral). The owner
+// is written through to the store so visibility checks see it at once, food
+// accounting restarts against the new owner's pool, and the previous owner's
+// client is told to drop the city and its buildings.
+func (state *cityActor) transferOwnership(owner *string) {
+	previous := state.City.Owner
+	if previous == owner || (previous != nil && owner != nil && *previous == *owner) {
+		return
+	}
+	// Queued orders were the previous owner's; refund them before the owner
+	// changes hands.
+	for _, o := range slices.Clone(state.City.ConstructionQueue) {
+		state.removeConstructionOrder(o.OrderID)
+		state.refundOwner(o.Paid)
+		metrics.ConstructionOrdersTotal.WithLabelValues("dropped").Inc()
+	}
+	state.City.Owner = owner
+	state.City.Capital = false
+	// The new owner's techs replace the old owner's once they arrive.
+	state.techs = nil
+	for id := range state.buildings {
+		state.sendTechs(id)
+	}
+	state.syncTechs()
+	if err := state.Store.UpdateCityOwner(state.Ctx(), state.City.CityID, owner); err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to persist city owner", "city_id", state.City.CityID, "error", err)
+	}
+
+	// The upkeep and tax remainders were carried against the old owner, and
+	// the new one starts with a population neither content nor restless.
+	state.demandRemainder = 0
+	state.taxRemainder = 0
+	state.City.Morale = constants.MoraleBase
+	state.City.Unrest = false
+	state.City.UnrestTicks = 0
+	// Only the derived figures change hands; food and population step on the
+	// next periodic tick as usual.
+	state.refreshPopulationCap()
+	state.refreshFoodBalance()
+	state.Store.EnqueueCity(state.City)
+
+	buildings, err := state.Store.GetBuildingsByCity(state.Ctx(), state.City.CityID)
+	if err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to list buildings for ownership transfer", "city_id", state.City.CityID, "error", err)
+	}
+	if previous != nil {
+		for _, b := range buildings {
+			id := b.BuildingID
+			stream.Publish(*previous, stream.StateUpdate{DeletedBuildingID: &id})
+		}
+		id := state.City.CityID
+		stream.Publish(*previous, stream.StateUpdate{DeletedCityID: &id})
+	}
+	state.publish()
+	// Buildings re-announce themselves through BuildingStateChangedMessage,
+	// which now relays their live state to the new owner.
+	for _, b := range buildings {
+		if err := state.Cluster.Tell("building", b.BuildingID, messages.RepublishBuildingMessage{}); err != nil {
+			slog.ErrorContext(state.Ctx(), "failed to republish building", "building_id", b.BuildingID, "error", err)
+		}
+	}
+
+	metrics.CitiesCapturedTotal.WithLabelValues(string(state.City.Type)).Inc()
+	slog.InfoContext(state.Ctx(), "city ownership transferred", "city_id", state.City.CityID, "type", state.City.Type)
+}
+
+// placeBuilding validates a player-ordered building against the city block and
+// its tile, charges the level-1 cost to the owner unless charge is false (the
+// order was paid when queued), and starts construction. Gold charged here is
+// refunded if the building actor can't be created.
+func (state *cityActor) placeBuilding(building domain.Building, charge bool) (domain.Building, error) {
+	buildingType := building.BuildingType()
+	if !constants.IsPlaceable(buildingType) {
+		return building, &messages.InvalidBuildingTypeError{BuildingType: buildingType}
+	}
+	if state.City.Owner == nil {
+		return building, &messages.InternalError{}
+	}
+	if !state.City.Contains(building.X, building.Y) {
+		return building, &messages.OutOfCityBoundsError{CityID: state.City.CityID, X: building.X, Y: building.Y}
+	}
+	if err := state.checkBuildingRules(buildingType, 1, true, false); err != nil {
+		return building, err
+	}
+	if err := state.checkConstructionSlot(); err != nil {
+		return building, err
+	}
+
+	tile, err := state.getTile(building.X, building.Y)
+	if err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to check tile for placement", "error", err)
+		return building, err
+	}
+	if tile.BuildingID != nil {
+		return building, &messages.TileOccupiedError{X: building.X, Y: building.Y, BuildingID: *tile.BuildingID}
+	}
+	if err := checkGround(tile, building.X, building.Y, buildingType); err != nil {
+		return building, err
+	}
+
+	var cost int64
+	if charge {
+		cost = constants.GetBuildingCost(buildingType, 1, state.techs)
+		if err := state.chargeOwner(cost); err != nil {
+			return building, err
+		}
+	}
+
+	building.Level = 0
+	building.TargetLevel = 1
+	if _, err := state.Cluster.Request("building", building.BuildingID, &messages.CreateBuildingMessage{
+		Building:  building,
+		Restore:   false,
+		Construct: true,
+		Techs:     state.techs,
+	}); err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to create building actor, refunding", "building_id", building.BuildingID, "error", err)
+		state.refundOwner(cost)
+		return building, err
+	}
+	// Counted at once, ahead of its first report, so the next placement sees
+	// it against the building limits.
+	state.buildings[building.BuildingID] = building
+	return building, nil
+}
+
+// starterFarmTile picks where a new capital's starter farm goes: the usual
+// (StartX+1, StartY+1) unless its ground rules a farm out, in which case the
+// first tile of the block, row by row, that allows one and isn't the center.
+func (state *cityActor) starterFarmTile(centerX, centerY int) (int, int) {
+	preferredX, preferredY := state.City.StartX+1, state.City.StartY+1
+	candidates := [][2]int{{preferredX, preferredY}}
+	for dy := range state.City.Size {
+		for dx := range state.City.Size {
+			x, y := state.City.StartX+dx, state.City.StartY+dy
+			if (x != centerX || y != centerY) && (x != preferredX || y != preferredY) {
+				candidates = append(candidates, [2]int{x, y})
+			}
+		}
+	}
+	for _, c := range candidates {
+		tile, err := state.getTile(c[0], c[1])
+		if err != nil {
+			break
+		}
+		if checkGround(tile, c[0], c[1], domain.BuildingTypeFarm) == nil {
+			return c[0], c[1]
+		}
+	}
+	return preferredX, preferredY
+}
+
+// getTile asks the tile at (x, y) for its ground and occupancy.
+func (state *cityActor) getTile(x, y int) (messages.GetTileResponseMessage, error) {
+	res, err := state.Cluster.Request("tile", utils.GetTileIndex(x, y), messages.GetTileMessage{})
+	if err != nil {
+		return messages.GetTileResponseMessage{}, err
+	}
+	tile, ok := res.(messages.GetTileResponseMessage)
+	if !ok {
+		return messages.GetTileResponseMessage{}, fmt.Errorf("unexpected tile response: %T", res)
+	}
+	return tile, nil
+}
+
+// checkGround rejects a building of type bt at (x, y) when the tile's terrain
+// forbids it or the tile lacks the deposit it works. Tiles of a world
+// generated before terrain know of neither and accept anything.
+func checkGround(tile messages.GetTileResponseMessage, x, y int, bt domain.BuildingType) error {
+	if tile.Terrain == "" {
+		return nil
+	}
+	if !constants.AllowsTerrain(bt, tile.Terrain) {
+		return &messages.TerrainNotAllowedError{X: x, Y: y, Terrain: tile.Terrain, BuildingType: bt}
+	}
+	if !constants.DepositSupports(bt, tile.Deposit) {
+		return &messages.MissingDepositError{X: x, Y: y, BuildingType: bt, Required: constants.GetRequiredDeposit(bt)}
+	}
+	return nil
+}
+
 // spawnInitialBuilding kicks off a fully-built level-1 building inside the
 // city block. Used during city creation for the center and (for capitals) the
 // starter farm.
@@ -233,8 +583,8 @@ func (state *cityActor) spawnInitialBuilding(buildingType domain.BuildingType, x
 }
 
 // tickFoodAndPopulation runs the per-tick food loop for the city: consume the
-// city's own production first, deposit any surplus to the user pool or request
-// the shortfall from it, then grow or decline the population.
+// city's own production first, store or deposit any surplus or request the
+// shortfall from the user pool, then grow or decline the population.
 //
 // Growth/decline is decided by *local* production vs demand — the pool can no
 // longer rescue a deficit city's population. A city that imports its food
@@ -246,20 +596,28 @@ func (state *cityActor) tickFoodAndPopulation() {
 		metrics.CityTickDurationSeconds.Observe(time.Since(start).Seconds())
 	}()
 
+	production := state.pendingFoodIncome
+	state.pendingFoodIncome = 0
+	surplus, shortfall := state.stepFoodAndPopulation(production)
+	state.settleFood(surplus, shortfall)
+}
+
+// stepFoodAndPopulation advances the city's food balance and population by
+// one tick given the food its buildings produced in it, and returns what the
+// city has left over for the pool or must import from it. It touches nothing
+// outside the city, so offline catch-up can replay it tick by tick.
+func (state *cityActor) stepFoodAndPopulation(production int64) (surplus, shortfall int64) {
 	if state.City.Owner == nil {
 		state.City.FoodProductionRate = 0
 		state.City.FoodUpkeep = 0
 		state.City.NetFoodFlow = 0
 		state.City.Starving = false
 		state.growPopulation(false, 0, 0)
-		return
+		return 0, 0
 	}
 
-	production := state.pendingFoodIncome
-	state.pendingFoodIncome = 0
-
 	tickSecs := constants.CityTickInterval
-	upkeepPerHour := int64(math.Round(state.City.Population * float64(constants.FoodPerPopPerHour)))
+	upkeepPerHour := state.foodUpkeepPerHour()
 
 	// Carry the sub-tick remainder so the actual per-tick demand averages
 	// exactly to upkeepPerHour over time. See demandRemainder doc.
@@ -273,52 +631,212 @@ func (state *cityActor) tickFoodAndPopulation() {
 	state.City.FoodUpkeep = upkeepPerHour
 	state.City.NetFoodFlow = productionPerHour - upkeepPerHour
 
+	// Food delivered by caravan counts as local supply, drawn only for what
+	// the city's own farms don't cover.
+	if production < demand && state.City.FoodStore > 0 {
+		drawn := min(state.City.FoodStore, demand-production)
+		state.City.FoodStore -= drawn
+		production += drawn
+	}
+
 	if production >= demand {
-		// Local surplus: deposit, no starvation, scale growth by surplus.
-		if surplus := production - demand; surplus > 0 {
-			if err := state.Cluster.Tell("user", *state.City.Owner, messages.DepositFoodMessage{Amount: surplus}); err != nil {
-				slog.ErrorContext(state.Ctx(), "failed to deposit surplus food to pool", "error", err)
-			} else {
-				metrics.FoodDepositedTotal.Add(float64(surplus))
-			}
-		}
+		// Local surplus: no starvation, scale growth by surplus.
 		state.City.Starving = false
 		var surplusRatio float64
 		if demand > 0 {
 			surplusRatio = float64(production-demand) / float64(demand)
 		}
 		state.growPopulation(false, 0, surplusRatio)
+		return production - demand, 0
+	}
+
+	// Local deficit: the city is starving from its own perspective and its
+	// population declines regardless of whether the pool covers the shortfall.
+	state.City.Starving = true
+	deficitRatio := float64(demand-production) / float64(demand)
+	state.growPopulation(true, deficitRatio, 0)
+	return 0, demand - production
+}
+
+// foodUpkeepPerHour is the food the city's population eats per hour.
+func (state *cityActor) foodUpkeepPerHour() int64 {
+	return int64(math.Round(state.City.Population * float64(constants.GetBalance().Population.FoodPerPopPerHour)))
+}
+
+// refreshFoodBalance recomputes the city's upkeep and net food flow for its
+// current owner from the last recorded production rate, without stepping
+// food or population. Neutral towns neither produce nor eat.
+func (state *cityActor) refreshFoodBalance() {
+	if state.City.Owner == nil {
+		state.City.FoodProductionRate = 0
+		state.City.FoodUpkeep = 0
+		state.City.NetFoodFlow = 0
+		state.City.Starving = false
 		return
 	}
+	state.City.FoodUpkeep = state.foodUpkeepPerHour()
+	state.City.NetFoodFlow = state.City.FoodProductionRate - state.City.FoodUpkeep
+}
 
-	// Local deficit: still draw from the pool (so the user's food drains as the
-	// city imports), but the city is starving from its own perspective and its
-	// population declines regardless of whether the pool covered the shortfall.
-	shortfall := demand - production
-	res, err := state.Cluster.Request("user", *state.City.Owner, messages.RequestFoodFromPoolMessage{Amount: shortfall})
-	if err != nil {
-		slog.ErrorContext(state.Ctx(), "failed to request food from pool", "error", err)
-		metrics.FoodPoolGrantsTotal.WithLabelValues("empty").Inc()
-	} else if resp, ok := res.(messages.RequestFoodFromPoolResponse); ok {
-		metrics.FoodWithdrawnTotal.Add(float64(resp.Granted))
-		switch {
-		case resp.Granted >= shortfall:
-			metrics.FoodPoolGrantsTotal.WithLabelValues("full").Inc()
-		case resp.Granted > 0:
-			metrics.FoodPoolGrantsTotal.WithLabelValues("partial").Inc()
-		default:
+// refreshPopulationCap sums the housing its buildings have reported into the
+// city's population cap.
+func (state *cityActor) refreshPopulationCap() {
+	var cap float64
+	for _, p := range state.populationContributions {
+		cap += p
+	}
+	state.City.PopulationCap = cap
+}
+
+// catchUp replays the city ticks missed between the city's last tick and now,
+// capped at MaxOfflineCatchUp. Production comes from the buildings that have
+// reported in, at their current levels; constructions that finished while the
+// server was down have already completed by the time this runs. The pool
+// deposit, shortfall request and gold credit are settled once for the whole
+// window rather than per replayed tick.
+func (state *cityActor) catchUp() {
+	if state.City.LastTickAt.IsZero() {
+		return
+	}
+	elapsed := min(time.Since(state.City.LastTickAt), constants.MaxOfflineCatchUp*time.Second)
+	ticks := int64(elapsed / (constants.CityTickInterval * time.Second))
+	if ticks <= 0 {
+		return
+	}
+
+	var goldPerTick, foodPerTick int64
+	for _, b := range state.buildings {
+		if b.Level < 1 || buildingConstructing(b) {
+			continue
+		}
+		// Bonuses come straight from the layout: restored buildings may not
+		// have received theirs yet.
+		b.Bonuses = constants.AdjacencyBonuses(b, state.buildings)
+		goldPerTick += b.ApplyBonus("gold", b.ApplyYield(constants.PerTickAmount(constants.GetBuildingProduction(b.BuildingType(), b.Level, "gold", state.techs), constants.CityTickInterval)))
+		foodPerTick += b.ApplyBonus("food", b.ApplyYield(constants.PerTickAmount(constants.GetBuildingProduction(b.BuildingType(), b.Level, "food", state.techs), constants.CityTickInterval)))
+	}
+
+	var surplus, shortfall, gold int64
+	for range ticks {
+		output := state.moraleOutput()
+		gold += int64(math.Round(float64(goldPerTick)*output)) + state.stepTax()
+		s, sf := state.stepFoodAndPopulation(int64(math.Round(float64(foodPerTick) * output)))
+		surplus += s
+		shortfall += sf
+		state.stepMorale()
+	}
+	state.settleFood(surplus, shortfall)
+
+	if gold > 0 && state.City.Owner != nil {
+		if err := state.Cluster.Tell("user", *state.City.Owner, messages.CreditUserMessage{Gold: gold}); err != nil {
+			slog.ErrorContext(state.Ctx(), "failed to credit catch-up gold to owner", "error", err)
+		}
+	}
+	state.checkRevolt()
+
+	state.City.LastTickAt = time.Now().UTC()
+	state.Store.EnqueueCity(state.City)
+	state.publish()
+	metrics.CatchUpTicksTotal.Add(float64(ticks))
+	slog.InfoContext(state.Ctx(), "caught up city after downtime",
+		"city_id", state.City.CityID,
+		"elapsed", elapsed,
+		"ticks", ticks,
+		"population", state.City.Population,
+	)
+}
+
+// collectTax takes one tick of tax from the city's population and credits it
+// to the owner.
+func (state *cityActor) collectTax() {
+	gold := state.stepTax()
+	if gold <= 0 || state.City.Owner == nil {
+		return
+	}
+	if err := state.Cluster.Tell("user", *state.City.Owner, messages.CreditUserMessage{Gold: gold}); err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to credit tax to owner", "error", err)
+	}
+}
+
+// stepTax converts one tick's worth of population into gold at the city's
+// tax rate, records the per-hour TaxIncome, and returns the gold. Neutral
+// towns collect nothing.
+func (state *cityActor) stepTax() int64 {
+	if state.City.Owner == nil {
+		state.City.TaxIncome = 0
+		return 0
+	}
+	perHour := int64(math.Round(state.City.Population * float64(state.City.TaxRate) / 100 * constants.GetBalance().Tax.GoldPerPopPerHour))
+	state.City.TaxIncome = perHour
+
+	// Carry the sub-tick remainder so the take averages exactly to perHour.
+	scaled := perHour*int64(constants.CityTickInterval) + state.taxRemainder
+	state.taxRemainder = scaled % int64(constants.SecondsPerHour)
+	return scaled / int64(constants.SecondsPerHour)
+}
+
+// loadCaravan takes a caravan's cargo at this city: food from the city's
+// FoodStore and gold from its owner, since cities hold no gold of their own.
+// Nothing is taken unless both are covered.
+func (state *cityActor) loadCaravan(gold, food int64) error {
+	if state.City.Owner == nil {
+		return &messages.InternalError{}
+	}
+	if missing := food - state.City.FoodStore; missing > 0 {
+		return &messages.InsufficientStoredFoodError{CityID: state.City.CityID, Missing: missing}
+	}
+	if gold > 0 {
+		if err := state.chargeOwner(gold); err != nil {
+			return err
+		}
+	}
+	state.City.FoodStore -= food
+	return nil
+}
+
+// settleFood keeps a surplus in the city's FoodStore up to the store capacity
+// and deposits the overflow into the owner's pool, or requests a shortfall
+// from the pool. The draw still happens for a starving city so the user's
+// food drains as the city imports.
+//
+// The pool stays the owner's empire-wide reserve and moves food without
+// travel. Caravans carry only what a city has stored, so shipping more than
+// the store holds means waiting for the city to refill it.
+func (state *cityActor) settleFood(surplus, shortfall int64) {
+	if state.City.Owner == nil {
+		return
+	}
+	if surplus > 0 {
+		stored := min(surplus, max(0, constants.GetBalance().Caravans.StoreCapacity-state.City.FoodStore))
+		state.City.FoodStore += stored
+		surplus -= stored
+	}
+	if surplus > 0 {
+		if err := state.Cluster.Tell("user", *state.City.Owner, messages.DepositFoodMessage{Amount: surplus}); err != nil {
+			slog.ErrorContext(state.Ctx(), "failed to deposit surplus food to pool", "error", err)
+		} else {
+			metrics.FoodDepositedTotal.Add(float64(surplus))
+		}
+	}
+	if shortfall > 0 {
+		if err := state.Cluster.Tell("user", *state.City.Owner, messages.RequestFoodFromPoolMessage{
+			CityID:     state.City.CityID,
+			Amount:     shortfall,
+			Capital:    state.City.Capital,
+			Population: state.City.Population,
+			Priority:   state.City.ImportPriority,
+		}); err != nil {
+			slog.ErrorContext(state.Ctx(), "failed to request food from pool", "error", err)
 			metrics.FoodPoolGrantsTotal.WithLabelValues("empty").Inc()
 		}
 	}
-	state.City.Starving = true
-	deficitRatio := float64(shortfall) / float64(demand)
-	state.growPopulation(true, deficitRatio, 0)
 }
 
 // growPopulation moves the population for one tick: logistic growth scaled by
-// a food-surplus bonus when fed, or a decline scaled by the local deficit
-// ratio when not. Records the per-tick delta as a per-hour rate on the city
-// so clients can render the trend without reverse-engineering the formulas.
+// a food-surplus bonus and by morale, and held back by the tax rate, when fed,
+// or a decline scaled by the local deficit ratio when not. Records the
+// per-tick delta as a per-hour rate on the city so clients can render the
+// trend without reverse-engineering the formulas.
 func (state *cityActor) growPopulation(starving bool, deficitRatio, surplusRatio float64) {
 	currentPopulation := state.City.Population
 	populationCap := state.City.PopulationCap
@@ -326,16 +844,20 @@ func (state *cityActor) growPopulation(starving bool, deficitRatio, surplusRatio
 		state.City.PopulationGrowthRate = 0
 		return
 	}
+	balance := constants.GetBalance()
 	var newPop float64
 	if starving {
-		newPop = currentPopulation * (1 - constants.StarvationDeclineRate*deficitRatio)
+		newPop = currentPopulation * (1 - balance.Population.StarvationDeclineRate*deficitRatio)
 	} else {
 		// Surplus bonus saturates at 100% extra production (surplusRatio = 1.0).
 		// fedFactor goes from 1.0 (just covered) up to 1 + SurplusGrowthBonus
 		// at saturation; beyond that, more farms give no further speedup.
-		bonus := math.Min(surplusRatio, 1.0) * constants.SurplusGrowthBonus
+		bonus := math.Min(surplusRatio, 1.0) * balance.Population.SurplusGrowthBonus
 		fedFactor := 1.0 + bonus
-		newPop = currentPopulation + constants.PopulationGrowthRate*currentPopulation*(1-currentPopulation/populationCap)*fedFactor
+		// Taxes hold growth back; at the penalty's full weight a 100% rate
+		// would stop it.
+		taxFactor := math.Max(0, 1-balance.Tax.GrowthPenalty*float64(state.City.TaxRate)/100)
+		newPop = currentPopulation + balance.Population.GrowthRate*currentPopulation*(1-currentPopulation/populationCap)*fedFactor*taxFactor*state.moraleGrowth()
 	}
 	delta := newPop - currentPopulation
 	state.City.PopulationGrowthRate = int64(math.Round(delta * float64(constants.SecondsPerHour) / float64(constants.CityTickInterval)))
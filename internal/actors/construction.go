@@ -0,0 +1,366 @@
+package actors
+
+import (
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/google/uuid"
+
+	"cityio/internal/constants"
+	"cityio/internal/domain"
+	"cityio/internal/messages"
+	"cityio/internal/metrics"
+)
+
+// The build queue lives on the city actor. Orders wait in City.ConstructionQueue
+// until a construction slot is free, are charged as they start (or when
+// queued, see ChargeConstructionOnEnqueue), and leave the queue once started;
+// from then on the building carries the construction. Completions arrive as
+// BuildingStateChangedMessage, which frees the slot and starts the next order.
+
+// restoreConstructionQueue loads the city's waiting orders after a restart.
+func (state *cityActor) restoreConstructionQueue() {
+	orders, err := state.Store.GetConstructionOrdersByCity(state.Ctx(), state.City.CityID)
+	if err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to load construction queue", "city_id", state.City.CityID, "error", err)
+		return
+	}
+	state.City.ConstructionQueue = orders
+}
+
+// trackBuilding records a building's reported state and refreshes the slot
+// count when it is the city's center.
+func (state *cityActor) trackBuilding(b domain.Building) {
+	if _, known := state.buildings[b.BuildingID]; !known && len(state.techs) > 0 {
+		state.sendTechs(b.BuildingID)
+	}
+	state.buildings[b.BuildingID] = b
+	if constants.IsCenter(b.BuildingType()) {
+		state.City.ConstructionSlots = constants.GetConstructionSlots(b.Level)
+	}
+}
+
+func buildingConstructing(b domain.Building) bool {
+	return b.Level != b.TargetLevel || b.ConstructionEnd.Time != nil
+}
+
+func (state *cityActor) activeConstructions() int {
+	n := 0
+	for _, b := range state.buildings {
+		if buildingConstructing(b) {
+			n++
+		}
+	}
+	return n
+}
+
+// checkConstructionSlot rejects a construction when every slot is taken.
+// Queued orders only start with a slot free, so this guards the direct paths.
+func (state *cityActor) checkConstructionSlot() error {
+	if state.activeConstructions() >= state.City.ConstructionSlots {
+		return &messages.NoFreeConstructionSlotError{CityID: state.City.CityID}
+	}
+	return nil
+}
+
+// startUpgrade checks and charges a direct upgrade of one of the city's
+// buildings, returning the gold charged. The slot is held at once so a second
+// direct upgrade can't claim it before the building reports in.
+func (state *cityActor) startUpgrade(msg messages.StartUpgradeMessage) (int64, error) {
+	if state.City.Owner == nil {
+		return 0, &messages.InternalError{}
+	}
+	if err := state.checkBuildingRules(msg.BuildingType, msg.Level, false, false); err != nil {
+		return 0, err
+	}
+	if err := state.checkConstructionSlot(); err != nil {
+		return 0, err
+	}
+	cost := constants.GetBuildingCost(msg.BuildingType, msg.Level, state.techs)
+	if err := state.chargeOwner(cost); err != nil {
+		return 0, err
+	}
+	if b, ok := state.buildings[msg.BuildingID]; ok {
+		b.TargetLevel = msg.Level
+		state.buildings[msg.BuildingID] = b
+	}
+	return cost, nil
+}
+
+// queuedLevel is the level a building reaches once its construction and every
+// queued upgrade for it complete.
+func (state *cityActor) queuedLevel(b domain.Building) int {
+	level := b.TargetLevel
+	for _, o := range state.City.ConstructionQueue {
+		if o.BuildingID != nil && *o.BuildingID == b.BuildingID {
+			level++
+		}
+	}
+	return level
+}
+
+func (state *cityActor) enqueueConstruction(msg messages.EnqueueConstructionMessage) (domain.ConstructionOrder, error) {
+	if state.City.Owner == nil {
+		return domain.ConstructionOrder{}, &messages.InternalError{}
+	}
+	if len(state.City.ConstructionQueue) >= constants.MaxConstructionQueue {
+		return domain.ConstructionOrder{}, &messages.ConstructionQueueFullError{CityID: state.City.CityID}
+	}
+
+	order := domain.ConstructionOrder{
+		OrderID:  uuid.New().String(),
+		CityID:   state.City.CityID,
+		Position: len(state.City.ConstructionQueue),
+	}
+	var cost int64
+	if msg.BuildingID != nil {
+		b, ok := state.buildings[*msg.BuildingID]
+		if !ok {
+			return order, &messages.BuildingNotFoundError{BuildingId: *msg.BuildingID}
+		}
+		level := state.queuedLevel(b)
+		if level >= constants.GetMaxBuildingLevel(b.BuildingType(), state.techs) {
+			return order, &messages.MaxLevelReachedError{BuildingID: b.BuildingID}
+		}
+		if err := state.checkBuildingRules(b.BuildingType(), level+1, false, true); err != nil {
+			return order, err
+		}
+		order.BuildingID = &b.BuildingID
+		order.BuildingType = b.BuildingType()
+		order.X, order.Y = b.X, b.Y
+		cost = constants.GetBuildingCost(order.BuildingType, level+1, state.techs)
+	} else {
+		if !constants.IsPlaceable(msg.BuildingType) {
+			return order, &messages.InvalidBuildingTypeError{BuildingType: msg.BuildingType}
+		}
+		if !state.City.Contains(msg.X, msg.Y) {
+			return order, &messages.OutOfCityBoundsError{CityID: state.City.CityID, X: msg.X, Y: msg.Y}
+		}
+		if err := state.checkBuildingRules(msg.BuildingType, 1, true, true); err != nil {
+			return order, err
+		}
+		// Occupancy is checked when the order starts; here only other queued
+		// buildings can claim the tile. Terrain and deposits never change,
+		// so they are checked up front.
+		for _, o := range state.City.ConstructionQueue {
+			if !o.Upgrade() && o.X == msg.X && o.Y == msg.Y {
+				return order, &messages.TileOccupiedError{X: msg.X, Y: msg.Y}
+			}
+		}
+		tile, err := state.getTile(msg.X, msg.Y)
+		if err != nil {
+			slog.ErrorContext(state.Ctx(), "failed to check tile ground for order", "error", err)
+			return order, err
+		}
+		if err := checkGround(tile, msg.X, msg.Y, msg.BuildingType); err != nil {
+			return order, err
+		}
+		order.BuildingType = msg.BuildingType
+		order.X, order.Y = msg.X, msg.Y
+		cost = constants.GetBuildingCost(order.BuildingType, 1, state.techs)
+	}
+
+	if constants.ChargeConstructionOnEnqueue {
+		if err := state.chargeOwner(cost); err != nil {
+			return order, err
+		}
+		order.Paid = cost
+	}
+	if err := state.Store.CreateConstructionOrder(state.Ctx(), order); err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to persist construction order", "order_id", order.OrderID, "error", err)
+		state.refundOwner(order.Paid)
+		return order, err
+	}
+	state.setConstructionQueue(append(slices.Clone(state.City.ConstructionQueue), order))
+	metrics.ConstructionOrdersTotal.WithLabelValues("queued").Inc()
+	state.publish()
+	state.startQueuedConstruction()
+	return order, nil
+}
+
+func (state *cityActor) reorderConstruction(orderIDs []string) error {
+	queue := state.City.ConstructionQueue
+	if len(orderIDs) != len(queue) {
+		return &messages.InvalidQueueOrderError{CityID: state.City.CityID}
+	}
+	reordered := make([]domain.ConstructionOrder, 0, len(queue))
+	for _, id := range orderIDs {
+		i := slices.IndexFunc(queue, func(o domain.ConstructionOrder) bool { return o.OrderID == id })
+		if i < 0 || slices.ContainsFunc(reordered, func(o domain.ConstructionOrder) bool { return o.OrderID == id }) {
+			return &messages.InvalidQueueOrderError{CityID: state.City.CityID}
+		}
+		reordered = append(reordered, queue[i])
+	}
+	state.setConstructionQueue(reordered)
+	state.publish()
+	state.startQueuedConstruction()
+	return nil
+}
+
+func (state *cityActor) cancelConstructionOrder(orderID string) error {
+	i := slices.IndexFunc(state.City.ConstructionQueue, func(o domain.ConstructionOrder) bool { return o.OrderID == orderID })
+	if i < 0 {
+		return &messages.ConstructionOrderNotFoundError{OrderID: orderID}
+	}
+	order := state.City.ConstructionQueue[i]
+	state.removeConstructionOrder(orderID)
+	state.refundOwner(order.Paid)
+	metrics.ConstructionOrdersTotal.WithLabelValues("cancelled").Inc()
+	state.publish()
+	return nil
+}
+
+// dropOrdersForBuilding discards queued upgrades of a building that no longer
+// exists, refunding anything paid for them.
+func (state *cityActor) dropOrdersForBuilding(buildingID string) {
+	for _, o := range slices.Clone(state.City.ConstructionQueue) {
+		if o.BuildingID == nil || *o.BuildingID != buildingID {
+			continue
+		}
+		state.removeConstructionOrder(o.OrderID)
+		state.refundOwner(o.Paid)
+		metrics.ConstructionOrdersTotal.WithLabelValues("dropped").Inc()
+	}
+}
+
+// startQueuedConstruction starts waiting orders, front to back, while the
+// city has free slots. Upgrades of a building that is already under
+// construction wait their turn without blocking the orders behind them; an
+// order the owner can't afford stops the queue so cheaper orders don't jump
+// ahead of it.
+func (state *cityActor) startQueuedConstruction() {
+	if len(state.City.ConstructionQueue) == 0 || state.City.Owner == nil {
+		return
+	}
+	free := state.City.ConstructionSlots - state.activeConstructions()
+	changed := false
+	for _, o := range slices.Clone(state.City.ConstructionQueue) {
+		if free <= 0 {
+			break
+		}
+		var err error
+		if o.Upgrade() {
+			b, ok := state.buildings[*o.BuildingID]
+			if ok && buildingConstructing(b) {
+				continue
+			}
+			err = state.startQueuedUpgrade(o, b, ok)
+		} else {
+			err = state.startQueuedBuilding(o)
+		}
+		if _, ok := err.(*messages.InsufficientGoldError); ok {
+			break
+		}
+		if state.waitsOnCenter(err) {
+			continue
+		}
+
+		state.removeConstructionOrder(o.OrderID)
+		changed = true
+		if err != nil {
+			slog.InfoContext(state.Ctx(), "dropping construction order", "order_id", o.OrderID, "error", err)
+			state.refundOwner(o.Paid)
+			metrics.ConstructionOrdersTotal.WithLabelValues("dropped").Inc()
+			continue
+		}
+		metrics.ConstructionOrdersTotal.WithLabelValues("started").Inc()
+		free--
+	}
+	if changed {
+		state.publish()
+	}
+}
+
+func (state *cityActor) startQueuedUpgrade(o domain.ConstructionOrder, b domain.Building, exists bool) error {
+	if !exists {
+		return &messages.BuildingNotFoundError{BuildingId: *o.BuildingID}
+	}
+	if b.Level >= constants.GetMaxBuildingLevel(b.BuildingType(), state.techs) {
+		return &messages.MaxLevelReachedError{BuildingID: b.BuildingID}
+	}
+	if err := state.checkBuildingRules(b.BuildingType(), b.Level+1, false, false); err != nil {
+		return err
+	}
+	charged := o.Paid
+	if charged == 0 {
+		charged = constants.GetBuildingCost(b.BuildingType(), b.Level+1, state.techs)
+		if err := state.chargeOwner(charged); err != nil {
+			return err
+		}
+	}
+	// Told rather than requested: the building may itself be waiting on this
+	// city. A rejection comes back as ConstructionOrderRejectedMessage.
+	if err := state.Cluster.Tell("building", b.BuildingID, messages.UpgradeBuildingMessage{Prepaid: charged}); err != nil {
+		if o.Paid == 0 {
+			state.refundOwner(charged)
+		}
+		return err
+	}
+	// Hold the slot until the building reports the construction.
+	b.TargetLevel = b.Level + 1
+	state.buildings[b.BuildingID] = b
+	return nil
+}
+
+func (state *cityActor) startQueuedBuilding(o domain.ConstructionOrder) error {
+	_, err := state.placeBuilding(domain.Building{
+		BuildingID: uuid.New().String(),
+		CityID:     state.City.CityID,
+		Type:       string(o.BuildingType),
+		X:          o.X,
+		Y:          o.Y,
+	}, o.Paid == 0)
+	return err
+}
+
+// removeConstructionOrder deletes an order and closes the gap it leaves.
+func (state *cityActor) removeConstructionOrder(orderID string) {
+	if err := state.Store.DeleteConstructionOrder(state.Ctx(), orderID); err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to delete construction order", "order_id", orderID, "error", err)
+	}
+	state.setConstructionQueue(slices.DeleteFunc(slices.Clone(state.City.ConstructionQueue), func(o domain.ConstructionOrder) bool {
+		return o.OrderID == orderID
+	}))
+}
+
+// setConstructionQueue installs a new queue, renumbering positions and
+// persisting any that moved. The slice is replaced, never edited in place,
+// since published snapshots share it.
+func (state *cityActor) setConstructionQueue(queue []domain.ConstructionOrder) {
+	for i := range queue {
+		if queue[i].Position != i {
+			queue[i].Position = i
+			state.Store.EnqueueConstructionOrder(queue[i])
+		}
+	}
+	state.City.ConstructionQueue = queue
+}
+
+// chargeOwner takes gold from the city's owner, returning
+// InsufficientGoldError when the owner can't cover it.
+func (state *cityActor) chargeOwner(gold int64) error {
+	res, err := state.Cluster.Request("user", *state.City.Owner, messages.CheckAndDeductGoldMessage{Amount: gold})
+	if err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to deduct gold from owner", "error", err)
+		return err
+	}
+	switch v := res.(type) {
+	case messages.Ack:
+		return nil
+	case messages.InsufficientGoldError:
+		return &v
+	default:
+		return fmt.Errorf("unexpected response type: %T", res)
+	}
+}
+
+// refundOwner returns gold to the city's owner. Zero is a no-op.
+func (state *cityActor) refundOwner(gold int64) {
+	if gold <= 0 || state.City.Owner == nil {
+		return
+	}
+	if _, err := state.Cluster.Request("user", *state.City.Owner, messages.CreditUserMessage{Gold: gold}); err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to refund owner", "gold", gold, "error", err)
+	}
+}
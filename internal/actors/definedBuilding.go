@@ -0,0 +1,81 @@
+package actors
+
+import (
+	"github.com/asynkron/protoactor-go/actor"
+
+	"cityio/internal/constants"
+	"cityio/internal/messages"
+)
+
+// definedImpl runs a building from its loaded definition (see
+// constants.BuildingDefinition): housing buildings report their population,
+// producing buildings credit their production every tick, and training
+// buildings hand troop orders to a barracksImpl.
+type definedImpl struct {
+	trainer *barracksImpl
+}
+
+func newDefinedImpl(state *buildingActor) buildingActorImpl {
+	impl := &definedImpl{}
+	if def, ok := constants.GetBuildingDefinition(state.Building.BuildingType()); ok && def.Trains {
+		impl.trainer = &barracksImpl{}
+	}
+	return impl
+}
+
+func (d *definedImpl) Create(ctx actor.Context, state *buildingActor) {
+	d.reportPopulation(state)
+	if d.trainer != nil {
+		d.trainer.Create(ctx, state)
+	}
+}
+
+func (d *definedImpl) Destroy(ctx actor.Context, state *buildingActor) {
+	if d.trainer != nil {
+		d.trainer.Destroy(ctx, state)
+	}
+}
+
+func (d *definedImpl) Handle(ctx actor.Context, state *buildingActor) {
+	if d.trainer != nil {
+		d.trainer.Handle(ctx, state)
+	}
+	switch ctx.Message().(type) {
+
+	case messages.PeriodicOperationMessage:
+		if state.constructionActive() {
+			return
+		}
+		d.reportPopulation(state)
+		d.produce(state)
+	}
+}
+
+func (d *definedImpl) reportPopulation(state *buildingActor) {
+	def, ok := constants.GetBuildingDefinition(state.Building.BuildingType())
+	if !ok || !def.Housing {
+		return
+	}
+	state.reportPopulation(constants.GetBuildingPopulation(def.Type, state.populationLevel()))
+}
+
+// produce credits one tick of the building's production, scaled by the
+// deposit under it.
+func (d *definedImpl) produce(state *buildingActor) {
+	def, ok := constants.GetBuildingDefinition(state.Building.BuildingType())
+	if !ok || !def.Produces {
+		return
+	}
+	var gold, food int64
+	for _, entry := range def.Production {
+		perHour := constants.GetBuildingProduction(def.Type, state.Building.Level, entry.Resource, state.techs)
+		amount := state.Building.ApplyYield(constants.PerTickAmount(perHour, constants.BuildingTickInterval))
+		switch entry.Resource {
+		case "gold":
+			gold += amount
+		case "food":
+			food += amount
+		}
+	}
+	state.creditProduction(gold, food)
+}
@@ -0,0 +1,267 @@
+package actors
+
+import (
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/cluster"
+	"github.com/google/uuid"
+
+	"cityio/internal/constants"
+	"cityio/internal/domain"
+	"cityio/internal/messages"
+	"cityio/internal/metrics"
+	"cityio/internal/stream"
+)
+
+// marketActor keeps the limit order book of one market, identified by its
+// market ID. Placing an order escrows its funds from the user actor: gold
+// for the whole order on a buy, the resource on a sell. Matched trades are
+// written through together with the fills of both orders, then settled by
+// crediting the buyer with the resource and the seller with the currency out
+// of that escrow.
+type marketActor struct {
+	baseActor
+	market constants.Market
+	book   domain.OrderBook
+	// recent holds the latest trades, newest first, for the ticker.
+	recent []domain.Trade
+}
+
+func NewMarketActor() BaseActorInterface {
+	return &marketActor{}
+}
+
+func (state *marketActor) ActorType() string {
+	return "market"
+}
+
+func (state *marketActor) Receive(ctx actor.Context) {
+	switch msg := ctx.Message().(type) {
+	case *cluster.ClusterInit:
+		// Markets aren't created by setup: the first message to a market
+		// activates it, and it restores its book from the database here.
+		state.restore(domain.MarketID(msg.Identity.Identity))
+
+	case messages.PlaceMarketOrderMessage:
+		if state.market.ID == "" {
+			ctx.Respond(&messages.UnknownMarketError{})
+			return
+		}
+		order, trades, err := state.placeOrder(msg)
+		if err != nil {
+			ctx.Respond(err)
+			return
+		}
+		ctx.Respond(&messages.PlaceMarketOrderResponse{Order: order, Trades: trades})
+
+	case messages.CancelMarketOrderMessage:
+		order, err := state.cancelOrder(msg.UserID, msg.OrderID)
+		if err != nil {
+			ctx.Respond(err)
+			return
+		}
+		ctx.Respond(&messages.CancelMarketOrderResponse{Order: order})
+
+	case messages.ListMarketOrdersMessage:
+		ctx.Respond(&messages.ListMarketOrdersResponse{Orders: state.book.UserOrders(msg.UserID)})
+
+	case messages.GetMarketTickerMessage:
+		ctx.Respond(&messages.GetMarketTickerResponse{Ticker: state.ticker()})
+	}
+}
+
+func (state *marketActor) restore(id domain.MarketID) {
+	market, ok := constants.GetMarket(id)
+	if !ok {
+		slog.ErrorContext(state.Ctx(), "activated unknown market", "market_id", id)
+		return
+	}
+	state.market = market
+
+	orders, err := state.Store.GetMarketOrders(state.Ctx(), id)
+	if err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to load market orders", "market_id", id, "error", err)
+	}
+	for _, o := range orders {
+		state.book.Add(o)
+	}
+	trades, err := state.Store.GetRecentTrades(state.Ctx(), id, constants.MarketRecentTrades)
+	if err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to load recent trades", "market_id", id, "error", err)
+	}
+	state.recent = trades
+}
+
+func (state *marketActor) placeOrder(msg messages.PlaceMarketOrderMessage) (domain.MarketOrder, []domain.Trade, error) {
+	switch {
+	case !msg.Side.Valid():
+		return domain.MarketOrder{}, nil, &messages.InvalidMarketOrderError{Reason: fmt.Sprintf("unknown side %q", msg.Side)}
+	case msg.Price <= 0 || msg.Price > constants.MaxMarketPrice:
+		return domain.MarketOrder{}, nil, &messages.InvalidMarketOrderError{Reason: fmt.Sprintf("price must be between 1 and %d", constants.MaxMarketPrice)}
+	case msg.Quantity <= 0 || msg.Quantity > constants.MaxMarketOrderQuantity:
+		return domain.MarketOrder{}, nil, &messages.InvalidMarketOrderError{Reason: fmt.Sprintf("quantity must be between 1 and %d", constants.MaxMarketOrderQuantity)}
+	}
+	if open := len(state.book.UserOrders(msg.UserID)); open >= constants.MaxOpenMarketOrders {
+		return domain.MarketOrder{}, nil, &messages.TooManyMarketOrdersError{Limit: constants.MaxOpenMarketOrders}
+	}
+
+	order := domain.MarketOrder{
+		OrderID:   uuid.New().String(),
+		MarketID:  state.market.ID,
+		UserID:    msg.UserID,
+		Side:      msg.Side,
+		Price:     msg.Price,
+		Quantity:  msg.Quantity,
+		Remaining: msg.Quantity,
+		CreatedAt: time.Now(),
+	}
+	escrow := state.escrowFor(order, order.Quantity)
+	if err := state.takeEscrow(order.UserID, escrow); err != nil {
+		return domain.MarketOrder{}, nil, err
+	}
+	if err := state.Store.CreateMarketOrder(state.Ctx(), order); err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to persist market order", "market_id", state.market.ID, "error", err)
+		state.credit(order.UserID, escrow)
+		return domain.MarketOrder{}, nil, &messages.InternalError{}
+	}
+	metrics.MarketOrdersPlacedTotal.WithLabelValues(string(state.market.ID), string(order.Side)).Inc()
+
+	var trades []domain.Trade
+	for _, fill := range state.book.Match(&order) {
+		trades = append(trades, state.settle(order, fill))
+	}
+	if order.Remaining > 0 {
+		state.book.Add(order)
+	} else {
+		state.deleteOrder(order.OrderID)
+	}
+	stream.PublishMarket(state.ticker())
+	return order, trades, nil
+}
+
+// settle records the trade between an incoming order and a resting one and
+// pays both sides out of escrow. The trade runs at the resting order's price,
+// so an incoming buy that crossed below its limit gets the difference back.
+func (state *marketActor) settle(incoming domain.MarketOrder, fill domain.Fill) domain.Trade {
+	buy, sell := incoming, fill.Resting
+	if incoming.Side == domain.OrderSideSell {
+		buy, sell = fill.Resting, incoming
+	}
+	trade := domain.Trade{
+		TradeID:     uuid.New().String(),
+		MarketID:    state.market.ID,
+		BuyOrderID:  buy.OrderID,
+		SellOrderID: sell.OrderID,
+		Buyer:       buy.UserID,
+		Seller:      sell.UserID,
+		Price:       fill.Resting.Price,
+		Quantity:    fill.Quantity,
+		ExecutedAt:  time.Now(),
+	}
+	if err := state.Store.RecordTrade(state.Ctx(), trade); err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to persist trade", "market_id", state.market.ID, "trade_id", trade.TradeID, "error", err)
+	}
+	if fill.Resting.Remaining == fill.Quantity {
+		state.deleteOrder(fill.Resting.OrderID)
+	}
+
+	buyer := map[string]int64{
+		state.market.Resource: trade.Quantity,
+		state.market.Currency: (buy.Price - trade.Price) * trade.Quantity,
+	}
+	state.credit(trade.Buyer, buyer)
+	state.credit(trade.Seller, map[string]int64{state.market.Currency: trade.Price * trade.Quantity})
+
+	state.recent = append([]domain.Trade{trade}, state.recent[:min(len(state.recent), constants.MarketRecentTrades-1)]...)
+	metrics.MarketTradesTotal.WithLabelValues(string(state.market.ID)).Inc()
+	metrics.MarketVolumeTotal.WithLabelValues(string(state.market.ID)).Add(float64(trade.Quantity))
+	return trade
+}
+
+func (state *marketActor) cancelOrder(userID, orderID string) (domain.MarketOrder, error) {
+	order, ok := state.book.Remove(orderID)
+	if !ok {
+		return domain.MarketOrder{}, &messages.MarketOrderNotFoundError{OrderID: orderID}
+	}
+	if order.UserID != userID {
+		state.book.Add(order)
+		return domain.MarketOrder{}, &messages.MarketOrderNotFoundError{OrderID: orderID}
+	}
+	state.deleteOrder(order.OrderID)
+	state.credit(order.UserID, state.escrowFor(order, order.Remaining))
+	stream.PublishMarket(state.ticker())
+	return order, nil
+}
+
+// escrowFor is what an order holds in escrow for quantity of its resource.
+func (state *marketActor) escrowFor(order domain.MarketOrder, quantity int64) map[string]int64 {
+	if order.Side == domain.OrderSideBuy {
+		return map[string]int64{state.market.Currency: order.Price * quantity}
+	}
+	return map[string]int64{state.market.Resource: quantity}
+}
+
+// takeEscrow deducts amounts from the user, failing with
+// InsufficientGoldError or InsufficientFoodError when they can't cover it.
+func (state *marketActor) takeEscrow(userID string, amounts map[string]int64) error {
+	res, err := state.Cluster.Request("user", userID, messages.CheckAndDeductGoldMessage{
+		Amount: amounts["gold"],
+		Food:   amounts["food"],
+	})
+	if err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to escrow market order", "user_id", userID, "error", err)
+		return &messages.InternalError{}
+	}
+	switch v := res.(type) {
+	case messages.Ack:
+		return nil
+	case messages.InsufficientGoldError:
+		return &v
+	case messages.InsufficientFoodError:
+		return &v
+	default:
+		return fmt.Errorf("unexpected response type: %T", res)
+	}
+}
+
+// credit pays amounts out of escrow to the user. Zero amounts are skipped.
+func (state *marketActor) credit(userID string, amounts map[string]int64) {
+	msg := messages.CreditUserMessage{Gold: amounts["gold"], Food: amounts["food"]}
+	if msg.Gold <= 0 && msg.Food <= 0 {
+		return
+	}
+	if _, err := state.Cluster.Request("user", userID, msg); err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to credit market settlement", "user_id", userID, "gold", msg.Gold, "food", msg.Food, "error", err)
+	}
+}
+
+func (state *marketActor) deleteOrder(orderID string) {
+	if err := state.Store.DeleteMarketOrder(state.Ctx(), orderID); err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to delete market order", "order_id", orderID, "error", err)
+	}
+}
+
+func (state *marketActor) ticker() domain.MarketTicker {
+	t := domain.MarketTicker{
+		MarketID:     state.market.ID,
+		RecentTrades: state.recent,
+	}
+	// Copied out: the ticker is published to other goroutines while the book
+	// keeps changing.
+	if len(state.book.Bids) > 0 {
+		bid := state.book.Bids[0].Price
+		t.BestBid = &bid
+	}
+	if len(state.book.Asks) > 0 {
+		ask := state.book.Asks[0].Price
+		t.BestAsk = &ask
+	}
+	if len(state.recent) > 0 {
+		last := state.recent[0].Price
+		t.LastPrice = &last
+	}
+	return t
+}
@@ -0,0 +1,82 @@
+package actors
+
+import (
+	"log/slog"
+
+	"cityio/internal/constants"
+	"cityio/internal/domain"
+	"cityio/internal/messages"
+	"cityio/internal/metrics"
+)
+
+// stepMorale recomputes the city's morale factors from this tick's food
+// balance, tax rate, crowding and amenities, moves morale toward their target
+// and advances the unrest count. Like stepFoodAndPopulation it touches
+// nothing outside the city, so offline catch-up can replay it.
+func (state *cityActor) stepMorale() {
+	if state.City.Owner == nil {
+		state.City.MoraleFactors = domain.MoraleFactors{}
+		state.City.Unrest = false
+		state.City.UnrestTicks = 0
+		return
+	}
+
+	balance := constants.GetBalance().Morale
+	f := domain.MoraleFactors{Base: constants.MoraleBase}
+	if upkeep := state.City.FoodUpkeep; upkeep > 0 {
+		ratio := max(-1, min(1, float64(state.City.NetFoodFlow)/float64(upkeep)))
+		if ratio >= 0 {
+			f.Food = ratio * balance.FoodSurplus
+		} else {
+			f.Food = ratio * balance.FoodDeficit
+		}
+	}
+	f.Tax = -balance.PerTaxPoint * float64(state.City.TaxRate)
+	if populationCap := state.City.PopulationCap; populationCap > 0 {
+		if occupancy := state.City.Population / populationCap; occupancy > balance.CrowdingStart {
+			f.Crowding = -balance.CrowdingPenalty * min(1, (occupancy-balance.CrowdingStart)/(1-balance.CrowdingStart))
+		}
+	}
+	for _, b := range state.buildings {
+		if b.Level >= 1 {
+			f.Amenities += constants.GetBuildingAmenity(b.BuildingType(), b.Level)
+		}
+	}
+
+	state.City.MoraleFactors = f
+	state.City.Morale += (f.Target() - state.City.Morale) * balance.Drift
+	if state.City.Morale < balance.Unrest {
+		state.City.Unrest = true
+		state.City.UnrestTicks++
+	} else {
+		state.City.Unrest = false
+		state.City.UnrestTicks = 0
+	}
+}
+
+// moraleOutput is the factor morale applies to the production the city's
+// buildings credit.
+func (state *cityActor) moraleOutput() float64 {
+	balance := constants.GetBalance().Morale
+	return 1 + (state.City.Morale-balance.Neutral)/balance.Neutral*balance.OutputSwing
+}
+
+// moraleGrowth is the factor morale applies to population growth.
+func (state *cityActor) moraleGrowth() float64 {
+	balance := constants.GetBalance().Morale
+	return 1 + (state.City.Morale-balance.Neutral)/balance.Neutral*balance.GrowthSwing
+}
+
+// checkRevolt sets the city neutral once its unrest has lasted RevoltTicks.
+// The change goes through UpdateCityOwnerMessage like any other transfer, so
+// the owner's client drops the city the same way it would on conquest.
+func (state *cityActor) checkRevolt() {
+	if state.City.Owner == nil || state.City.UnrestTicks < constants.GetBalance().Morale.RevoltTicks {
+		return
+	}
+	slog.InfoContext(state.Ctx(), "city revolted", "city_id", state.City.CityID, "owner", *state.City.Owner, "morale", state.City.Morale)
+	metrics.CityRevoltsTotal.Inc()
+	if err := state.Cluster.Tell("city", state.City.CityID, messages.UpdateCityOwnerMessage{Owner: nil}); err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to set revolting city neutral", "city_id", state.City.CityID, "error", err)
+	}
+}
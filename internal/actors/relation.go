@@ -0,0 +1,301 @@
+package actors
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+	"github.com/asynkron/protoactor-go/cluster"
+
+	"cityio/internal/constants"
+	"cityio/internal/domain"
+	"cityio/internal/messages"
+	"cityio/internal/metrics"
+	"cityio/internal/persistence"
+	"cityio/internal/stream"
+)
+
+// relationActor serializes the diplomacy between one pair of players,
+// identified by domain.RelationID. Like alliance membership, the relation is
+// written through as it changes: armies and caravans decide hostility from
+// the database. A delayed change is applied by a one-shot timer, and every
+// change is pushed to both players' streams.
+type relationActor struct {
+	baseActor
+	relation domain.Relation
+
+	// changeTimer fires a RelationChangeDueMessage when the pending change
+	// is due. See scheduleChange.
+	changeTimer *time.Timer
+}
+
+func NewRelationActor() BaseActorInterface {
+	return &relationActor{}
+}
+
+func (state *relationActor) ActorType() string {
+	return "relation"
+}
+
+func (state *relationActor) Receive(ctx actor.Context) {
+	switch msg := ctx.Message().(type) {
+	case *cluster.ClusterInit:
+		// Relations load themselves on activation like alliances. Setup
+		// activates the ones with a pending change, so their timers survive
+		// a restart.
+		state.restore(msg.Identity.Identity)
+		state.settle()
+		state.scheduleChange(ctx)
+
+	case messages.RestoreRelationMessage:
+		ctx.Respond(messages.Ack{})
+
+	case messages.RelationChangeDueMessage:
+		state.changeTimer = nil
+		state.settle()
+		state.scheduleChange(ctx)
+
+	case messages.GetRelationMessage:
+		if state.relation.UserA == "" {
+			ctx.Respond(&messages.InvalidRelationError{Reason: "unknown players"})
+			return
+		}
+		state.settle()
+		ctx.Respond(&messages.RelationResponse{Relation: state.relation})
+
+	case messages.DeclareWarMessage:
+		state.respond(ctx, msg.UserID, state.declareWar)
+
+	case messages.ProposeTreatyMessage:
+		state.respond(ctx, msg.UserID, func(userID string) error {
+			return state.proposeTreaty(userID, msg.State)
+		})
+
+	case messages.AcceptTreatyMessage:
+		state.respond(ctx, msg.UserID, state.acceptTreaty)
+
+	case messages.DeclineTreatyMessage:
+		state.respond(ctx, msg.UserID, state.declineTreaty)
+
+	case messages.CancelTreatyMessage:
+		state.respond(ctx, msg.UserID, state.cancelTreaty)
+	}
+}
+
+// respond settles any due change, runs a change on behalf of userID and
+// answers with the resulting relation.
+func (state *relationActor) respond(ctx actor.Context, userID string, change func(userID string) error) {
+	if !state.relation.Involves(userID) {
+		ctx.Respond(&messages.InvalidRelationError{Reason: "not a party to this relation"})
+		return
+	}
+	state.settle()
+	if err := change(userID); err != nil {
+		ctx.Respond(err)
+		return
+	}
+	state.scheduleChange(ctx)
+	ctx.Respond(&messages.RelationResponse{Relation: state.relation})
+}
+
+// restore loads the relation named by id. A pair without a row is neutral.
+func (state *relationActor) restore(id string) {
+	a, b, ok := domain.ParseRelationID(id)
+	if !ok {
+		slog.ErrorContext(state.Ctx(), "invalid relation id", "relation_id", id)
+		return
+	}
+	relation, err := state.Store.GetRelation(state.Ctx(), a, b)
+	if errors.Is(err, persistence.ErrNotFound) {
+		state.relation = domain.NewRelation(a, b)
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to load relation", "relation_id", id, "error", err)
+		state.relation = domain.NewRelation(a, b)
+		return
+	}
+	state.relation = *relation
+}
+
+func (state *relationActor) declareWar(userID string) error {
+	r := state.relation
+	if r.Pending() {
+		return &messages.RelationChangePendingError{State: r.PendingState}
+	}
+	if r.State != domain.RelationNeutral {
+		return &messages.RelationStateError{Action: "declare war", State: r.State}
+	}
+	if state.allied(r.UserA, r.UserB) {
+		return &messages.AlliedPlayersError{}
+	}
+
+	effectiveAt := time.Now().Add(time.Duration(constants.GetBalance().Diplomacy.WarDeclarationSeconds) * time.Second)
+	r.PendingState = domain.RelationWar
+	r.EffectiveAt = domain.NullTime{Time: &effectiveAt}
+	r.ProposedState = ""
+	r.ProposedBy = ""
+	if err := state.save(r); err != nil {
+		return err
+	}
+	metrics.DiplomacyChangesTotal.WithLabelValues("war_declared").Inc()
+	slog.InfoContext(state.Ctx(), "war declared", "user_id", userID, "target", r.Other(userID), "effective_at", effectiveAt)
+	return nil
+}
+
+func (state *relationActor) proposeTreaty(userID string, treaty domain.RelationState) error {
+	r := state.relation
+	if !treaty.Treaty() {
+		return &messages.InvalidRelationError{Reason: fmt.Sprintf("%q is not a treaty", treaty)}
+	}
+	if r.State == treaty && !r.Pending() {
+		return &messages.RelationStateError{Action: "propose " + string(treaty), State: r.State}
+	}
+	if r.State == domain.RelationWar && treaty != domain.RelationPeace {
+		return &messages.RelationStateError{Action: "propose " + string(treaty), State: r.State}
+	}
+	if r.ProposedState == treaty && r.ProposedBy == r.Other(userID) {
+		return state.acceptTreaty(userID)
+	}
+
+	r.ProposedState = treaty
+	r.ProposedBy = userID
+	if err := state.save(r); err != nil {
+		return err
+	}
+	metrics.DiplomacyChangesTotal.WithLabelValues("treaty_proposed").Inc()
+	return nil
+}
+
+// acceptTreaty puts the other side's proposal in force at once. It replaces
+// any pending change, so peace calls off a declared war and re-signing a
+// cancelled treaty keeps it.
+func (state *relationActor) acceptTreaty(userID string) error {
+	r := state.relation
+	if r.ProposedState == "" || r.ProposedBy == userID {
+		return &messages.NoTreatyProposalError{}
+	}
+	if r.State == domain.RelationWar && r.ProposedState != domain.RelationPeace {
+		return &messages.RelationStateError{Action: "sign " + string(r.ProposedState), State: r.State}
+	}
+
+	r.State = r.ProposedState
+	r.PendingState = ""
+	r.EffectiveAt = domain.NullTime{}
+	r.ProposedState = ""
+	r.ProposedBy = ""
+	if err := state.save(r); err != nil {
+		return err
+	}
+	metrics.DiplomacyChangesTotal.WithLabelValues("treaty_signed").Inc()
+	slog.InfoContext(state.Ctx(), "treaty signed", "user_a", r.UserA, "user_b", r.UserB, "state", r.State)
+	return nil
+}
+
+func (state *relationActor) declineTreaty(string) error {
+	r := state.relation
+	if r.ProposedState == "" {
+		return &messages.NoTreatyProposalError{}
+	}
+	r.ProposedState = ""
+	r.ProposedBy = ""
+	if err := state.save(r); err != nil {
+		return err
+	}
+	metrics.DiplomacyChangesTotal.WithLabelValues("treaty_declined").Inc()
+	return nil
+}
+
+func (state *relationActor) cancelTreaty(userID string) error {
+	r := state.relation
+	if !r.State.Treaty() {
+		return &messages.RelationStateError{Action: "cancel a treaty", State: r.State}
+	}
+	if r.Pending() {
+		return &messages.RelationChangePendingError{State: r.PendingState}
+	}
+
+	diplomacy := constants.GetBalance().Diplomacy
+	delay := diplomacy.PactCancelSeconds
+	if r.State == domain.RelationPeace {
+		delay = diplomacy.PeaceCancelSeconds
+	}
+	effectiveAt := time.Now().Add(time.Duration(delay) * time.Second)
+	r.PendingState = domain.RelationNeutral
+	r.EffectiveAt = domain.NullTime{Time: &effectiveAt}
+	if err := state.save(r); err != nil {
+		return err
+	}
+	metrics.DiplomacyChangesTotal.WithLabelValues("treaty_cancelled").Inc()
+	slog.InfoContext(state.Ctx(), "treaty cancelled", "user_id", userID, "state", r.State, "effective_at", effectiveAt)
+	return nil
+}
+
+// settle applies the pending change once it is due. It is idempotent, so a
+// stray RelationChangeDueMessage is harmless, and every request settles first
+// in case the timer hasn't fired yet.
+func (state *relationActor) settle() {
+	r := state.relation
+	if !r.Settle(time.Now()) {
+		return
+	}
+	if err := state.save(r); err != nil {
+		return
+	}
+	change := "treaty_lapsed"
+	if r.State == domain.RelationWar {
+		change = "war_started"
+	}
+	metrics.DiplomacyChangesTotal.WithLabelValues(change).Inc()
+	slog.InfoContext(state.Ctx(), "relation changed", "user_a", r.UserA, "user_b", r.UserB, "state", r.State)
+}
+
+// save writes the relation through and, once it is stored, makes it current
+// and pushes it to both players.
+func (state *relationActor) save(r domain.Relation) error {
+	r.UpdatedAt = time.Now()
+	if err := state.Store.UpdateRelation(state.Ctx(), r); err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to persist relation", "user_a", r.UserA, "user_b", r.UserB, "error", err)
+		return &messages.InternalError{}
+	}
+	state.relation = r
+	stream.Publish(r.UserA, stream.StateUpdate{Relation: &r})
+	stream.Publish(r.UserB, stream.StateUpdate{Relation: &r})
+	return nil
+}
+
+// scheduleChange arms a one-shot timer for the pending change, if any. See
+// buildingActor.scheduleConstructionComplete.
+func (state *relationActor) scheduleChange(ctx actor.Context) {
+	if state.changeTimer != nil {
+		state.changeTimer.Stop()
+		state.changeTimer = nil
+	}
+	if !state.relation.Pending() {
+		return
+	}
+	pid := ctx.Self()
+	system := ctx.ActorSystem()
+	state.changeTimer = time.AfterFunc(max(time.Until(*state.relation.EffectiveAt.Time), 0), func() {
+		system.Root.Send(pid, messages.RelationChangeDueMessage{})
+	})
+}
+
+// atWar reports whether a war between two players is in force. It reads the
+// written-through relation, counting a declared war whose time has come even
+// before its actor settles it, so any actor can ask. Allies are never at war.
+func (b *baseActor) atWar(userID, otherID string) bool {
+	if userID == otherID {
+		return false
+	}
+	r, err := b.Store.GetRelation(b.Ctx(), userID, otherID)
+	if err != nil {
+		if !errors.Is(err, persistence.ErrNotFound) {
+			slog.ErrorContext(b.Ctx(), "failed to look up relation", "user_id", userID, "other_id", otherID, "error", err)
+		}
+		return false
+	}
+	return r.Current(time.Now()) == domain.RelationWar && !b.allied(userID, otherID)
+}
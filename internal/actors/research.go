@@ -0,0 +1,182 @@
+package actors
+
+import (
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/asynkron/protoactor-go/actor"
+
+	"cityio/internal/constants"
+	"cityio/internal/domain"
+	"cityio/internal/messages"
+	"cityio/internal/metrics"
+)
+
+// Research lives on the user actor. Techs are researched one at a time: the
+// cost is charged when research starts, a one-shot timer fires at its end,
+// and the finished tech is pushed to the user's cities, which pass it on to
+// their buildings. Costs, construction times and production read the techs
+// from there.
+
+// restoreResearch loads the user's finished techs and the research in
+// progress after a restart.
+func (state *userActor) restoreResearch() {
+	research, err := state.Store.GetResearchByUser(state.Ctx(), state.User.UserID)
+	if err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to load research", "user_id", state.User.UserID, "error", err)
+		return
+	}
+	state.User.Techs = nil
+	state.User.Researching = nil
+	for _, r := range research {
+		if r.Completed {
+			state.User.Techs = append(state.User.Techs, r.Tech)
+			continue
+		}
+		state.User.Researching = &r
+	}
+}
+
+func (state *userActor) startResearch(ctx actor.Context, id domain.TechID) (*domain.Research, error) {
+	tech, ok := constants.GetTech(id)
+	if !ok {
+		return nil, &messages.UnknownTechError{Tech: id}
+	}
+	if slices.Contains(state.User.Techs, id) {
+		return nil, &messages.TechAlreadyResearchedError{Tech: id}
+	}
+	if state.User.Researching != nil {
+		return nil, &messages.ResearchInProgressError{Tech: state.User.Researching.Tech}
+	}
+	for _, pre := range tech.Prerequisites {
+		if !slices.Contains(state.User.Techs, pre) {
+			return nil, &messages.MissingPrerequisiteError{Tech: id, Missing: pre}
+		}
+	}
+	if missing := tech.Cost - state.User.Gold; missing > 0 {
+		return nil, &messages.InsufficientGoldError{Missing: missing}
+	}
+
+	now := time.Now()
+	research := domain.Research{
+		UserID:        state.User.UserID,
+		Tech:          id,
+		ResearchStart: now,
+		ResearchEnd:   now.Add(time.Duration(tech.Duration) * time.Second),
+	}
+	if err := state.Store.CreateResearch(state.Ctx(), research); err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to persist research", "user_id", state.User.UserID, "tech", id, "error", err)
+		return nil, &messages.InternalError{}
+	}
+	state.User.Gold -= tech.Cost
+	state.User.Researching = &research
+	state.Store.EnqueueUser(state.User)
+	state.scheduleResearchComplete(ctx)
+	state.publish()
+	metrics.ResearchStartedTotal.WithLabelValues(string(id)).Inc()
+	return &research, nil
+}
+
+// cancelResearch abandons the research in progress and refunds
+// GetBalance().Research.CancelRefund of its cost, prorated by the time
+// remaining.
+func (state *userActor) cancelResearch() (int64, error) {
+	research := state.User.Researching
+	if research == nil {
+		return 0, &messages.NoResearchInProgressError{}
+	}
+	if err := state.Store.DeleteResearch(state.Ctx(), state.User.UserID, research.Tech); err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to delete cancelled research", "user_id", state.User.UserID, "tech", research.Tech, "error", err)
+		return 0, &messages.InternalError{}
+	}
+	remaining := 1.0
+	if total := research.ResearchEnd.Sub(research.ResearchStart); total > 0 {
+		remaining = min(max(time.Until(research.ResearchEnd).Seconds()/total.Seconds(), 0), 1)
+	}
+	tech, _ := constants.GetTech(research.Tech)
+	refund := int64(float64(tech.Cost) * constants.GetBalance().Research.CancelRefund * remaining)
+
+	if state.researchTimer != nil {
+		state.researchTimer.Stop()
+		state.researchTimer = nil
+	}
+	state.User.Gold += refund
+	state.User.Researching = nil
+	state.Store.EnqueueUser(state.User)
+	state.publish()
+	return refund, nil
+}
+
+// checkResearchComplete finishes the research in progress once its end has
+// passed, reporting whether it did. Like checkConstructionComplete it is
+// idempotent, so the periodic tick doubles as a safety net for the timer.
+func (state *userActor) checkResearchComplete() bool {
+	research := state.User.Researching
+	if research == nil || time.Now().Before(research.ResearchEnd) {
+		return false
+	}
+	if err := state.Store.CompleteResearch(state.Ctx(), state.User.UserID, research.Tech); err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to persist research completion", "user_id", state.User.UserID, "tech", research.Tech, "error", err)
+		return false
+	}
+	// Replaced, not appended in place: published snapshots share the slice.
+	state.User.Techs = append(slices.Clone(state.User.Techs), research.Tech)
+	state.User.Researching = nil
+	metrics.ResearchCompletedTotal.WithLabelValues(string(research.Tech)).Inc()
+	slog.InfoContext(state.Ctx(), "research complete", "user_id", state.User.UserID, "tech", research.Tech)
+	return true
+}
+
+// scheduleResearchComplete arms a one-shot timer for the end of the research
+// in progress. See buildingActor.scheduleConstructionComplete.
+func (state *userActor) scheduleResearchComplete(ctx actor.Context) {
+	if state.researchTimer != nil {
+		state.researchTimer.Stop()
+		state.researchTimer = nil
+	}
+	if state.User.Researching == nil {
+		return
+	}
+	pid := ctx.Self()
+	system := ctx.ActorSystem()
+	state.researchTimer = time.AfterFunc(max(time.Until(state.User.Researching.ResearchEnd), 0), func() {
+		system.Root.Send(pid, messages.ResearchCompleteMessage{})
+	})
+}
+
+// pushTechs tells every city the user owns about their researched techs.
+func (state *userActor) pushTechs() {
+	cities, err := state.Store.GetCitiesByOwner(state.Ctx(), state.User.UserID)
+	if err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to list cities for research update", "user_id", state.User.UserID, "error", err)
+		return
+	}
+	for _, c := range cities {
+		state.sendTechs(c.CityID)
+	}
+}
+
+func (state *userActor) sendTechs(cityID string) {
+	if err := state.Cluster.Tell("city", cityID, messages.SetTechsMessage{Techs: state.User.Techs}); err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to send techs to city", "city_id", cityID, "error", err)
+	}
+}
+
+// syncTechs asks the city's owner for their researched techs. Told rather
+// than requested: a new capital is created from inside its user's handler.
+func (state *cityActor) syncTechs() {
+	if state.City.Owner == nil {
+		return
+	}
+	if err := state.Cluster.Tell("user", *state.City.Owner, messages.SyncTechsMessage{CityID: state.City.CityID}); err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to request owner techs", "city_id", state.City.CityID, "error", err)
+	}
+}
+
+// sendTechs passes the owner's techs on to one of the city's buildings.
+func (state *cityActor) sendTechs(buildingID string) {
+	if err := state.Cluster.Tell("building", buildingID, messages.SetTechsMessage{Techs: state.techs}); err != nil {
+		slog.ErrorContext(state.Ctx(), "failed to send techs to building", "building_id", buildingID, "error", err)
+	}
+}
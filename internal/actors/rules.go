@@ -0,0 +1,106 @@
+package actors
+
+import (
+	"cityio/internal/constants"
+	"cityio/internal/domain"
+	"cityio/internal/messages"
+)
+
+// The building rules are checked against the city's view of its buildings.
+// Orders joining the build queue are checked against the planned layout, as
+// it will be once the queue ahead of them has run; orders starting and direct
+// placements and upgrades against the buildings as they stand.
+
+// centerLevel returns the level of the city's center, or the level it
+// reaches once its construction and queued upgrades finish when planned.
+func (state *cityActor) centerLevel(planned bool) int {
+	level := 0
+	for _, b := range state.buildings {
+		if !constants.IsCenter(b.BuildingType()) {
+			continue
+		}
+		if planned {
+			level = max(level, state.queuedLevel(b))
+		} else {
+			level = max(level, b.Level)
+		}
+	}
+	return level
+}
+
+// buildingLevel returns the highest level among the city's buildings of type
+// bt. A city center requirement reads the city's center, whichever kind.
+func (state *cityActor) buildingLevel(bt domain.BuildingType, planned bool) int {
+	if bt == domain.BuildingTypeCityCenter {
+		return state.centerLevel(planned)
+	}
+	level := 0
+	for _, b := range state.buildings {
+		if b.BuildingType() != bt {
+			continue
+		}
+		if planned {
+			level = max(level, state.queuedLevel(b))
+		} else {
+			level = max(level, b.Level)
+		}
+	}
+	return level
+}
+
+// buildingCount returns how many buildings of type bt the city has, counting
+// unfinished ones and, when planned, queued new buildings.
+func (state *cityActor) buildingCount(bt domain.BuildingType, planned bool) int {
+	n := 0
+	for _, b := range state.buildings {
+		if b.BuildingType() == bt {
+			n++
+		}
+	}
+	if planned {
+		for _, o := range state.City.ConstructionQueue {
+			if !o.Upgrade() && o.BuildingType == bt {
+				n++
+			}
+		}
+	}
+	return n
+}
+
+// checkBuildingRules rejects taking a building of type bt to level: a new
+// building (placing) must have its prerequisites and fit under its type's
+// limit, and no building may outgrow the city's center.
+func (state *cityActor) checkBuildingRules(bt domain.BuildingType, level int, placing, planned bool) error {
+	center := state.centerLevel(planned)
+	if placing {
+		for _, pre := range constants.GetBuildingPrerequisites(bt) {
+			if state.buildingLevel(pre.BuildingType, planned) < pre.Level {
+				return &messages.PrerequisiteNotMetError{BuildingType: bt, Requires: pre.BuildingType, Level: pre.Level}
+			}
+		}
+		if limit, ok := constants.GetBuildingLimit(bt, center); ok && state.buildingCount(bt, planned) >= limit {
+			return &messages.BuildingLimitReachedError{BuildingType: bt, Limit: limit}
+		}
+	}
+	if constants.CenterCapsLevel(bt) && level > center {
+		return &messages.CenterLevelTooLowError{BuildingType: bt, Level: level, CenterLevel: center}
+	}
+	return nil
+}
+
+// waitsOnCenter reports whether a queued order that broke a building rule
+// should wait rather than be dropped: every rule loosens as the center
+// grows, so while the center is under construction the order may yet pass.
+func (state *cityActor) waitsOnCenter(err error) bool {
+	switch err.(type) {
+	case *messages.PrerequisiteNotMetError, *messages.BuildingLimitReachedError, *messages.CenterLevelTooLowError:
+	default:
+		return false
+	}
+	for _, b := range state.buildings {
+		if constants.IsCenter(b.BuildingType()) && buildingConstructing(b) {
+			return true
+		}
+	}
+	return false
+}
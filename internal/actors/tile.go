@@ -3,14 +3,23 @@ package actors
 import (
 	"github.com/asynkron/protoactor-go/actor"
 
+	"cityio/internal/domain"
 	"cityio/internal/messages"
 )
 
 type tileActor struct {
 	baseActor
 
+	Terrain    domain.Terrain
+	Deposit    *domain.Deposit
 	CityID     *string
 	BuildingID *string
+
+	// Armies is the set of armies currently standing on the tile. Like the
+	// building index it is derived: each army reports itself on enter/leave.
+	Armies map[string]struct{}
+	// Caravans is the set of caravans on the tile, kept the same way.
+	Caravans map[string]struct{}
 }
 
 func NewTileActor() BaseActorInterface {
@@ -24,6 +33,13 @@ func (*tileActor) ActorType() string {
 func (state *tileActor) Receive(ctx actor.Context) {
 	switch msg := ctx.Message().(type) {
 
+	case messages.SetTileTerrainMessage:
+		state.Terrain = msg.Terrain
+		state.Deposit = msg.Deposit
+		if ctx.Sender() != nil {
+			ctx.Respond(messages.Ack{})
+		}
+
 	case messages.UpdateTileCityMessage:
 		state.CityID = &msg.CityID
 		if ctx.Sender() != nil {
@@ -36,10 +52,48 @@ func (state *tileActor) Receive(ctx actor.Context) {
 			ctx.Respond(messages.Ack{})
 		}
 
+	case messages.UpdateTileArmyMessage:
+		if state.Armies == nil {
+			state.Armies = make(map[string]struct{})
+		}
+		if msg.Present {
+			state.Armies[msg.ArmyID] = struct{}{}
+		} else {
+			delete(state.Armies, msg.ArmyID)
+		}
+		if ctx.Sender() != nil {
+			ctx.Respond(messages.Ack{})
+		}
+
+	case messages.UpdateTileCaravanMessage:
+		if state.Caravans == nil {
+			state.Caravans = make(map[string]struct{})
+		}
+		if msg.Present {
+			state.Caravans[msg.CaravanID] = struct{}{}
+		} else {
+			delete(state.Caravans, msg.CaravanID)
+		}
+		if ctx.Sender() != nil {
+			ctx.Respond(messages.Ack{})
+		}
+
 	case messages.GetTileMessage:
+		armyIDs := make([]string, 0, len(state.Armies))
+		for id := range state.Armies {
+			armyIDs = append(armyIDs, id)
+		}
+		caravanIDs := make([]string, 0, len(state.Caravans))
+		for id := range state.Caravans {
+			caravanIDs = append(caravanIDs, id)
+		}
 		ctx.Respond(messages.GetTileResponseMessage{
+			Terrain:    state.Terrain,
+			Deposit:    state.Deposit,
 			CityID:     state.CityID,
 			BuildingID: state.BuildingID,
+			ArmyIDs:    armyIDs,
+			CaravanIDs: caravanIDs,
 		})
 	}
 }
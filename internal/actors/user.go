@@ -24,6 +24,21 @@ type userActor struct {
 	foodIncomeAccum int64
 	foodUpkeepAccum int64
 
+	// foodRequests holds the pool requests of the current allocation window,
+	// at most one per city. allocationTimer closes the window.
+	foodRequests    []domain.FoodRequest
+	allocationTimer *time.Timer
+
+	// researchTimer fires ResearchCompleteMessage when the research in
+	// progress ends. See scheduleResearchComplete.
+	researchTimer *time.Timer
+
+	// chatMute is the user's mute, if any. chatTokens is their chat rate
+	// limit bucket as of chatTokensAt. See chat.go.
+	chatMute     *domain.ChatMute
+	chatTokens   float64
+	chatTokensAt time.Time
+
 	ticker       *time.Ticker
 	stopTickerCh chan struct{}
 }
@@ -42,6 +57,9 @@ func (state *userActor) Receive(ctx actor.Context) {
 	case *messages.CreateUserMessage:
 		slog.DebugContext(state.Ctx(), "registering user actor", "username", msg.User.Username)
 		state.User = msg.User
+		if !state.User.FoodPolicy.Valid() {
+			state.User.FoodPolicy = domain.FoodPolicyCapitalFirst
+		}
 		if !msg.Restore {
 			if err := state.Store.CreateUser(state.Ctx(), state.User); err != nil {
 				slog.ErrorContext(state.Ctx(), "failed to persist user create", "user_id", state.User.UserID, "error", err)
@@ -49,12 +67,21 @@ func (state *userActor) Receive(ctx actor.Context) {
 				return
 			}
 			services.CreateCity(state.Ctx(), state.Cluster, state.Store, &services.CityInput{ //nolint:errcheck // fire-and-forget
-				Type:  domain.CityTypeCity,
-				Owner: &state.User.UserID,
-				Name:  fmt.Sprintf("%s's City", state.User.Username),
-				Size:  constants.CitySize,
+				Type:    domain.CityTypeCity,
+				Owner:   &state.User.UserID,
+				Name:    fmt.Sprintf("%s's City", state.User.Username),
+				Size:    constants.CitySize,
+				Capital: true,
 			})
+		} else {
+			// Research that ended while the server was down completes now.
+			// The cities aren't restored yet; they ask for the techs as they
+			// come up.
+			state.restoreResearch()
+			state.checkResearchComplete()
+			state.restoreChatMute()
 		}
+		state.scheduleResearchComplete(ctx)
 		state.startPeriodicOperation(ctx)
 		ctx.Respond(messages.Ack{})
 
@@ -76,14 +103,29 @@ func (state *userActor) Receive(ctx actor.Context) {
 		}
 
 	case messages.RequestFoodFromPoolMessage:
-		// City deficit drawing from the pool. Same batching reasoning.
-		granted := max(min(msg.Amount, state.User.Food), 0)
-		state.User.Food -= granted
-		state.foodUpkeepAccum += granted
-		// TODO: when players can own multiple cities, batch requests within a
-		// window and allocate by priority (capital first, then by population
-		// descending) instead of first-come.
-		ctx.Respond(messages.RequestFoodFromPoolResponse{Granted: granted})
+		// City deficit drawing from the pool. Requests are held until the
+		// window closes so every city competes for the same balance instead
+		// of whichever ticks first draining it.
+		state.queueFoodRequest(ctx, domain.FoodRequest{
+			CityID:     msg.CityID,
+			Amount:     msg.Amount,
+			Capital:    msg.Capital,
+			Population: msg.Population,
+			Priority:   msg.Priority,
+		})
+
+	case messages.AllocateFoodPoolMessage:
+		state.allocateFoodPool()
+
+	case messages.SetFoodPolicyMessage:
+		if !msg.Policy.Valid() {
+			ctx.Respond(&messages.InvalidFoodPolicyError{Policy: msg.Policy})
+			return
+		}
+		state.User.FoodPolicy = msg.Policy
+		state.Store.EnqueueUser(state.User)
+		state.publish()
+		ctx.Respond(messages.Ack{})
 
 	case messages.CheckAndDeductGoldMessage:
 		// Player-initiated spend (e.g. building upgrade). Publish immediately
@@ -97,10 +139,64 @@ func (state *userActor) Receive(ctx actor.Context) {
 			})
 			return
 		}
+		if missing := msg.Food - state.User.Food; missing > 0 {
+			ctx.Respond(messages.InsufficientFoodError{
+				Missing: missing,
+			})
+			return
+		}
 		state.User.Gold -= msg.Amount
+		state.User.Food -= msg.Food
 		state.publish()
 		ctx.Respond(messages.Ack{})
 
+	case messages.StartResearchMessage:
+		research, err := state.startResearch(ctx, msg.Tech)
+		if err != nil {
+			ctx.Respond(err)
+			return
+		}
+		ctx.Respond(&messages.StartResearchResponseMessage{Research: *research})
+
+	case messages.CancelResearchMessage:
+		refund, err := state.cancelResearch()
+		if err != nil {
+			ctx.Respond(err)
+			return
+		}
+		ctx.Respond(&messages.CancelResearchResponseMessage{Refund: refund})
+
+	case messages.ResearchCompleteMessage:
+		if state.checkResearchComplete() {
+			state.pushTechs()
+			state.publish()
+		}
+
+	case messages.SyncTechsMessage:
+		state.sendTechs(msg.CityID)
+
+	case messages.SendChatMessage:
+		message, err := state.sendChat(msg.Channel, msg.Body)
+		if err != nil {
+			ctx.Respond(err)
+			return
+		}
+		ctx.Respond(&messages.SendChatResponse{Message: *message})
+
+	case messages.MuteUserMessage:
+		if err := state.muteChat(msg); err != nil {
+			ctx.Respond(err)
+			return
+		}
+		ctx.Respond(messages.Ack{})
+
+	case messages.UnmuteUserMessage:
+		if err := state.unmuteChat(); err != nil {
+			ctx.Respond(err)
+			return
+		}
+		ctx.Respond(messages.Ack{})
+
 	case messages.GetUserMessage:
 		ctx.Respond(&messages.GetUserResponseMessage{
 			User: state.User,
@@ -112,6 +208,14 @@ func (state *userActor) Receive(ctx actor.Context) {
 		}
 
 		slog.DebugContext(state.Ctx(), "shutting down user actor", "user_id", state.User.UserID)
+		if state.allocationTimer != nil {
+			state.allocationTimer.Stop()
+			state.allocationTimer = nil
+		}
+		if state.researchTimer != nil {
+			state.researchTimer.Stop()
+			state.researchTimer = nil
+		}
 		state.stopPeriodicOperation()
 		ctx.Stop(ctx.Self())
 
@@ -121,6 +225,9 @@ func (state *userActor) Receive(ctx actor.Context) {
 		state.User.FoodUpkeepRate = state.foodUpkeepAccum * int64(constants.SecondsPerHour) / windowSecs
 		state.foodIncomeAccum = 0
 		state.foodUpkeepAccum = 0
+		if state.checkResearchComplete() {
+			state.pushTechs()
+		}
 		state.Store.EnqueueUser(state.User)
 		state.publish()
 	}
@@ -150,6 +257,56 @@ func (state *userActor) stopPeriodicOperation() {
 	state.ticker = nil
 }
 
+// queueFoodRequest adds a city's request to the current allocation window,
+// opening the window if none is pending. A city that asks twice in one window
+// has its amounts summed, while its capital flag, population and priority
+// follow the latest request.
+func (state *userActor) queueFoodRequest(ctx actor.Context, req domain.FoodRequest) {
+	if req.Amount <= 0 {
+		return
+	}
+	for i, r := range state.foodRequests {
+		if r.CityID == req.CityID {
+			req.Amount += r.Amount
+			state.foodRequests[i] = req
+			return
+		}
+	}
+	state.foodRequests = append(state.foodRequests, req)
+	if state.allocationTimer != nil {
+		return
+	}
+	pid := ctx.Self()
+	system := ctx.ActorSystem()
+	state.allocationTimer = time.AfterFunc(constants.FoodAllocationWindow*time.Second, func() {
+		system.Root.Send(pid, messages.AllocateFoodPoolMessage{})
+	})
+}
+
+// allocateFoodPool closes the allocation window: the pool is split between
+// the queued requests under the user's policy, withdrawn, and each city is
+// told what it received.
+func (state *userActor) allocateFoodPool() {
+	state.allocationTimer = nil
+	requests := state.foodRequests
+	state.foodRequests = nil
+	if len(requests) == 0 {
+		return
+	}
+
+	grants := domain.AllocateFood(state.User.Food, requests, state.User.FoodPolicy)
+	for i, req := range requests {
+		state.User.Food -= grants[i]
+		state.foodUpkeepAccum += grants[i]
+		if err := state.Cluster.Tell("city", req.CityID, messages.FoodPoolGrantMessage{
+			Requested: req.Amount,
+			Granted:   grants[i],
+		}); err != nil {
+			slog.ErrorContext(state.Ctx(), "failed to send food grant", "city_id", req.CityID, "error", err)
+		}
+	}
+}
+
 // publish pushes the user's current state to their StreamState
 // subscribers via the in-process pub/sub. Call after any change the player
 // should see without waiting for the next periodic tick — gold/food balance
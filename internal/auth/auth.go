@@ -74,9 +74,12 @@ func ClaimsFromContext(ctx context.Context) (Claims, bool) {
 
 // publicProcedures are reachable without a token.
 var publicProcedures = map[string]struct{}{
-	"/cityio.service.v1.UserService/Register":        {},
-	"/cityio.service.v1.UserService/Login":           {},
-	"/cityio.service.v1.ConfigService/GetGameConfig": {},
+	"/cityio.service.v1.UserService/Register":            {},
+	"/cityio.service.v1.UserService/Login":               {},
+	"/cityio.service.v1.ConfigService/GetGameConfig":     {},
+	"/cityio.service.v1.WorldService/GetWorld":           {},
+	"/cityio.service.v1.WorldService/ListSeasons":        {},
+	"/cityio.service.v1.WorldService/GetSeasonStandings": {},
 }
 
 // Interceptor verifies the bearer token on every non-public procedure and
@@ -45,6 +45,11 @@ func NewRuntime(ctx context.Context, store ports.Store, environment string) *Clu
 		cluster.NewKind("city", actor.PropsFromProducer(spawn(actors.NewCityActor))),
 		cluster.NewKind("tile", actor.PropsFromProducer(spawn(actors.NewTileActor))),
 		cluster.NewKind("building", actor.PropsFromProducer(spawn(actors.NewBuildingActor))),
+		cluster.NewKind("army", actor.PropsFromProducer(spawn(actors.NewArmyActor))),
+		cluster.NewKind("caravan", actor.PropsFromProducer(spawn(actors.NewCaravanActor))),
+		cluster.NewKind("market", actor.PropsFromProducer(spawn(actors.NewMarketActor))),
+		cluster.NewKind("alliance", actor.PropsFromProducer(spawn(actors.NewAllianceActor))),
+		cluster.NewKind("relation", actor.PropsFromProducer(spawn(actors.NewRelationActor))),
 	}
 
 	remoteConfig := remote.Configure("127.0.0.1", 8090)
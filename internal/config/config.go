@@ -12,10 +12,15 @@ import (
 // Config is the application configuration, populated from environment
 // variables.
 type Config struct {
-	Environment string         `env:"ENVIRONMENT" envDefault:"development"`
-	APIPort     string         `env:"API_PORT" envDefault:"8080"`
-	JWTSecret   string         `env:"JWT_SECRET"`
-	DB          DatabaseConfig `envPrefix:"PSQL_"`
+	Environment string   `env:"ENVIRONMENT" envDefault:"development"`
+	APIPort     string   `env:"API_PORT" envDefault:"8080"`
+	JWTSecret   string   `env:"JWT_SECRET"`
+	Admins      []string `env:"ADMIN_USERNAMES" envSeparator:","` // usernames allowed to call AdminService
+	// BalanceFile and BuildingsFile replace the built-in balance numbers and
+	// building definitions when set. Both are reread on SIGHUP.
+	BalanceFile   string         `env:"BALANCE_FILE"`
+	BuildingsFile string         `env:"BUILDINGS_FILE"`
+	DB            DatabaseConfig `envPrefix:"PSQL_"`
 }
 
 // DatabaseConfig holds the connection settings for the PostgreSQL database.
@@ -0,0 +1,98 @@
+package constants
+
+import (
+	"fmt"
+	"slices"
+
+	"cityio/internal/domain"
+)
+
+// AdjacencyRule raises one output of a building for every neighbouring
+// building of another type. Neighbours are the eight tiles around the
+// building, and only completed neighbours (level 1 and up) count. Rules are
+// loaded with the building definitions and apply to a city from its next
+// layout change.
+type AdjacencyRule struct {
+	ID        string              `json:"id"`
+	Building  domain.BuildingType `json:"building"`
+	Neighbour domain.BuildingType `json:"neighbour"`
+	Resource  string              `json:"resource"`   // "gold", "food" or "population"
+	Percent   float64             `json:"percent"`    // per matching neighbour
+	MaxStacks int                 `json:"max_stacks"` // matching neighbours counted at most
+}
+
+// validate checks the rule against the building definitions it was loaded
+// with: both types must be defined, and the building must produce the
+// resource (or house people, for population).
+func (rule *AdjacencyRule) validate(defs map[domain.BuildingType]*BuildingDefinition) error {
+	building, ok := defs[rule.Building]
+	if !ok {
+		return fmt.Errorf("building %q is not defined", rule.Building)
+	}
+	if _, ok := defs[rule.Neighbour]; !ok {
+		return fmt.Errorf("neighbour %q is not defined", rule.Neighbour)
+	}
+	switch rule.Resource {
+	case "population":
+		if !building.Housing {
+			return fmt.Errorf("population bonus on %q, which houses no one", rule.Building)
+		}
+	case "gold", "food":
+		if !slices.ContainsFunc(building.Production, func(e BuildingProductionEntry) bool { return e.Resource == rule.Resource }) {
+			return fmt.Errorf("%s bonus on %q, which produces none", rule.Resource, rule.Building)
+		}
+	default:
+		return fmt.Errorf("unknown resource %q", rule.Resource)
+	}
+	if rule.Percent <= 0 {
+		return fmt.Errorf("percent must be positive")
+	}
+	if rule.MaxStacks < 1 {
+		return fmt.Errorf("max_stacks must be at least 1")
+	}
+	return nil
+}
+
+// GetAdjacencyRules returns the adjacency rules in effect, in file order.
+func GetAdjacencyRules() []AdjacencyRule {
+	return current.Load().Buildings.Adjacency
+}
+
+// AdjacencyBonuses evaluates the adjacency rules for b against the rest of
+// its city's layout, in rule order. Rules with no matching neighbour are
+// left out.
+func AdjacencyBonuses(b domain.Building, layout map[string]domain.Building) []domain.AdjacencyBonus {
+	var bonuses []domain.AdjacencyBonus
+	for _, rule := range GetAdjacencyRules() {
+		if rule.Building != b.BuildingType() {
+			continue
+		}
+		n := 0
+		for _, other := range layout {
+			if other.BuildingID == b.BuildingID || other.BuildingType() != rule.Neighbour || other.Level < 1 {
+				continue
+			}
+			if max(abs(other.X-b.X), abs(other.Y-b.Y)) == 1 {
+				n++
+			}
+		}
+		n = min(n, rule.MaxStacks)
+		if n == 0 {
+			continue
+		}
+		bonuses = append(bonuses, domain.AdjacencyBonus{
+			Rule:       rule.ID,
+			Resource:   rule.Resource,
+			Percent:    rule.Percent * float64(n),
+			Neighbours: n,
+		})
+	}
+	return bonuses
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
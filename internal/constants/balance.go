@@ -0,0 +1,389 @@
+package constants
+
+import (
+	"bytes"
+	"crypto/sha256"
+	_ "embed"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+
+	"cityio/internal/domain"
+)
+
+// Balance numbers and building definitions are loaded from data files and can
+// be reloaded while the server runs. Together they form a Snapshot; readers
+// take the current one and see consistent values for as long as they hold
+// it. Structural values (map and city size, tick intervals, persistence
+// cadence, queue limits) stay constants: changing them needs a restart, and
+// usually a new world.
+
+// BalanceVersion is the balance file format this server reads.
+const BalanceVersion = 1
+
+//go:embed balance.json
+var defaultBalance []byte
+
+// Balance holds the tunable numbers of the game.
+type Balance struct {
+	Version      int                 `json:"version"`
+	Population   PopulationBalance   `json:"population"`
+	Tax          TaxBalance          `json:"tax"`
+	Morale       MoraleBalance       `json:"morale"`
+	Troops       TroopBalance        `json:"troops"`
+	Caravans     CaravanBalance      `json:"caravans"`
+	Construction ConstructionBalance `json:"construction"`
+	Research     ResearchBalance     `json:"research"`
+	Diplomacy    DiplomacyBalance    `json:"diplomacy"`
+	Combat       CombatBalance       `json:"combat"`
+	Towns        TownBalance         `json:"towns"`
+}
+
+type PopulationBalance struct {
+	// GrowthRate is the base logistic growth rate per city tick, in the
+	// absence of any food surplus bonus. Slow on purpose so a food surplus is
+	// meaningfully felt — a city that only just covers its upkeep grows at
+	// this rate, and stacking extra farms speeds it up via
+	// SurplusGrowthBonus.
+	GrowthRate float64 `json:"growth_rate"`
+	// SurplusGrowthBonus is the maximum additional growth multiplier from a
+	// food surplus. The bonus saturates at 100% surplus (production = 2×
+	// demand): below that it scales linearly; above it stays capped. At full
+	// saturation growth runs at (1 + SurplusGrowthBonus)× the base rate.
+	SurplusGrowthBonus float64 `json:"surplus_growth_bonus"`
+	// StarvationDeclineRate scales population loss per tick when a city's
+	// own production doesn't cover its demand. Applied as
+	// pop *= (1 - rate * deficitRatio). Pool coverage doesn't prevent the
+	// decline — a city has to be locally self-sufficient to hold or grow.
+	StarvationDeclineRate float64 `json:"starvation_decline_rate"`
+	// FoodPerPopPerHour is the per-population food upkeep per hour. 250 pop ×
+	// 48 = 12,000 food/hour, exactly one L1 farm's output.
+	FoodPerPopPerHour int64 `json:"food_per_pop_per_hour"`
+}
+
+type TaxBalance struct {
+	// MaxRate caps the tax rate, in percent, a player may set.
+	MaxRate int `json:"max_rate"`
+	// GoldPerPopPerHour is the gold one inhabitant yields per hour at a 100%
+	// tax rate. 250 pop at the default 10% bring in 1,500 gold/hour.
+	GoldPerPopPerHour float64 `json:"gold_per_pop_per_hour"`
+	// GrowthPenalty scales how much taxes hold back growth: a fed city grows
+	// at (1 - GrowthPenalty × rate/100)× its untaxed pace.
+	GrowthPenalty float64 `json:"growth_penalty"`
+}
+
+// MoraleBalance tunes morale, which runs from 0 to 100. A city's target
+// morale starts at MoraleBase and is pushed by food, taxes, crowding and
+// amenities; actual morale closes Drift of the gap to it each tick. At
+// Neutral production and growth run at their normal pace.
+type MoraleBalance struct {
+	Neutral float64 `json:"neutral"`
+	Drift   float64 `json:"drift"`
+	// A full food surplus (production = 2× demand) adds FoodSurplus; a total
+	// deficit takes FoodDeficit. Both scale linearly.
+	FoodSurplus float64 `json:"food_surplus"`
+	FoodDeficit float64 `json:"food_deficit"`
+	// PerTaxPoint is lost for every percentage point of tax.
+	PerTaxPoint float64 `json:"per_tax_point"`
+	// Past CrowdingStart of the population cap, morale falls linearly to
+	// CrowdingPenalty below base at a full city.
+	CrowdingStart   float64 `json:"crowding_start"`
+	CrowdingPenalty float64 `json:"crowding_penalty"`
+	// OutputSwing and GrowthSwing are how far production and growth move
+	// from normal at 0 or 100 morale: 0.25 means 0.75×–1.25×.
+	OutputSwing float64 `json:"output_swing"`
+	GrowthSwing float64 `json:"growth_swing"`
+	// Below Unrest a city is in unrest; after RevoltTicks consecutive ticks
+	// of it the population revolts and the city goes neutral.
+	Unrest      float64 `json:"unrest"`
+	RevoltTicks int     `json:"revolt_ticks"`
+}
+
+type TroopBalance struct {
+	// per troop, charged up front when training is ordered
+	GoldCost int64 `json:"gold_cost"`
+	FoodCost int64 `json:"food_cost"`
+	// TrainingSeconds is how long a level-1 barracks takes to train one
+	// troop; MovementSeconds how long a troop takes to cross one tile.
+	TrainingSeconds int64 `json:"training_seconds"`
+	MovementSeconds int64 `json:"movement_seconds"`
+}
+
+type CaravanBalance struct {
+	// MovementSeconds is how long a caravan takes to cross one tile.
+	MovementSeconds int64 `json:"movement_seconds"`
+	// Capacity caps a caravan's cargo, gold and food together.
+	Capacity int64 `json:"capacity"`
+	// StoreCapacity is how much of its food surplus a city keeps in its own
+	// store for caravans to load; the overflow goes to the owner's pool.
+	StoreCapacity int64 `json:"store_capacity"`
+}
+
+type ConstructionBalance struct {
+	// CancelRefund is the share of a construction's cost returned when it is
+	// cancelled right after starting; the refund shrinks in proportion to the
+	// time already spent.
+	CancelRefund float64 `json:"cancel_refund"`
+	// speeding up construction costs SpeedUpGoldPerSecond for every second
+	// skipped, and never less than SpeedUpMinimumCost
+	SpeedUpGoldPerSecond int64 `json:"speed_up_gold_per_second"`
+	SpeedUpMinimumCost   int64 `json:"speed_up_minimum_cost"`
+	// Slots is how many constructions a city runs in parallel, by center
+	// level. A city picks up a changed table at its center's next report.
+	Slots []int `json:"slots"`
+}
+
+// TownBalance shapes the neutral towns generated at a season start; a change
+// applies from the next season.
+type TownBalance struct {
+	// Sizes are the town sizes generated, each drawn with probability
+	// Weight over the total weight.
+	Sizes []TownSize `json:"sizes"`
+}
+
+// TownSize is one kind of generated town: its block size, the level of its
+// town center and how many level-1 houses stand around it.
+type TownSize struct {
+	Size        int `json:"size"`
+	CenterLevel int `json:"center_level"`
+	HouseCount  int `json:"house_count"`
+	Weight      int `json:"weight"`
+}
+
+type ResearchBalance struct {
+	// CancelRefund is the share of a tech's cost returned when its research
+	// is cancelled, prorated the same way as construction.
+	CancelRefund float64 `json:"cancel_refund"`
+}
+
+// DiplomacyBalance sets how long relation changes take to come into force.
+// Treaties take effect as soon as both sides agree; breaking one, like
+// declaring war, gives the other side time to prepare.
+type DiplomacyBalance struct {
+	// WarDeclarationSeconds is the delay between declaring war and the war
+	// starting.
+	WarDeclarationSeconds int64 `json:"war_declaration_seconds"`
+	// PactCancelSeconds and PeaceCancelSeconds are how long a cancelled
+	// non-aggression pact or peace treaty stays in force before the pair
+	// falls back to neutral.
+	PactCancelSeconds  int64 `json:"pact_cancel_seconds"`
+	PeaceCancelSeconds int64 `json:"peace_cancel_seconds"`
+}
+
+type CombatBalance struct {
+	// TerrainDefense multiplies a defender's strength by the ground the
+	// battle is fought on. Terrains left out fight as open ground, 1.
+	TerrainDefense map[domain.Terrain]float64 `json:"terrain_defense"`
+}
+
+// GetTerrainDefense returns the defence multiplier of a battle fought on
+// terrain t.
+func GetTerrainDefense(t domain.Terrain) float64 {
+	if m, ok := GetBalance().Combat.TerrainDefense[t]; ok {
+		return m
+	}
+	return 1
+}
+
+func (b *Balance) validate() error {
+	var errs []error
+	check := func(ok bool, format string, args ...any) {
+		if !ok {
+			errs = append(errs, fmt.Errorf(format, args...))
+		}
+	}
+	check(b.Version == BalanceVersion, "balance version %d, want %d", b.Version, BalanceVersion)
+	check(b.Population.GrowthRate >= 0, "population.growth_rate must not be negative")
+	check(b.Population.SurplusGrowthBonus >= 0, "population.surplus_growth_bonus must not be negative")
+	check(b.Population.StarvationDeclineRate >= 0 && b.Population.StarvationDeclineRate <= 1, "population.starvation_decline_rate must be within [0, 1]")
+	check(b.Population.FoodPerPopPerHour >= 0, "population.food_per_pop_per_hour must not be negative")
+	check(b.Tax.MaxRate >= DefaultTaxRate && b.Tax.MaxRate <= 100, "tax.max_rate must be within [%d, 100]", DefaultTaxRate)
+	check(b.Tax.GoldPerPopPerHour >= 0, "tax.gold_per_pop_per_hour must not be negative")
+	check(b.Tax.GrowthPenalty >= 0, "tax.growth_penalty must not be negative")
+	check(b.Morale.Neutral > 0 && b.Morale.Neutral < 100, "morale.neutral must be within (0, 100)")
+	check(b.Morale.Drift > 0 && b.Morale.Drift <= 1, "morale.drift must be within (0, 1]")
+	check(b.Morale.CrowdingStart >= 0 && b.Morale.CrowdingStart < 1, "morale.crowding_start must be within [0, 1)")
+	check(b.Morale.OutputSwing >= 0 && b.Morale.OutputSwing <= 1, "morale.output_swing must be within [0, 1]")
+	check(b.Morale.GrowthSwing >= 0 && b.Morale.GrowthSwing <= 1, "morale.growth_swing must be within [0, 1]")
+	check(b.Morale.Unrest >= 0 && b.Morale.Unrest <= 100, "morale.unrest must be within [0, 100]")
+	check(b.Morale.RevoltTicks > 0, "morale.revolt_ticks must be positive")
+	check(b.Troops.GoldCost >= 0 && b.Troops.FoodCost >= 0, "troop costs must not be negative")
+	check(b.Troops.TrainingSeconds > 0, "troops.training_seconds must be positive")
+	check(b.Troops.MovementSeconds > 0, "troops.movement_seconds must be positive")
+	check(b.Caravans.MovementSeconds > 0, "caravans.movement_seconds must be positive")
+	check(b.Caravans.Capacity > 0, "caravans.capacity must be positive")
+	check(b.Caravans.StoreCapacity >= 0, "caravans.store_capacity must not be negative")
+	check(b.Construction.CancelRefund >= 0 && b.Construction.CancelRefund <= 1, "construction.cancel_refund must be within [0, 1]")
+	check(b.Construction.SpeedUpGoldPerSecond >= 0 && b.Construction.SpeedUpMinimumCost >= 0, "speed-up prices must not be negative")
+	check(len(b.Construction.Slots) == MAX_BUILDING_LEVEL, "construction.slots has %d levels, want %d", len(b.Construction.Slots), MAX_BUILDING_LEVEL)
+	for i, n := range b.Construction.Slots {
+		check(n >= 1, "construction.slots[%d] must be at least 1", i)
+	}
+	check(len(b.Towns.Sizes) > 0, "towns.sizes must not be empty")
+	for _, t := range b.Towns.Sizes {
+		check(t.Size >= 2, "towns.sizes: size %d must be at least 2", t.Size)
+		check(t.CenterLevel >= 1 && t.CenterLevel <= MAX_BUILDING_LEVEL, "towns.sizes: size %d center_level out of range", t.Size)
+		check(t.HouseCount >= 0 && t.HouseCount < t.Size*t.Size, "towns.sizes: size %d has no room for %d houses", t.Size, t.HouseCount)
+		check(t.Weight > 0, "towns.sizes: size %d weight must be positive", t.Size)
+	}
+	check(b.Research.CancelRefund >= 0 && b.Research.CancelRefund <= 1, "research.cancel_refund must be within [0, 1]")
+	check(b.Diplomacy.WarDeclarationSeconds >= 0, "diplomacy.war_declaration_seconds must not be negative")
+	check(b.Diplomacy.PactCancelSeconds >= 0 && b.Diplomacy.PeaceCancelSeconds >= 0, "treaty cancellation delays must not be negative")
+	for t, m := range b.Combat.TerrainDefense {
+		check(t.Valid(), "combat.terrain_defense: unknown terrain %q", t)
+		check(m > 0, "combat.terrain_defense.%s must be positive", t)
+	}
+	return errors.Join(errs...)
+}
+
+// Snapshot is one loaded version of the balance numbers and building
+// definitions. Snapshots are never modified once current; a reload swaps in a
+// new one.
+type Snapshot struct {
+	// Version is a hash of the loaded values, so clients can tell when to
+	// refetch GetGameConfig.
+	Version   string
+	Balance   Balance
+	Buildings BuildingDefinitions
+
+	buildingsByType map[domain.BuildingType]*BuildingDefinition
+}
+
+var (
+	current atomic.Pointer[Snapshot]
+	// loadMu serialises loads so a reload checks against the snapshot it
+	// replaces.
+	loadMu sync.Mutex
+)
+
+func init() {
+	if _, err := load(nil, nil, false); err != nil {
+		panic(fmt.Sprintf("built-in balance: %v", err))
+	}
+}
+
+// Current returns the snapshot in effect. Callers that read several values
+// for one decision should take it once rather than call the getters, which
+// each read the latest snapshot.
+func Current() *Snapshot {
+	return current.Load()
+}
+
+// GetBalance returns the balance numbers in effect.
+func GetBalance() *Balance {
+	return &current.Load().Balance
+}
+
+// LoadFiles reads the balance and building definition files at the given
+// paths and, if both are valid, makes them the current snapshot. An empty path
+// loads the built-in file, and keys a balance file leaves out keep their
+// built-in values. Call it at startup, before the world is restored.
+func LoadFiles(balancePath, buildingsPath string) (*Snapshot, error) {
+	balance, buildings, err := readFiles(balancePath, buildingsPath)
+	if err != nil {
+		return nil, err
+	}
+	return load(balance, buildings, false)
+}
+
+// ReloadFiles is LoadFiles for a running server. The building definitions
+// must keep every loaded type and its behaviour flags: buildings of those
+// types stand in the world, and their actors chose their behaviour when they
+// started.
+func ReloadFiles(balancePath, buildingsPath string) (*Snapshot, error) {
+	balance, buildings, err := readFiles(balancePath, buildingsPath)
+	if err != nil {
+		return nil, err
+	}
+	return load(balance, buildings, true)
+}
+
+func readFiles(balancePath, buildingsPath string) (balance, buildings []byte, err error) {
+	if balancePath != "" {
+		if balance, err = os.ReadFile(balancePath); err != nil {
+			return nil, nil, err
+		}
+	}
+	if buildingsPath != "" {
+		if buildings, err = os.ReadFile(buildingsPath); err != nil {
+			return nil, nil, err
+		}
+	}
+	return balance, buildings, nil
+}
+
+func load(balance, buildings []byte, reload bool) (*Snapshot, error) {
+	loadMu.Lock()
+	defer loadMu.Unlock()
+
+	snap := &Snapshot{}
+	if err := decodeStrict(defaultBalance, &snap.Balance); err != nil {
+		return nil, fmt.Errorf("parse built-in balance: %w", err)
+	}
+	if balance != nil {
+		if err := decodeStrict(balance, &snap.Balance); err != nil {
+			return nil, fmt.Errorf("parse balance: %w", err)
+		}
+	}
+	if err := snap.Balance.validate(); err != nil {
+		return nil, err
+	}
+
+	if buildings == nil {
+		buildings = defaultBuildingDefinitions
+	}
+	if err := decodeStrict(buildings, &snap.Buildings); err != nil {
+		return nil, fmt.Errorf("parse building definitions: %w", err)
+	}
+	if err := snap.Buildings.validate(); err != nil {
+		return nil, err
+	}
+	snap.buildingsByType = make(map[domain.BuildingType]*BuildingDefinition, len(snap.Buildings.Buildings))
+	for i := range snap.Buildings.Buildings {
+		snap.buildingsByType[snap.Buildings.Buildings[i].Type] = &snap.Buildings.Buildings[i]
+	}
+	if reload {
+		if err := snap.checkReplaces(current.Load()); err != nil {
+			return nil, err
+		}
+	}
+
+	hash := sha256.New()
+	if err := json.NewEncoder(hash).Encode(snap.Balance); err != nil {
+		return nil, err
+	}
+	if err := json.NewEncoder(hash).Encode(snap.Buildings); err != nil {
+		return nil, err
+	}
+	snap.Version = hex.EncodeToString(hash.Sum(nil))[:16]
+
+	current.Store(snap)
+	return snap, nil
+}
+
+// checkReplaces rejects definitions that drop a building type or change the
+// behaviour of one from prev.
+func (snap *Snapshot) checkReplaces(prev *Snapshot) error {
+	for _, old := range prev.Buildings.Buildings {
+		def, ok := snap.buildingsByType[old.Type]
+		if !ok {
+			return fmt.Errorf("building %q can't be removed while the server runs", old.Type)
+		}
+		if def.Center != old.Center || def.Produces != old.Produces || def.Housing != old.Housing || def.Trains != old.Trains {
+			return fmt.Errorf("building %q: behaviour flags can't change while the server runs", old.Type)
+		}
+	}
+	return nil
+}
+
+// decodeStrict decodes JSON into v, rejecting unknown keys so a misspelt
+// setting fails the load instead of being ignored.
+func decodeStrict(data []byte, v any) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	return dec.Decode(v)
+}
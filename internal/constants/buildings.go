@@ -1,58 +1,249 @@
 package constants
 
-import "cityio/internal/domain"
+import (
+	_ "embed"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"cityio/internal/domain"
+)
 
 const MAX_BUILDING_LEVEL = 10
 
+// BuildingDefinitionsVersion is the definition file format this server reads.
+// Bump it when a change to BuildingDefinition would misread older files.
+const BuildingDefinitionsVersion = 1
+
+// Building types are data: every type, its tables and its behaviour come from
+// a definition file, buildings.json by default, loaded as part of the current
+// Snapshot. The BuildingType constants in domain only name the types the
+// server itself relies on (centers to found cities around, the starting
+// farm); loading refuses files that leave any of them out.
+
+//go:embed buildings.json
+var defaultBuildingDefinitions []byte
+
 // BuildingProductionEntry pairs a resource name with per-level amounts. Amounts
 // are stored as integer values per SecondsPerHour (i.e. per hour).
-type BuildingProductionEntry struct {
-	Resource string
-	Amounts  []int64
-}
-
+//
 // All production values are per hour. Chosen so per-tick math
 // (amount * tickSeconds / SecondsPerHour) is exact integer division for the
 // current 3s tick: each value is a multiple of 1200 = 3600/3.
-var buildingProduction = map[domain.BuildingType][]BuildingProductionEntry{
-	domain.BuildingTypeCityCenter: {{"gold", []int64{3600, 7200, 10800, 14400, 18000, 21600, 25200, 28800, 32400, 36000}}},
-	domain.BuildingTypeTownCenter: {{"gold", []int64{3600, 7200, 10800, 14400, 18000, 21600, 25200, 28800, 32400, 36000}}},
-	domain.BuildingTypeFarm:       {{"food", []int64{12000, 24000, 36000, 48000, 60000, 72000, 84000, 96000, 108000, 120000}}},
-	domain.BuildingTypeMine:       {{"gold", []int64{7200, 14400, 21600, 28800, 36000, 43200, 50400, 57600, 64800, 72000}}},
+type BuildingProductionEntry struct {
+	Resource string  `json:"resource"`
+	Amounts  []int64 `json:"amounts"`
 }
 
-var buildingPopulation = map[domain.BuildingType][]float64{
-	domain.BuildingTypeCityCenter: {250, 350, 450, 550, 650, 750, 850, 950, 1050, 1150},
-	domain.BuildingTypeTownCenter: {50, 100, 150, 200, 250, 300, 350, 400, 450, 500},
-	domain.BuildingTypeHouse:      {50, 100, 150, 200, 250, 300, 350, 400, 450, 500},
+// BuildingDefinition describes one building type. Per-level tables hold
+// MAX_BUILDING_LEVEL entries, level 1 first; the behaviour flags say which of
+// the optional tables a type has.
+type BuildingDefinition struct {
+	Type domain.BuildingType `json:"type"`
+
+	// Center marks the building a city is founded around. Its level sets the
+	// city's construction slots, building limits and level cap.
+	Center bool `json:"center"`
+	// Produces buildings credit Production to their city every tick.
+	Produces bool `json:"produces"`
+	// Housing buildings add Population to their city's cap.
+	Housing bool `json:"housing"`
+	// Trains buildings train troops, TrainingSlots batches at a time.
+	Trains bool `json:"trains"`
+
+	Cost             []int64                   `json:"cost"`              // gold
+	ConstructionTime []int64                   `json:"construction_time"` // seconds
+	Production       []BuildingProductionEntry `json:"production,omitempty"`
+	Population       []float64                 `json:"population,omitempty"`
+	// Amenity is the morale the building adds to its city. Optional.
+	Amenity []float64 `json:"amenity,omitempty"`
+	// TrainingSlots is how many batches train in parallel.
+	TrainingSlots []int `json:"training_slots,omitempty"`
+	// TrainingSpeed is the per-troop training time as a percentage of the
+	// balance's troops.training_seconds.
+	TrainingSpeed []int64 `json:"training_speed,omitempty"`
+
+	Prerequisites []BuildingPrerequisite `json:"prerequisites,omitempty"`
+	// MaxCount caps how many of the type a city holds, by center level.
+	// Empty means unlimited.
+	MaxCount []int `json:"max_count,omitempty"`
+	// LevelCap holds the type below MAX_BUILDING_LEVEL until a tech lifts it.
+	// Zero means no cap.
+	LevelCap int `json:"level_cap,omitempty"`
+
+	// Deposit is the kind of deposit the building must stand on; its richness
+	// scales the building's production. Empty means the building needs none.
+	Deposit domain.DepositKind `json:"deposit,omitempty"`
+	// ForbiddenTerrain lists the terrains the building can't be placed on.
+	// Nothing is ever built on water.
+	ForbiddenTerrain []domain.Terrain `json:"forbidden_terrain,omitempty"`
 }
 
-var buildingCosts = map[domain.BuildingType][]int64{
-	domain.BuildingTypeCityCenter: {1000, 2000, 3000, 4000, 5000, 6000, 7000, 8000, 9000, 10000},
-	domain.BuildingTypeTownCenter: {500, 1000, 1500, 2000, 2500, 3000, 3500, 4000, 4500, 5000},
-	domain.BuildingTypeBarracks:   {500, 1000, 1500, 2000, 2500, 3000, 3500, 4000, 4500, 5000},
-	domain.BuildingTypeHouse:      {200, 400, 600, 800, 1000, 1200, 1400, 1600, 1800, 2000},
-	domain.BuildingTypeFarm:       {300, 600, 900, 1200, 1500, 1800, 2100, 2400, 2700, 3000},
-	domain.BuildingTypeMine:       {300, 600, 900, 1200, 1500, 1800, 2100, 2400, 2700, 3000},
+// BuildingDefinitions is the contents of a definition file.
+type BuildingDefinitions struct {
+	Version   int                  `json:"version"`
+	Buildings []BuildingDefinition `json:"buildings"`
+	// Adjacency holds the bonuses buildings give their neighbours.
+	Adjacency []AdjacencyRule `json:"adjacency,omitempty"`
 }
 
-// in seconds
-var buildingConstructionTime = map[domain.BuildingType][]int64{
-	domain.BuildingTypeCityCenter: {0, 20, 30, 40, 50, 60, 70, 80, 90, 100},
-	domain.BuildingTypeTownCenter: {0, 20, 30, 40, 50, 60, 70, 80, 90, 100},
-	domain.BuildingTypeBarracks:   {10, 20, 30, 40, 50, 60, 70, 80, 90, 100},
-	domain.BuildingTypeHouse:      {5, 10, 15, 20, 25, 30, 35, 40, 45, 50},
-	domain.BuildingTypeFarm:       {5, 10, 15, 20, 25, 30, 35, 40, 45, 50},
-	domain.BuildingTypeMine:       {5, 10, 15, 20, 25, 30, 35, 40, 45, 50},
+// requiredBuildingTypes are referenced by name in the server and must be
+// defined, with the given center flag.
+var requiredBuildingTypes = map[domain.BuildingType]bool{
+	domain.BuildingTypeCityCenter: true,
+	domain.BuildingTypeTownCenter: true,
+	domain.BuildingTypeFarm:       false,
+}
+
+func (defs *BuildingDefinitions) validate() error {
+	if defs.Version != BuildingDefinitionsVersion {
+		return fmt.Errorf("building definitions version %d, want %d", defs.Version, BuildingDefinitionsVersion)
+	}
+	seen := map[domain.BuildingType]bool{}
+	for _, def := range defs.Buildings {
+		if def.Type == "" {
+			return fmt.Errorf("building definition without a type")
+		}
+		if seen[def.Type] {
+			return fmt.Errorf("building %q defined twice", def.Type)
+		}
+		seen[def.Type] = true
+		if err := def.validate(); err != nil {
+			return fmt.Errorf("building %q: %w", def.Type, err)
+		}
+	}
+	for _, def := range defs.Buildings {
+		for _, pre := range def.Prerequisites {
+			if !seen[pre.BuildingType] {
+				return fmt.Errorf("building %q: prerequisite %q is not defined", def.Type, pre.BuildingType)
+			}
+		}
+	}
+	for bt, center := range requiredBuildingTypes {
+		i := slices.IndexFunc(defs.Buildings, func(d BuildingDefinition) bool { return d.Type == bt })
+		if i < 0 {
+			return fmt.Errorf("building %q is required", bt)
+		}
+		if defs.Buildings[i].Center != center {
+			return fmt.Errorf("building %q: center must be %t", bt, center)
+		}
+	}
+	byType := make(map[domain.BuildingType]*BuildingDefinition, len(defs.Buildings))
+	for i := range defs.Buildings {
+		byType[defs.Buildings[i].Type] = &defs.Buildings[i]
+	}
+	rules := map[string]bool{}
+	for _, rule := range defs.Adjacency {
+		if rule.ID == "" {
+			return fmt.Errorf("adjacency rule without an id")
+		}
+		if rules[rule.ID] {
+			return fmt.Errorf("adjacency rule %q defined twice", rule.ID)
+		}
+		rules[rule.ID] = true
+		if err := rule.validate(byType); err != nil {
+			return fmt.Errorf("adjacency rule %q: %w", rule.ID, err)
+		}
+	}
+	return nil
+}
+
+func (def *BuildingDefinition) validate() error {
+	if err := checkLevels("cost", len(def.Cost), true); err != nil {
+		return err
+	}
+	if err := checkLevels("construction_time", len(def.ConstructionTime), true); err != nil {
+		return err
+	}
+	if def.Produces != (len(def.Production) > 0) {
+		return fmt.Errorf("produces and production must be set together")
+	}
+	for _, entry := range def.Production {
+		if entry.Resource != "gold" && entry.Resource != "food" {
+			return fmt.Errorf("unknown production resource %q", entry.Resource)
+		}
+		if err := checkLevels("production", len(entry.Amounts), true); err != nil {
+			return err
+		}
+	}
+	if err := checkLevels("population", len(def.Population), def.Housing); err != nil {
+		return err
+	}
+	if err := checkLevels("training_slots", len(def.TrainingSlots), def.Trains); err != nil {
+		return err
+	}
+	if err := checkLevels("training_speed", len(def.TrainingSpeed), def.Trains); err != nil {
+		return err
+	}
+	if len(def.Amenity) > 0 {
+		if err := checkLevels("amenity", len(def.Amenity), true); err != nil {
+			return err
+		}
+	}
+	if len(def.MaxCount) > 0 {
+		if err := checkLevels("max_count", len(def.MaxCount), true); err != nil {
+			return err
+		}
+	}
+	if def.LevelCap < 0 || def.LevelCap > MAX_BUILDING_LEVEL {
+		return fmt.Errorf("level_cap %d out of range", def.LevelCap)
+	}
+	if def.Deposit != "" && !def.Deposit.Valid() {
+		return fmt.Errorf("unknown deposit %q", def.Deposit)
+	}
+	for _, t := range def.ForbiddenTerrain {
+		if !t.Valid() {
+			return fmt.Errorf("forbidden_terrain: unknown terrain %q", t)
+		}
+	}
+	return nil
+}
+
+// checkLevels reports a per-level table that is missing when required, set
+// when not, or of the wrong length.
+func checkLevels(name string, n int, required bool) error {
+	switch {
+	case !required && n > 0:
+		return fmt.Errorf("%s set without its behaviour flag", name)
+	case required && n != MAX_BUILDING_LEVEL:
+		return fmt.Errorf("%s has %d levels, want %d", name, n, MAX_BUILDING_LEVEL)
+	}
+	return nil
+}
+
+// Building looks up a building type in the snapshot.
+func (snap *Snapshot) Building(buildingType domain.BuildingType) (*BuildingDefinition, bool) {
+	def, ok := snap.buildingsByType[buildingType]
+	return def, ok
+}
+
+// GetBuildingDefinition looks up a loaded building type.
+func GetBuildingDefinition(buildingType domain.BuildingType) (*BuildingDefinition, bool) {
+	return current.Load().Building(buildingType)
+}
+
+// AllBuildingTypes returns every loaded building type in file order.
+func AllBuildingTypes() []domain.BuildingType {
+	defs := current.Load().Buildings.Buildings
+	types := make([]domain.BuildingType, len(defs))
+	for i, def := range defs {
+		types[i] = def.Type
+	}
+	return types
 }
 
 // GetBuildingProduction returns the per-hour production rate for the given
-// resource at the given level. Returns 0 if the building does not produce that
-// resource.
-func GetBuildingProduction(buildingType domain.BuildingType, level int, resource string) int64 {
-	for _, entry := range buildingProduction[buildingType] {
+// resource at the given level, raised by the owner's researched techs. Returns
+// 0 if the building does not produce that resource.
+func GetBuildingProduction(buildingType domain.BuildingType, level int, resource string, researched []domain.TechID) int64 {
+	def, ok := GetBuildingDefinition(buildingType)
+	if !ok || level < 1 {
+		return 0
+	}
+	for _, entry := range def.Production {
 		if entry.Resource == resource {
-			return entry.Amounts[level-1]
+			return int64(math.Round(float64(entry.Amounts[level-1]) * techMultiplier(researched, TechEffectProduction, buildingType, resource)))
 		}
 	}
 	return 0
@@ -65,42 +256,128 @@ func PerTickAmount(perHour int64, tickSeconds int) int64 {
 	return perHour * int64(tickSeconds) / SecondsPerHour
 }
 
-func GetBuildingProductionEntries(buildingType domain.BuildingType) []BuildingProductionEntry {
-	return buildingProduction[buildingType]
+// GetBuildingAmenity returns the morale a building adds to its city at the
+// given level, 0 for buildings that provide none.
+func GetBuildingAmenity(buildingType domain.BuildingType, level int) float64 {
+	def, ok := GetBuildingDefinition(buildingType)
+	if !ok || len(def.Amenity) == 0 || level < 1 {
+		return 0
+	}
+	return def.Amenity[level-1]
 }
 
+// GetBuildingPopulation returns the population a housing building adds to its
+// city's cap at the given level, 0 for other buildings.
 func GetBuildingPopulation(buildingType domain.BuildingType, level int) float64 {
-	return buildingPopulation[buildingType][level-1]
+	def, ok := GetBuildingDefinition(buildingType)
+	if !ok || !def.Housing || level < 1 {
+		return 0
+	}
+	return def.Population[level-1]
 }
 
-func GetBuildingCost(buildingType domain.BuildingType, level int) int64 {
-	return buildingCosts[buildingType][level-1]
+// GetBuildingCost returns the gold cost of building the given level, with
+// the owner's researched techs applied.
+func GetBuildingCost(buildingType domain.BuildingType, level int, researched []domain.TechID) int64 {
+	def, ok := GetBuildingDefinition(buildingType)
+	if !ok {
+		return 0
+	}
+	return int64(math.Round(float64(def.Cost[level-1]) * techMultiplier(researched, TechEffectCost, buildingType, "")))
 }
 
-func GetBuildingConstructionTime(buildingType domain.BuildingType, level int) int64 {
-	return buildingConstructionTime[buildingType][level-1]
+// GetBuildingConstructionTime returns the seconds it takes to build the given
+// level, with the owner's researched techs applied.
+func GetBuildingConstructionTime(buildingType domain.BuildingType, level int, researched []domain.TechID) int64 {
+	def, ok := GetBuildingDefinition(buildingType)
+	if !ok {
+		return 0
+	}
+	return int64(math.Round(float64(def.ConstructionTime[level-1]) * techMultiplier(researched, TechEffectConstructionTime, buildingType, "")))
 }
 
-// AllBuildingTypes returns every building type that has a cost table defined.
-func AllBuildingTypes() []domain.BuildingType {
-	return []domain.BuildingType{
-		domain.BuildingTypeCityCenter,
-		domain.BuildingTypeTownCenter,
-		domain.BuildingTypeBarracks,
-		domain.BuildingTypeHouse,
-		domain.BuildingTypeFarm,
-		domain.BuildingTypeMine,
+// CanTrain reports whether buildings of the given type train troops.
+func CanTrain(buildingType domain.BuildingType) bool {
+	def, ok := GetBuildingDefinition(buildingType)
+	return ok && def.Trains
+}
+
+// GetTrainingSlots returns how many batches a training building of the given
+// level trains in parallel, 0 for buildings that don't train.
+func GetTrainingSlots(buildingType domain.BuildingType, level int) int {
+	def, ok := GetBuildingDefinition(buildingType)
+	if !ok || !def.Trains || level < 1 {
+		return 0
+	}
+	return def.TrainingSlots[level-1]
+}
+
+// AllowsTerrain reports whether a building of the given type may be placed
+// on terrain t.
+func AllowsTerrain(buildingType domain.BuildingType, t domain.Terrain) bool {
+	if t == domain.TerrainWater {
+		return false
+	}
+	def, ok := GetBuildingDefinition(buildingType)
+	return !ok || !slices.Contains(def.ForbiddenTerrain, t)
+}
+
+// GetRequiredDeposit returns the deposit kind a building of the given type
+// must be placed on, empty if it needs none.
+func GetRequiredDeposit(buildingType domain.BuildingType) domain.DepositKind {
+	def, ok := GetBuildingDefinition(buildingType)
+	if !ok {
+		return ""
+	}
+	return def.Deposit
+}
+
+// DepositSupports reports whether a building of the given type may be placed
+// over d, which is nil for a tile without a deposit.
+func DepositSupports(buildingType domain.BuildingType, d *domain.Deposit) bool {
+	kind := GetRequiredDeposit(buildingType)
+	return kind == "" || (d != nil && d.Kind == kind)
+}
+
+// GetDepositYield returns the factor applied to the production of a building
+// of the given type standing over d: the deposit's richness when the building
+// works it, 1 when it needs no deposit, and 0 otherwise.
+func GetDepositYield(buildingType domain.BuildingType, d *domain.Deposit) float64 {
+	if GetRequiredDeposit(buildingType) == "" {
+		return 1
+	}
+	if !DepositSupports(buildingType, d) {
+		return 0
 	}
+	return d.Richness
 }
 
-func GetBuildingCosts(buildingType domain.BuildingType) []int64 {
-	return buildingCosts[buildingType]
+// GetConstructionSlots returns how many constructions a city with the given
+// center level runs at once. A city without a finished center gets the
+// level-1 count.
+func GetConstructionSlots(centerLevel int) int {
+	slots := GetBalance().Construction.Slots
+	if centerLevel < 1 {
+		return slots[0]
+	}
+	return slots[min(centerLevel, len(slots))-1]
 }
 
-func GetBuildingConstructionTimes(buildingType domain.BuildingType) []int64 {
-	return buildingConstructionTime[buildingType]
+// GetSpeedUpCost returns the gold price of skipping the given amount of
+// construction time. Partial seconds are charged as whole ones.
+func GetSpeedUpCost(skip time.Duration) int64 {
+	seconds := int64(math.Ceil(skip.Seconds()))
+	pricing := GetBalance().Construction
+	return max(seconds*pricing.SpeedUpGoldPerSecond, pricing.SpeedUpMinimumCost)
 }
 
-func GetBuildingPopulations(buildingType domain.BuildingType) []float64 {
-	return buildingPopulation[buildingType]
+// GetTroopTrainingTime returns how long a training building of the given
+// level takes to train a single troop.
+func GetTroopTrainingTime(buildingType domain.BuildingType, level int) time.Duration {
+	base := time.Duration(GetBalance().Troops.TrainingSeconds) * time.Second
+	def, ok := GetBuildingDefinition(buildingType)
+	if !ok || !def.Trains || level < 1 {
+		return base
+	}
+	return base * time.Duration(def.TrainingSpeed[level-1]) / 100
 }
@@ -11,59 +11,52 @@ const (
 	// and the same clean-tick property holds (3600 % 3 == 0).
 	SecondsPerHour = 3600
 
-	// PopulationGrowthRate is the base logistic growth rate per city tick, in
-	// the absence of any food surplus bonus. Slow on purpose so a food
-	// surplus is meaningfully felt — a city that only just covers its upkeep
-	// grows at this rate, and stacking extra farms speeds it up via
-	// SurplusGrowthBonus.
-	PopulationGrowthRate = 0.0002
-
-	// SurplusGrowthBonus is the maximum additional growth multiplier from a
-	// food surplus. The bonus saturates at 100% surplus (production = 2×
-	// demand): below that it scales linearly; above it stays capped. At full
-	// saturation growth runs at (1 + SurplusGrowthBonus)× the base rate.
-	SurplusGrowthBonus = 1.0
-
-	// FoodPerPopPerHour is the per-population food upkeep per hour. 250 pop ×
-	// 48 = 12,000 food/hour, exactly one L1 farm's output.
-	FoodPerPopPerHour int64 = 48
-
-	// StarvationDeclineRate scales population loss per tick when a city's
-	// own production doesn't cover its demand. Applied as
-	// pop *= (1 - rate * deficitRatio). Pool coverage no longer prevents the
-	// decline — a city has to be locally self-sufficient to hold or grow.
-	StarvationDeclineRate = 0.005
+	// DefaultTaxRate is a new city's tax rate in percent, matching the column
+	// default. The balance's tax.max_rate caps what a player may set.
+	DefaultTaxRate = 10
+
+	// MoraleBase is where a city's target morale starts (see MoraleBalance)
+	// and where new cities start, matching the column default.
+	MoraleBase = 60.0
 
 	InitialPlayerCityPopulation = 250
 
 	InitialPlayerGold = 5000
 	InitialPlayerFood = 5000
 
+	MaxTrainingQueue     = 10   // batches a barracks will hold, active and waiting
+	MaxTroopsPerTraining = 1000 // largest single training batch
+
+	MaxConstructionQueue = 10 // orders a city's build queue will hold, waiting only
+
+	// ChargeConstructionOnEnqueue charges build-queue orders when they are
+	// queued rather than when they start. Up-front orders are refunded in full
+	// if cancelled before starting.
+	ChargeConstructionOnEnqueue = false
+
 	TroopMovementBackupFrequency = 5 // number of tile movements before state saved to db
 
 	// in seconds
-	DBBackupFrequency    = 2  // frequency of database flushing buffer queue and writing to database
-	UserBackupFrequency  = 10 // frequency of user state being sent to update queue
-	CityTickInterval     = 3  // cadence of the city actor: food loop, population growth, stream push, backup enqueue
-	BuildingTickInterval = 3  // cadence of building actors: resource production, construction checks, tile reaffirm
+	DBBackupFrequency    = 2     // frequency of database flushing buffer queue and writing to database
+	UserBackupFrequency  = 10    // frequency of user state being sent to update queue
+	CityTickInterval     = 3     // cadence of the city actor: food loop, population growth, stream push, backup enqueue
+	BuildingTickInterval = 3     // cadence of building actors: resource production, construction checks, tile reaffirm
+	FoodAllocationWindow = 3     // how long a user collects city food requests before splitting the pool
+	MaxOfflineCatchUp    = 86400 // longest downtime a restored city replays; anything beyond is forfeit
 
 	ActorTimeoutDuration = 2 // timeout on actor response await
 
-	TroopTrainingDuration = 5
-	TroopMovementDuration = 1 // time it takes to cross 1 tile
+	SeasonLength        = 30 * 24 * 3600 // how long a season runs before the world rolls over
+	SeasonCheckInterval = 60             // how often the server checks whether the season has run out
 
-	VisionRadius = 3 // Chebyshev distance beyond owned city edges that a player can see
-)
+	VisionRadius = 3 // Chebyshev distance beyond owned and allied city edges that a player can see
+
+	MaxAllianceMembers    = 20 // players an alliance will hold, leader included
+	MinAllianceNameLength = 3
+	MaxAllianceNameLength = 32 // matches the alliances.name column
 
-type TownConfig struct {
-	CenterLevel int
-	HouseCount  int
-}
-
-// TC L1=50, L2=100, L3=150; House L1=50
-var TownSizeConfig = map[int]TownConfig{
-	2: {CenterLevel: 1, HouseCount: 1}, // 50 + 50 = 100
-	3: {CenterLevel: 1, HouseCount: 2}, // 50 + 100 = 150
-	4: {CenterLevel: 2, HouseCount: 2}, // 100 + 100 = 200
-	5: {CenterLevel: 3, HouseCount: 2}, // 150 + 100 = 250
-}
+	MaxChatMessageLength = 500 // characters, matches the chat_messages.body column
+	ChatBurst            = 5   // messages a player may send back to back
+	ChatRefillSeconds    = 2   // seconds for one more message to be allowed after a burst
+	MaxMuteReasonLength  = 200 // characters, matches the chat_mutes.reason column
+)
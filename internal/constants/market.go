@@ -0,0 +1,40 @@
+package constants
+
+import (
+	"slices"
+
+	"cityio/internal/domain"
+)
+
+// Market is a tradable pair: Resource bought and sold for Currency. Both are
+// user resources ("gold" or "food").
+type Market struct {
+	ID       domain.MarketID
+	Resource string
+	Currency string
+}
+
+var markets = []Market{
+	{ID: "food_gold", Resource: "food", Currency: "gold"},
+}
+
+const (
+	MaxOpenMarketOrders    = 20        // resting orders a user may hold per market
+	MaxMarketOrderQuantity = 1_000_000 // largest single order, in units of the resource
+	MaxMarketPrice         = 1_000_000 // highest limit price, in currency per unit
+	MarketRecentTrades     = 50        // trades a market keeps for its ticker
+)
+
+// AllMarkets returns every market.
+func AllMarkets() []Market {
+	return markets
+}
+
+// GetMarket looks a market up by ID.
+func GetMarket(id domain.MarketID) (Market, bool) {
+	i := slices.IndexFunc(markets, func(m Market) bool { return m.ID == id })
+	if i < 0 {
+		return Market{}, false
+	}
+	return markets[i], true
+}
@@ -0,0 +1,135 @@
+package constants
+
+import (
+	"math"
+	"slices"
+
+	"cityio/internal/domain"
+)
+
+// TechEffectKind is what a researched tech changes.
+type TechEffectKind string
+
+const (
+	// TechEffectProduction raises a building's output of one resource.
+	TechEffectProduction TechEffectKind = "production"
+	// TechEffectCost changes what a building costs to build or upgrade.
+	TechEffectCost TechEffectKind = "cost"
+	// TechEffectConstructionTime changes how long a building takes to build
+	// or upgrade.
+	TechEffectConstructionTime TechEffectKind = "construction_time"
+	// TechEffectMaxLevel lets a building be upgraded past its level cap.
+	TechEffectMaxLevel TechEffectKind = "max_level"
+)
+
+// TechEffect is one modifier granted by a tech. Percent effects of the same
+// kind add up across techs before they are applied.
+type TechEffect struct {
+	Kind         TechEffectKind
+	BuildingType domain.BuildingType // empty applies to every building
+	Resource     string              // production effects only
+	Percent      float64             // production, cost and construction time effects
+	MaxLevel     int                 // max level effects only
+}
+
+// Tech is an entry of the research tree. Cost is gold, charged when research
+// starts; Duration is in seconds.
+type Tech struct {
+	ID            domain.TechID
+	Name          string
+	Cost          int64
+	Duration      int64
+	Prerequisites []domain.TechID
+	Effects       []TechEffect
+}
+
+var techs = []Tech{
+	{
+		ID: "irrigation", Name: "Irrigation", Cost: 2000, Duration: 600,
+		Effects: []TechEffect{{Kind: TechEffectProduction, BuildingType: domain.BuildingTypeFarm, Resource: "food", Percent: 10}},
+	},
+	{
+		ID: "crop_rotation", Name: "Crop Rotation", Cost: 6000, Duration: 1800,
+		Prerequisites: []domain.TechID{"irrigation"},
+		Effects:       []TechEffect{{Kind: TechEffectProduction, BuildingType: domain.BuildingTypeFarm, Resource: "food", Percent: 15}},
+	},
+	{
+		ID: "prospecting", Name: "Prospecting", Cost: 2000, Duration: 600,
+		Effects: []TechEffect{{Kind: TechEffectProduction, BuildingType: domain.BuildingTypeMine, Resource: "gold", Percent: 10}},
+	},
+	{
+		ID: "deep_shafts", Name: "Deep Shafts", Cost: 6000, Duration: 1800,
+		Prerequisites: []domain.TechID{"prospecting"},
+		Effects:       []TechEffect{{Kind: TechEffectProduction, BuildingType: domain.BuildingTypeMine, Resource: "gold", Percent: 15}},
+	},
+	{
+		ID: "masonry", Name: "Masonry", Cost: 3000, Duration: 900,
+		Effects: []TechEffect{{Kind: TechEffectCost, Percent: -10}},
+	},
+	{
+		ID: "engineering", Name: "Engineering", Cost: 8000, Duration: 2700,
+		Prerequisites: []domain.TechID{"masonry"},
+		Effects:       []TechEffect{{Kind: TechEffectConstructionTime, Percent: -20}},
+	},
+	{
+		ID: "military_academy", Name: "Military Academy", Cost: 5000, Duration: 1800,
+		Prerequisites: []domain.TechID{"masonry"},
+		Effects:       []TechEffect{{Kind: TechEffectMaxLevel, BuildingType: domain.BuildingTypeBarracks, MaxLevel: MAX_BUILDING_LEVEL}},
+	},
+}
+
+// AllTechs returns the research tree in display order.
+func AllTechs() []Tech {
+	return techs
+}
+
+// GetTech looks a tech up by ID.
+func GetTech(id domain.TechID) (Tech, bool) {
+	i := slices.IndexFunc(techs, func(t Tech) bool { return t.ID == id })
+	if i < 0 {
+		return Tech{}, false
+	}
+	return techs[i], true
+}
+
+// techEffects calls fn for every effect of the researched techs that applies
+// to the given kind and building type.
+func techEffects(researched []domain.TechID, kind TechEffectKind, buildingType domain.BuildingType, fn func(TechEffect)) {
+	for _, id := range researched {
+		tech, ok := GetTech(id)
+		if !ok {
+			continue
+		}
+		for _, e := range tech.Effects {
+			if e.Kind == kind && (e.BuildingType == "" || e.BuildingType == buildingType) {
+				fn(e)
+			}
+		}
+	}
+}
+
+// techMultiplier sums the percent effects of one kind and returns them as a
+// multiplier, never below zero.
+func techMultiplier(researched []domain.TechID, kind TechEffectKind, buildingType domain.BuildingType, resource string) float64 {
+	var percent float64
+	techEffects(researched, kind, buildingType, func(e TechEffect) {
+		if e.Resource == resource {
+			percent += e.Percent
+		}
+	})
+	return math.Max(0, 1+percent/100)
+}
+
+// GetMaxBuildingLevel returns the highest level a building may be upgraded to
+// with the given techs researched.
+func GetMaxBuildingLevel(buildingType domain.BuildingType, researched []domain.TechID) int {
+	def, ok := GetBuildingDefinition(buildingType)
+	if !ok || def.LevelCap == 0 {
+		return MAX_BUILDING_LEVEL
+	}
+	level := def.LevelCap
+	techEffects(researched, TechEffectMaxLevel, buildingType, func(e TechEffect) {
+		level = max(level, e.MaxLevel)
+	})
+	return min(level, MAX_BUILDING_LEVEL)
+}
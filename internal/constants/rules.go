@@ -0,0 +1,61 @@
+package constants
+
+import "cityio/internal/domain"
+
+// BuildingPrerequisite requires a finished building of BuildingType at Level
+// or above in the same city before a building can be placed. A city center
+// requirement is met by a town center too: whichever center the city has.
+type BuildingPrerequisite struct {
+	BuildingType domain.BuildingType `json:"type"`
+	Level        int                 `json:"level"`
+}
+
+// IsCenter reports whether bt is a city's center building.
+func IsCenter(bt domain.BuildingType) bool {
+	def, ok := GetBuildingDefinition(bt)
+	return ok && def.Center
+}
+
+// IsPlaceable reports whether players may place buildings of type bt: it is
+// loaded and isn't a center, which cities are only founded with.
+func IsPlaceable(bt domain.BuildingType) bool {
+	def, ok := GetBuildingDefinition(bt)
+	return ok && !def.Center
+}
+
+// GetBuildingPrerequisites returns what a city needs before a building of
+// the given type can be placed.
+func GetBuildingPrerequisites(buildingType domain.BuildingType) []BuildingPrerequisite {
+	if def, ok := GetBuildingDefinition(buildingType); ok {
+		return def.Prerequisites
+	}
+	return nil
+}
+
+// GetBuildingLimit returns how many buildings of the given type a city with
+// the given center level may hold, and false for types without a limit.
+func GetBuildingLimit(buildingType domain.BuildingType, centerLevel int) (int, bool) {
+	limits := GetBuildingLimits(buildingType)
+	if len(limits) == 0 {
+		return 0, false
+	}
+	if centerLevel < 1 {
+		return limits[0], true
+	}
+	return limits[min(centerLevel, len(limits))-1], true
+}
+
+// GetBuildingLimits returns the per-type limit by center level, nil for types
+// without one.
+func GetBuildingLimits(buildingType domain.BuildingType) []int {
+	if def, ok := GetBuildingDefinition(buildingType); ok {
+		return def.MaxCount
+	}
+	return nil
+}
+
+// CenterCapsLevel reports whether a building's level is held at or below its
+// city's center level. Every building but the center itself is.
+func CenterCapsLevel(buildingType domain.BuildingType) bool {
+	return !IsCenter(buildingType)
+}
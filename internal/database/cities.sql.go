@@ -81,32 +81,54 @@ SET
     population      = v.population,
     population_cap  = v.population_cap,
     start_coords    = ROW(v.start_x, v.start_y)::coordinates,
-    size            = v.size
+    size            = v.size,
+    troops          = v.troops,
+    import_priority = v.import_priority,
+    tax_rate        = v.tax_rate,
+    morale          = v.morale,
+    unrest_ticks    = v.unrest_ticks,
+    food_store      = v.food_store,
+    last_tick_at    = v.last_tick_at,
+    updated_at      = NOW()
 FROM (
     SELECT
-        UNNEST($1::text[])          AS city_id,
-        UNNEST($2::text[])             AS type,
-        UNNEST($3::text[])            AS owner,
-        UNNEST($4::text[])             AS name,
-        UNNEST($5::float8[])     AS population,
-        UNNEST($6::float8[]) AS population_cap,
-        UNNEST($7::int[])           AS start_x,
-        UNNEST($8::int[])           AS start_y,
-        UNNEST($9::int[])              AS size
+        UNNEST($1::text[])           AS city_id,
+        UNNEST($2::text[])              AS type,
+        UNNEST($3::text[])             AS owner,
+        UNNEST($4::text[])              AS name,
+        UNNEST($5::float8[])      AS population,
+        UNNEST($6::float8[])  AS population_cap,
+        UNNEST($7::int[])            AS start_x,
+        UNNEST($8::int[])            AS start_y,
+        UNNEST($9::int[])               AS size,
+        UNNEST($10::int8[])             AS troops,
+        UNNEST($11::int[])   AS import_priority,
+        UNNEST($12::int[])           AS tax_rate,
+        UNNEST($13::float8[])          AS morale,
+        UNNEST($14::int[])        AS unrest_ticks,
+        UNNEST($15::int8[])        AS food_store,
+        UNNEST($16::timestamp[]) AS last_tick_at
 ) AS v
 WHERE c.city_id = v.city_id
 `
 
 type BatchUpdateCitiesParams struct {
-	CityIds        []string  `json:"city_ids"`
-	Types          []string  `json:"types"`
-	Owners         []string  `json:"owners"`
-	Names          []string  `json:"names"`
-	Populations    []float64 `json:"populations"`
-	PopulationCaps []float64 `json:"population_caps"`
-	StartXs        []int32   `json:"start_xs"`
-	StartYs        []int32   `json:"start_ys"`
-	Sizes          []int32   `json:"sizes"`
+	CityIds          []string           `json:"city_ids"`
+	Types            []string           `json:"types"`
+	Owners           []string           `json:"owners"`
+	Names            []string           `json:"names"`
+	Populations      []float64          `json:"populations"`
+	PopulationCaps   []float64          `json:"population_caps"`
+	StartXs          []int32            `json:"start_xs"`
+	StartYs          []int32            `json:"start_ys"`
+	Sizes            []int32            `json:"sizes"`
+	Troops           []int64            `json:"troops"`
+	ImportPriorities []int32            `json:"import_priorities"`
+	TaxRates         []int32            `json:"tax_rates"`
+	Morales          []float64          `json:"morales"`
+	UnrestTicks      []int32            `json:"unrest_ticks"`
+	FoodStores       []int64            `json:"food_stores"`
+	LastTickAts      []pgtype.Timestamp `json:"last_tick_ats"`
 }
 
 func (q *Queries) BatchUpdateCities(ctx context.Context, arg BatchUpdateCitiesParams) error {
@@ -120,6 +142,13 @@ func (q *Queries) BatchUpdateCities(ctx context.Context, arg BatchUpdateCitiesPa
 		arg.StartXs,
 		arg.StartYs,
 		arg.Sizes,
+		arg.Troops,
+		arg.ImportPriorities,
+		arg.TaxRates,
+		arg.Morales,
+		arg.UnrestTicks,
+		arg.FoodStores,
+		arg.LastTickAts,
 	)
 	return err
 }
@@ -133,7 +162,8 @@ INSERT INTO cities (
     population,
     population_cap,
     start_coords,
-    size
+    size,
+    capital
 )
 VALUES (
     $1,
@@ -143,7 +173,8 @@ VALUES (
     $5,
     $6,
     ROW($7::int4, $8::int4)::coordinates,
-    $9
+    $9,
+    $10
 )
 `
 
@@ -157,6 +188,7 @@ type CreateCityParams struct {
 	StartX        int32   `json:"start_x"`
 	StartY        int32   `json:"start_y"`
 	Size          int32   `json:"size"`
+	Capital       bool    `json:"capital"`
 }
 
 func (q *Queries) CreateCity(ctx context.Context, arg CreateCityParams) error {
@@ -170,10 +202,21 @@ func (q *Queries) CreateCity(ctx context.Context, arg CreateCityParams) error {
 		arg.StartX,
 		arg.StartY,
 		arg.Size,
+		arg.Capital,
 	)
 	return err
 }
 
+const deleteAllCities = `-- name: DeleteAllCities :exec
+DELETE FROM cities
+`
+
+// Cascades to every building, training and construction order.
+func (q *Queries) DeleteAllCities(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, deleteAllCities)
+	return err
+}
+
 const deleteCity = `-- name: DeleteCity :exec
 DELETE FROM cities
 WHERE city_id = $1
@@ -201,14 +244,36 @@ WHERE NOT EXISTS (
     AND (c.start_coords).y + c.size >= y
     AND (c.start_coords).y <= y + $2::int4
 )
+AND NOT EXISTS (
+  SELECT 1
+  FROM tiles t
+  WHERE
+    t.terrain = 'water'
+    AND (t.coords).x BETWEEN x AND x + $2::int4 - 1
+    AND (t.coords).y BETWEEN y AND y + $2::int4 - 1
+)
+AND (
+  $4::varchar = ''
+  OR NOT EXISTS (SELECT 1 FROM tiles)
+  OR EXISTS (
+    SELECT 1
+    FROM tiles t
+    WHERE
+      t.deposit = $4::varchar
+      AND (t.coords).x BETWEEN x AND x + $2::int4 - 1
+      AND (t.coords).y BETWEEN y AND y + $2::int4 - 1
+      AND NOT ((t.coords).x = x + $2::int4 / 2 AND (t.coords).y = y + $2::int4 / 2)
+  )
+)
 ORDER BY random()
 LIMIT 1
 `
 
 type FindEmptyCityBlockParams struct {
-	MapWidth  int32 `json:"map_width"`
-	Size      int32 `json:"size"`
-	MapHeight int32 `json:"map_height"`
+	MapWidth       int32  `json:"map_width"`
+	Size           int32  `json:"size"`
+	MapHeight      int32  `json:"map_height"`
+	StarterDeposit string `json:"starter_deposit"`
 }
 
 type FindEmptyCityBlockRow struct {
@@ -219,9 +284,16 @@ type FindEmptyCityBlockRow struct {
 // Picks a uniformly random empty (size × size) block, enforcing a 1-tile gap
 // from the map boundary on every side as well as from every other city.
 // Range [1, mapWidth - size - 1] guarantees the block's footprint never
-// touches the map edge.
+// touches the map edge. Blocks covering any water are skipped, as are blocks
+// without the starter farm's deposit off the center tile (unless the farm
+// needs none or the world predates generated tiles).
 func (q *Queries) FindEmptyCityBlock(ctx context.Context, arg FindEmptyCityBlockParams) (FindEmptyCityBlockRow, error) {
-	row := q.db.QueryRow(ctx, findEmptyCityBlock, arg.MapWidth, arg.Size, arg.MapHeight)
+	row := q.db.QueryRow(ctx, findEmptyCityBlock,
+		arg.MapWidth,
+		arg.Size,
+		arg.MapHeight,
+		arg.StarterDeposit,
+	)
 	var i FindEmptyCityBlockRow
 	err := row.Scan(&i.X, &i.Y)
 	return i, err
@@ -238,23 +310,39 @@ SELECT
     (start_coords).x::int4 AS start_x,
     (start_coords).y::int4 AS start_y,
     size,
+    troops,
+    import_priority,
+    tax_rate,
+    morale,
+    unrest_ticks,
+    food_store,
+    last_tick_at,
+    capital,
     created_at,
     updated_at
 FROM cities
 `
 
 type GetAllCitiesRow struct {
-	CityID        string           `json:"city_id"`
-	Type          string           `json:"type"`
-	Owner         *string          `json:"owner"`
-	Name          string           `json:"name"`
-	Population    float64          `json:"population"`
-	PopulationCap float64          `json:"population_cap"`
-	StartX        int32            `json:"start_x"`
-	StartY        int32            `json:"start_y"`
-	Size          int32            `json:"size"`
-	CreatedAt     pgtype.Timestamp `json:"created_at"`
-	UpdatedAt     pgtype.Timestamp `json:"updated_at"`
+	CityID         string           `json:"city_id"`
+	Type           string           `json:"type"`
+	Owner          *string          `json:"owner"`
+	Name           string           `json:"name"`
+	Population     float64          `json:"population"`
+	PopulationCap  float64          `json:"population_cap"`
+	StartX         int32            `json:"start_x"`
+	StartY         int32            `json:"start_y"`
+	Size           int32            `json:"size"`
+	Troops         int64            `json:"troops"`
+	ImportPriority int32            `json:"import_priority"`
+	TaxRate        int32            `json:"tax_rate"`
+	Morale         float64          `json:"morale"`
+	UnrestTicks    int32            `json:"unrest_ticks"`
+	FoodStore      int64            `json:"food_store"`
+	LastTickAt     pgtype.Timestamp `json:"last_tick_at"`
+	Capital        bool             `json:"capital"`
+	CreatedAt      pgtype.Timestamp `json:"created_at"`
+	UpdatedAt      pgtype.Timestamp `json:"updated_at"`
 }
 
 func (q *Queries) GetAllCities(ctx context.Context) ([]GetAllCitiesRow, error) {
@@ -276,6 +364,14 @@ func (q *Queries) GetAllCities(ctx context.Context) ([]GetAllCitiesRow, error) {
 			&i.StartX,
 			&i.StartY,
 			&i.Size,
+			&i.Troops,
+			&i.ImportPriority,
+			&i.TaxRate,
+			&i.Morale,
+			&i.UnrestTicks,
+			&i.FoodStore,
+			&i.LastTickAt,
+			&i.Capital,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 		); err != nil {
@@ -300,6 +396,13 @@ SELECT
     (start_coords).x::int4 AS start_x,
     (start_coords).y::int4 AS start_y,
     size,
+    troops,
+    import_priority,
+    tax_rate,
+    morale,
+    unrest_ticks,
+    food_store,
+    capital,
     created_at,
     updated_at
 FROM cities
@@ -307,17 +410,24 @@ WHERE owner = $1
 `
 
 type GetCitiesByOwnerRow struct {
-	CityID        string           `json:"city_id"`
-	Type          string           `json:"type"`
-	Owner         *string          `json:"owner"`
-	Name          string           `json:"name"`
-	Population    float64          `json:"population"`
-	PopulationCap float64          `json:"population_cap"`
-	StartX        int32            `json:"start_x"`
-	StartY        int32            `json:"start_y"`
-	Size          int32            `json:"size"`
-	CreatedAt     pgtype.Timestamp `json:"created_at"`
-	UpdatedAt     pgtype.Timestamp `json:"updated_at"`
+	CityID         string           `json:"city_id"`
+	Type           string           `json:"type"`
+	Owner          *string          `json:"owner"`
+	Name           string           `json:"name"`
+	Population     float64          `json:"population"`
+	PopulationCap  float64          `json:"population_cap"`
+	StartX         int32            `json:"start_x"`
+	StartY         int32            `json:"start_y"`
+	Size           int32            `json:"size"`
+	Troops         int64            `json:"troops"`
+	ImportPriority int32            `json:"import_priority"`
+	TaxRate        int32            `json:"tax_rate"`
+	Morale         float64          `json:"morale"`
+	UnrestTicks    int32            `json:"unrest_ticks"`
+	FoodStore      int64            `json:"food_store"`
+	Capital        bool             `json:"capital"`
+	CreatedAt      pgtype.Timestamp `json:"created_at"`
+	UpdatedAt      pgtype.Timestamp `json:"updated_at"`
 }
 
 func (q *Queries) GetCitiesByOwner(ctx context.Context, owner *string) ([]GetCitiesByOwnerRow, error) {
@@ -339,6 +449,13 @@ func (q *Queries) GetCitiesByOwner(ctx context.Context, owner *string) ([]GetCit
 			&i.StartX,
 			&i.StartY,
 			&i.Size,
+			&i.Troops,
+			&i.ImportPriority,
+			&i.TaxRate,
+			&i.Morale,
+			&i.UnrestTicks,
+			&i.FoodStore,
+			&i.Capital,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 		); err != nil {
@@ -392,3 +509,23 @@ func (q *Queries) UpdateCity(ctx context.Context, arg UpdateCityParams) error {
 	)
 	return err
 }
+
+const updateCityOwner = `-- name: UpdateCityOwner :exec
+UPDATE cities
+SET
+    owner      = $1,
+    capital    = FALSE,
+    updated_at = NOW()
+WHERE city_id = $2
+`
+
+type UpdateCityOwnerParams struct {
+	Owner  *string `json:"owner"`
+	CityID string  `json:"city_id"`
+}
+
+// A city that changes hands is no longer anyone's capital.
+func (q *Queries) UpdateCityOwner(ctx context.Context, arg UpdateCityOwnerParams) error {
+	_, err := q.db.Exec(ctx, updateCityOwner, arg.Owner, arg.CityID)
+	return err
+}
@@ -11,8 +11,9 @@ import (
 	"github.com/pressly/goose/v3"
 )
 
-// NewDB connects to the database described by dsn, runs migrations and returns
-// a Querier. It terminates the process on any fatal initialization error.
+// NewDB connects to the database described by dsn, applies any pending
+// migrations and returns a Querier. It terminates the process on any fatal
+// initialization error.
 func NewDB(ctx context.Context, dsn string) Querier {
 	pool, err := pgxpool.New(ctx, dsn)
 	if err != nil {
@@ -26,17 +27,6 @@ func NewDB(ctx context.Context, dsn string) Querier {
 		os.Exit(1)
 	}
 
-	if err := goose.RunContext(
-		ctx,
-		"down-to",
-		migrations,
-		"db/migrations",
-		"0",
-	); err != nil {
-		slog.ErrorContext(ctx, "failed to open goose reset migration", "error", err)
-		os.Exit(1)
-	}
-
 	if err := goose.RunContext(
 		ctx,
 		"up",
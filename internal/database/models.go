@@ -9,6 +9,56 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type Alliance struct {
+	AllianceID string           `json:"alliance_id"`
+	Name       string           `json:"name"`
+	CreatedAt  pgtype.Timestamp `json:"created_at"`
+}
+
+type AllianceInvite struct {
+	AllianceID string           `json:"alliance_id"`
+	UserID     string           `json:"user_id"`
+	InvitedBy  string           `json:"invited_by"`
+	CreatedAt  pgtype.Timestamp `json:"created_at"`
+}
+
+type AllianceMember struct {
+	UserID     string           `json:"user_id"`
+	AllianceID string           `json:"alliance_id"`
+	Role       string           `json:"role"`
+	JoinedAt   pgtype.Timestamp `json:"joined_at"`
+}
+
+type Army struct {
+	ArmyID      string             `json:"army_id"`
+	Owner       string             `json:"owner"`
+	CityID      string             `json:"city_id"`
+	Troops      int64              `json:"troops"`
+	Coords      domain.Coordinates `json:"coords"`
+	Destination domain.Coordinates `json:"destination"`
+	CreatedAt   pgtype.Timestamp   `json:"created_at"`
+	UpdatedAt   pgtype.Timestamp   `json:"updated_at"`
+}
+
+type BattleReport struct {
+	ReportID        string             `json:"report_id"`
+	Seed            int64              `json:"seed"`
+	ArmyID          string             `json:"army_id"`
+	Attacker        string             `json:"attacker"`
+	Defender        *string            `json:"defender"`
+	CityID          string             `json:"city_id"`
+	Coords          domain.Coordinates `json:"coords"`
+	CityCenterLevel int32              `json:"city_center_level"`
+	AttackerTroops  int64              `json:"attacker_troops"`
+	DefenderTroops  int64              `json:"defender_troops"`
+	AttackerLosses  int64              `json:"attacker_losses"`
+	DefenderLosses  int64              `json:"defender_losses"`
+	Outcome         string             `json:"outcome"`
+	Rounds          int32              `json:"rounds"`
+	FoughtAt        pgtype.Timestamp   `json:"fought_at"`
+	TerrainDefense  float64            `json:"terrain_defense"`
+}
+
 type Building struct {
 	BuildingID        string             `json:"building_id"`
 	CityID            string             `json:"city_id"`
@@ -21,26 +71,168 @@ type Building struct {
 	UpdatedAt         pgtype.Timestamp   `json:"updated_at"`
 }
 
+type Caravan struct {
+	CaravanID         string             `json:"caravan_id"`
+	Owner             string             `json:"owner"`
+	SourceCityID      string             `json:"source_city_id"`
+	DestinationCityID string             `json:"destination_city_id"`
+	Gold              int64              `json:"gold"`
+	Food              int64              `json:"food"`
+	Coords            domain.Coordinates `json:"coords"`
+	Destination       domain.Coordinates `json:"destination"`
+	CreatedAt         pgtype.Timestamp   `json:"created_at"`
+	UpdatedAt         pgtype.Timestamp   `json:"updated_at"`
+}
+
+type ChatMessage struct {
+	MessageID string           `json:"message_id"`
+	Channel   string           `json:"channel"`
+	Sender    string           `json:"sender"`
+	Body      string           `json:"body"`
+	SentAt    pgtype.Timestamp `json:"sent_at"`
+}
+
+type ChatMute struct {
+	UserID     string           `json:"user_id"`
+	MutedUntil pgtype.Timestamp `json:"muted_until"`
+	Reason     string           `json:"reason"`
+	MutedBy    string           `json:"muted_by"`
+	CreatedAt  pgtype.Timestamp `json:"created_at"`
+}
+
 type City struct {
-	CityID        string             `json:"city_id"`
-	Type          string             `json:"type"`
-	Owner         *string            `json:"owner"`
-	Name          string             `json:"name"`
-	Population    float64            `json:"population"`
-	PopulationCap float64            `json:"population_cap"`
-	StartCoords   domain.Coordinates `json:"start_coords"`
-	Size          int32              `json:"size"`
-	CreatedAt     pgtype.Timestamp   `json:"created_at"`
-	UpdatedAt     pgtype.Timestamp   `json:"updated_at"`
+	CityID         string             `json:"city_id"`
+	Type           string             `json:"type"`
+	Owner          *string            `json:"owner"`
+	Name           string             `json:"name"`
+	Population     float64            `json:"population"`
+	PopulationCap  float64            `json:"population_cap"`
+	StartCoords    domain.Coordinates `json:"start_coords"`
+	Size           int32              `json:"size"`
+	CreatedAt      pgtype.Timestamp   `json:"created_at"`
+	UpdatedAt      pgtype.Timestamp   `json:"updated_at"`
+	Troops         int64              `json:"troops"`
+	ImportPriority int32              `json:"import_priority"`
+	TaxRate        int32              `json:"tax_rate"`
+	Morale         float64            `json:"morale"`
+	UnrestTicks    int32              `json:"unrest_ticks"`
+	FoodStore      int64              `json:"food_store"`
+	LastTickAt     pgtype.Timestamp   `json:"last_tick_at"`
+	Capital        bool               `json:"capital"`
 }
 
-type User struct {
+type ConstructionOrder struct {
+	OrderID      string             `json:"order_id"`
+	CityID       string             `json:"city_id"`
+	BuildingID   *string            `json:"building_id"`
+	BuildingType string             `json:"building_type"`
+	Coords       domain.Coordinates `json:"coords"`
+	Position     int32              `json:"position"`
+	Paid         int64              `json:"paid"`
+	CreatedAt    pgtype.Timestamp   `json:"created_at"`
+	UpdatedAt    pgtype.Timestamp   `json:"updated_at"`
+}
+
+type MarketOrder struct {
+	OrderID   string           `json:"order_id"`
+	MarketID  string           `json:"market_id"`
 	UserID    string           `json:"user_id"`
-	Email     string           `json:"email"`
-	Username  string           `json:"username"`
-	Password  string           `json:"password"`
-	Gold      int64            `json:"gold"`
-	Food      int64            `json:"food"`
+	Side      string           `json:"side"`
+	Price     int64            `json:"price"`
+	Quantity  int64            `json:"quantity"`
+	Remaining int64            `json:"remaining"`
 	CreatedAt pgtype.Timestamp `json:"created_at"`
 	UpdatedAt pgtype.Timestamp `json:"updated_at"`
 }
+
+type Relation struct {
+	UserA         string           `json:"user_a"`
+	UserB         string           `json:"user_b"`
+	State         string           `json:"state"`
+	PendingState  *string          `json:"pending_state"`
+	EffectiveAt   pgtype.Timestamp `json:"effective_at"`
+	ProposedState *string          `json:"proposed_state"`
+	ProposedBy    *string          `json:"proposed_by"`
+	UpdatedAt     pgtype.Timestamp `json:"updated_at"`
+}
+
+type Research struct {
+	UserID        string           `json:"user_id"`
+	Tech          string           `json:"tech"`
+	ResearchStart pgtype.Timestamp `json:"research_start"`
+	ResearchEnd   pgtype.Timestamp `json:"research_end"`
+	Completed     bool             `json:"completed"`
+	CreatedAt     pgtype.Timestamp `json:"created_at"`
+	UpdatedAt     pgtype.Timestamp `json:"updated_at"`
+}
+
+type Season struct {
+	Season    int32            `json:"season"`
+	Seed      int64            `json:"seed"`
+	EndReason string           `json:"end_reason"`
+	StartedAt pgtype.Timestamp `json:"started_at"`
+	EndedAt   pgtype.Timestamp `json:"ended_at"`
+}
+
+type SeasonStanding struct {
+	Season     int32   `json:"season"`
+	Rank       int32   `json:"rank"`
+	UserID     string  `json:"user_id"`
+	Username   string  `json:"username"`
+	Cities     int32   `json:"cities"`
+	Population float64 `json:"population"`
+	Gold       int64   `json:"gold"`
+}
+
+type Tile struct {
+	Coords   domain.Coordinates `json:"coords"`
+	Terrain  string             `json:"terrain"`
+	Deposit  *string            `json:"deposit"`
+	Richness float64            `json:"richness"`
+}
+
+type Trade struct {
+	TradeID     string           `json:"trade_id"`
+	MarketID    string           `json:"market_id"`
+	BuyOrderID  string           `json:"buy_order_id"`
+	SellOrderID string           `json:"sell_order_id"`
+	Buyer       string           `json:"buyer"`
+	Seller      string           `json:"seller"`
+	Price       int64            `json:"price"`
+	Quantity    int64            `json:"quantity"`
+	ExecutedAt  pgtype.Timestamp `json:"executed_at"`
+}
+
+type Training struct {
+	TrainingID    string           `json:"training_id"`
+	BarracksID    string           `json:"barracks_id"`
+	Troops        int64            `json:"troops"`
+	TrainingStart pgtype.Timestamp `json:"training_start"`
+	TrainingEnd   pgtype.Timestamp `json:"training_end"`
+	CreatedAt     pgtype.Timestamp `json:"created_at"`
+	UpdatedAt     pgtype.Timestamp `json:"updated_at"`
+}
+
+type User struct {
+	UserID     string           `json:"user_id"`
+	Email      string           `json:"email"`
+	Username   string           `json:"username"`
+	Password   string           `json:"password"`
+	Gold       int64            `json:"gold"`
+	Food       int64            `json:"food"`
+	CreatedAt  pgtype.Timestamp `json:"created_at"`
+	UpdatedAt  pgtype.Timestamp `json:"updated_at"`
+	FoodPolicy string           `json:"food_policy"`
+}
+
+type World struct {
+	WorldID         int32            `json:"world_id"`
+	Seed            int64            `json:"seed"`
+	Season          int32            `json:"season"`
+	State           string           `json:"state"`
+	EndReason       *string          `json:"end_reason"`
+	SeasonStartedAt pgtype.Timestamp `json:"season_started_at"`
+	SeasonEndsAt    pgtype.Timestamp `json:"season_ends_at"`
+	CreatedAt       pgtype.Timestamp `json:"created_at"`
+	UpdatedAt       pgtype.Timestamp `json:"updated_at"`
+}
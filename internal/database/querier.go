@@ -9,31 +9,126 @@ import (
 )
 
 type Querier interface {
+	AddAllianceMember(ctx context.Context, arg AddAllianceMemberParams) error
+	AllianceNameTaken(ctx context.Context, name string) (bool, error)
+	ArchiveSeason(ctx context.Context) error
+	// Ranks every user by the population they hold at season end, gold breaking
+	// ties, and snapshots the result under the world's current season.
+	ArchiveSeasonStandings(ctx context.Context) error
 	BatchCreateBuildings(ctx context.Context, arg BatchCreateBuildingsParams) error
 	BatchCreateCities(ctx context.Context, arg BatchCreateCitiesParams) error
+	BatchCreateTiles(ctx context.Context, arg BatchCreateTilesParams) error
+	BatchUpdateArmies(ctx context.Context, arg BatchUpdateArmiesParams) error
 	BatchUpdateBuildings(ctx context.Context, arg BatchUpdateBuildingsParams) error
+	// Only the position changes in transit: cargo and destination are fixed when
+	// the caravan sets out.
+	BatchUpdateCaravans(ctx context.Context, arg BatchUpdateCaravansParams) error
 	BatchUpdateCities(ctx context.Context, arg BatchUpdateCitiesParams) error
+	BatchUpdateConstructionOrders(ctx context.Context, arg BatchUpdateConstructionOrdersParams) error
+	BatchUpdateTrainings(ctx context.Context, arg BatchUpdateTrainingsParams) error
 	BatchUpdateUsers(ctx context.Context, arg BatchUpdateUsersParams) error
+	CompleteResearch(ctx context.Context, arg CompleteResearchParams) error
+	CreateAlliance(ctx context.Context, arg CreateAllianceParams) error
+	// Inviting a player again refreshes the invite rather than failing.
+	CreateAllianceInvite(ctx context.Context, arg CreateAllianceInviteParams) error
+	CreateArmy(ctx context.Context, arg CreateArmyParams) error
+	CreateBattleReport(ctx context.Context, arg CreateBattleReportParams) error
 	CreateBuilding(ctx context.Context, arg CreateBuildingParams) error
+	CreateCaravan(ctx context.Context, arg CreateCaravanParams) error
+	CreateChatMessage(ctx context.Context, arg CreateChatMessageParams) error
 	CreateCity(ctx context.Context, arg CreateCityParams) error
+	CreateConstructionOrder(ctx context.Context, arg CreateConstructionOrderParams) error
+	CreateMarketOrder(ctx context.Context, arg CreateMarketOrderParams) error
+	CreateResearch(ctx context.Context, arg CreateResearchParams) error
+	CreateTraining(ctx context.Context, arg CreateTrainingParams) error
 	CreateUser(ctx context.Context, arg CreateUserParams) error
+	DeleteAllArmies(ctx context.Context) error
+	DeleteAllBattleReports(ctx context.Context) error
+	DeleteAllCaravans(ctx context.Context) error
+	// Cascades to every building, training and construction order.
+	DeleteAllCities(ctx context.Context) error
+	// Escrowed funds belong to the season's users, so open orders go with it.
+	DeleteAllMarketOrders(ctx context.Context) error
+	// Every season starts at peace with nobody and at war with nobody.
+	DeleteAllRelations(ctx context.Context) error
+	// Research is per season; accounts carry over but start the tree afresh.
+	DeleteAllResearch(ctx context.Context) error
+	DeleteAllTiles(ctx context.Context) error
+	DeleteAllTrades(ctx context.Context) error
+	DeleteAlliance(ctx context.Context, allianceID string) error
+	DeleteAllianceInvite(ctx context.Context, arg DeleteAllianceInviteParams) error
+	// A player who joins an alliance drops every other invite they held.
+	DeleteAllianceInvitesByUser(ctx context.Context, userID string) error
+	DeleteAllianceMember(ctx context.Context, userID string) error
+	DeleteArmy(ctx context.Context, armyID string) error
 	DeleteBuilding(ctx context.Context, buildingID string) error
+	DeleteCaravan(ctx context.Context, caravanID string) error
+	DeleteChatMute(ctx context.Context, userID string) error
 	DeleteCity(ctx context.Context, cityID string) error
+	DeleteConstructionOrder(ctx context.Context, orderID string) error
+	DeleteMarketOrder(ctx context.Context, orderID string) error
+	DeleteResearch(ctx context.Context, arg DeleteResearchParams) error
+	DeleteTraining(ctx context.Context, trainingID string) error
 	DeleteUser(ctx context.Context, userID string) error
+	EndSeason(ctx context.Context, endReason *string) (int64, error)
 	// Picks a uniformly random empty (size × size) block, enforcing a 1-tile gap
 	// from the map boundary on every side as well as from every other city.
 	// Range [1, mapWidth - size - 1] guarantees the block's footprint never
-	// touches the map edge.
+	// touches the map edge. Blocks covering any water are skipped, as are blocks
+	// without the starter farm's deposit off the center tile (unless the farm
+	// needs none or the world predates generated tiles).
 	FindEmptyCityBlock(ctx context.Context, arg FindEmptyCityBlockParams) (FindEmptyCityBlockRow, error)
+	GetAllArmies(ctx context.Context) ([]GetAllArmiesRow, error)
 	GetAllBuildings(ctx context.Context) ([]GetAllBuildingsRow, error)
+	GetAllCaravans(ctx context.Context) ([]GetAllCaravansRow, error)
 	GetAllCities(ctx context.Context) ([]GetAllCitiesRow, error)
+	GetAllTiles(ctx context.Context) ([]GetAllTilesRow, error)
 	GetAllUsers(ctx context.Context) ([]User, error)
+	GetAlliance(ctx context.Context, allianceID string) (Alliance, error)
+	// Every city owned by a member of the alliance, for shared vision.
+	GetAllianceCities(ctx context.Context, allianceID string) ([]GetAllianceCitiesRow, error)
+	GetAllianceInvites(ctx context.Context, allianceID string) ([]GetAllianceInvitesRow, error)
+	GetAllianceInvitesByUser(ctx context.Context, userID string) ([]GetAllianceInvitesByUserRow, error)
+	GetAllianceMembers(ctx context.Context, allianceID string) ([]GetAllianceMembersRow, error)
+	GetAllianceMembership(ctx context.Context, userID string) (AllianceMember, error)
+	GetArmiesByOwner(ctx context.Context, owner string) ([]GetArmiesByOwnerRow, error)
+	GetBattleReport(ctx context.Context, reportID string) (GetBattleReportRow, error)
+	GetBattleReportsByUser(ctx context.Context, arg GetBattleReportsByUserParams) ([]GetBattleReportsByUserRow, error)
 	GetBuildingsByCity(ctx context.Context, cityID string) ([]GetBuildingsByCityRow, error)
+	GetCaravansByOwner(ctx context.Context, owner string) ([]GetCaravansByOwnerRow, error)
+	// A page of a channel's history, newest first. Passing the ID of the oldest
+	// message of the previous page as before_id continues from there; an empty
+	// before_id starts at the newest.
+	GetChatMessages(ctx context.Context, arg GetChatMessagesParams) ([]GetChatMessagesRow, error)
+	GetChatMute(ctx context.Context, userID string) (ChatMute, error)
 	GetCitiesByOwner(ctx context.Context, owner *string) ([]GetCitiesByOwnerRow, error)
+	GetConstructionOrdersByCity(ctx context.Context, cityID string) ([]GetConstructionOrdersByCityRow, error)
+	GetMarketOrders(ctx context.Context, marketID string) ([]GetMarketOrdersRow, error)
+	// Relations with a transition still to come, whose timers are re-armed on
+	// boot.
+	GetPendingRelations(ctx context.Context) ([]Relation, error)
+	GetRecentTrades(ctx context.Context, arg GetRecentTradesParams) ([]Trade, error)
+	GetRelation(ctx context.Context, arg GetRelationParams) (Relation, error)
+	GetRelationsByUser(ctx context.Context, userA string) ([]Relation, error)
+	GetResearchByUser(ctx context.Context, userID string) ([]GetResearchByUserRow, error)
+	GetSeasonStandings(ctx context.Context, arg GetSeasonStandingsParams) ([]SeasonStanding, error)
+	GetSeasons(ctx context.Context) ([]Season, error)
+	GetTrainingsByBarracks(ctx context.Context, barracksID string) ([]GetTrainingsByBarracksRow, error)
 	GetUserByIdentifier(ctx context.Context, email string) (User, error)
+	GetWorld(ctx context.Context) (World, error)
+	// One statement, so the trade and the fills of both orders land together.
+	RecordTrade(ctx context.Context, arg RecordTradeParams) error
+	ResetUserStats(ctx context.Context, arg ResetUserStatsParams) error
+	StartSeason(ctx context.Context, arg StartSeasonParams) error
+	UpdateAllianceMemberRole(ctx context.Context, arg UpdateAllianceMemberRoleParams) error
 	UpdateCity(ctx context.Context, arg UpdateCityParams) error
+	// A city that changes hands is no longer anyone's capital.
+	UpdateCityOwner(ctx context.Context, arg UpdateCityOwnerParams) error
 	UpdateUser(ctx context.Context, arg UpdateUserParams) error
 	UpdateUserStats(ctx context.Context, arg UpdateUserStatsParams) error
+	// Muting a muted player replaces their mute.
+	UpsertChatMute(ctx context.Context, arg UpsertChatMuteParams) error
+	UpsertRelation(ctx context.Context, arg UpsertRelationParams) error
 }
 
 var _ Querier = (*Queries)(nil)
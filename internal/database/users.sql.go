@@ -12,25 +12,33 @@ import (
 const batchUpdateUsers = `-- name: BatchUpdateUsers :exec
 UPDATE users AS u
 SET
-    gold = v.gold,
-    food = v.food
+    gold        = v.gold,
+    food        = v.food,
+    food_policy = v.food_policy
 FROM (
     SELECT
-        UNNEST($1::text[])  AS user_id,
-        UNNEST($2::int8[])     AS gold,
-        UNNEST($3::int8[])     AS food
+        UNNEST($1::text[])      AS user_id,
+        UNNEST($2::int8[])         AS gold,
+        UNNEST($3::int8[])         AS food,
+        UNNEST($4::text[]) AS food_policy
 ) AS v
 WHERE u.user_id = v.user_id
 `
 
 type BatchUpdateUsersParams struct {
-	UserIds []string `json:"user_ids"`
-	Golds   []int64  `json:"golds"`
-	Foods   []int64  `json:"foods"`
+	UserIds      []string `json:"user_ids"`
+	Golds        []int64  `json:"golds"`
+	Foods        []int64  `json:"foods"`
+	FoodPolicies []string `json:"food_policies"`
 }
 
 func (q *Queries) BatchUpdateUsers(ctx context.Context, arg BatchUpdateUsersParams) error {
-	_, err := q.db.Exec(ctx, batchUpdateUsers, arg.UserIds, arg.Golds, arg.Foods)
+	_, err := q.db.Exec(ctx, batchUpdateUsers,
+		arg.UserIds,
+		arg.Golds,
+		arg.Foods,
+		arg.FoodPolicies,
+	)
 	return err
 }
 
@@ -75,7 +83,7 @@ func (q *Queries) DeleteUser(ctx context.Context, userID string) error {
 }
 
 const getAllUsers = `-- name: GetAllUsers :many
-SELECT user_id, email, username, password, gold, food, created_at, updated_at FROM users
+SELECT user_id, email, username, password, gold, food, created_at, updated_at, food_policy FROM users
 `
 
 func (q *Queries) GetAllUsers(ctx context.Context) ([]User, error) {
@@ -96,6 +104,7 @@ func (q *Queries) GetAllUsers(ctx context.Context) ([]User, error) {
 			&i.Food,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.FoodPolicy,
 		); err != nil {
 			return nil, err
 		}
@@ -108,7 +117,7 @@ func (q *Queries) GetAllUsers(ctx context.Context) ([]User, error) {
 }
 
 const getUserByIdentifier = `-- name: GetUserByIdentifier :one
-SELECT user_id, email, username, password, gold, food, created_at, updated_at FROM users
+SELECT user_id, email, username, password, gold, food, created_at, updated_at, food_policy FROM users
 WHERE email = $1 OR username = $1
 `
 
@@ -124,10 +133,29 @@ func (q *Queries) GetUserByIdentifier(ctx context.Context, email string) (User,
 		&i.Food,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.FoodPolicy,
 	)
 	return i, err
 }
 
+const resetUserStats = `-- name: ResetUserStats :exec
+UPDATE users
+SET
+    gold       = $1,
+    food       = $2,
+    updated_at = NOW()
+`
+
+type ResetUserStatsParams struct {
+	Gold int64 `json:"gold"`
+	Food int64 `json:"food"`
+}
+
+func (q *Queries) ResetUserStats(ctx context.Context, arg ResetUserStatsParams) error {
+	_, err := q.db.Exec(ctx, resetUserStats, arg.Gold, arg.Food)
+	return err
+}
+
 const updateUser = `-- name: UpdateUser :exec
 UPDATE users
 SET
@@ -36,42 +36,60 @@ func targetLevelFromConstruction(level int32, start, end pgtype.Timestamp) int {
 
 func (c City) ToModel() *domain.City {
 	return &domain.City{
-		CityID:        c.CityID,
-		Type:          domain.CityType(c.Type),
-		Owner:         c.Owner,
-		Name:          c.Name,
-		Population:    c.Population,
-		PopulationCap: c.PopulationCap,
-		StartX:        c.StartCoords.X,
-		StartY:        c.StartCoords.Y,
-		Size:          int(c.Size),
+		CityID:         c.CityID,
+		Type:           domain.CityType(c.Type),
+		Owner:          c.Owner,
+		Name:           c.Name,
+		Population:     c.Population,
+		PopulationCap:  c.PopulationCap,
+		StartX:         c.StartCoords.X,
+		StartY:         c.StartCoords.Y,
+		Size:           int(c.Size),
+		Troops:         c.Troops,
+		ImportPriority: int(c.ImportPriority),
+		TaxRate:        int(c.TaxRate),
+		Morale:         c.Morale,
+		UnrestTicks:    int(c.UnrestTicks),
+		FoodStore:      c.FoodStore,
+		Capital:        c.Capital,
 	}
 }
 
 func (c GetAllCitiesRow) ToModel() *domain.City {
 	return &domain.City{
-		CityID:        c.CityID,
-		Type:          domain.CityType(c.Type),
-		Owner:         c.Owner,
-		Name:          c.Name,
-		Population:    c.Population,
-		PopulationCap: c.PopulationCap,
-		StartX:        int(c.StartX),
-		StartY:        int(c.StartY),
-		Size:          int(c.Size),
+		CityID:         c.CityID,
+		Type:           domain.CityType(c.Type),
+		Owner:          c.Owner,
+		Name:           c.Name,
+		Population:     c.Population,
+		PopulationCap:  c.PopulationCap,
+		StartX:         int(c.StartX),
+		StartY:         int(c.StartY),
+		Size:           int(c.Size),
+		Troops:         c.Troops,
+		ImportPriority: int(c.ImportPriority),
+		TaxRate:        int(c.TaxRate),
+		Morale:         c.Morale,
+		UnrestTicks:    int(c.UnrestTicks),
+		FoodStore:      c.FoodStore,
+		Capital:        c.Capital,
+		LastTickAt:     c.LastTickAt.Time,
+		CreatedAt:      c.CreatedAt.Time,
+		UpdatedAt:      c.UpdatedAt.Time,
 	}
 }
 
 func (u User) ToModel() *domain.User {
 	return &domain.User{
-		UserID:    u.UserID,
-		Email:     u.Email,
-		Username:  u.Username,
-		Password:  u.Password,
-		Gold:      u.Gold,
-		Food:      u.Food,
-		CreatedAt: u.CreatedAt.Time,
-		UpdatedAt: u.UpdatedAt.Time,
+		UserID:     u.UserID,
+		Email:      u.Email,
+		Username:   u.Username,
+		Password:   u.Password,
+		Gold:       u.Gold,
+		Food:       u.Food,
+		FoodPolicy: domain.FoodAllocationPolicy(u.FoodPolicy),
+		CreatedAt:  u.CreatedAt.Time,
+		UpdatedAt:  u.UpdatedAt.Time,
 	}
 }
 
@@ -91,15 +109,22 @@ func (b Building) ToModel() *domain.Building {
 
 func (c GetCitiesByOwnerRow) ToModel() *domain.City {
 	return &domain.City{
-		CityID:        c.CityID,
-		Type:          domain.CityType(c.Type),
-		Owner:         c.Owner,
-		Name:          c.Name,
-		Population:    c.Population,
-		PopulationCap: c.PopulationCap,
-		StartX:        int(c.StartX),
-		StartY:        int(c.StartY),
-		Size:          int(c.Size),
+		CityID:         c.CityID,
+		Type:           domain.CityType(c.Type),
+		Owner:          c.Owner,
+		Name:           c.Name,
+		Population:     c.Population,
+		PopulationCap:  c.PopulationCap,
+		StartX:         int(c.StartX),
+		StartY:         int(c.StartY),
+		Size:           int(c.Size),
+		Troops:         c.Troops,
+		ImportPriority: int(c.ImportPriority),
+		TaxRate:        int(c.TaxRate),
+		Morale:         c.Morale,
+		UnrestTicks:    int(c.UnrestTicks),
+		FoodStore:      c.FoodStore,
+		Capital:        c.Capital,
 	}
 }
 
@@ -130,3 +155,331 @@ func (b GetAllBuildingsRow) ToModel() *domain.Building {
 		ConstructionEnd:   toNullTime(b.ConstructionEnd),
 	}
 }
+
+func (a GetAllArmiesRow) ToModel() *domain.Army {
+	return &domain.Army{
+		ArmyID:       a.ArmyID,
+		Owner:        a.Owner,
+		CityID:       a.CityID,
+		Troops:       a.Troops,
+		X:            int(a.X),
+		Y:            int(a.Y),
+		DestinationX: int(a.DestinationX),
+		DestinationY: int(a.DestinationY),
+	}
+}
+
+func (a GetArmiesByOwnerRow) ToModel() *domain.Army {
+	return &domain.Army{
+		ArmyID:       a.ArmyID,
+		Owner:        a.Owner,
+		CityID:       a.CityID,
+		Troops:       a.Troops,
+		X:            int(a.X),
+		Y:            int(a.Y),
+		DestinationX: int(a.DestinationX),
+		DestinationY: int(a.DestinationY),
+	}
+}
+
+func (t GetTrainingsByBarracksRow) ToModel() *domain.Training {
+	return &domain.Training{
+		TrainingID:    t.TrainingID,
+		BarracksID:    t.BarracksID,
+		Troops:        t.Troops,
+		TrainingStart: toNullTime(t.TrainingStart),
+		TrainingEnd:   toNullTime(t.TrainingEnd),
+	}
+}
+
+func (r GetResearchByUserRow) ToModel() *domain.Research {
+	return &domain.Research{
+		UserID:        r.UserID,
+		Tech:          domain.TechID(r.Tech),
+		ResearchStart: r.ResearchStart.Time,
+		ResearchEnd:   r.ResearchEnd.Time,
+		Completed:     r.Completed,
+	}
+}
+
+func (r GetMarketOrdersRow) ToModel() *domain.MarketOrder {
+	return &domain.MarketOrder{
+		OrderID:   r.OrderID,
+		MarketID:  domain.MarketID(r.MarketID),
+		UserID:    r.UserID,
+		Side:      domain.OrderSide(r.Side),
+		Price:     r.Price,
+		Quantity:  r.Quantity,
+		Remaining: r.Remaining,
+		CreatedAt: r.CreatedAt.Time,
+	}
+}
+
+func (t Trade) ToModel() *domain.Trade {
+	return &domain.Trade{
+		TradeID:     t.TradeID,
+		MarketID:    domain.MarketID(t.MarketID),
+		BuyOrderID:  t.BuyOrderID,
+		SellOrderID: t.SellOrderID,
+		Buyer:       t.Buyer,
+		Seller:      t.Seller,
+		Price:       t.Price,
+		Quantity:    t.Quantity,
+		ExecutedAt:  t.ExecutedAt.Time,
+	}
+}
+
+func (o GetConstructionOrdersByCityRow) ToModel() *domain.ConstructionOrder {
+	return &domain.ConstructionOrder{
+		OrderID:      o.OrderID,
+		CityID:       o.CityID,
+		BuildingID:   o.BuildingID,
+		BuildingType: domain.BuildingType(o.BuildingType),
+		X:            int(o.X),
+		Y:            int(o.Y),
+		Position:     int(o.Position),
+		Paid:         o.Paid,
+	}
+}
+
+func (r GetBattleReportRow) ToModel() *domain.BattleReport {
+	return &domain.BattleReport{
+		ReportID:        r.ReportID,
+		Seed:            r.Seed,
+		ArmyID:          r.ArmyID,
+		Attacker:        r.Attacker,
+		Defender:        r.Defender,
+		CityID:          r.CityID,
+		X:               int(r.X),
+		Y:               int(r.Y),
+		CityCenterLevel: int(r.CityCenterLevel),
+		TerrainDefense:  r.TerrainDefense,
+		AttackerTroops:  r.AttackerTroops,
+		DefenderTroops:  r.DefenderTroops,
+		AttackerLosses:  r.AttackerLosses,
+		DefenderLosses:  r.DefenderLosses,
+		Outcome:         r.Outcome,
+		Rounds:          int(r.Rounds),
+		FoughtAt:        r.FoughtAt.Time,
+	}
+}
+
+func (r GetBattleReportsByUserRow) ToModel() *domain.BattleReport {
+	return &domain.BattleReport{
+		ReportID:        r.ReportID,
+		Seed:            r.Seed,
+		ArmyID:          r.ArmyID,
+		Attacker:        r.Attacker,
+		Defender:        r.Defender,
+		CityID:          r.CityID,
+		X:               int(r.X),
+		Y:               int(r.Y),
+		CityCenterLevel: int(r.CityCenterLevel),
+		TerrainDefense:  r.TerrainDefense,
+		AttackerTroops:  r.AttackerTroops,
+		DefenderTroops:  r.DefenderTroops,
+		AttackerLosses:  r.AttackerLosses,
+		DefenderLosses:  r.DefenderLosses,
+		Outcome:         r.Outcome,
+		Rounds:          int(r.Rounds),
+		FoughtAt:        r.FoughtAt.Time,
+	}
+}
+
+func (w World) ToModel() *domain.World {
+	var reason domain.SeasonEndReason
+	if w.EndReason != nil {
+		reason = domain.SeasonEndReason(*w.EndReason)
+	}
+	return &domain.World{
+		Seed:            w.Seed,
+		Season:          int(w.Season),
+		State:           domain.WorldState(w.State),
+		EndReason:       reason,
+		SeasonStartedAt: w.SeasonStartedAt.Time,
+		SeasonEndsAt:    w.SeasonEndsAt.Time,
+		CreatedAt:       w.CreatedAt.Time,
+	}
+}
+
+func (s Season) ToModel() *domain.Season {
+	return &domain.Season{
+		Season:    int(s.Season),
+		Seed:      s.Seed,
+		EndReason: domain.SeasonEndReason(s.EndReason),
+		StartedAt: s.StartedAt.Time,
+		EndedAt:   s.EndedAt.Time,
+	}
+}
+
+func (s SeasonStanding) ToModel() *domain.SeasonStanding {
+	return &domain.SeasonStanding{
+		Season:     int(s.Season),
+		Rank:       int(s.Rank),
+		UserID:     s.UserID,
+		Username:   s.Username,
+		Cities:     int(s.Cities),
+		Population: s.Population,
+		Gold:       s.Gold,
+	}
+}
+
+func (t GetAllTilesRow) ToModel() *domain.Tile {
+	tile := &domain.Tile{
+		X:       int(t.X),
+		Y:       int(t.Y),
+		Terrain: domain.Terrain(t.Terrain),
+	}
+	if t.Deposit != nil {
+		tile.Deposit = &domain.Deposit{Kind: domain.DepositKind(*t.Deposit), Richness: t.Richness}
+	}
+	return tile
+}
+
+func (c GetAllCaravansRow) ToModel() *domain.Caravan {
+	return &domain.Caravan{
+		CaravanID:         c.CaravanID,
+		Owner:             c.Owner,
+		SourceCityID:      c.SourceCityID,
+		DestinationCityID: c.DestinationCityID,
+		Gold:              c.Gold,
+		Food:              c.Food,
+		X:                 int(c.X),
+		Y:                 int(c.Y),
+		DestinationX:      int(c.DestinationX),
+		DestinationY:      int(c.DestinationY),
+	}
+}
+
+func (c GetCaravansByOwnerRow) ToModel() *domain.Caravan {
+	return &domain.Caravan{
+		CaravanID:         c.CaravanID,
+		Owner:             c.Owner,
+		SourceCityID:      c.SourceCityID,
+		DestinationCityID: c.DestinationCityID,
+		Gold:              c.Gold,
+		Food:              c.Food,
+		X:                 int(c.X),
+		Y:                 int(c.Y),
+		DestinationX:      int(c.DestinationX),
+		DestinationY:      int(c.DestinationY),
+	}
+}
+
+func (c GetAllianceCitiesRow) ToModel() *domain.City {
+	return &domain.City{
+		CityID:         c.CityID,
+		Type:           domain.CityType(c.Type),
+		Owner:          c.Owner,
+		Name:           c.Name,
+		Population:     c.Population,
+		PopulationCap:  c.PopulationCap,
+		StartX:         int(c.StartX),
+		StartY:         int(c.StartY),
+		Size:           int(c.Size),
+		Troops:         c.Troops,
+		ImportPriority: int(c.ImportPriority),
+		TaxRate:        int(c.TaxRate),
+		Morale:         c.Morale,
+		UnrestTicks:    int(c.UnrestTicks),
+		FoodStore:      c.FoodStore,
+		Capital:        c.Capital,
+	}
+}
+
+func (a Alliance) ToModel() *domain.Alliance {
+	return &domain.Alliance{
+		AllianceID: a.AllianceID,
+		Name:       a.Name,
+		CreatedAt:  a.CreatedAt.Time,
+	}
+}
+
+func (m AllianceMember) ToModel() *domain.AllianceMember {
+	return &domain.AllianceMember{
+		AllianceID: m.AllianceID,
+		UserID:     m.UserID,
+		Role:       domain.AllianceRole(m.Role),
+		JoinedAt:   m.JoinedAt.Time,
+	}
+}
+
+func (m GetAllianceMembersRow) ToModel() *domain.AllianceMember {
+	return &domain.AllianceMember{
+		AllianceID: m.AllianceID,
+		UserID:     m.UserID,
+		Username:   m.Username,
+		Role:       domain.AllianceRole(m.Role),
+		JoinedAt:   m.JoinedAt.Time,
+	}
+}
+
+func (i GetAllianceInvitesRow) ToModel() *domain.AllianceInvite {
+	return &domain.AllianceInvite{
+		AllianceID:   i.AllianceID,
+		AllianceName: i.AllianceName,
+		UserID:       i.UserID,
+		InvitedBy:    i.InvitedBy,
+		CreatedAt:    i.CreatedAt.Time,
+	}
+}
+
+func (i GetAllianceInvitesByUserRow) ToModel() *domain.AllianceInvite {
+	return &domain.AllianceInvite{
+		AllianceID:   i.AllianceID,
+		AllianceName: i.AllianceName,
+		UserID:       i.UserID,
+		InvitedBy:    i.InvitedBy,
+		CreatedAt:    i.CreatedAt.Time,
+	}
+}
+
+func (r Relation) ToModel() *domain.Relation {
+	relation := &domain.Relation{
+		UserA:       r.UserA,
+		UserB:       r.UserB,
+		State:       domain.RelationState(r.State),
+		EffectiveAt: toNullTime(r.EffectiveAt),
+		UpdatedAt:   r.UpdatedAt.Time,
+	}
+	if r.PendingState != nil {
+		relation.PendingState = domain.RelationState(*r.PendingState)
+	}
+	if r.ProposedState != nil {
+		relation.ProposedState = domain.RelationState(*r.ProposedState)
+	}
+	if r.ProposedBy != nil {
+		relation.ProposedBy = *r.ProposedBy
+	}
+	return relation
+}
+
+// ToNullString converts an empty string, the domain's "none", into NULL.
+func ToNullString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func (m GetChatMessagesRow) ToModel() *domain.ChatMessage {
+	channel, _ := domain.ParseChatChannel(m.Channel)
+	return &domain.ChatMessage{
+		MessageID:  m.MessageID,
+		Channel:    channel,
+		Sender:     m.Sender,
+		SenderName: m.SenderName,
+		Body:       m.Body,
+		SentAt:     m.SentAt.Time,
+	}
+}
+
+func (m ChatMute) ToModel() *domain.ChatMute {
+	return &domain.ChatMute{
+		UserID:     m.UserID,
+		MutedUntil: m.MutedUntil.Time,
+		Reason:     m.Reason,
+		MutedBy:    m.MutedBy,
+		CreatedAt:  m.CreatedAt.Time,
+	}
+}
@@ -0,0 +1,91 @@
+package domain
+
+import (
+	"slices"
+	"time"
+)
+
+// AllianceRole is a member's rank within an alliance.
+type AllianceRole string
+
+const (
+	AllianceRoleLeader  AllianceRole = "leader"
+	AllianceRoleOfficer AllianceRole = "officer"
+	AllianceRoleMember  AllianceRole = "member"
+)
+
+// Valid reports whether r is a known alliance role.
+func (r AllianceRole) Valid() bool {
+	return r == AllianceRoleLeader || r == AllianceRoleOfficer || r == AllianceRoleMember
+}
+
+// CanInvite reports whether members of this role may invite players.
+func (r AllianceRole) CanInvite() bool {
+	return r == AllianceRoleLeader || r == AllianceRoleOfficer
+}
+
+// CanKick reports whether members of this role may kick a member of the
+// target role. The leader kicks anyone but themself; officers kick members.
+func (r AllianceRole) CanKick(target AllianceRole) bool {
+	switch r {
+	case AllianceRoleLeader:
+		return target != AllianceRoleLeader
+	case AllianceRoleOfficer:
+		return target == AllianceRoleMember
+	}
+	return false
+}
+
+// AllianceMember is a player's membership in an alliance. A player belongs
+// to at most one alliance.
+type AllianceMember struct {
+	AllianceID string       `json:"allianceId"`
+	UserID     string       `json:"userId"`
+	Username   string       `json:"username"`
+	Role       AllianceRole `json:"role"`
+	JoinedAt   time.Time    `json:"joinedAt"`
+}
+
+// Alliance is a group of players who share vision of the map. Members are in
+// the order they joined.
+type Alliance struct {
+	AllianceID string           `json:"allianceId"`
+	Name       string           `json:"name"`
+	Members    []AllianceMember `json:"members"`
+	CreatedAt  time.Time        `json:"createdAt"`
+}
+
+// Member looks a member up by user ID.
+func (a *Alliance) Member(userID string) (*AllianceMember, bool) {
+	i := slices.IndexFunc(a.Members, func(m AllianceMember) bool { return m.UserID == userID })
+	if i < 0 {
+		return nil, false
+	}
+	return &a.Members[i], true
+}
+
+// Successor picks who leads once the leader is gone: the longest-serving
+// officer, or failing that the longest-serving member. It reports false when
+// nobody but the leader is left.
+func (a *Alliance) Successor() (*AllianceMember, bool) {
+	var next *AllianceMember
+	for i := range a.Members {
+		m := &a.Members[i]
+		if m.Role == AllianceRoleLeader {
+			continue
+		}
+		if next == nil || (m.Role == AllianceRoleOfficer && next.Role != AllianceRoleOfficer) {
+			next = m
+		}
+	}
+	return next, next != nil
+}
+
+// AllianceInvite is a standing invitation for a player to join an alliance.
+type AllianceInvite struct {
+	AllianceID   string    `json:"allianceId"`
+	AllianceName string    `json:"allianceName"`
+	UserID       string    `json:"userId"`
+	InvitedBy    string    `json:"invitedBy"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
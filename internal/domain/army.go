@@ -0,0 +1,42 @@
+package domain
+
+import "time"
+
+// Army is a body of troops on the map. It marches one tile per step toward
+// its destination and is idle once it gets there.
+type Army struct {
+	ArmyID       string   `json:"armyId"`
+	Owner        string   `json:"owner"`
+	CityID       string   `json:"cityId"`
+	Troops       int64    `json:"troops"`
+	X            int      `json:"x"`
+	Y            int      `json:"y"`
+	DestinationX int      `json:"destinationX"`
+	DestinationY int      `json:"destinationY"`
+	NextStepAt   NullTime `json:"nextStepAt"`
+
+	CreatedAt time.Time `json:"-"`
+	UpdatedAt time.Time `json:"-"`
+}
+
+// Moving reports whether the army still has tiles to cross.
+func (a Army) Moving() bool {
+	return a.X != a.DestinationX || a.Y != a.DestinationY
+}
+
+// NextStep returns the tile the army enters next: one step along each axis
+// toward the destination, so diagonal moves cost the same as straight ones
+// (Chebyshev distance, matching vision).
+func (a Army) NextStep() (int, int) {
+	return a.X + sign(a.DestinationX-a.X), a.Y + sign(a.DestinationY-a.Y)
+}
+
+func sign(v int) int {
+	switch {
+	case v > 0:
+		return 1
+	case v < 0:
+		return -1
+	}
+	return 0
+}
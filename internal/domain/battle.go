@@ -0,0 +1,26 @@
+package domain
+
+import "time"
+
+// BattleReport records a battle fought when an army reached a hostile city:
+// who fought, what each side brought and lost, and the seed that lets the
+// combat engine replay it exactly.
+type BattleReport struct {
+	ReportID        string    `json:"reportId"`
+	Seed            int64     `json:"seed"`
+	ArmyID          string    `json:"armyId"`
+	Attacker        string    `json:"attacker"`
+	Defender        *string   `json:"defender"`
+	CityID          string    `json:"cityId"`
+	X               int       `json:"x"`
+	Y               int       `json:"y"`
+	CityCenterLevel int       `json:"cityCenterLevel"`
+	TerrainDefense  float64   `json:"terrainDefense"`
+	AttackerTroops  int64     `json:"attackerTroops"`
+	DefenderTroops  int64     `json:"defenderTroops"`
+	AttackerLosses  int64     `json:"attackerLosses"`
+	DefenderLosses  int64     `json:"defenderLosses"`
+	Outcome         string    `json:"outcome"`
+	Rounds          int       `json:"rounds"`
+	FoughtAt        time.Time `json:"foughtAt"`
+}
@@ -1,6 +1,9 @@
 package domain
 
-import "time"
+import (
+	"math"
+	"time"
+)
 
 // BuildingType identifies a kind of building and its behavior.
 type BuildingType string
@@ -27,9 +30,54 @@ type Building struct {
 	ConstructionEnd   NullTime  `json:"construction_end"`
 	CreatedAt         time.Time `json:"-"`
 	UpdatedAt         time.Time `json:"-"`
+
+	// TrainingQueue is a barracks' troop training queue, active batches
+	// first. Stored in its own table, not on the building row.
+	TrainingQueue []Training `json:"training_queue"`
+
+	// Yield scales production by the deposit under the building (see
+	// Deposit.Multiplier). The building actor reads it from its tile on
+	// start; it is not stored.
+	Yield float64 `json:"-"`
+
+	// Bonuses are the adjacency bonuses active on the building. The city
+	// derives them from its layout and hands them down; they are not stored.
+	Bonuses []AdjacencyBonus `json:"bonuses"`
+}
+
+// AdjacencyBonus is an adjacency rule in effect on a building: Neighbours
+// matching buildings next to it raise Resource by Percent in total.
+type AdjacencyBonus struct {
+	Rule       string  `json:"rule"`
+	Resource   string  `json:"resource"`
+	Percent    float64 `json:"percent"`
+	Neighbours int     `json:"neighbours"`
 }
 
 // BuildingType returns the typed building kind.
 func (b Building) BuildingType() BuildingType {
 	return BuildingType(b.Type)
 }
+
+// ApplyYield scales a production amount by the building's Yield.
+func (b Building) ApplyYield(amount int64) int64 {
+	return int64(math.Round(float64(amount) * b.Yield))
+}
+
+// BonusMultiplier is the factor the building's adjacency bonuses apply to
+// resource ("gold", "food" or "population").
+func (b Building) BonusMultiplier(resource string) float64 {
+	m := 1.0
+	for _, bonus := range b.Bonuses {
+		if bonus.Resource == resource {
+			m += bonus.Percent / 100
+		}
+	}
+	return m
+}
+
+// ApplyBonus scales an amount of resource by the building's adjacency
+// bonuses.
+func (b Building) ApplyBonus(resource string, amount int64) int64 {
+	return int64(math.Round(float64(amount) * b.BonusMultiplier(resource)))
+}
@@ -0,0 +1,34 @@
+package domain
+
+import "time"
+
+// Caravan carries gold and food from one city to another across the map. It
+// marches one tile per step like an army, unloads at its destination city,
+// and is lost with its cargo if a hostile army catches it on the way.
+type Caravan struct {
+	CaravanID         string   `json:"caravanId"`
+	Owner             string   `json:"owner"`
+	SourceCityID      string   `json:"sourceCityId"`
+	DestinationCityID string   `json:"destinationCityId"`
+	Gold              int64    `json:"gold"`
+	Food              int64    `json:"food"`
+	X                 int      `json:"x"`
+	Y                 int      `json:"y"`
+	DestinationX      int      `json:"destinationX"`
+	DestinationY      int      `json:"destinationY"`
+	NextStepAt        NullTime `json:"nextStepAt"`
+
+	CreatedAt time.Time `json:"-"`
+	UpdatedAt time.Time `json:"-"`
+}
+
+// Moving reports whether the caravan still has tiles to cross.
+func (c Caravan) Moving() bool {
+	return c.X != c.DestinationX || c.Y != c.DestinationY
+}
+
+// NextStep returns the tile the caravan enters next, stepping the same way
+// as Army.NextStep.
+func (c Caravan) NextStep() (int, int) {
+	return c.X + sign(c.DestinationX-c.X), c.Y + sign(c.DestinationY-c.Y)
+}
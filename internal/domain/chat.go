@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+// ChatChannelKind is the audience a chat channel reaches.
+type ChatChannelKind string
+
+const (
+	// ChatChannelGlobal reaches every player.
+	ChatChannelGlobal ChatChannelKind = "global"
+	// ChatChannelAlliance reaches the members of one alliance.
+	ChatChannelAlliance ChatChannelKind = "alliance"
+	// ChatChannelDirect is a conversation between two players.
+	ChatChannelDirect ChatChannelKind = "direct"
+)
+
+// ChatChannel names a chat channel. ID is empty for the global channel, the
+// alliance ID for an alliance channel, and the ordered pair of players for a
+// direct channel.
+type ChatChannel struct {
+	Kind ChatChannelKind `json:"kind"`
+	ID   string          `json:"id"`
+}
+
+func GlobalChatChannel() ChatChannel {
+	return ChatChannel{Kind: ChatChannelGlobal}
+}
+
+func AllianceChatChannel(allianceID string) ChatChannel {
+	return ChatChannel{Kind: ChatChannelAlliance, ID: allianceID}
+}
+
+// DirectChatChannel is the conversation between two players, the same
+// whichever order they are given in.
+func DirectChatChannel(userID, otherID string) ChatChannel {
+	a, b := relationPair(userID, otherID)
+	return ChatChannel{Kind: ChatChannelDirect, ID: a + ":" + b}
+}
+
+// Key is the channel's stored form: "global", "alliance:<id>" or
+// "direct:<user>:<user>".
+func (c ChatChannel) Key() string {
+	if c.Kind == ChatChannelGlobal {
+		return string(c.Kind)
+	}
+	return string(c.Kind) + ":" + c.ID
+}
+
+// ParseChatChannel reads a channel back from its Key.
+func ParseChatChannel(key string) (ChatChannel, bool) {
+	kind, id, _ := strings.Cut(key, ":")
+	c := ChatChannel{Kind: ChatChannelKind(kind), ID: id}
+	switch c.Kind {
+	case ChatChannelGlobal:
+		return c, id == ""
+	case ChatChannelAlliance:
+		return c, id != ""
+	case ChatChannelDirect:
+		_, _, ok := ParseRelationID(id)
+		return c, ok
+	}
+	return ChatChannel{}, false
+}
+
+// Participants returns the two players of a direct channel.
+func (c ChatChannel) Participants() (string, string, bool) {
+	if c.Kind != ChatChannelDirect {
+		return "", "", false
+	}
+	return ParseRelationID(c.ID)
+}
+
+// ChatMessage is one message sent to a chat channel.
+type ChatMessage struct {
+	MessageID  string      `json:"messageId"`
+	Channel    ChatChannel `json:"channel"`
+	Sender     string      `json:"sender"`
+	SenderName string      `json:"senderName"`
+	Body       string      `json:"body"`
+	SentAt     time.Time   `json:"sentAt"`
+}
+
+// ChatMute bars a player from sending chat messages until MutedUntil.
+// MutedBy is the administrator who muted them.
+type ChatMute struct {
+	UserID     string    `json:"userId"`
+	MutedUntil time.Time `json:"mutedUntil"`
+	Reason     string    `json:"reason"`
+	MutedBy    string    `json:"mutedBy"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+// Active reports whether the mute is still in force at now.
+func (m *ChatMute) Active(now time.Time) bool {
+	return now.Before(m.MutedUntil)
+}
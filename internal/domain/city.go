@@ -2,7 +2,7 @@ package domain
 
 import "time"
 
-// CityType distinguishes player capitals from neutral towns.
+// CityType distinguishes player cities from neutral towns.
 type CityType string
 
 const (
@@ -22,6 +22,11 @@ type City struct {
 	StartY        int      `json:"startY"`
 	Size          int      `json:"size"`
 
+	// Capital marks the city its owner was founded with. It is cleared when
+	// the city changes hands, so a conquered capital is an ordinary city to
+	// its new owner.
+	Capital bool `json:"capital"`
+
 	// FoodProductionRate is the food this city's own farms produce per hour.
 	// FoodUpkeep is the food this city's population consumes per hour. NetFoodFlow
 	// = production - upkeep (positive = surplus exported to the user pool;
@@ -37,6 +42,64 @@ type City struct {
 	// declining. Computed from the per-tick delta applied in growPopulation.
 	PopulationGrowthRate int64 `json:"populationGrowthRate"`
 
-	CreatedAt time.Time `json:"-"`
-	UpdatedAt time.Time `json:"-"`
+	// Troops is the garrison stationed in the city. Armies are raised from it
+	// and fold back into it when they return home.
+	Troops int64 `json:"troops"`
+
+	// FoodStore is food held in the city itself: its own surplus, up to the
+	// caravan store capacity, and whatever caravans have delivered. The city
+	// eats from it before it counts as starving, and caravans leaving the city
+	// load their food from it, so a remote city can be fed from a neighbour.
+	FoodStore int64 `json:"foodStore"`
+
+	// ImportPriority orders this city's claim on its owner's food pool under
+	// FoodPolicyPriority; higher is served first.
+	ImportPriority int `json:"importPriority"`
+
+	// TaxRate is the percentage of its population's earning power the city
+	// collects as gold each tick. TaxIncome is the gold it brings in per hour.
+	// Higher rates slow population growth.
+	TaxRate   int   `json:"taxRate"`
+	TaxIncome int64 `json:"taxIncome"`
+
+	// Morale is how content the population is, from 0 to 100. It drifts each
+	// tick toward the level its MoraleFactors add up to and scales production
+	// and growth. UnrestTicks counts consecutive ticks spent below the unrest
+	// threshold; a city that stays there long enough revolts.
+	Morale        float64       `json:"morale"`
+	MoraleFactors MoraleFactors `json:"moraleFactors"`
+	Unrest        bool          `json:"unrest"`
+	UnrestTicks   int           `json:"unrestTicks"`
+
+	// ConstructionQueue holds the orders waiting for a construction slot, in
+	// the order they will start. ConstructionSlots is how many constructions
+	// the city runs at once, set by its center's level.
+	ConstructionQueue []ConstructionOrder `json:"constructionQueue"`
+	ConstructionSlots int                 `json:"constructionSlots"`
+
+	// LastTickAt is when the city last ticked, in UTC; zero if it never has.
+	// Offline catch-up replays the ticks missed since.
+	LastTickAt time.Time `json:"-"`
+	CreatedAt  time.Time `json:"-"`
+	UpdatedAt  time.Time `json:"-"`
+}
+
+// Contains reports whether (x, y) lies inside the city's block.
+func (c City) Contains(x, y int) bool {
+	return x >= c.StartX && x < c.StartX+c.Size && y >= c.StartY && y < c.StartY+c.Size
+}
+
+// MoraleFactors breaks a city's target morale down into what pushes it up or
+// down, each in morale points.
+type MoraleFactors struct {
+	Base      float64 `json:"base"`
+	Food      float64 `json:"food"`
+	Tax       float64 `json:"tax"`
+	Crowding  float64 `json:"crowding"`
+	Amenities float64 `json:"amenities"`
+}
+
+// Target is the morale the factors add up to, clamped to [0, 100].
+func (f MoraleFactors) Target() float64 {
+	return min(100, max(0, f.Base+f.Food+f.Tax+f.Crowding+f.Amenities))
 }
@@ -0,0 +1,187 @@
+// Package combat resolves battles between unit stacks. Resolution is a pure
+// function of its input: the same Battle, seed included, always produces the
+// same Result, so a stored battle can be replayed exactly.
+package combat
+
+import (
+	"math"
+	"math/rand"
+)
+
+// MaxRounds caps a battle's length. A defender still standing after the last
+// round holds the field.
+const MaxRounds = 10
+
+// CasualtyRate is the fraction of a side's effective strength inflicted as
+// casualties on the other side each round.
+const CasualtyRate = 0.25
+
+// Per-level defensive bonuses, added to the defender's base multiplier of 1.
+const (
+	CityCenterDefenseBonus = 0.05
+	WallDefenseBonus       = 0.10
+)
+
+// Unit is a troop type's per-head fighting strength.
+type Unit struct {
+	Type    string
+	Attack  float64
+	Defense float64
+}
+
+// Infantry is the only troop type today; every garrison and army is made of
+// it.
+var Infantry = Unit{Type: "infantry", Attack: 1, Defense: 1}
+
+// Stack is a number of troops of a single unit type.
+type Stack struct {
+	Unit  Unit
+	Count int64
+}
+
+// Modifiers adjust the defender's strength: fortifications of the defended
+// city and the terrain it stands on.
+type Modifiers struct {
+	CityCenterLevel int
+	WallLevel       int
+	// TerrainDefense multiplies defensive strength; 0 is treated as 1 (open
+	// ground).
+	TerrainDefense float64
+}
+
+// DefenseMultiplier is the factor applied to the defender's strength.
+func (m Modifiers) DefenseMultiplier() float64 {
+	terrain := m.TerrainDefense
+	if terrain == 0 {
+		terrain = 1
+	}
+	return (1 + CityCenterDefenseBonus*float64(m.CityCenterLevel) + WallDefenseBonus*float64(m.WallLevel)) * terrain
+}
+
+// Battle is the input to Resolve.
+type Battle struct {
+	Seed      int64
+	Attackers []Stack
+	Defenders []Stack
+	Modifiers Modifiers
+}
+
+// Outcome is who holds the field after a battle.
+type Outcome string
+
+const (
+	OutcomeAttackerVictory Outcome = "attacker_victory"
+	OutcomeDefenderVictory Outcome = "defender_victory"
+)
+
+// Result is the outcome of a battle and what each side lost. Stacks are in
+// the same order as the Battle's.
+type Result struct {
+	Outcome           Outcome
+	Rounds            int
+	AttackerLosses    []Stack
+	DefenderLosses    []Stack
+	AttackerSurvivors []Stack
+	DefenderSurvivors []Stack
+}
+
+// Resolve fights the battle round by round. Each round both sides strike
+// simultaneously with their strength scaled by a ±10% roll; casualties are
+// spread over a side's stacks in proportion to their size. The attacker wins
+// only by wiping out every defender, an empty garrison included.
+func Resolve(b Battle) Result {
+	rnd := rand.New(rand.NewSource(b.Seed))
+	attackers := clone(b.Attackers)
+	defenders := clone(b.Defenders)
+	multiplier := b.Modifiers.DefenseMultiplier()
+
+	rounds := 0
+	for rounds < MaxRounds && total(attackers) > 0 && total(defenders) > 0 {
+		rounds++
+		attack := strength(attackers, func(u Unit) float64 { return u.Attack }) * roll(rnd)
+		defense := strength(defenders, func(u Unit) float64 { return u.Defense }) * multiplier * roll(rnd)
+
+		// Simultaneous: both hits are computed before either is applied.
+		inflict(defenders, attack*CasualtyRate)
+		inflict(attackers, defense*CasualtyRate)
+	}
+
+	outcome := OutcomeDefenderVictory
+	if total(defenders) == 0 && total(attackers) > 0 {
+		outcome = OutcomeAttackerVictory
+	}
+	return Result{
+		Outcome:           outcome,
+		Rounds:            rounds,
+		AttackerLosses:    losses(b.Attackers, attackers),
+		DefenderLosses:    losses(b.Defenders, defenders),
+		AttackerSurvivors: attackers,
+		DefenderSurvivors: defenders,
+	}
+}
+
+// Total returns the number of troops across stacks.
+func Total(stacks []Stack) int64 {
+	return total(stacks)
+}
+
+func total(stacks []Stack) int64 {
+	var n int64
+	for _, s := range stacks {
+		n += s.Count
+	}
+	return n
+}
+
+func strength(stacks []Stack, stat func(Unit) float64) float64 {
+	var s float64
+	for _, st := range stacks {
+		s += float64(st.Count) * stat(st.Unit)
+	}
+	return s
+}
+
+// roll returns a multiplier in [0.9, 1.1).
+func roll(rnd *rand.Rand) float64 {
+	return 0.9 + rnd.Float64()*0.2
+}
+
+// inflict kills ceil(damage) troops, split over stacks by size. Rounding
+// leftovers go to the largest stacks first so the total is exact.
+func inflict(stacks []Stack, damage float64) {
+	alive := total(stacks)
+	if alive == 0 {
+		return
+	}
+	kills := min(int64(math.Ceil(damage)), alive)
+	remaining := kills
+	for i := range stacks {
+		share := kills * stacks[i].Count / alive
+		stacks[i].Count -= share
+		remaining -= share
+	}
+	for remaining > 0 {
+		largest := 0
+		for i := range stacks {
+			if stacks[i].Count > stacks[largest].Count {
+				largest = i
+			}
+		}
+		stacks[largest].Count--
+		remaining--
+	}
+}
+
+func losses(before, after []Stack) []Stack {
+	out := make([]Stack, len(before))
+	for i := range before {
+		out[i] = Stack{Unit: before[i].Unit, Count: before[i].Count - after[i].Count}
+	}
+	return out
+}
+
+func clone(stacks []Stack) []Stack {
+	out := make([]Stack, len(stacks))
+	copy(out, stacks)
+	return out
+}
@@ -0,0 +1,138 @@
+package combat_test
+
+import (
+	"slices"
+	"testing"
+
+	"cityio/internal/domain/combat"
+)
+
+func stacks(counts ...int64) []combat.Stack {
+	out := make([]combat.Stack, len(counts))
+	for i, n := range counts {
+		out[i] = combat.Stack{Unit: combat.Infantry, Count: n}
+	}
+	return out
+}
+
+func counts(stacks []combat.Stack) []int64 {
+	out := make([]int64, len(stacks))
+	for i, s := range stacks {
+		out[i] = s.Count
+	}
+	return out
+}
+
+func TestResolve(t *testing.T) {
+	tests := []struct {
+		name           string
+		battle         combat.Battle
+		outcome        combat.Outcome
+		rounds         int
+		attackerLosses []int64
+		defenderLosses []int64
+	}{
+		{
+			name: "open field",
+			battle: combat.Battle{
+				Seed:      42,
+				Attackers: stacks(100),
+				Defenders: stacks(60),
+			},
+			outcome:        combat.OutcomeAttackerVictory,
+			rounds:         3,
+			attackerLosses: []int64{27},
+			defenderLosses: []int64{60},
+		},
+		{
+			name: "fortified city",
+			battle: combat.Battle{
+				Seed:      7,
+				Attackers: stacks(100),
+				Defenders: stacks(80),
+				Modifiers: combat.Modifiers{CityCenterLevel: 5, WallLevel: 3, TerrainDefense: 1.2},
+			},
+			outcome:        combat.OutcomeDefenderVictory,
+			rounds:         5,
+			attackerLosses: []int64{100},
+			defenderLosses: []int64{63},
+		},
+		{
+			name: "losses split over stacks",
+			battle: combat.Battle{
+				Seed:      3,
+				Attackers: stacks(75, 25),
+				Defenders: stacks(40, 20),
+			},
+			outcome:        combat.OutcomeAttackerVictory,
+			rounds:         3,
+			attackerLosses: []int64{22, 6},
+			defenderLosses: []int64{40, 20},
+		},
+		{
+			name: "empty garrison",
+			battle: combat.Battle{
+				Seed:      9,
+				Attackers: stacks(50),
+			},
+			outcome:        combat.OutcomeAttackerVictory,
+			rounds:         0,
+			attackerLosses: []int64{0},
+			defenderLosses: []int64{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := combat.Resolve(tt.battle)
+			if got.Outcome != tt.outcome {
+				t.Errorf("outcome = %s, want %s", got.Outcome, tt.outcome)
+			}
+			if got.Rounds != tt.rounds {
+				t.Errorf("rounds = %d, want %d", got.Rounds, tt.rounds)
+			}
+			if c := counts(got.AttackerLosses); !slices.Equal(c, tt.attackerLosses) {
+				t.Errorf("attacker losses = %v, want %v", c, tt.attackerLosses)
+			}
+			if c := counts(got.DefenderLosses); !slices.Equal(c, tt.defenderLosses) {
+				t.Errorf("defender losses = %v, want %v", c, tt.defenderLosses)
+			}
+			for i, s := range tt.battle.Attackers {
+				if got.AttackerLosses[i].Count+got.AttackerSurvivors[i].Count != s.Count {
+					t.Errorf("attacker stack %d: losses and survivors do not add up to %d", i, s.Count)
+				}
+			}
+			for i, s := range tt.battle.Defenders {
+				if got.DefenderLosses[i].Count+got.DefenderSurvivors[i].Count != s.Count {
+					t.Errorf("defender stack %d: losses and survivors do not add up to %d", i, s.Count)
+				}
+			}
+
+			// The same battle, seed included, replays to the same result.
+			again := combat.Resolve(tt.battle)
+			if !slices.Equal(counts(again.AttackerSurvivors), counts(got.AttackerSurvivors)) ||
+				!slices.Equal(counts(again.DefenderSurvivors), counts(got.DefenderSurvivors)) {
+				t.Errorf("replay differs: %+v vs %+v", again, got)
+			}
+		})
+	}
+}
+
+func TestDefenseMultiplier(t *testing.T) {
+	tests := []struct {
+		name      string
+		modifiers combat.Modifiers
+		want      float64
+	}{
+		{"open ground", combat.Modifiers{}, 1},
+		{"city center", combat.Modifiers{CityCenterLevel: 4}, 1.2},
+		{"walls and terrain", combat.Modifiers{WallLevel: 5, TerrainDefense: 2}, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.modifiers.DefenseMultiplier(); got < tt.want-1e-9 || got > tt.want+1e-9 {
+				t.Errorf("DefenseMultiplier() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
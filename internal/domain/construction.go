@@ -0,0 +1,29 @@
+package domain
+
+import "time"
+
+// ConstructionOrder is a new building or an upgrade waiting in its city's
+// build queue. Orders leave the queue when they start; the building then
+// carries the construction itself.
+type ConstructionOrder struct {
+	OrderID string `json:"orderId"`
+	CityID  string `json:"cityId"`
+	// BuildingID is the building to upgrade, or nil for a new building at
+	// (X, Y).
+	BuildingID   *string      `json:"buildingId"`
+	BuildingType BuildingType `json:"buildingType"`
+	X            int          `json:"x"`
+	Y            int          `json:"y"`
+	Position     int          `json:"position"`
+	// Paid is the gold charged when the order was queued, refunded if it is
+	// cancelled. Zero when the order is charged as it starts.
+	Paid int64 `json:"paid"`
+
+	CreatedAt time.Time `json:"-"`
+	UpdatedAt time.Time `json:"-"`
+}
+
+// Upgrade reports whether the order upgrades an existing building.
+func (o ConstructionOrder) Upgrade() bool {
+	return o.BuildingID != nil
+}
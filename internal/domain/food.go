@@ -0,0 +1,103 @@
+package domain
+
+import (
+	"cmp"
+	"slices"
+)
+
+// FoodAllocationPolicy decides how a user's food pool is shared out when the
+// importing cities ask for more than it holds.
+type FoodAllocationPolicy string
+
+const (
+	// FoodPolicyCapitalFirst serves the capital, then other cities by
+	// population, largest first.
+	FoodPolicyCapitalFirst FoodAllocationPolicy = "capital_first"
+	// FoodPolicyProportional gives every city the same fraction of its
+	// request.
+	FoodPolicyProportional FoodAllocationPolicy = "proportional"
+	// FoodPolicyPriority serves cities by their player-set ImportPriority,
+	// highest first, falling back to capital-first order on ties.
+	FoodPolicyPriority FoodAllocationPolicy = "priority"
+)
+
+// Valid reports whether p is a known policy.
+func (p FoodAllocationPolicy) Valid() bool {
+	switch p {
+	case FoodPolicyCapitalFirst, FoodPolicyProportional, FoodPolicyPriority:
+		return true
+	}
+	return false
+}
+
+// FoodRequest is one city's draw on its owner's pool in an allocation window.
+type FoodRequest struct {
+	CityID     string
+	Amount     int64
+	Capital    bool
+	Population float64
+	Priority   int
+}
+
+// AllocateFood splits pool across requests under policy and returns the grant
+// for each request, in request order. Grants never exceed a request and never
+// sum to more than the pool.
+func AllocateFood(pool int64, requests []FoodRequest, policy FoodAllocationPolicy) []int64 {
+	grants := make([]int64, len(requests))
+	pool = max(pool, 0)
+
+	var total int64
+	for _, r := range requests {
+		total += r.Amount
+	}
+	if total <= pool {
+		for i, r := range requests {
+			grants[i] = r.Amount
+		}
+		return grants
+	}
+
+	order := make([]int, len(requests))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		ra, rb := requests[a], requests[b]
+		if policy == FoodPolicyPriority && ra.Priority != rb.Priority {
+			return cmp.Compare(rb.Priority, ra.Priority)
+		}
+		if ra.Capital != rb.Capital {
+			if ra.Capital {
+				return -1
+			}
+			return 1
+		}
+		return cmp.Compare(rb.Population, ra.Population)
+	})
+
+	if policy == FoodPolicyProportional {
+		remaining := pool
+		for i, r := range requests {
+			grants[i] = pool * r.Amount / total
+			remaining -= grants[i]
+		}
+		// Integer division leaves a few units over; hand them out in
+		// capital-first order.
+		for _, i := range order {
+			if remaining == 0 {
+				break
+			}
+			extra := min(requests[i].Amount-grants[i], remaining)
+			grants[i] += extra
+			remaining -= extra
+		}
+		return grants
+	}
+
+	remaining := pool
+	for _, i := range order {
+		grants[i] = min(requests[i].Amount, remaining)
+		remaining -= grants[i]
+	}
+	return grants
+}
@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestAllocateFood(t *testing.T) {
+	// 120 requested in total. The capital has the smallest population and the
+	// lowest priority, so each policy serves the cities in a different order.
+	requests := []FoodRequest{
+		{CityID: "capital", Amount: 50, Capital: true, Population: 100, Priority: 0},
+		{CityID: "large", Amount: 40, Population: 300, Priority: 5},
+		{CityID: "medium", Amount: 30, Population: 200, Priority: 1},
+	}
+	tied := []FoodRequest{
+		{CityID: "small", Amount: 30, Population: 100, Priority: 2},
+		{CityID: "capital", Amount: 30, Capital: true, Population: 50, Priority: 2},
+		{CityID: "large", Amount: 30, Population: 200, Priority: 2},
+	}
+
+	tests := []struct {
+		name     string
+		pool     int64
+		requests []FoodRequest
+		policy   FoodAllocationPolicy
+		want     []int64
+	}{
+		{"pool covers all", 120, requests, FoodPolicyCapitalFirst, []int64{50, 40, 30}},
+		{"pool covers all proportional", 500, requests, FoodPolicyProportional, []int64{50, 40, 30}},
+		{"capital first", 70, requests, FoodPolicyCapitalFirst, []int64{50, 20, 0}},
+		{"capital first short of capital", 30, requests, FoodPolicyCapitalFirst, []int64{30, 0, 0}},
+		// 70/120 of each request rounds down to 29, 23 and 17; the unit left
+		// over goes to the capital.
+		{"proportional", 70, requests, FoodPolicyProportional, []int64{30, 23, 17}},
+		{"proportional exact", 60, requests, FoodPolicyProportional, []int64{25, 20, 15}},
+		{"priority", 70, requests, FoodPolicyPriority, []int64{0, 40, 30}},
+		{"priority partial", 50, requests, FoodPolicyPriority, []int64{0, 40, 10}},
+		{"priority tie falls back to capital first", 70, tied, FoodPolicyPriority, []int64{10, 30, 30}},
+		{"empty pool", 0, requests, FoodPolicyProportional, []int64{0, 0, 0}},
+		{"negative pool", -10, requests, FoodPolicyCapitalFirst, []int64{0, 0, 0}},
+		{"no requests", 100, nil, FoodPolicyPriority, []int64{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := AllocateFood(tt.pool, tt.requests, tt.policy)
+			if !slices.Equal(got, tt.want) {
+				t.Fatalf("AllocateFood(%d, %s) = %v, want %v", tt.pool, tt.policy, got, tt.want)
+			}
+			var granted int64
+			for i, g := range got {
+				if g > tt.requests[i].Amount {
+					t.Errorf("%s granted %d, more than its request of %d", tt.requests[i].CityID, g, tt.requests[i].Amount)
+				}
+				granted += g
+			}
+			if granted > max(tt.pool, 0) {
+				t.Errorf("granted %d from a pool of %d", granted, tt.pool)
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package domain
+
+import (
+	"slices"
+	"time"
+)
+
+// MarketID names a market: one resource traded against a currency, such as
+// food priced in gold.
+type MarketID string
+
+// OrderSide is whether a market order buys or sells the market's resource.
+type OrderSide string
+
+const (
+	OrderSideBuy  OrderSide = "buy"
+	OrderSideSell OrderSide = "sell"
+)
+
+// Valid reports whether s is a known order side.
+func (s OrderSide) Valid() bool {
+	return s == OrderSideBuy || s == OrderSideSell
+}
+
+// MarketOrder is a limit order resting in a market's book. Price is in the
+// market's currency per unit of its resource. Remaining is what is still
+// unfilled; the escrow held for the order covers exactly that.
+type MarketOrder struct {
+	OrderID   string    `json:"orderId"`
+	MarketID  MarketID  `json:"marketId"`
+	UserID    string    `json:"userId"`
+	Side      OrderSide `json:"side"`
+	Price     int64     `json:"price"`
+	Quantity  int64     `json:"quantity"`
+	Remaining int64     `json:"remaining"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// Trade is a match between a buy and a sell order. It executes at the price
+// of the order that was resting in the book.
+type Trade struct {
+	TradeID     string    `json:"tradeId"`
+	MarketID    MarketID  `json:"marketId"`
+	BuyOrderID  string    `json:"buyOrderId"`
+	SellOrderID string    `json:"sellOrderId"`
+	Buyer       string    `json:"buyer"`
+	Seller      string    `json:"seller"`
+	Price       int64     `json:"price"`
+	Quantity    int64     `json:"quantity"`
+	ExecutedAt  time.Time `json:"executedAt"`
+}
+
+// OrderBook holds a market's resting orders in price-time priority: bids
+// highest price first, asks lowest first, earlier orders first at a price.
+type OrderBook struct {
+	Bids []MarketOrder
+	Asks []MarketOrder
+}
+
+// Add rests an order in the book at its priority.
+func (b *OrderBook) Add(o MarketOrder) {
+	side := b.side(o.Side)
+	i := slices.IndexFunc(*side, func(r MarketOrder) bool { return ahead(o, r) })
+	if i < 0 {
+		i = len(*side)
+	}
+	*side = slices.Insert(*side, i, o)
+}
+
+// ahead reports whether o takes priority over r, on the same side.
+func ahead(o, r MarketOrder) bool {
+	if o.Price != r.Price {
+		if o.Side == OrderSideBuy {
+			return o.Price > r.Price
+		}
+		return o.Price < r.Price
+	}
+	return o.CreatedAt.Before(r.CreatedAt)
+}
+
+func (b *OrderBook) side(s OrderSide) *[]MarketOrder {
+	if s == OrderSideBuy {
+		return &b.Bids
+	}
+	return &b.Asks
+}
+
+// Remove takes an order out of the book, returning it.
+func (b *OrderBook) Remove(orderID string) (MarketOrder, bool) {
+	for _, side := range []*[]MarketOrder{&b.Bids, &b.Asks} {
+		if i := slices.IndexFunc(*side, func(o MarketOrder) bool { return o.OrderID == orderID }); i >= 0 {
+			o := (*side)[i]
+			*side = slices.Delete(*side, i, i+1)
+			return o, true
+		}
+	}
+	return MarketOrder{}, false
+}
+
+// Fill is one match found for an incoming order: Quantity of Resting at
+// Resting's price.
+type Fill struct {
+	Resting  MarketOrder
+	Quantity int64
+}
+
+// Match finds the resting orders an incoming order crosses, best price first,
+// and takes the filled quantities out of the book. Orders of the same user
+// never match each other. The incoming order itself is not added; its
+// Remaining is reduced by what filled.
+func (b *OrderBook) Match(o *MarketOrder) []Fill {
+	opposite := b.side(OrderSideSell)
+	if o.Side == OrderSideSell {
+		opposite = b.side(OrderSideBuy)
+	}
+	var fills []Fill
+	book := (*opposite)[:0]
+	for _, r := range *opposite {
+		crosses := (o.Side == OrderSideBuy && r.Price <= o.Price) || (o.Side == OrderSideSell && r.Price >= o.Price)
+		if o.Remaining == 0 || !crosses || r.UserID == o.UserID {
+			book = append(book, r)
+			continue
+		}
+		qty := min(o.Remaining, r.Remaining)
+		fills = append(fills, Fill{Resting: r, Quantity: qty})
+		o.Remaining -= qty
+		r.Remaining -= qty
+		if r.Remaining > 0 {
+			book = append(book, r)
+		}
+	}
+	*opposite = book
+	return fills
+}
+
+// UserOrders returns the user's resting orders, bids first.
+func (b *OrderBook) UserOrders(userID string) []MarketOrder {
+	var orders []MarketOrder
+	for _, side := range [][]MarketOrder{b.Bids, b.Asks} {
+		for _, o := range side {
+			if o.UserID == userID {
+				orders = append(orders, o)
+			}
+		}
+	}
+	return orders
+}
+
+// MarketTicker is the public state of a market: the best prices on each side,
+// the last traded price and the most recent trades, newest first.
+type MarketTicker struct {
+	MarketID     MarketID `json:"marketId"`
+	BestBid      *int64   `json:"bestBid"`
+	BestAsk      *int64   `json:"bestAsk"`
+	LastPrice    *int64   `json:"lastPrice"`
+	RecentTrades []Trade  `json:"recentTrades"`
+}
@@ -0,0 +1,127 @@
+package domain
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestOrderBookMatch(t *testing.T) {
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	order := func(id, user string, side OrderSide, price, qty int64, at int) MarketOrder {
+		return MarketOrder{
+			OrderID:   id,
+			UserID:    user,
+			Side:      side,
+			Price:     price,
+			Quantity:  qty,
+			Remaining: qty,
+			CreatedAt: base.Add(time.Duration(at) * time.Second),
+		}
+	}
+	// Added out of priority order; Add sorts them. Asks rest as ask-9, ask-10a,
+	// ask-10b, ask-12 and bids as bid-11, bid-10a, bid-10b, bid-8.
+	resting := []MarketOrder{
+		order("ask-12", "seller-a", OrderSideSell, 12, 10, 0),
+		order("ask-10b", "seller-b", OrderSideSell, 10, 5, 2),
+		order("ask-10a", "seller-a", OrderSideSell, 10, 5, 1),
+		order("ask-9", "seller-c", OrderSideSell, 9, 3, 3),
+		order("bid-8", "buyer-a", OrderSideBuy, 8, 10, 0),
+		order("bid-10b", "buyer-b", OrderSideBuy, 10, 4, 2),
+		order("bid-10a", "buyer-a", OrderSideBuy, 10, 4, 1),
+		order("bid-11", "buyer-c", OrderSideBuy, 11, 2, 3),
+	}
+
+	type fill struct {
+		OrderID  string
+		Quantity int64
+	}
+	type rest struct {
+		OrderID   string
+		Remaining int64
+	}
+	tests := []struct {
+		name      string
+		incoming  MarketOrder
+		fills     []fill
+		remaining int64
+		asks      []rest
+		bids      []rest
+	}{
+		{
+			name:      "buy fills best price then earliest and leaves a partial",
+			incoming:  order("in", "buyer-x", OrderSideBuy, 10, 10, 10),
+			fills:     []fill{{"ask-9", 3}, {"ask-10a", 5}, {"ask-10b", 2}},
+			remaining: 0,
+			asks:      []rest{{"ask-10b", 3}, {"ask-12", 10}},
+		},
+		{
+			name:      "buy larger than the crossing asks keeps its remainder",
+			incoming:  order("in", "buyer-x", OrderSideBuy, 10, 20, 10),
+			fills:     []fill{{"ask-9", 3}, {"ask-10a", 5}, {"ask-10b", 5}},
+			remaining: 7,
+			asks:      []rest{{"ask-12", 10}},
+		},
+		{
+			name:      "sell fills highest bids first",
+			incoming:  order("in", "seller-x", OrderSideSell, 10, 5, 10),
+			fills:     []fill{{"bid-11", 2}, {"bid-10a", 3}},
+			remaining: 0,
+			bids:      []rest{{"bid-10a", 1}, {"bid-10b", 4}, {"bid-8", 10}},
+		},
+		{
+			name:      "own orders are skipped",
+			incoming:  order("in", "seller-a", OrderSideBuy, 12, 10, 10),
+			fills:     []fill{{"ask-9", 3}, {"ask-10b", 5}},
+			remaining: 2,
+			asks:      []rest{{"ask-10a", 5}, {"ask-12", 10}},
+		},
+		{
+			name:      "no cross",
+			incoming:  order("in", "seller-x", OrderSideSell, 12, 5, 10),
+			remaining: 5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b OrderBook
+			for _, o := range resting {
+				b.Add(o)
+			}
+			// Sides the incoming order cannot touch stay as they were.
+			if tt.asks == nil {
+				tt.asks = []rest{{"ask-9", 3}, {"ask-10a", 5}, {"ask-10b", 5}, {"ask-12", 10}}
+			}
+			if tt.bids == nil {
+				tt.bids = []rest{{"bid-11", 2}, {"bid-10a", 4}, {"bid-10b", 4}, {"bid-8", 10}}
+			}
+
+			in := tt.incoming
+			var fills []fill
+			for _, f := range b.Match(&in) {
+				fills = append(fills, fill{f.Resting.OrderID, f.Quantity})
+			}
+			if !slices.Equal(fills, tt.fills) {
+				t.Errorf("fills = %v, want %v", fills, tt.fills)
+			}
+			if in.Remaining != tt.remaining {
+				t.Errorf("incoming remaining = %d, want %d", in.Remaining, tt.remaining)
+			}
+
+			book := func(side []MarketOrder) []rest {
+				out := make([]rest, len(side))
+				for i, o := range side {
+					out[i] = rest{o.OrderID, o.Remaining}
+				}
+				return out
+			}
+			if got := book(b.Asks); !slices.Equal(got, tt.asks) {
+				t.Errorf("asks = %v, want %v", got, tt.asks)
+			}
+			if got := book(b.Bids); !slices.Equal(got, tt.bids) {
+				t.Errorf("bids = %v, want %v", got, tt.bids)
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+// RelationState is the diplomatic standing between two players.
+type RelationState string
+
+const (
+	RelationNeutral       RelationState = "neutral"
+	RelationPeace         RelationState = "peace"
+	RelationNonAggression RelationState = "non_aggression"
+	RelationWar           RelationState = "war"
+)
+
+// Valid reports whether s is a known relation state.
+func (s RelationState) Valid() bool {
+	return s == RelationNeutral || s == RelationPeace || s == RelationNonAggression || s == RelationWar
+}
+
+// Treaty reports whether s is agreed by both sides: peace or a
+// non-aggression pact.
+func (s RelationState) Treaty() bool {
+	return s == RelationPeace || s == RelationNonAggression
+}
+
+// Relation is the standing between a pair of players, stored once per pair
+// with UserA < UserB. Changes may be delayed: PendingState replaces State at
+// EffectiveAt. Treaties are proposed by one side and accepted by the other;
+// ProposedState is the treaty on the table, if any.
+type Relation struct {
+	UserA         string        `json:"userA"`
+	UserB         string        `json:"userB"`
+	State         RelationState `json:"state"`
+	PendingState  RelationState `json:"pendingState"` // empty when nothing is pending
+	EffectiveAt   NullTime      `json:"effectiveAt"`
+	ProposedState RelationState `json:"proposedState"` // empty when nothing is proposed
+	ProposedBy    string        `json:"proposedBy"`
+	UpdatedAt     time.Time     `json:"updatedAt"`
+}
+
+// RelationID is the identity of the relation between two players, the same
+// whichever order they are given in.
+func RelationID(userID, otherID string) string {
+	a, b := relationPair(userID, otherID)
+	return a + ":" + b
+}
+
+// ParseRelationID splits a relation ID back into its ordered pair.
+func ParseRelationID(id string) (string, string, bool) {
+	a, b, ok := strings.Cut(id, ":")
+	if !ok || a == "" || b == "" || a >= b {
+		return "", "", false
+	}
+	return a, b, true
+}
+
+func relationPair(userID, otherID string) (string, string) {
+	if otherID < userID {
+		return otherID, userID
+	}
+	return userID, otherID
+}
+
+// NewRelation returns the neutral relation two players start from.
+func NewRelation(userID, otherID string) Relation {
+	a, b := relationPair(userID, otherID)
+	return Relation{UserA: a, UserB: b, State: RelationNeutral}
+}
+
+// ID is the relation's identity. See RelationID.
+func (r *Relation) ID() string {
+	return r.UserA + ":" + r.UserB
+}
+
+// Involves reports whether userID is one side of the relation.
+func (r *Relation) Involves(userID string) bool {
+	return r.UserA == userID || r.UserB == userID
+}
+
+// Other returns the side of the relation that isn't userID.
+func (r *Relation) Other(userID string) string {
+	if r.UserA == userID {
+		return r.UserB
+	}
+	return r.UserA
+}
+
+// Pending reports whether a delayed change is still to take effect.
+func (r *Relation) Pending() bool {
+	return r.PendingState != "" && r.EffectiveAt.Time != nil
+}
+
+// Current returns the state in force at now, counting a pending change whose
+// time has come even if it hasn't been settled yet.
+func (r *Relation) Current(now time.Time) RelationState {
+	if r.Pending() && !now.Before(*r.EffectiveAt.Time) {
+		return r.PendingState
+	}
+	return r.State
+}
+
+// Settle applies a pending change whose time has come, reporting whether it
+// did.
+func (r *Relation) Settle(now time.Time) bool {
+	if !r.Pending() || now.Before(*r.EffectiveAt.Time) {
+		return false
+	}
+	r.State = r.PendingState
+	r.PendingState = ""
+	r.EffectiveAt = NullTime{}
+	return true
+}
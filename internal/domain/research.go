@@ -0,0 +1,16 @@
+package domain
+
+import "time"
+
+// TechID names a tech in the research tree.
+type TechID string
+
+// Research is a tech a user has researched or is researching. Only one tech
+// is researched at a time; it completes at ResearchEnd.
+type Research struct {
+	UserID        string    `json:"userId"`
+	Tech          TechID    `json:"tech"`
+	ResearchStart time.Time `json:"researchStart"`
+	ResearchEnd   time.Time `json:"researchEnd"`
+	Completed     bool      `json:"completed"`
+}
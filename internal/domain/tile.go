@@ -2,8 +2,52 @@ package domain
 
 // Tile is a single map cell, optionally occupied by a city and/or building.
 type Tile struct {
-	X          int     `json:"x"`
-	Y          int     `json:"y"`
-	CityID     *string `json:"cityId"`
-	BuildingID *string `json:"buildingId"`
+	X          int      `json:"x"`
+	Y          int      `json:"y"`
+	Terrain    Terrain  `json:"terrain"`
+	Deposit    *Deposit `json:"deposit"`
+	CityID     *string  `json:"cityId"`
+	BuildingID *string  `json:"buildingId"`
+}
+
+// Terrain is the ground a tile is made of, fixed when the world is generated.
+type Terrain string
+
+const (
+	TerrainGrassland Terrain = "grassland"
+	TerrainForest    Terrain = "forest"
+	TerrainHills     Terrain = "hills"
+	TerrainMountains Terrain = "mountains"
+	TerrainWater     Terrain = "water"
+)
+
+// Valid reports whether t is a known terrain.
+func (t Terrain) Valid() bool {
+	return t == TerrainGrassland || t == TerrainForest || t == TerrainHills || t == TerrainMountains || t == TerrainWater
+}
+
+// AllowsCity reports whether a city block may cover this terrain.
+func (t Terrain) AllowsCity() bool {
+	return t != TerrainWater
+}
+
+// DepositKind is a natural resource lying under a tile.
+type DepositKind string
+
+const (
+	DepositGoldVein    DepositKind = "gold_vein"
+	DepositFertileSoil DepositKind = "fertile_soil"
+)
+
+// Valid reports whether k is a known deposit kind.
+func (k DepositKind) Valid() bool {
+	return k == DepositGoldVein || k == DepositFertileSoil
+}
+
+// Deposit is a resource under a tile, fixed when the world is generated.
+// Richness scales the output of the building working it; 1 is an ordinary
+// deposit.
+type Deposit struct {
+	Kind     DepositKind `json:"kind"`
+	Richness float64     `json:"richness"`
 }
@@ -0,0 +1,22 @@
+package domain
+
+import "time"
+
+// Training is a batch of troops ordered at a barracks. Batches wait in FIFO
+// order until a training slot frees up; TrainingStart and TrainingEnd are set
+// when the batch claims one.
+type Training struct {
+	TrainingID    string   `json:"trainingId"`
+	BarracksID    string   `json:"barracksId"`
+	Troops        int64    `json:"troops"`
+	TrainingStart NullTime `json:"trainingStart"`
+	TrainingEnd   NullTime `json:"trainingEnd"`
+
+	CreatedAt time.Time `json:"-"`
+	UpdatedAt time.Time `json:"-"`
+}
+
+// Active reports whether the batch holds a training slot.
+func (t Training) Active() bool {
+	return t.TrainingEnd.Time != nil
+}
@@ -17,6 +17,16 @@ type User struct {
 	FoodIncomeRate int64 `json:"foodIncomeRate"`
 	FoodUpkeepRate int64 `json:"foodUpkeepRate"`
 
+	// FoodPolicy decides how the pool is split when importing cities ask for
+	// more than it holds.
+	FoodPolicy FoodAllocationPolicy `json:"foodPolicy"`
+
+	// Techs are the techs the user has finished researching, in completion
+	// order. Researching is the tech in progress, if any. Both are loaded
+	// from the research table rather than the users row.
+	Techs       []TechID  `json:"techs"`
+	Researching *Research `json:"researching"`
+
 	CreatedAt time.Time `json:"-"`
 	UpdatedAt time.Time `json:"-"`
 }
@@ -0,0 +1,56 @@
+package domain
+
+import "time"
+
+// WorldState is where the world is in its season lifecycle.
+type WorldState string
+
+const (
+	// WorldStateActive is a season in play. Boot restores it as-is.
+	WorldStateActive WorldState = "active"
+	// WorldStateEnding is a season that has been ended but not yet rolled
+	// over. The next boot archives it and generates a fresh world.
+	WorldStateEnding WorldState = "ending"
+)
+
+// SeasonEndReason records why a season ended.
+type SeasonEndReason string
+
+const (
+	// SeasonEndAdmin is a season ended by an administrator.
+	SeasonEndAdmin SeasonEndReason = "admin"
+	// SeasonEndExpired is a season that ran its full length.
+	SeasonEndExpired SeasonEndReason = "expired"
+)
+
+// World is the persisted game world: the seed its map was generated from and
+// the season being played on it.
+type World struct {
+	Seed            int64
+	Season          int
+	State           WorldState
+	EndReason       SeasonEndReason
+	SeasonStartedAt time.Time
+	SeasonEndsAt    time.Time
+	CreatedAt       time.Time
+}
+
+// Season is an archived, finished season.
+type Season struct {
+	Season    int
+	Seed      int64
+	EndReason SeasonEndReason
+	StartedAt time.Time
+	EndedAt   time.Time
+}
+
+// SeasonStanding is one player's final placing in an archived season.
+type SeasonStanding struct {
+	Season     int
+	Rank       int
+	UserID     string
+	Username   string
+	Cities     int
+	Population float64
+	Gold       int64
+}
@@ -0,0 +1,394 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: cityio/entity/v1/alliance.proto
+
+package entityv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AllianceRole is a member's rank. The leader and officers invite players;
+// the leader kicks anyone and officers kick members. Only the leader changes
+// roles.
+type AllianceRole int32
+
+const (
+	AllianceRole_ALLIANCE_ROLE_UNSPECIFIED AllianceRole = 0
+	AllianceRole_ALLIANCE_ROLE_LEADER      AllianceRole = 1
+	AllianceRole_ALLIANCE_ROLE_OFFICER     AllianceRole = 2
+	AllianceRole_ALLIANCE_ROLE_MEMBER      AllianceRole = 3
+)
+
+// Enum value maps for AllianceRole.
+var (
+	AllianceRole_name = map[int32]string{
+		0: "ALLIANCE_ROLE_UNSPECIFIED",
+		1: "ALLIANCE_ROLE_LEADER",
+		2: "ALLIANCE_ROLE_OFFICER",
+		3: "ALLIANCE_ROLE_MEMBER",
+	}
+	AllianceRole_value = map[string]int32{
+		"ALLIANCE_ROLE_UNSPECIFIED": 0,
+		"ALLIANCE_ROLE_LEADER":      1,
+		"ALLIANCE_ROLE_OFFICER":     2,
+		"ALLIANCE_ROLE_MEMBER":      3,
+	}
+)
+
+func (x AllianceRole) Enum() *AllianceRole {
+	p := new(AllianceRole)
+	*p = x
+	return p
+}
+
+func (x AllianceRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AllianceRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_cityio_entity_v1_alliance_proto_enumTypes[0].Descriptor()
+}
+
+func (AllianceRole) Type() protoreflect.EnumType {
+	return &file_cityio_entity_v1_alliance_proto_enumTypes[0]
+}
+
+func (x AllianceRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AllianceRole.Descriptor instead.
+func (AllianceRole) EnumDescriptor() ([]byte, []int) {
+	return file_cityio_entity_v1_alliance_proto_rawDescGZIP(), []int{0}
+}
+
+type AllianceMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *UserId                `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Role          AllianceRole           `protobuf:"varint,3,opt,name=role,proto3,enum=cityio.entity.v1.AllianceRole" json:"role,omitempty"`
+	JoinedAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllianceMember) Reset() {
+	*x = AllianceMember{}
+	mi := &file_cityio_entity_v1_alliance_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllianceMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllianceMember) ProtoMessage() {}
+
+func (x *AllianceMember) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_entity_v1_alliance_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllianceMember.ProtoReflect.Descriptor instead.
+func (*AllianceMember) Descriptor() ([]byte, []int) {
+	return file_cityio_entity_v1_alliance_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AllianceMember) GetUserId() *UserId {
+	if x != nil {
+		return x.UserId
+	}
+	return nil
+}
+
+func (x *AllianceMember) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *AllianceMember) GetRole() AllianceRole {
+	if x != nil {
+		return x.Role
+	}
+	return AllianceRole_ALLIANCE_ROLE_UNSPECIFIED
+}
+
+func (x *AllianceMember) GetJoinedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return nil
+}
+
+// Alliance is a group of players who share vision: each member sees the map
+// around every member's cities.
+type Alliance struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	AllianceId *AllianceId            `protobuf:"bytes,1,opt,name=alliance_id,json=allianceId,proto3" json:"alliance_id,omitempty"`
+	Name       string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// members are in the order they joined.
+	Members       []*AllianceMember      `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Alliance) Reset() {
+	*x = Alliance{}
+	mi := &file_cityio_entity_v1_alliance_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Alliance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Alliance) ProtoMessage() {}
+
+func (x *Alliance) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_entity_v1_alliance_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Alliance.ProtoReflect.Descriptor instead.
+func (*Alliance) Descriptor() ([]byte, []int) {
+	return file_cityio_entity_v1_alliance_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Alliance) GetAllianceId() *AllianceId {
+	if x != nil {
+		return x.AllianceId
+	}
+	return nil
+}
+
+func (x *Alliance) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Alliance) GetMembers() []*AllianceMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *Alliance) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// AllianceInvite is a standing invitation for a player to join an alliance.
+type AllianceInvite struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AllianceId    *AllianceId            `protobuf:"bytes,1,opt,name=alliance_id,json=allianceId,proto3" json:"alliance_id,omitempty"`
+	AllianceName  string                 `protobuf:"bytes,2,opt,name=alliance_name,json=allianceName,proto3" json:"alliance_name,omitempty"`
+	UserId        *UserId                `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	InvitedBy     *UserId                `protobuf:"bytes,4,opt,name=invited_by,json=invitedBy,proto3" json:"invited_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllianceInvite) Reset() {
+	*x = AllianceInvite{}
+	mi := &file_cityio_entity_v1_alliance_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllianceInvite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllianceInvite) ProtoMessage() {}
+
+func (x *AllianceInvite) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_entity_v1_alliance_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllianceInvite.ProtoReflect.Descriptor instead.
+func (*AllianceInvite) Descriptor() ([]byte, []int) {
+	return file_cityio_entity_v1_alliance_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AllianceInvite) GetAllianceId() *AllianceId {
+	if x != nil {
+		return x.AllianceId
+	}
+	return nil
+}
+
+func (x *AllianceInvite) GetAllianceName() string {
+	if x != nil {
+		return x.AllianceName
+	}
+	return ""
+}
+
+func (x *AllianceInvite) GetUserId() *UserId {
+	if x != nil {
+		return x.UserId
+	}
+	return nil
+}
+
+func (x *AllianceInvite) GetInvitedBy() *UserId {
+	if x != nil {
+		return x.InvitedBy
+	}
+	return nil
+}
+
+func (x *AllianceInvite) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+var File_cityio_entity_v1_alliance_proto protoreflect.FileDescriptor
+
+const file_cityio_entity_v1_alliance_proto_rawDesc = "" +
+	"\n" +
+	"\x1fcityio/entity/v1/alliance.proto\x12\x10cityio.entity.v1\x1a\x1dcityio/entity/v1/common.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xcc\x01\n" +
+	"\x0eAllianceMember\x121\n" +
+	"\auser_id\x18\x01 \x01(\v2\x18.cityio.entity.v1.UserIdR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x122\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x1e.cityio.entity.v1.AllianceRoleR\x04role\x127\n" +
+	"\tjoined_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bjoinedAt\"\xd4\x01\n" +
+	"\bAlliance\x12=\n" +
+	"\valliance_id\x18\x01 \x01(\v2\x1c.cityio.entity.v1.AllianceIdR\n" +
+	"allianceId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12:\n" +
+	"\amembers\x18\x03 \x03(\v2 .cityio.entity.v1.AllianceMemberR\amembers\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x9b\x02\n" +
+	"\x0eAllianceInvite\x12=\n" +
+	"\valliance_id\x18\x01 \x01(\v2\x1c.cityio.entity.v1.AllianceIdR\n" +
+	"allianceId\x12#\n" +
+	"\ralliance_name\x18\x02 \x01(\tR\fallianceName\x121\n" +
+	"\auser_id\x18\x03 \x01(\v2\x18.cityio.entity.v1.UserIdR\x06userId\x127\n" +
+	"\n" +
+	"invited_by\x18\x04 \x01(\v2\x18.cityio.entity.v1.UserIdR\tinvitedBy\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt*|\n" +
+	"\fAllianceRole\x12\x1d\n" +
+	"\x19ALLIANCE_ROLE_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14ALLIANCE_ROLE_LEADER\x10\x01\x12\x19\n" +
+	"\x15ALLIANCE_ROLE_OFFICER\x10\x02\x12\x18\n" +
+	"\x14ALLIANCE_ROLE_MEMBER\x10\x03B\xb6\x01\n" +
+	"\x14com.cityio.entity.v1B\rAllianceProtoP\x01Z-cityio/internal/gen/cityio/entity/v1;entityv1\xa2\x02\x03CEX\xaa\x02\x10Cityio.Entity.V1\xca\x02\x10Cityio\\Entity\\V1\xe2\x02\x1cCityio\\Entity\\V1\\GPBMetadata\xea\x02\x12Cityio::Entity::V1b\x06proto3"
+
+var (
+	file_cityio_entity_v1_alliance_proto_rawDescOnce sync.Once
+	file_cityio_entity_v1_alliance_proto_rawDescData []byte
+)
+
+func file_cityio_entity_v1_alliance_proto_rawDescGZIP() []byte {
+	file_cityio_entity_v1_alliance_proto_rawDescOnce.Do(func() {
+		file_cityio_entity_v1_alliance_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cityio_entity_v1_alliance_proto_rawDesc), len(file_cityio_entity_v1_alliance_proto_rawDesc)))
+	})
+	return file_cityio_entity_v1_alliance_proto_rawDescData
+}
+
+var file_cityio_entity_v1_alliance_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_cityio_entity_v1_alliance_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_cityio_entity_v1_alliance_proto_goTypes = []any{
+	(AllianceRole)(0),             // 0: cityio.entity.v1.AllianceRole
+	(*AllianceMember)(nil),        // 1: cityio.entity.v1.AllianceMember
+	(*Alliance)(nil),              // 2: cityio.entity.v1.Alliance
+	(*AllianceInvite)(nil),        // 3: cityio.entity.v1.AllianceInvite
+	(*UserId)(nil),                // 4: cityio.entity.v1.UserId
+	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
+	(*AllianceId)(nil),            // 6: cityio.entity.v1.AllianceId
+}
+var file_cityio_entity_v1_alliance_proto_depIdxs = []int32{
+	4,  // 0: cityio.entity.v1.AllianceMember.user_id:type_name -> cityio.entity.v1.UserId
+	0,  // 1: cityio.entity.v1.AllianceMember.role:type_name -> cityio.entity.v1.AllianceRole
+	5,  // 2: cityio.entity.v1.AllianceMember.joined_at:type_name -> google.protobuf.Timestamp
+	6,  // 3: cityio.entity.v1.Alliance.alliance_id:type_name -> cityio.entity.v1.AllianceId
+	1,  // 4: cityio.entity.v1.Alliance.members:type_name -> cityio.entity.v1.AllianceMember
+	5,  // 5: cityio.entity.v1.Alliance.created_at:type_name -> google.protobuf.Timestamp
+	6,  // 6: cityio.entity.v1.AllianceInvite.alliance_id:type_name -> cityio.entity.v1.AllianceId
+	4,  // 7: cityio.entity.v1.AllianceInvite.user_id:type_name -> cityio.entity.v1.UserId
+	4,  // 8: cityio.entity.v1.AllianceInvite.invited_by:type_name -> cityio.entity.v1.UserId
+	5,  // 9: cityio.entity.v1.AllianceInvite.created_at:type_name -> google.protobuf.Timestamp
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_cityio_entity_v1_alliance_proto_init() }
+func file_cityio_entity_v1_alliance_proto_init() {
+	if File_cityio_entity_v1_alliance_proto != nil {
+		return
+	}
+	file_cityio_entity_v1_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cityio_entity_v1_alliance_proto_rawDesc), len(file_cityio_entity_v1_alliance_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cityio_entity_v1_alliance_proto_goTypes,
+		DependencyIndexes: file_cityio_entity_v1_alliance_proto_depIdxs,
+		EnumInfos:         file_cityio_entity_v1_alliance_proto_enumTypes,
+		MessageInfos:      file_cityio_entity_v1_alliance_proto_msgTypes,
+	}.Build()
+	File_cityio_entity_v1_alliance_proto = out.File
+	file_cityio_entity_v1_alliance_proto_goTypes = nil
+	file_cityio_entity_v1_alliance_proto_depIdxs = nil
+}
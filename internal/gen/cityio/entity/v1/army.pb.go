@@ -0,0 +1,197 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: cityio/entity/v1/army.proto
+
+package entityv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Army is a body of troops marching across the map, one tile per step.
+// It is idle when coords equals destination.
+type Army struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	ArmyId *ArmyId                `protobuf:"bytes,1,opt,name=army_id,json=armyId,proto3" json:"army_id,omitempty"`
+	Owner  *UserId                `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// city_id is the home city the troops were raised from.
+	CityId      *CityId      `protobuf:"bytes,3,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
+	Troops      int64        `protobuf:"varint,4,opt,name=troops,proto3" json:"troops,omitempty"`
+	Coords      *Coordinates `protobuf:"bytes,5,opt,name=coords,proto3" json:"coords,omitempty"`
+	Destination *Coordinates `protobuf:"bytes,6,opt,name=destination,proto3" json:"destination,omitempty"`
+	// next_step_at is when the army enters its next tile. Unset while idle.
+	NextStepAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=next_step_at,json=nextStepAt,proto3,oneof" json:"next_step_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Army) Reset() {
+	*x = Army{}
+	mi := &file_cityio_entity_v1_army_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Army) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Army) ProtoMessage() {}
+
+func (x *Army) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_entity_v1_army_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Army.ProtoReflect.Descriptor instead.
+func (*Army) Descriptor() ([]byte, []int) {
+	return file_cityio_entity_v1_army_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Army) GetArmyId() *ArmyId {
+	if x != nil {
+		return x.ArmyId
+	}
+	return nil
+}
+
+func (x *Army) GetOwner() *UserId {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+func (x *Army) GetCityId() *CityId {
+	if x != nil {
+		return x.CityId
+	}
+	return nil
+}
+
+func (x *Army) GetTroops() int64 {
+	if x != nil {
+		return x.Troops
+	}
+	return 0
+}
+
+func (x *Army) GetCoords() *Coordinates {
+	if x != nil {
+		return x.Coords
+	}
+	return nil
+}
+
+func (x *Army) GetDestination() *Coordinates {
+	if x != nil {
+		return x.Destination
+	}
+	return nil
+}
+
+func (x *Army) GetNextStepAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextStepAt
+	}
+	return nil
+}
+
+var File_cityio_entity_v1_army_proto protoreflect.FileDescriptor
+
+const file_cityio_entity_v1_army_proto_rawDesc = "" +
+	"\n" +
+	"\x1bcityio/entity/v1/army.proto\x12\x10cityio.entity.v1\x1a\x1dcityio/entity/v1/common.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x80\x03\n" +
+	"\x04Army\x121\n" +
+	"\aarmy_id\x18\x01 \x01(\v2\x18.cityio.entity.v1.ArmyIdR\x06armyId\x12.\n" +
+	"\x05owner\x18\x02 \x01(\v2\x18.cityio.entity.v1.UserIdR\x05owner\x121\n" +
+	"\acity_id\x18\x03 \x01(\v2\x18.cityio.entity.v1.CityIdR\x06cityId\x12\x16\n" +
+	"\x06troops\x18\x04 \x01(\x03R\x06troops\x125\n" +
+	"\x06coords\x18\x05 \x01(\v2\x1d.cityio.entity.v1.CoordinatesR\x06coords\x12?\n" +
+	"\vdestination\x18\x06 \x01(\v2\x1d.cityio.entity.v1.CoordinatesR\vdestination\x12A\n" +
+	"\fnext_step_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x00R\n" +
+	"nextStepAt\x88\x01\x01B\x0f\n" +
+	"\r_next_step_atB\xb2\x01\n" +
+	"\x14com.cityio.entity.v1B\tArmyProtoP\x01Z-cityio/internal/gen/cityio/entity/v1;entityv1\xa2\x02\x03CEX\xaa\x02\x10Cityio.Entity.V1\xca\x02\x10Cityio\\Entity\\V1\xe2\x02\x1cCityio\\Entity\\V1\\GPBMetadata\xea\x02\x12Cityio::Entity::V1b\x06proto3"
+
+var (
+	file_cityio_entity_v1_army_proto_rawDescOnce sync.Once
+	file_cityio_entity_v1_army_proto_rawDescData []byte
+)
+
+func file_cityio_entity_v1_army_proto_rawDescGZIP() []byte {
+	file_cityio_entity_v1_army_proto_rawDescOnce.Do(func() {
+		file_cityio_entity_v1_army_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cityio_entity_v1_army_proto_rawDesc), len(file_cityio_entity_v1_army_proto_rawDesc)))
+	})
+	return file_cityio_entity_v1_army_proto_rawDescData
+}
+
+var file_cityio_entity_v1_army_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_cityio_entity_v1_army_proto_goTypes = []any{
+	(*Army)(nil),                  // 0: cityio.entity.v1.Army
+	(*ArmyId)(nil),                // 1: cityio.entity.v1.ArmyId
+	(*UserId)(nil),                // 2: cityio.entity.v1.UserId
+	(*CityId)(nil),                // 3: cityio.entity.v1.CityId
+	(*Coordinates)(nil),           // 4: cityio.entity.v1.Coordinates
+	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
+}
+var file_cityio_entity_v1_army_proto_depIdxs = []int32{
+	1, // 0: cityio.entity.v1.Army.army_id:type_name -> cityio.entity.v1.ArmyId
+	2, // 1: cityio.entity.v1.Army.owner:type_name -> cityio.entity.v1.UserId
+	3, // 2: cityio.entity.v1.Army.city_id:type_name -> cityio.entity.v1.CityId
+	4, // 3: cityio.entity.v1.Army.coords:type_name -> cityio.entity.v1.Coordinates
+	4, // 4: cityio.entity.v1.Army.destination:type_name -> cityio.entity.v1.Coordinates
+	5, // 5: cityio.entity.v1.Army.next_step_at:type_name -> google.protobuf.Timestamp
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_cityio_entity_v1_army_proto_init() }
+func file_cityio_entity_v1_army_proto_init() {
+	if File_cityio_entity_v1_army_proto != nil {
+		return
+	}
+	file_cityio_entity_v1_common_proto_init()
+	file_cityio_entity_v1_army_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cityio_entity_v1_army_proto_rawDesc), len(file_cityio_entity_v1_army_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cityio_entity_v1_army_proto_goTypes,
+		DependencyIndexes: file_cityio_entity_v1_army_proto_depIdxs,
+		MessageInfos:      file_cityio_entity_v1_army_proto_msgTypes,
+	}.Build()
+	File_cityio_entity_v1_army_proto = out.File
+	file_cityio_entity_v1_army_proto_goTypes = nil
+	file_cityio_entity_v1_army_proto_depIdxs = nil
+}
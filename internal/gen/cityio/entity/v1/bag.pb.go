@@ -29,8 +29,25 @@ type EntityBag struct {
 	Cities             []*City                `protobuf:"bytes,2,rep,name=cities,proto3" json:"cities,omitempty"`
 	Buildings          []*Building            `protobuf:"bytes,3,rep,name=buildings,proto3" json:"buildings,omitempty"`
 	DeletedBuildingIds []*BuildingId          `protobuf:"bytes,4,rep,name=deleted_building_ids,json=deletedBuildingIds,proto3" json:"deleted_building_ids,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	Armies             []*Army                `protobuf:"bytes,5,rep,name=armies,proto3" json:"armies,omitempty"`
+	DeletedArmyIds     []*ArmyId              `protobuf:"bytes,6,rep,name=deleted_army_ids,json=deletedArmyIds,proto3" json:"deleted_army_ids,omitempty"`
+	BattleReports      []*BattleReport        `protobuf:"bytes,7,rep,name=battle_reports,json=battleReports,proto3" json:"battle_reports,omitempty"`
+	// deleted_city_ids lists cities the receiver no longer owns (StreamState).
+	DeletedCityIds    []*CityId    `protobuf:"bytes,8,rep,name=deleted_city_ids,json=deletedCityIds,proto3" json:"deleted_city_ids,omitempty"`
+	Caravans          []*Caravan   `protobuf:"bytes,9,rep,name=caravans,proto3" json:"caravans,omitempty"`
+	DeletedCaravanIds []*CaravanId `protobuf:"bytes,10,rep,name=deleted_caravan_ids,json=deletedCaravanIds,proto3" json:"deleted_caravan_ids,omitempty"`
+	// alliances carries the receiver's alliance whenever its roster changes
+	// (StreamState).
+	Alliances []*Alliance `protobuf:"bytes,11,rep,name=alliances,proto3" json:"alliances,omitempty"`
+	// left_alliance_ids lists alliances the receiver left, was kicked from or
+	// saw disband (StreamState).
+	LeftAllianceIds []*AllianceId     `protobuf:"bytes,12,rep,name=left_alliance_ids,json=leftAllianceIds,proto3" json:"left_alliance_ids,omitempty"`
+	AllianceInvites []*AllianceInvite `protobuf:"bytes,13,rep,name=alliance_invites,json=allianceInvites,proto3" json:"alliance_invites,omitempty"`
+	// relations carries a relation of the receiver's whenever it changes
+	// (StreamState).
+	Relations     []*Relation `protobuf:"bytes,14,rep,name=relations,proto3" json:"relations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *EntityBag) Reset() {
@@ -91,16 +108,97 @@ func (x *EntityBag) GetDeletedBuildingIds() []*BuildingId {
 	return nil
 }
 
+func (x *EntityBag) GetArmies() []*Army {
+	if x != nil {
+		return x.Armies
+	}
+	return nil
+}
+
+func (x *EntityBag) GetDeletedArmyIds() []*ArmyId {
+	if x != nil {
+		return x.DeletedArmyIds
+	}
+	return nil
+}
+
+func (x *EntityBag) GetBattleReports() []*BattleReport {
+	if x != nil {
+		return x.BattleReports
+	}
+	return nil
+}
+
+func (x *EntityBag) GetDeletedCityIds() []*CityId {
+	if x != nil {
+		return x.DeletedCityIds
+	}
+	return nil
+}
+
+func (x *EntityBag) GetCaravans() []*Caravan {
+	if x != nil {
+		return x.Caravans
+	}
+	return nil
+}
+
+func (x *EntityBag) GetDeletedCaravanIds() []*CaravanId {
+	if x != nil {
+		return x.DeletedCaravanIds
+	}
+	return nil
+}
+
+func (x *EntityBag) GetAlliances() []*Alliance {
+	if x != nil {
+		return x.Alliances
+	}
+	return nil
+}
+
+func (x *EntityBag) GetLeftAllianceIds() []*AllianceId {
+	if x != nil {
+		return x.LeftAllianceIds
+	}
+	return nil
+}
+
+func (x *EntityBag) GetAllianceInvites() []*AllianceInvite {
+	if x != nil {
+		return x.AllianceInvites
+	}
+	return nil
+}
+
+func (x *EntityBag) GetRelations() []*Relation {
+	if x != nil {
+		return x.Relations
+	}
+	return nil
+}
+
 var File_cityio_entity_v1_bag_proto protoreflect.FileDescriptor
 
 const file_cityio_entity_v1_bag_proto_rawDesc = "" +
 	"\n" +
-	"\x1acityio/entity/v1/bag.proto\x12\x10cityio.entity.v1\x1a\x1dcityio/entity/v1/common.proto\x1a\x1bcityio/entity/v1/user.proto\x1a\x1bcityio/entity/v1/city.proto\x1a\x1fcityio/entity/v1/building.proto\"\xf3\x01\n" +
+	"\x1acityio/entity/v1/bag.proto\x12\x10cityio.entity.v1\x1a\x1dcityio/entity/v1/common.proto\x1a\x1bcityio/entity/v1/user.proto\x1a\x1bcityio/entity/v1/city.proto\x1a\x1fcityio/entity/v1/building.proto\x1a\x1bcityio/entity/v1/army.proto\x1a\x1ecityio/entity/v1/caravan.proto\x1a\x1dcityio/entity/v1/battle.proto\x1a\x1fcityio/entity/v1/alliance.proto\x1a\x1fcityio/entity/v1/relation.proto\"\x81\a\n" +
 	"\tEntityBag\x12,\n" +
 	"\x05users\x18\x01 \x03(\v2\x16.cityio.entity.v1.UserR\x05users\x12.\n" +
 	"\x06cities\x18\x02 \x03(\v2\x16.cityio.entity.v1.CityR\x06cities\x128\n" +
 	"\tbuildings\x18\x03 \x03(\v2\x1a.cityio.entity.v1.BuildingR\tbuildings\x12N\n" +
-	"\x14deleted_building_ids\x18\x04 \x03(\v2\x1c.cityio.entity.v1.BuildingIdR\x12deletedBuildingIdsB\xb1\x01\n" +
+	"\x14deleted_building_ids\x18\x04 \x03(\v2\x1c.cityio.entity.v1.BuildingIdR\x12deletedBuildingIds\x12.\n" +
+	"\x06armies\x18\x05 \x03(\v2\x16.cityio.entity.v1.ArmyR\x06armies\x12B\n" +
+	"\x10deleted_army_ids\x18\x06 \x03(\v2\x18.cityio.entity.v1.ArmyIdR\x0edeletedArmyIds\x12E\n" +
+	"\x0ebattle_reports\x18\a \x03(\v2\x1e.cityio.entity.v1.BattleReportR\rbattleReports\x12B\n" +
+	"\x10deleted_city_ids\x18\b \x03(\v2\x18.cityio.entity.v1.CityIdR\x0edeletedCityIds\x125\n" +
+	"\bcaravans\x18\t \x03(\v2\x19.cityio.entity.v1.CaravanR\bcaravans\x12K\n" +
+	"\x13deleted_caravan_ids\x18\n" +
+	" \x03(\v2\x1b.cityio.entity.v1.CaravanIdR\x11deletedCaravanIds\x128\n" +
+	"\talliances\x18\v \x03(\v2\x1a.cityio.entity.v1.AllianceR\talliances\x12H\n" +
+	"\x11left_alliance_ids\x18\f \x03(\v2\x1c.cityio.entity.v1.AllianceIdR\x0fleftAllianceIds\x12K\n" +
+	"\x10alliance_invites\x18\r \x03(\v2 .cityio.entity.v1.AllianceInviteR\x0fallianceInvites\x128\n" +
+	"\trelations\x18\x0e \x03(\v2\x1a.cityio.entity.v1.RelationR\trelationsB\xb1\x01\n" +
 	"\x14com.cityio.entity.v1B\bBagProtoP\x01Z-cityio/internal/gen/cityio/entity/v1;entityv1\xa2\x02\x03CEX\xaa\x02\x10Cityio.Entity.V1\xca\x02\x10Cityio\\Entity\\V1\xe2\x02\x1cCityio\\Entity\\V1\\GPBMetadata\xea\x02\x12Cityio::Entity::V1b\x06proto3"
 
 var (
@@ -117,22 +215,42 @@ func file_cityio_entity_v1_bag_proto_rawDescGZIP() []byte {
 
 var file_cityio_entity_v1_bag_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_cityio_entity_v1_bag_proto_goTypes = []any{
-	(*EntityBag)(nil),  // 0: cityio.entity.v1.EntityBag
-	(*User)(nil),       // 1: cityio.entity.v1.User
-	(*City)(nil),       // 2: cityio.entity.v1.City
-	(*Building)(nil),   // 3: cityio.entity.v1.Building
-	(*BuildingId)(nil), // 4: cityio.entity.v1.BuildingId
+	(*EntityBag)(nil),      // 0: cityio.entity.v1.EntityBag
+	(*User)(nil),           // 1: cityio.entity.v1.User
+	(*City)(nil),           // 2: cityio.entity.v1.City
+	(*Building)(nil),       // 3: cityio.entity.v1.Building
+	(*BuildingId)(nil),     // 4: cityio.entity.v1.BuildingId
+	(*Army)(nil),           // 5: cityio.entity.v1.Army
+	(*ArmyId)(nil),         // 6: cityio.entity.v1.ArmyId
+	(*BattleReport)(nil),   // 7: cityio.entity.v1.BattleReport
+	(*CityId)(nil),         // 8: cityio.entity.v1.CityId
+	(*Caravan)(nil),        // 9: cityio.entity.v1.Caravan
+	(*CaravanId)(nil),      // 10: cityio.entity.v1.CaravanId
+	(*Alliance)(nil),       // 11: cityio.entity.v1.Alliance
+	(*AllianceId)(nil),     // 12: cityio.entity.v1.AllianceId
+	(*AllianceInvite)(nil), // 13: cityio.entity.v1.AllianceInvite
+	(*Relation)(nil),       // 14: cityio.entity.v1.Relation
 }
 var file_cityio_entity_v1_bag_proto_depIdxs = []int32{
-	1, // 0: cityio.entity.v1.EntityBag.users:type_name -> cityio.entity.v1.User
-	2, // 1: cityio.entity.v1.EntityBag.cities:type_name -> cityio.entity.v1.City
-	3, // 2: cityio.entity.v1.EntityBag.buildings:type_name -> cityio.entity.v1.Building
-	4, // 3: cityio.entity.v1.EntityBag.deleted_building_ids:type_name -> cityio.entity.v1.BuildingId
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	1,  // 0: cityio.entity.v1.EntityBag.users:type_name -> cityio.entity.v1.User
+	2,  // 1: cityio.entity.v1.EntityBag.cities:type_name -> cityio.entity.v1.City
+	3,  // 2: cityio.entity.v1.EntityBag.buildings:type_name -> cityio.entity.v1.Building
+	4,  // 3: cityio.entity.v1.EntityBag.deleted_building_ids:type_name -> cityio.entity.v1.BuildingId
+	5,  // 4: cityio.entity.v1.EntityBag.armies:type_name -> cityio.entity.v1.Army
+	6,  // 5: cityio.entity.v1.EntityBag.deleted_army_ids:type_name -> cityio.entity.v1.ArmyId
+	7,  // 6: cityio.entity.v1.EntityBag.battle_reports:type_name -> cityio.entity.v1.BattleReport
+	8,  // 7: cityio.entity.v1.EntityBag.deleted_city_ids:type_name -> cityio.entity.v1.CityId
+	9,  // 8: cityio.entity.v1.EntityBag.caravans:type_name -> cityio.entity.v1.Caravan
+	10, // 9: cityio.entity.v1.EntityBag.deleted_caravan_ids:type_name -> cityio.entity.v1.CaravanId
+	11, // 10: cityio.entity.v1.EntityBag.alliances:type_name -> cityio.entity.v1.Alliance
+	12, // 11: cityio.entity.v1.EntityBag.left_alliance_ids:type_name -> cityio.entity.v1.AllianceId
+	13, // 12: cityio.entity.v1.EntityBag.alliance_invites:type_name -> cityio.entity.v1.AllianceInvite
+	14, // 13: cityio.entity.v1.EntityBag.relations:type_name -> cityio.entity.v1.Relation
+	14, // [14:14] is the sub-list for method output_type
+	14, // [14:14] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_cityio_entity_v1_bag_proto_init() }
@@ -144,6 +262,11 @@ func file_cityio_entity_v1_bag_proto_init() {
 	file_cityio_entity_v1_user_proto_init()
 	file_cityio_entity_v1_city_proto_init()
 	file_cityio_entity_v1_building_proto_init()
+	file_cityio_entity_v1_army_proto_init()
+	file_cityio_entity_v1_caravan_proto_init()
+	file_cityio_entity_v1_battle_proto_init()
+	file_cityio_entity_v1_alliance_proto_init()
+	file_cityio_entity_v1_relation_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
@@ -0,0 +1,341 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: cityio/entity/v1/battle.proto
+
+package entityv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// BattleOutcome is who held the field after a battle.
+type BattleOutcome int32
+
+const (
+	BattleOutcome_BATTLE_OUTCOME_UNSPECIFIED      BattleOutcome = 0
+	BattleOutcome_BATTLE_OUTCOME_ATTACKER_VICTORY BattleOutcome = 1
+	BattleOutcome_BATTLE_OUTCOME_DEFENDER_VICTORY BattleOutcome = 2
+)
+
+// Enum value maps for BattleOutcome.
+var (
+	BattleOutcome_name = map[int32]string{
+		0: "BATTLE_OUTCOME_UNSPECIFIED",
+		1: "BATTLE_OUTCOME_ATTACKER_VICTORY",
+		2: "BATTLE_OUTCOME_DEFENDER_VICTORY",
+	}
+	BattleOutcome_value = map[string]int32{
+		"BATTLE_OUTCOME_UNSPECIFIED":      0,
+		"BATTLE_OUTCOME_ATTACKER_VICTORY": 1,
+		"BATTLE_OUTCOME_DEFENDER_VICTORY": 2,
+	}
+)
+
+func (x BattleOutcome) Enum() *BattleOutcome {
+	p := new(BattleOutcome)
+	*p = x
+	return p
+}
+
+func (x BattleOutcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BattleOutcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_cityio_entity_v1_battle_proto_enumTypes[0].Descriptor()
+}
+
+func (BattleOutcome) Type() protoreflect.EnumType {
+	return &file_cityio_entity_v1_battle_proto_enumTypes[0]
+}
+
+func (x BattleOutcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BattleOutcome.Descriptor instead.
+func (BattleOutcome) EnumDescriptor() ([]byte, []int) {
+	return file_cityio_entity_v1_battle_proto_rawDescGZIP(), []int{0}
+}
+
+// BattleReport records a battle fought when an army reached a hostile city.
+// Visible to the attacker and, for owned cities, the defender.
+type BattleReport struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	ReportId *BattleReportId        `protobuf:"bytes,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	ArmyId   *ArmyId                `protobuf:"bytes,2,opt,name=army_id,json=armyId,proto3" json:"army_id,omitempty"`
+	Attacker *UserId                `protobuf:"bytes,3,opt,name=attacker,proto3" json:"attacker,omitempty"`
+	// defender is unset when the city was a neutral town.
+	Defender       *UserId       `protobuf:"bytes,4,opt,name=defender,proto3,oneof" json:"defender,omitempty"`
+	CityId         *CityId       `protobuf:"bytes,5,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
+	Coords         *Coordinates  `protobuf:"bytes,6,opt,name=coords,proto3" json:"coords,omitempty"`
+	AttackerTroops int64         `protobuf:"varint,7,opt,name=attacker_troops,json=attackerTroops,proto3" json:"attacker_troops,omitempty"`
+	DefenderTroops int64         `protobuf:"varint,8,opt,name=defender_troops,json=defenderTroops,proto3" json:"defender_troops,omitempty"`
+	AttackerLosses int64         `protobuf:"varint,9,opt,name=attacker_losses,json=attackerLosses,proto3" json:"attacker_losses,omitempty"`
+	DefenderLosses int64         `protobuf:"varint,10,opt,name=defender_losses,json=defenderLosses,proto3" json:"defender_losses,omitempty"`
+	Outcome        BattleOutcome `protobuf:"varint,11,opt,name=outcome,proto3,enum=cityio.entity.v1.BattleOutcome" json:"outcome,omitempty"`
+	Rounds         int32         `protobuf:"varint,12,opt,name=rounds,proto3" json:"rounds,omitempty"`
+	// seed, city_center_level and terrain_defense are the combat engine inputs
+	// beyond troop counts; together they replay the battle exactly.
+	Seed            int64                  `protobuf:"varint,13,opt,name=seed,proto3" json:"seed,omitempty"`
+	CityCenterLevel int32                  `protobuf:"varint,14,opt,name=city_center_level,json=cityCenterLevel,proto3" json:"city_center_level,omitempty"`
+	FoughtAt        *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=fought_at,json=foughtAt,proto3" json:"fought_at,omitempty"`
+	// terrain_defense multiplies the defender's strength for the ground the
+	// battle was fought on; 1 is open ground.
+	TerrainDefense float64 `protobuf:"fixed64,16,opt,name=terrain_defense,json=terrainDefense,proto3" json:"terrain_defense,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BattleReport) Reset() {
+	*x = BattleReport{}
+	mi := &file_cityio_entity_v1_battle_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BattleReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BattleReport) ProtoMessage() {}
+
+func (x *BattleReport) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_entity_v1_battle_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BattleReport.ProtoReflect.Descriptor instead.
+func (*BattleReport) Descriptor() ([]byte, []int) {
+	return file_cityio_entity_v1_battle_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BattleReport) GetReportId() *BattleReportId {
+	if x != nil {
+		return x.ReportId
+	}
+	return nil
+}
+
+func (x *BattleReport) GetArmyId() *ArmyId {
+	if x != nil {
+		return x.ArmyId
+	}
+	return nil
+}
+
+func (x *BattleReport) GetAttacker() *UserId {
+	if x != nil {
+		return x.Attacker
+	}
+	return nil
+}
+
+func (x *BattleReport) GetDefender() *UserId {
+	if x != nil {
+		return x.Defender
+	}
+	return nil
+}
+
+func (x *BattleReport) GetCityId() *CityId {
+	if x != nil {
+		return x.CityId
+	}
+	return nil
+}
+
+func (x *BattleReport) GetCoords() *Coordinates {
+	if x != nil {
+		return x.Coords
+	}
+	return nil
+}
+
+func (x *BattleReport) GetAttackerTroops() int64 {
+	if x != nil {
+		return x.AttackerTroops
+	}
+	return 0
+}
+
+func (x *BattleReport) GetDefenderTroops() int64 {
+	if x != nil {
+		return x.DefenderTroops
+	}
+	return 0
+}
+
+func (x *BattleReport) GetAttackerLosses() int64 {
+	if x != nil {
+		return x.AttackerLosses
+	}
+	return 0
+}
+
+func (x *BattleReport) GetDefenderLosses() int64 {
+	if x != nil {
+		return x.DefenderLosses
+	}
+	return 0
+}
+
+func (x *BattleReport) GetOutcome() BattleOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return BattleOutcome_BATTLE_OUTCOME_UNSPECIFIED
+}
+
+func (x *BattleReport) GetRounds() int32 {
+	if x != nil {
+		return x.Rounds
+	}
+	return 0
+}
+
+func (x *BattleReport) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *BattleReport) GetCityCenterLevel() int32 {
+	if x != nil {
+		return x.CityCenterLevel
+	}
+	return 0
+}
+
+func (x *BattleReport) GetFoughtAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FoughtAt
+	}
+	return nil
+}
+
+func (x *BattleReport) GetTerrainDefense() float64 {
+	if x != nil {
+		return x.TerrainDefense
+	}
+	return 0
+}
+
+var File_cityio_entity_v1_battle_proto protoreflect.FileDescriptor
+
+const file_cityio_entity_v1_battle_proto_rawDesc = "" +
+	"\n" +
+	"\x1dcityio/entity/v1/battle.proto\x12\x10cityio.entity.v1\x1a\x1dcityio/entity/v1/common.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x81\x06\n" +
+	"\fBattleReport\x12=\n" +
+	"\treport_id\x18\x01 \x01(\v2 .cityio.entity.v1.BattleReportIdR\breportId\x121\n" +
+	"\aarmy_id\x18\x02 \x01(\v2\x18.cityio.entity.v1.ArmyIdR\x06armyId\x124\n" +
+	"\battacker\x18\x03 \x01(\v2\x18.cityio.entity.v1.UserIdR\battacker\x129\n" +
+	"\bdefender\x18\x04 \x01(\v2\x18.cityio.entity.v1.UserIdH\x00R\bdefender\x88\x01\x01\x121\n" +
+	"\acity_id\x18\x05 \x01(\v2\x18.cityio.entity.v1.CityIdR\x06cityId\x125\n" +
+	"\x06coords\x18\x06 \x01(\v2\x1d.cityio.entity.v1.CoordinatesR\x06coords\x12'\n" +
+	"\x0fattacker_troops\x18\a \x01(\x03R\x0eattackerTroops\x12'\n" +
+	"\x0fdefender_troops\x18\b \x01(\x03R\x0edefenderTroops\x12'\n" +
+	"\x0fattacker_losses\x18\t \x01(\x03R\x0eattackerLosses\x12'\n" +
+	"\x0fdefender_losses\x18\n" +
+	" \x01(\x03R\x0edefenderLosses\x129\n" +
+	"\aoutcome\x18\v \x01(\x0e2\x1f.cityio.entity.v1.BattleOutcomeR\aoutcome\x12\x16\n" +
+	"\x06rounds\x18\f \x01(\x05R\x06rounds\x12\x12\n" +
+	"\x04seed\x18\r \x01(\x03R\x04seed\x12*\n" +
+	"\x11city_center_level\x18\x0e \x01(\x05R\x0fcityCenterLevel\x127\n" +
+	"\tfought_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\bfoughtAt\x12'\n" +
+	"\x0fterrain_defense\x18\x10 \x01(\x01R\x0eterrainDefenseB\v\n" +
+	"\t_defender*y\n" +
+	"\rBattleOutcome\x12\x1e\n" +
+	"\x1aBATTLE_OUTCOME_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fBATTLE_OUTCOME_ATTACKER_VICTORY\x10\x01\x12#\n" +
+	"\x1fBATTLE_OUTCOME_DEFENDER_VICTORY\x10\x02B\xb4\x01\n" +
+	"\x14com.cityio.entity.v1B\vBattleProtoP\x01Z-cityio/internal/gen/cityio/entity/v1;entityv1\xa2\x02\x03CEX\xaa\x02\x10Cityio.Entity.V1\xca\x02\x10Cityio\\Entity\\V1\xe2\x02\x1cCityio\\Entity\\V1\\GPBMetadata\xea\x02\x12Cityio::Entity::V1b\x06proto3"
+
+var (
+	file_cityio_entity_v1_battle_proto_rawDescOnce sync.Once
+	file_cityio_entity_v1_battle_proto_rawDescData []byte
+)
+
+func file_cityio_entity_v1_battle_proto_rawDescGZIP() []byte {
+	file_cityio_entity_v1_battle_proto_rawDescOnce.Do(func() {
+		file_cityio_entity_v1_battle_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cityio_entity_v1_battle_proto_rawDesc), len(file_cityio_entity_v1_battle_proto_rawDesc)))
+	})
+	return file_cityio_entity_v1_battle_proto_rawDescData
+}
+
+var file_cityio_entity_v1_battle_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_cityio_entity_v1_battle_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_cityio_entity_v1_battle_proto_goTypes = []any{
+	(BattleOutcome)(0),            // 0: cityio.entity.v1.BattleOutcome
+	(*BattleReport)(nil),          // 1: cityio.entity.v1.BattleReport
+	(*BattleReportId)(nil),        // 2: cityio.entity.v1.BattleReportId
+	(*ArmyId)(nil),                // 3: cityio.entity.v1.ArmyId
+	(*UserId)(nil),                // 4: cityio.entity.v1.UserId
+	(*CityId)(nil),                // 5: cityio.entity.v1.CityId
+	(*Coordinates)(nil),           // 6: cityio.entity.v1.Coordinates
+	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
+}
+var file_cityio_entity_v1_battle_proto_depIdxs = []int32{
+	2, // 0: cityio.entity.v1.BattleReport.report_id:type_name -> cityio.entity.v1.BattleReportId
+	3, // 1: cityio.entity.v1.BattleReport.army_id:type_name -> cityio.entity.v1.ArmyId
+	4, // 2: cityio.entity.v1.BattleReport.attacker:type_name -> cityio.entity.v1.UserId
+	4, // 3: cityio.entity.v1.BattleReport.defender:type_name -> cityio.entity.v1.UserId
+	5, // 4: cityio.entity.v1.BattleReport.city_id:type_name -> cityio.entity.v1.CityId
+	6, // 5: cityio.entity.v1.BattleReport.coords:type_name -> cityio.entity.v1.Coordinates
+	0, // 6: cityio.entity.v1.BattleReport.outcome:type_name -> cityio.entity.v1.BattleOutcome
+	7, // 7: cityio.entity.v1.BattleReport.fought_at:type_name -> google.protobuf.Timestamp
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_cityio_entity_v1_battle_proto_init() }
+func file_cityio_entity_v1_battle_proto_init() {
+	if File_cityio_entity_v1_battle_proto != nil {
+		return
+	}
+	file_cityio_entity_v1_common_proto_init()
+	file_cityio_entity_v1_battle_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cityio_entity_v1_battle_proto_rawDesc), len(file_cityio_entity_v1_battle_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cityio_entity_v1_battle_proto_goTypes,
+		DependencyIndexes: file_cityio_entity_v1_battle_proto_depIdxs,
+		EnumInfos:         file_cityio_entity_v1_battle_proto_enumTypes,
+		MessageInfos:      file_cityio_entity_v1_battle_proto_msgTypes,
+	}.Build()
+	File_cityio_entity_v1_battle_proto = out.File
+	file_cityio_entity_v1_battle_proto_goTypes = nil
+	file_cityio_entity_v1_battle_proto_depIdxs = nil
+}
@@ -23,6 +23,9 @@ const (
 )
 
 // Building is a structure within a city.
+//
+// Visibility: training_queue is military intel and only populated for the
+// building's owner (see mapping.HidePrivateBuildingFields).
 type Building struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	BuildingId        *BuildingId            `protobuf:"bytes,1,opt,name=building_id,json=buildingId,proto3" json:"building_id,omitempty"`
@@ -33,8 +36,17 @@ type Building struct {
 	Coords            *Coordinates           `protobuf:"bytes,6,opt,name=coords,proto3" json:"coords,omitempty"`
 	ConstructionStart *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=construction_start,json=constructionStart,proto3,oneof" json:"construction_start,omitempty"`
 	ConstructionEnd   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=construction_end,json=constructionEnd,proto3,oneof" json:"construction_end,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// training_queue lists a barracks' troop batches in FIFO order, training
+	// batches first. Empty for every other building type.
+	TrainingQueue []*TroopTraining `protobuf:"bytes,9,rep,name=training_queue,json=trainingQueue,proto3" json:"training_queue,omitempty"`
+	// adjacency_bonuses are the adjacency rules in effect on the building,
+	// derived from the buildings around it.
+	AdjacencyBonuses []*AdjacencyBonus `protobuf:"bytes,10,rep,name=adjacency_bonuses,json=adjacencyBonuses,proto3" json:"adjacency_bonuses,omitempty"`
+	// type_id is the building's type as named in the building definitions.
+	// type is UNSPECIFIED for types the enum doesn't name.
+	TypeId        string `protobuf:"bytes,11,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Building) Reset() {
@@ -123,11 +135,173 @@ func (x *Building) GetConstructionEnd() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Building) GetTrainingQueue() []*TroopTraining {
+	if x != nil {
+		return x.TrainingQueue
+	}
+	return nil
+}
+
+func (x *Building) GetAdjacencyBonuses() []*AdjacencyBonus {
+	if x != nil {
+		return x.AdjacencyBonuses
+	}
+	return nil
+}
+
+func (x *Building) GetTypeId() string {
+	if x != nil {
+		return x.TypeId
+	}
+	return ""
+}
+
+// AdjacencyBonus is an adjacency rule in effect on a building: neighbours
+// matching buildings next to it raise resource ("gold", "food" or
+// "population") by percent in total.
+type AdjacencyBonus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          string                 `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	Resource      string                 `protobuf:"bytes,2,opt,name=resource,proto3" json:"resource,omitempty"`
+	Percent       float64                `protobuf:"fixed64,3,opt,name=percent,proto3" json:"percent,omitempty"`
+	Neighbours    int32                  `protobuf:"varint,4,opt,name=neighbours,proto3" json:"neighbours,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdjacencyBonus) Reset() {
+	*x = AdjacencyBonus{}
+	mi := &file_cityio_entity_v1_building_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjacencyBonus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjacencyBonus) ProtoMessage() {}
+
+func (x *AdjacencyBonus) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_entity_v1_building_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjacencyBonus.ProtoReflect.Descriptor instead.
+func (*AdjacencyBonus) Descriptor() ([]byte, []int) {
+	return file_cityio_entity_v1_building_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AdjacencyBonus) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *AdjacencyBonus) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *AdjacencyBonus) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *AdjacencyBonus) GetNeighbours() int32 {
+	if x != nil {
+		return x.Neighbours
+	}
+	return 0
+}
+
+// TroopTraining is a batch of troops ordered at a barracks. training_start and
+// training_end are unset while the batch waits for a free training slot.
+type TroopTraining struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TrainingId    string                 `protobuf:"bytes,1,opt,name=training_id,json=trainingId,proto3" json:"training_id,omitempty"`
+	Troops        int64                  `protobuf:"varint,2,opt,name=troops,proto3" json:"troops,omitempty"`
+	TrainingStart *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=training_start,json=trainingStart,proto3,oneof" json:"training_start,omitempty"`
+	TrainingEnd   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=training_end,json=trainingEnd,proto3,oneof" json:"training_end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TroopTraining) Reset() {
+	*x = TroopTraining{}
+	mi := &file_cityio_entity_v1_building_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TroopTraining) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TroopTraining) ProtoMessage() {}
+
+func (x *TroopTraining) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_entity_v1_building_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TroopTraining.ProtoReflect.Descriptor instead.
+func (*TroopTraining) Descriptor() ([]byte, []int) {
+	return file_cityio_entity_v1_building_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TroopTraining) GetTrainingId() string {
+	if x != nil {
+		return x.TrainingId
+	}
+	return ""
+}
+
+func (x *TroopTraining) GetTroops() int64 {
+	if x != nil {
+		return x.Troops
+	}
+	return 0
+}
+
+func (x *TroopTraining) GetTrainingStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TrainingStart
+	}
+	return nil
+}
+
+func (x *TroopTraining) GetTrainingEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TrainingEnd
+	}
+	return nil
+}
+
 var File_cityio_entity_v1_building_proto protoreflect.FileDescriptor
 
 const file_cityio_entity_v1_building_proto_rawDesc = "" +
 	"\n" +
-	"\x1fcityio/entity/v1/building.proto\x12\x10cityio.entity.v1\x1a\x1dcityio/entity/v1/common.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe8\x03\n" +
+	"\x1fcityio/entity/v1/building.proto\x12\x10cityio.entity.v1\x1a\x1dcityio/entity/v1/common.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x98\x05\n" +
 	"\bBuilding\x12=\n" +
 	"\vbuilding_id\x18\x01 \x01(\v2\x1c.cityio.entity.v1.BuildingIdR\n" +
 	"buildingId\x121\n" +
@@ -137,9 +311,28 @@ const file_cityio_entity_v1_building_proto_rawDesc = "" +
 	"\ftarget_level\x18\x05 \x01(\x05R\vtargetLevel\x125\n" +
 	"\x06coords\x18\x06 \x01(\v2\x1d.cityio.entity.v1.CoordinatesR\x06coords\x12N\n" +
 	"\x12construction_start\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x11constructionStart\x88\x01\x01\x12J\n" +
-	"\x10construction_end\x18\b \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x0fconstructionEnd\x88\x01\x01B\x15\n" +
+	"\x10construction_end\x18\b \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x0fconstructionEnd\x88\x01\x01\x12F\n" +
+	"\x0etraining_queue\x18\t \x03(\v2\x1f.cityio.entity.v1.TroopTrainingR\rtrainingQueue\x12M\n" +
+	"\x11adjacency_bonuses\x18\n" +
+	" \x03(\v2 .cityio.entity.v1.AdjacencyBonusR\x10adjacencyBonuses\x12\x17\n" +
+	"\atype_id\x18\v \x01(\tR\x06typeIdB\x15\n" +
 	"\x13_construction_startB\x13\n" +
-	"\x11_construction_endB\xb6\x01\n" +
+	"\x11_construction_end\"z\n" +
+	"\x0eAdjacencyBonus\x12\x12\n" +
+	"\x04rule\x18\x01 \x01(\tR\x04rule\x12\x1a\n" +
+	"\bresource\x18\x02 \x01(\tR\bresource\x12\x18\n" +
+	"\apercent\x18\x03 \x01(\x01R\apercent\x12\x1e\n" +
+	"\n" +
+	"neighbours\x18\x04 \x01(\x05R\n" +
+	"neighbours\"\xf8\x01\n" +
+	"\rTroopTraining\x12\x1f\n" +
+	"\vtraining_id\x18\x01 \x01(\tR\n" +
+	"trainingId\x12\x16\n" +
+	"\x06troops\x18\x02 \x01(\x03R\x06troops\x12F\n" +
+	"\x0etraining_start\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\rtrainingStart\x88\x01\x01\x12B\n" +
+	"\ftraining_end\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\vtrainingEnd\x88\x01\x01B\x11\n" +
+	"\x0f_training_startB\x0f\n" +
+	"\r_training_endB\xb6\x01\n" +
 	"\x14com.cityio.entity.v1B\rBuildingProtoP\x01Z-cityio/internal/gen/cityio/entity/v1;entityv1\xa2\x02\x03CEX\xaa\x02\x10Cityio.Entity.V1\xca\x02\x10Cityio\\Entity\\V1\xe2\x02\x1cCityio\\Entity\\V1\\GPBMetadata\xea\x02\x12Cityio::Entity::V1b\x06proto3"
 
 var (
@@ -154,27 +347,33 @@ func file_cityio_entity_v1_building_proto_rawDescGZIP() []byte {
 	return file_cityio_entity_v1_building_proto_rawDescData
 }
 
-var file_cityio_entity_v1_building_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_cityio_entity_v1_building_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_cityio_entity_v1_building_proto_goTypes = []any{
 	(*Building)(nil),              // 0: cityio.entity.v1.Building
-	(*BuildingId)(nil),            // 1: cityio.entity.v1.BuildingId
-	(*CityId)(nil),                // 2: cityio.entity.v1.CityId
-	(BuildingType)(0),             // 3: cityio.entity.v1.BuildingType
-	(*Coordinates)(nil),           // 4: cityio.entity.v1.Coordinates
-	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
+	(*AdjacencyBonus)(nil),        // 1: cityio.entity.v1.AdjacencyBonus
+	(*TroopTraining)(nil),         // 2: cityio.entity.v1.TroopTraining
+	(*BuildingId)(nil),            // 3: cityio.entity.v1.BuildingId
+	(*CityId)(nil),                // 4: cityio.entity.v1.CityId
+	(BuildingType)(0),             // 5: cityio.entity.v1.BuildingType
+	(*Coordinates)(nil),           // 6: cityio.entity.v1.Coordinates
+	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
 }
 var file_cityio_entity_v1_building_proto_depIdxs = []int32{
-	1, // 0: cityio.entity.v1.Building.building_id:type_name -> cityio.entity.v1.BuildingId
-	2, // 1: cityio.entity.v1.Building.city_id:type_name -> cityio.entity.v1.CityId
-	3, // 2: cityio.entity.v1.Building.type:type_name -> cityio.entity.v1.BuildingType
-	4, // 3: cityio.entity.v1.Building.coords:type_name -> cityio.entity.v1.Coordinates
-	5, // 4: cityio.entity.v1.Building.construction_start:type_name -> google.protobuf.Timestamp
-	5, // 5: cityio.entity.v1.Building.construction_end:type_name -> google.protobuf.Timestamp
-	6, // [6:6] is the sub-list for method output_type
-	6, // [6:6] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	3,  // 0: cityio.entity.v1.Building.building_id:type_name -> cityio.entity.v1.BuildingId
+	4,  // 1: cityio.entity.v1.Building.city_id:type_name -> cityio.entity.v1.CityId
+	5,  // 2: cityio.entity.v1.Building.type:type_name -> cityio.entity.v1.BuildingType
+	6,  // 3: cityio.entity.v1.Building.coords:type_name -> cityio.entity.v1.Coordinates
+	7,  // 4: cityio.entity.v1.Building.construction_start:type_name -> google.protobuf.Timestamp
+	7,  // 5: cityio.entity.v1.Building.construction_end:type_name -> google.protobuf.Timestamp
+	2,  // 6: cityio.entity.v1.Building.training_queue:type_name -> cityio.entity.v1.TroopTraining
+	1,  // 7: cityio.entity.v1.Building.adjacency_bonuses:type_name -> cityio.entity.v1.AdjacencyBonus
+	7,  // 8: cityio.entity.v1.TroopTraining.training_start:type_name -> google.protobuf.Timestamp
+	7,  // 9: cityio.entity.v1.TroopTraining.training_end:type_name -> google.protobuf.Timestamp
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_cityio_entity_v1_building_proto_init() }
@@ -184,13 +383,14 @@ func file_cityio_entity_v1_building_proto_init() {
 	}
 	file_cityio_entity_v1_common_proto_init()
 	file_cityio_entity_v1_building_proto_msgTypes[0].OneofWrappers = []any{}
+	file_cityio_entity_v1_building_proto_msgTypes[2].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cityio_entity_v1_building_proto_rawDesc), len(file_cityio_entity_v1_building_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,217 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: cityio/entity/v1/caravan.proto
+
+package entityv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Caravan carries gold and food from one city to another, one tile per step.
+// Hostile armies that catch it on the way take its cargo.
+type Caravan struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	CaravanId         *CaravanId             `protobuf:"bytes,1,opt,name=caravan_id,json=caravanId,proto3" json:"caravan_id,omitempty"`
+	Owner             *UserId                `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	SourceCityId      *CityId                `protobuf:"bytes,3,opt,name=source_city_id,json=sourceCityId,proto3" json:"source_city_id,omitempty"`
+	DestinationCityId *CityId                `protobuf:"bytes,4,opt,name=destination_city_id,json=destinationCityId,proto3" json:"destination_city_id,omitempty"`
+	Gold              int64                  `protobuf:"varint,5,opt,name=gold,proto3" json:"gold,omitempty"`
+	Food              int64                  `protobuf:"varint,6,opt,name=food,proto3" json:"food,omitempty"`
+	Coords            *Coordinates           `protobuf:"bytes,7,opt,name=coords,proto3" json:"coords,omitempty"`
+	// destination is the center of the destination city.
+	Destination *Coordinates `protobuf:"bytes,8,opt,name=destination,proto3" json:"destination,omitempty"`
+	// next_step_at is when the caravan enters its next tile.
+	NextStepAt    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=next_step_at,json=nextStepAt,proto3,oneof" json:"next_step_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Caravan) Reset() {
+	*x = Caravan{}
+	mi := &file_cityio_entity_v1_caravan_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Caravan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Caravan) ProtoMessage() {}
+
+func (x *Caravan) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_entity_v1_caravan_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Caravan.ProtoReflect.Descriptor instead.
+func (*Caravan) Descriptor() ([]byte, []int) {
+	return file_cityio_entity_v1_caravan_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Caravan) GetCaravanId() *CaravanId {
+	if x != nil {
+		return x.CaravanId
+	}
+	return nil
+}
+
+func (x *Caravan) GetOwner() *UserId {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+func (x *Caravan) GetSourceCityId() *CityId {
+	if x != nil {
+		return x.SourceCityId
+	}
+	return nil
+}
+
+func (x *Caravan) GetDestinationCityId() *CityId {
+	if x != nil {
+		return x.DestinationCityId
+	}
+	return nil
+}
+
+func (x *Caravan) GetGold() int64 {
+	if x != nil {
+		return x.Gold
+	}
+	return 0
+}
+
+func (x *Caravan) GetFood() int64 {
+	if x != nil {
+		return x.Food
+	}
+	return 0
+}
+
+func (x *Caravan) GetCoords() *Coordinates {
+	if x != nil {
+		return x.Coords
+	}
+	return nil
+}
+
+func (x *Caravan) GetDestination() *Coordinates {
+	if x != nil {
+		return x.Destination
+	}
+	return nil
+}
+
+func (x *Caravan) GetNextStepAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextStepAt
+	}
+	return nil
+}
+
+var File_cityio_entity_v1_caravan_proto protoreflect.FileDescriptor
+
+const file_cityio_entity_v1_caravan_proto_rawDesc = "" +
+	"\n" +
+	"\x1ecityio/entity/v1/caravan.proto\x12\x10cityio.entity.v1\x1a\x1dcityio/entity/v1/common.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf3\x03\n" +
+	"\aCaravan\x12:\n" +
+	"\n" +
+	"caravan_id\x18\x01 \x01(\v2\x1b.cityio.entity.v1.CaravanIdR\tcaravanId\x12.\n" +
+	"\x05owner\x18\x02 \x01(\v2\x18.cityio.entity.v1.UserIdR\x05owner\x12>\n" +
+	"\x0esource_city_id\x18\x03 \x01(\v2\x18.cityio.entity.v1.CityIdR\fsourceCityId\x12H\n" +
+	"\x13destination_city_id\x18\x04 \x01(\v2\x18.cityio.entity.v1.CityIdR\x11destinationCityId\x12\x12\n" +
+	"\x04gold\x18\x05 \x01(\x03R\x04gold\x12\x12\n" +
+	"\x04food\x18\x06 \x01(\x03R\x04food\x125\n" +
+	"\x06coords\x18\a \x01(\v2\x1d.cityio.entity.v1.CoordinatesR\x06coords\x12?\n" +
+	"\vdestination\x18\b \x01(\v2\x1d.cityio.entity.v1.CoordinatesR\vdestination\x12A\n" +
+	"\fnext_step_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampH\x00R\n" +
+	"nextStepAt\x88\x01\x01B\x0f\n" +
+	"\r_next_step_atB\xb5\x01\n" +
+	"\x14com.cityio.entity.v1B\fCaravanProtoP\x01Z-cityio/internal/gen/cityio/entity/v1;entityv1\xa2\x02\x03CEX\xaa\x02\x10Cityio.Entity.V1\xca\x02\x10Cityio\\Entity\\V1\xe2\x02\x1cCityio\\Entity\\V1\\GPBMetadata\xea\x02\x12Cityio::Entity::V1b\x06proto3"
+
+var (
+	file_cityio_entity_v1_caravan_proto_rawDescOnce sync.Once
+	file_cityio_entity_v1_caravan_proto_rawDescData []byte
+)
+
+func file_cityio_entity_v1_caravan_proto_rawDescGZIP() []byte {
+	file_cityio_entity_v1_caravan_proto_rawDescOnce.Do(func() {
+		file_cityio_entity_v1_caravan_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cityio_entity_v1_caravan_proto_rawDesc), len(file_cityio_entity_v1_caravan_proto_rawDesc)))
+	})
+	return file_cityio_entity_v1_caravan_proto_rawDescData
+}
+
+var file_cityio_entity_v1_caravan_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_cityio_entity_v1_caravan_proto_goTypes = []any{
+	(*Caravan)(nil),               // 0: cityio.entity.v1.Caravan
+	(*CaravanId)(nil),             // 1: cityio.entity.v1.CaravanId
+	(*UserId)(nil),                // 2: cityio.entity.v1.UserId
+	(*CityId)(nil),                // 3: cityio.entity.v1.CityId
+	(*Coordinates)(nil),           // 4: cityio.entity.v1.Coordinates
+	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
+}
+var file_cityio_entity_v1_caravan_proto_depIdxs = []int32{
+	1, // 0: cityio.entity.v1.Caravan.caravan_id:type_name -> cityio.entity.v1.CaravanId
+	2, // 1: cityio.entity.v1.Caravan.owner:type_name -> cityio.entity.v1.UserId
+	3, // 2: cityio.entity.v1.Caravan.source_city_id:type_name -> cityio.entity.v1.CityId
+	3, // 3: cityio.entity.v1.Caravan.destination_city_id:type_name -> cityio.entity.v1.CityId
+	4, // 4: cityio.entity.v1.Caravan.coords:type_name -> cityio.entity.v1.Coordinates
+	4, // 5: cityio.entity.v1.Caravan.destination:type_name -> cityio.entity.v1.Coordinates
+	5, // 6: cityio.entity.v1.Caravan.next_step_at:type_name -> google.protobuf.Timestamp
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_cityio_entity_v1_caravan_proto_init() }
+func file_cityio_entity_v1_caravan_proto_init() {
+	if File_cityio_entity_v1_caravan_proto != nil {
+		return
+	}
+	file_cityio_entity_v1_common_proto_init()
+	file_cityio_entity_v1_caravan_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cityio_entity_v1_caravan_proto_rawDesc), len(file_cityio_entity_v1_caravan_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cityio_entity_v1_caravan_proto_goTypes,
+		DependencyIndexes: file_cityio_entity_v1_caravan_proto_depIdxs,
+		MessageInfos:      file_cityio_entity_v1_caravan_proto_msgTypes,
+	}.Build()
+	File_cityio_entity_v1_caravan_proto = out.File
+	file_cityio_entity_v1_caravan_proto_goTypes = nil
+	file_cityio_entity_v1_caravan_proto_depIdxs = nil
+}
@@ -0,0 +1,317 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: cityio/entity/v1/chat.proto
+
+package entityv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ChatChannelKind is the audience a chat channel reaches.
+type ChatChannelKind int32
+
+const (
+	ChatChannelKind_CHAT_CHANNEL_KIND_UNSPECIFIED ChatChannelKind = 0
+	// CHAT_CHANNEL_KIND_GLOBAL reaches every player.
+	ChatChannelKind_CHAT_CHANNEL_KIND_GLOBAL ChatChannelKind = 1
+	// CHAT_CHANNEL_KIND_ALLIANCE reaches the members of the caller's alliance.
+	ChatChannelKind_CHAT_CHANNEL_KIND_ALLIANCE ChatChannelKind = 2
+	// CHAT_CHANNEL_KIND_DIRECT is a conversation between two players.
+	ChatChannelKind_CHAT_CHANNEL_KIND_DIRECT ChatChannelKind = 3
+)
+
+// Enum value maps for ChatChannelKind.
+var (
+	ChatChannelKind_name = map[int32]string{
+		0: "CHAT_CHANNEL_KIND_UNSPECIFIED",
+		1: "CHAT_CHANNEL_KIND_GLOBAL",
+		2: "CHAT_CHANNEL_KIND_ALLIANCE",
+		3: "CHAT_CHANNEL_KIND_DIRECT",
+	}
+	ChatChannelKind_value = map[string]int32{
+		"CHAT_CHANNEL_KIND_UNSPECIFIED": 0,
+		"CHAT_CHANNEL_KIND_GLOBAL":      1,
+		"CHAT_CHANNEL_KIND_ALLIANCE":    2,
+		"CHAT_CHANNEL_KIND_DIRECT":      3,
+	}
+)
+
+func (x ChatChannelKind) Enum() *ChatChannelKind {
+	p := new(ChatChannelKind)
+	*p = x
+	return p
+}
+
+func (x ChatChannelKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChatChannelKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_cityio_entity_v1_chat_proto_enumTypes[0].Descriptor()
+}
+
+func (ChatChannelKind) Type() protoreflect.EnumType {
+	return &file_cityio_entity_v1_chat_proto_enumTypes[0]
+}
+
+func (x ChatChannelKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChatChannelKind.Descriptor instead.
+func (ChatChannelKind) EnumDescriptor() ([]byte, []int) {
+	return file_cityio_entity_v1_chat_proto_rawDescGZIP(), []int{0}
+}
+
+// ChatChannel names a chat channel as the receiver sees it.
+type ChatChannel struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Kind  ChatChannelKind        `protobuf:"varint,1,opt,name=kind,proto3,enum=cityio.entity.v1.ChatChannelKind" json:"kind,omitempty"`
+	// alliance_id is set for an alliance channel.
+	AllianceId *AllianceId `protobuf:"bytes,2,opt,name=alliance_id,json=allianceId,proto3" json:"alliance_id,omitempty"`
+	// user_id is the other player of a direct channel. Requests name the player
+	// to talk to; messages name whoever the receiver is talking to.
+	UserId        *UserId `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatChannel) Reset() {
+	*x = ChatChannel{}
+	mi := &file_cityio_entity_v1_chat_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatChannel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatChannel) ProtoMessage() {}
+
+func (x *ChatChannel) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_entity_v1_chat_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatChannel.ProtoReflect.Descriptor instead.
+func (*ChatChannel) Descriptor() ([]byte, []int) {
+	return file_cityio_entity_v1_chat_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ChatChannel) GetKind() ChatChannelKind {
+	if x != nil {
+		return x.Kind
+	}
+	return ChatChannelKind_CHAT_CHANNEL_KIND_UNSPECIFIED
+}
+
+func (x *ChatChannel) GetAllianceId() *AllianceId {
+	if x != nil {
+		return x.AllianceId
+	}
+	return nil
+}
+
+func (x *ChatChannel) GetUserId() *UserId {
+	if x != nil {
+		return x.UserId
+	}
+	return nil
+}
+
+type ChatMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     *ChatMessageId         `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Channel       *ChatChannel           `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	SenderId      *UserId                `protobuf:"bytes,3,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	SenderName    string                 `protobuf:"bytes,4,opt,name=sender_name,json=senderName,proto3" json:"sender_name,omitempty"`
+	Body          string                 `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	SentAt        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=sent_at,json=sentAt,proto3" json:"sent_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatMessage) Reset() {
+	*x = ChatMessage{}
+	mi := &file_cityio_entity_v1_chat_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatMessage) ProtoMessage() {}
+
+func (x *ChatMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_entity_v1_chat_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatMessage.ProtoReflect.Descriptor instead.
+func (*ChatMessage) Descriptor() ([]byte, []int) {
+	return file_cityio_entity_v1_chat_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ChatMessage) GetMessageId() *ChatMessageId {
+	if x != nil {
+		return x.MessageId
+	}
+	return nil
+}
+
+func (x *ChatMessage) GetChannel() *ChatChannel {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+func (x *ChatMessage) GetSenderId() *UserId {
+	if x != nil {
+		return x.SenderId
+	}
+	return nil
+}
+
+func (x *ChatMessage) GetSenderName() string {
+	if x != nil {
+		return x.SenderName
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetSentAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SentAt
+	}
+	return nil
+}
+
+var File_cityio_entity_v1_chat_proto protoreflect.FileDescriptor
+
+const file_cityio_entity_v1_chat_proto_rawDesc = "" +
+	"\n" +
+	"\x1bcityio/entity/v1/chat.proto\x12\x10cityio.entity.v1\x1a\x1dcityio/entity/v1/common.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb6\x01\n" +
+	"\vChatChannel\x125\n" +
+	"\x04kind\x18\x01 \x01(\x0e2!.cityio.entity.v1.ChatChannelKindR\x04kind\x12=\n" +
+	"\valliance_id\x18\x02 \x01(\v2\x1c.cityio.entity.v1.AllianceIdR\n" +
+	"allianceId\x121\n" +
+	"\auser_id\x18\x03 \x01(\v2\x18.cityio.entity.v1.UserIdR\x06userId\"\xa7\x02\n" +
+	"\vChatMessage\x12>\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\v2\x1f.cityio.entity.v1.ChatMessageIdR\tmessageId\x127\n" +
+	"\achannel\x18\x02 \x01(\v2\x1d.cityio.entity.v1.ChatChannelR\achannel\x125\n" +
+	"\tsender_id\x18\x03 \x01(\v2\x18.cityio.entity.v1.UserIdR\bsenderId\x12\x1f\n" +
+	"\vsender_name\x18\x04 \x01(\tR\n" +
+	"senderName\x12\x12\n" +
+	"\x04body\x18\x05 \x01(\tR\x04body\x123\n" +
+	"\asent_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x06sentAt*\x90\x01\n" +
+	"\x0fChatChannelKind\x12!\n" +
+	"\x1dCHAT_CHANNEL_KIND_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18CHAT_CHANNEL_KIND_GLOBAL\x10\x01\x12\x1e\n" +
+	"\x1aCHAT_CHANNEL_KIND_ALLIANCE\x10\x02\x12\x1c\n" +
+	"\x18CHAT_CHANNEL_KIND_DIRECT\x10\x03B\xb2\x01\n" +
+	"\x14com.cityio.entity.v1B\tChatProtoP\x01Z-cityio/internal/gen/cityio/entity/v1;entityv1\xa2\x02\x03CEX\xaa\x02\x10Cityio.Entity.V1\xca\x02\x10Cityio\\Entity\\V1\xe2\x02\x1cCityio\\Entity\\V1\\GPBMetadata\xea\x02\x12Cityio::Entity::V1b\x06proto3"
+
+var (
+	file_cityio_entity_v1_chat_proto_rawDescOnce sync.Once
+	file_cityio_entity_v1_chat_proto_rawDescData []byte
+)
+
+func file_cityio_entity_v1_chat_proto_rawDescGZIP() []byte {
+	file_cityio_entity_v1_chat_proto_rawDescOnce.Do(func() {
+		file_cityio_entity_v1_chat_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cityio_entity_v1_chat_proto_rawDesc), len(file_cityio_entity_v1_chat_proto_rawDesc)))
+	})
+	return file_cityio_entity_v1_chat_proto_rawDescData
+}
+
+var file_cityio_entity_v1_chat_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_cityio_entity_v1_chat_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_cityio_entity_v1_chat_proto_goTypes = []any{
+	(ChatChannelKind)(0),          // 0: cityio.entity.v1.ChatChannelKind
+	(*ChatChannel)(nil),           // 1: cityio.entity.v1.ChatChannel
+	(*ChatMessage)(nil),           // 2: cityio.entity.v1.ChatMessage
+	(*AllianceId)(nil),            // 3: cityio.entity.v1.AllianceId
+	(*UserId)(nil),                // 4: cityio.entity.v1.UserId
+	(*ChatMessageId)(nil),         // 5: cityio.entity.v1.ChatMessageId
+	(*timestamppb.Timestamp)(nil), // 6: google.protobuf.Timestamp
+}
+var file_cityio_entity_v1_chat_proto_depIdxs = []int32{
+	0, // 0: cityio.entity.v1.ChatChannel.kind:type_name -> cityio.entity.v1.ChatChannelKind
+	3, // 1: cityio.entity.v1.ChatChannel.alliance_id:type_name -> cityio.entity.v1.AllianceId
+	4, // 2: cityio.entity.v1.ChatChannel.user_id:type_name -> cityio.entity.v1.UserId
+	5, // 3: cityio.entity.v1.ChatMessage.message_id:type_name -> cityio.entity.v1.ChatMessageId
+	1, // 4: cityio.entity.v1.ChatMessage.channel:type_name -> cityio.entity.v1.ChatChannel
+	4, // 5: cityio.entity.v1.ChatMessage.sender_id:type_name -> cityio.entity.v1.UserId
+	6, // 6: cityio.entity.v1.ChatMessage.sent_at:type_name -> google.protobuf.Timestamp
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_cityio_entity_v1_chat_proto_init() }
+func file_cityio_entity_v1_chat_proto_init() {
+	if File_cityio_entity_v1_chat_proto != nil {
+		return
+	}
+	file_cityio_entity_v1_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cityio_entity_v1_chat_proto_rawDesc), len(file_cityio_entity_v1_chat_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cityio_entity_v1_chat_proto_goTypes,
+		DependencyIndexes: file_cityio_entity_v1_chat_proto_depIdxs,
+		EnumInfos:         file_cityio_entity_v1_chat_proto_enumTypes,
+		MessageInfos:      file_cityio_entity_v1_chat_proto_msgTypes,
+	}.Build()
+	File_cityio_entity_v1_chat_proto = out.File
+	file_cityio_entity_v1_chat_proto_goTypes = nil
+	file_cityio_entity_v1_chat_proto_depIdxs = nil
+}
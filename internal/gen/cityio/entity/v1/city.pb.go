@@ -24,8 +24,10 @@ const (
 // City is a settlement on the map, owned by a player or neutral.
 //
 // Visibility: public fields are returned to anyone whose vision covers the
-// city (population, population_cap, starving, identity, location). Private
-// fields (food_production, food_upkeep, net_food_flow) are economy intel and
+// city (population, population_cap, starving, unrest, identity, location).
+// Private fields (food_production, food_upkeep, net_food_flow, tax_rate,
+// tax_income, morale, morale_factors, troops, import_priority,
+// construction_queue, construction_slots, food_store) are economy and military intel and
 // only populated when the requester is the city's owner; for non-owners they
 // arrive unset. The owner-only restriction is enforced in
 // mapping.HidePrivateCityFields, called from GetMap and GetCity.
@@ -48,6 +50,12 @@ type City struct {
 	// when growing, negative when declining). Public — observable from outside
 	// by anyone watching the city over time.
 	PopulationGrowth *Rate `protobuf:"bytes,13,opt,name=population_growth,json=populationGrowth,proto3" json:"population_growth,omitempty"`
+	// unrest is public like starving: riots are seen from outside. A city left
+	// in unrest for long enough revolts and goes neutral.
+	Unrest bool `protobuf:"varint,22,opt,name=unrest,proto3" json:"unrest,omitempty"`
+	// capital marks the city its owner was founded with. A captured capital is
+	// an ordinary city to its new owner.
+	Capital bool `protobuf:"varint,24,opt,name=capital,proto3" json:"capital,omitempty"`
 	// --- Owner-only ---
 	// food_production, food_upkeep, and net_food_flow expose this city's
 	// economy. They are populated when the requester owns the city and unset
@@ -55,8 +63,32 @@ type City struct {
 	FoodProduction *Rate `protobuf:"bytes,9,opt,name=food_production,json=foodProduction,proto3" json:"food_production,omitempty"`
 	FoodUpkeep     *Rate `protobuf:"bytes,10,opt,name=food_upkeep,json=foodUpkeep,proto3" json:"food_upkeep,omitempty"`
 	NetFoodFlow    *Rate `protobuf:"bytes,11,opt,name=net_food_flow,json=netFoodFlow,proto3" json:"net_food_flow,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// tax_rate is the percentage of its population's earning power the city
+	// collects as gold; tax_income is the gold it brings in.
+	TaxRate   int32 `protobuf:"varint,18,opt,name=tax_rate,json=taxRate,proto3" json:"tax_rate,omitempty"`
+	TaxIncome *Rate `protobuf:"bytes,19,opt,name=tax_income,json=taxIncome,proto3" json:"tax_income,omitempty"`
+	// morale is how content the population is, from 0 to 100; it scales
+	// production and growth. morale_factors break down the level it is
+	// drifting toward.
+	Morale        float64        `protobuf:"fixed64,20,opt,name=morale,proto3" json:"morale,omitempty"`
+	MoraleFactors *MoraleFactors `protobuf:"bytes,21,opt,name=morale_factors,json=moraleFactors,proto3" json:"morale_factors,omitempty"`
+	// troops is the garrison stationed in the city, available to dispatch as
+	// an army.
+	Troops int64 `protobuf:"varint,14,opt,name=troops,proto3" json:"troops,omitempty"`
+	// import_priority orders this city's claim on the owner's food pool under
+	// FOOD_ALLOCATION_POLICY_PRIORITY; higher is served first.
+	ImportPriority int32 `protobuf:"varint,15,opt,name=import_priority,json=importPriority,proto3" json:"import_priority,omitempty"`
+	// construction_queue lists the orders waiting for a construction slot, in
+	// the order they will start. construction_slots is how many constructions
+	// the city runs at once.
+	ConstructionQueue []*ConstructionOrder `protobuf:"bytes,16,rep,name=construction_queue,json=constructionQueue,proto3" json:"construction_queue,omitempty"`
+	ConstructionSlots int32                `protobuf:"varint,17,opt,name=construction_slots,json=constructionSlots,proto3" json:"construction_slots,omitempty"`
+	// food_store is food held in the city: its own surplus, up to the caravan
+	// store capacity, and food delivered by caravans. The city eats from it
+	// before it starves, and caravans leaving the city load from it.
+	FoodStore     int64 `protobuf:"varint,23,opt,name=food_store,json=foodStore,proto3" json:"food_store,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *City) Reset() {
@@ -159,6 +191,20 @@ func (x *City) GetPopulationGrowth() *Rate {
 	return nil
 }
 
+func (x *City) GetUnrest() bool {
+	if x != nil {
+		return x.Unrest
+	}
+	return false
+}
+
+func (x *City) GetCapital() bool {
+	if x != nil {
+		return x.Capital
+	}
+	return false
+}
+
 func (x *City) GetFoodProduction() *Rate {
 	if x != nil {
 		return x.FoodProduction
@@ -180,11 +226,240 @@ func (x *City) GetNetFoodFlow() *Rate {
 	return nil
 }
 
+func (x *City) GetTaxRate() int32 {
+	if x != nil {
+		return x.TaxRate
+	}
+	return 0
+}
+
+func (x *City) GetTaxIncome() *Rate {
+	if x != nil {
+		return x.TaxIncome
+	}
+	return nil
+}
+
+func (x *City) GetMorale() float64 {
+	if x != nil {
+		return x.Morale
+	}
+	return 0
+}
+
+func (x *City) GetMoraleFactors() *MoraleFactors {
+	if x != nil {
+		return x.MoraleFactors
+	}
+	return nil
+}
+
+func (x *City) GetTroops() int64 {
+	if x != nil {
+		return x.Troops
+	}
+	return 0
+}
+
+func (x *City) GetImportPriority() int32 {
+	if x != nil {
+		return x.ImportPriority
+	}
+	return 0
+}
+
+func (x *City) GetConstructionQueue() []*ConstructionOrder {
+	if x != nil {
+		return x.ConstructionQueue
+	}
+	return nil
+}
+
+func (x *City) GetConstructionSlots() int32 {
+	if x != nil {
+		return x.ConstructionSlots
+	}
+	return 0
+}
+
+func (x *City) GetFoodStore() int64 {
+	if x != nil {
+		return x.FoodStore
+	}
+	return 0
+}
+
+// MoraleFactors are what push a city's morale up or down, in morale points.
+// Their sum, clamped to [0, 100], is the level morale drifts toward.
+type MoraleFactors struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          float64                `protobuf:"fixed64,1,opt,name=base,proto3" json:"base,omitempty"`
+	Food          float64                `protobuf:"fixed64,2,opt,name=food,proto3" json:"food,omitempty"`
+	Tax           float64                `protobuf:"fixed64,3,opt,name=tax,proto3" json:"tax,omitempty"`
+	Crowding      float64                `protobuf:"fixed64,4,opt,name=crowding,proto3" json:"crowding,omitempty"`
+	Amenities     float64                `protobuf:"fixed64,5,opt,name=amenities,proto3" json:"amenities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoraleFactors) Reset() {
+	*x = MoraleFactors{}
+	mi := &file_cityio_entity_v1_city_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoraleFactors) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoraleFactors) ProtoMessage() {}
+
+func (x *MoraleFactors) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_entity_v1_city_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoraleFactors.ProtoReflect.Descriptor instead.
+func (*MoraleFactors) Descriptor() ([]byte, []int) {
+	return file_cityio_entity_v1_city_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MoraleFactors) GetBase() float64 {
+	if x != nil {
+		return x.Base
+	}
+	return 0
+}
+
+func (x *MoraleFactors) GetFood() float64 {
+	if x != nil {
+		return x.Food
+	}
+	return 0
+}
+
+func (x *MoraleFactors) GetTax() float64 {
+	if x != nil {
+		return x.Tax
+	}
+	return 0
+}
+
+func (x *MoraleFactors) GetCrowding() float64 {
+	if x != nil {
+		return x.Crowding
+	}
+	return 0
+}
+
+func (x *MoraleFactors) GetAmenities() float64 {
+	if x != nil {
+		return x.Amenities
+	}
+	return 0
+}
+
+// ConstructionOrder is a new building or an upgrade waiting in a city's build
+// queue. building_id is set for upgrades and unset for new buildings.
+type ConstructionOrder struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	OrderId    string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	BuildingId *BuildingId            `protobuf:"bytes,2,opt,name=building_id,json=buildingId,proto3,oneof" json:"building_id,omitempty"`
+	Type       BuildingType           `protobuf:"varint,3,opt,name=type,proto3,enum=cityio.entity.v1.BuildingType" json:"type,omitempty"`
+	Coords     *Coordinates           `protobuf:"bytes,4,opt,name=coords,proto3" json:"coords,omitempty"`
+	// paid is the gold charged when the order was queued; zero when it is
+	// charged as it starts.
+	Paid          int64  `protobuf:"varint,5,opt,name=paid,proto3" json:"paid,omitempty"`
+	TypeId        string `protobuf:"bytes,6,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConstructionOrder) Reset() {
+	*x = ConstructionOrder{}
+	mi := &file_cityio_entity_v1_city_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConstructionOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConstructionOrder) ProtoMessage() {}
+
+func (x *ConstructionOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_entity_v1_city_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConstructionOrder.ProtoReflect.Descriptor instead.
+func (*ConstructionOrder) Descriptor() ([]byte, []int) {
+	return file_cityio_entity_v1_city_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ConstructionOrder) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ConstructionOrder) GetBuildingId() *BuildingId {
+	if x != nil {
+		return x.BuildingId
+	}
+	return nil
+}
+
+func (x *ConstructionOrder) GetType() BuildingType {
+	if x != nil {
+		return x.Type
+	}
+	return BuildingType_BUILDING_TYPE_UNSPECIFIED
+}
+
+func (x *ConstructionOrder) GetCoords() *Coordinates {
+	if x != nil {
+		return x.Coords
+	}
+	return nil
+}
+
+func (x *ConstructionOrder) GetPaid() int64 {
+	if x != nil {
+		return x.Paid
+	}
+	return 0
+}
+
+func (x *ConstructionOrder) GetTypeId() string {
+	if x != nil {
+		return x.TypeId
+	}
+	return ""
+}
+
 var File_cityio_entity_v1_city_proto protoreflect.FileDescriptor
 
 const file_cityio_entity_v1_city_proto_rawDesc = "" +
 	"\n" +
-	"\x1bcityio/entity/v1/city.proto\x12\x10cityio.entity.v1\x1a\x1dcityio/entity/v1/common.proto\"\xe3\x04\n" +
+	"\x1bcityio/entity/v1/city.proto\x12\x10cityio.entity.v1\x1a\x1dcityio/entity/v1/common.proto\"\xaa\b\n" +
 	"\x04City\x121\n" +
 	"\acity_id\x18\x01 \x01(\v2\x18.cityio.entity.v1.CityIdR\x06cityId\x12.\n" +
 	"\x04type\x18\x02 \x01(\x0e2\x1a.cityio.entity.v1.CityTypeR\x04type\x123\n" +
@@ -197,13 +472,41 @@ const file_cityio_entity_v1_city_proto_rawDesc = "" +
 	"\x05start\x18\a \x01(\v2\x1d.cityio.entity.v1.CoordinatesR\x05start\x12\x12\n" +
 	"\x04size\x18\b \x01(\x05R\x04size\x12\x1a\n" +
 	"\bstarving\x18\f \x01(\bR\bstarving\x12C\n" +
-	"\x11population_growth\x18\r \x01(\v2\x16.cityio.entity.v1.RateR\x10populationGrowth\x12?\n" +
+	"\x11population_growth\x18\r \x01(\v2\x16.cityio.entity.v1.RateR\x10populationGrowth\x12\x16\n" +
+	"\x06unrest\x18\x16 \x01(\bR\x06unrest\x12\x18\n" +
+	"\acapital\x18\x18 \x01(\bR\acapital\x12?\n" +
 	"\x0ffood_production\x18\t \x01(\v2\x16.cityio.entity.v1.RateR\x0efoodProduction\x127\n" +
 	"\vfood_upkeep\x18\n" +
 	" \x01(\v2\x16.cityio.entity.v1.RateR\n" +
 	"foodUpkeep\x12:\n" +
-	"\rnet_food_flow\x18\v \x01(\v2\x16.cityio.entity.v1.RateR\vnetFoodFlowB\b\n" +
-	"\x06_ownerB\xb2\x01\n" +
+	"\rnet_food_flow\x18\v \x01(\v2\x16.cityio.entity.v1.RateR\vnetFoodFlow\x12\x19\n" +
+	"\btax_rate\x18\x12 \x01(\x05R\ataxRate\x125\n" +
+	"\n" +
+	"tax_income\x18\x13 \x01(\v2\x16.cityio.entity.v1.RateR\ttaxIncome\x12\x16\n" +
+	"\x06morale\x18\x14 \x01(\x01R\x06morale\x12F\n" +
+	"\x0emorale_factors\x18\x15 \x01(\v2\x1f.cityio.entity.v1.MoraleFactorsR\rmoraleFactors\x12\x16\n" +
+	"\x06troops\x18\x0e \x01(\x03R\x06troops\x12'\n" +
+	"\x0fimport_priority\x18\x0f \x01(\x05R\x0eimportPriority\x12R\n" +
+	"\x12construction_queue\x18\x10 \x03(\v2#.cityio.entity.v1.ConstructionOrderR\x11constructionQueue\x12-\n" +
+	"\x12construction_slots\x18\x11 \x01(\x05R\x11constructionSlots\x12\x1d\n" +
+	"\n" +
+	"food_store\x18\x17 \x01(\x03R\tfoodStoreB\b\n" +
+	"\x06_owner\"\x83\x01\n" +
+	"\rMoraleFactors\x12\x12\n" +
+	"\x04base\x18\x01 \x01(\x01R\x04base\x12\x12\n" +
+	"\x04food\x18\x02 \x01(\x01R\x04food\x12\x10\n" +
+	"\x03tax\x18\x03 \x01(\x01R\x03tax\x12\x1a\n" +
+	"\bcrowding\x18\x04 \x01(\x01R\bcrowding\x12\x1c\n" +
+	"\tamenities\x18\x05 \x01(\x01R\tamenities\"\x9a\x02\n" +
+	"\x11ConstructionOrder\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12B\n" +
+	"\vbuilding_id\x18\x02 \x01(\v2\x1c.cityio.entity.v1.BuildingIdH\x00R\n" +
+	"buildingId\x88\x01\x01\x122\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x1e.cityio.entity.v1.BuildingTypeR\x04type\x125\n" +
+	"\x06coords\x18\x04 \x01(\v2\x1d.cityio.entity.v1.CoordinatesR\x06coords\x12\x12\n" +
+	"\x04paid\x18\x05 \x01(\x03R\x04paid\x12\x17\n" +
+	"\atype_id\x18\x06 \x01(\tR\x06typeIdB\x0e\n" +
+	"\f_building_idB\xb2\x01\n" +
 	"\x14com.cityio.entity.v1B\tCityProtoP\x01Z-cityio/internal/gen/cityio/entity/v1;entityv1\xa2\x02\x03CEX\xaa\x02\x10Cityio.Entity.V1\xca\x02\x10Cityio\\Entity\\V1\xe2\x02\x1cCityio\\Entity\\V1\\GPBMetadata\xea\x02\x12Cityio::Entity::V1b\x06proto3"
 
 var (
@@ -218,29 +521,39 @@ func file_cityio_entity_v1_city_proto_rawDescGZIP() []byte {
 	return file_cityio_entity_v1_city_proto_rawDescData
 }
 
-var file_cityio_entity_v1_city_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_cityio_entity_v1_city_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_cityio_entity_v1_city_proto_goTypes = []any{
-	(*City)(nil),        // 0: cityio.entity.v1.City
-	(*CityId)(nil),      // 1: cityio.entity.v1.CityId
-	(CityType)(0),       // 2: cityio.entity.v1.CityType
-	(*UserId)(nil),      // 3: cityio.entity.v1.UserId
-	(*Coordinates)(nil), // 4: cityio.entity.v1.Coordinates
-	(*Rate)(nil),        // 5: cityio.entity.v1.Rate
+	(*City)(nil),              // 0: cityio.entity.v1.City
+	(*MoraleFactors)(nil),     // 1: cityio.entity.v1.MoraleFactors
+	(*ConstructionOrder)(nil), // 2: cityio.entity.v1.ConstructionOrder
+	(*CityId)(nil),            // 3: cityio.entity.v1.CityId
+	(CityType)(0),             // 4: cityio.entity.v1.CityType
+	(*UserId)(nil),            // 5: cityio.entity.v1.UserId
+	(*Coordinates)(nil),       // 6: cityio.entity.v1.Coordinates
+	(*Rate)(nil),              // 7: cityio.entity.v1.Rate
+	(*BuildingId)(nil),        // 8: cityio.entity.v1.BuildingId
+	(BuildingType)(0),         // 9: cityio.entity.v1.BuildingType
 }
 var file_cityio_entity_v1_city_proto_depIdxs = []int32{
-	1, // 0: cityio.entity.v1.City.city_id:type_name -> cityio.entity.v1.CityId
-	2, // 1: cityio.entity.v1.City.type:type_name -> cityio.entity.v1.CityType
-	3, // 2: cityio.entity.v1.City.owner:type_name -> cityio.entity.v1.UserId
-	4, // 3: cityio.entity.v1.City.start:type_name -> cityio.entity.v1.Coordinates
-	5, // 4: cityio.entity.v1.City.population_growth:type_name -> cityio.entity.v1.Rate
-	5, // 5: cityio.entity.v1.City.food_production:type_name -> cityio.entity.v1.Rate
-	5, // 6: cityio.entity.v1.City.food_upkeep:type_name -> cityio.entity.v1.Rate
-	5, // 7: cityio.entity.v1.City.net_food_flow:type_name -> cityio.entity.v1.Rate
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	3,  // 0: cityio.entity.v1.City.city_id:type_name -> cityio.entity.v1.CityId
+	4,  // 1: cityio.entity.v1.City.type:type_name -> cityio.entity.v1.CityType
+	5,  // 2: cityio.entity.v1.City.owner:type_name -> cityio.entity.v1.UserId
+	6,  // 3: cityio.entity.v1.City.start:type_name -> cityio.entity.v1.Coordinates
+	7,  // 4: cityio.entity.v1.City.population_growth:type_name -> cityio.entity.v1.Rate
+	7,  // 5: cityio.entity.v1.City.food_production:type_name -> cityio.entity.v1.Rate
+	7,  // 6: cityio.entity.v1.City.food_upkeep:type_name -> cityio.entity.v1.Rate
+	7,  // 7: cityio.entity.v1.City.net_food_flow:type_name -> cityio.entity.v1.Rate
+	7,  // 8: cityio.entity.v1.City.tax_income:type_name -> cityio.entity.v1.Rate
+	1,  // 9: cityio.entity.v1.City.morale_factors:type_name -> cityio.entity.v1.MoraleFactors
+	2,  // 10: cityio.entity.v1.City.construction_queue:type_name -> cityio.entity.v1.ConstructionOrder
+	8,  // 11: cityio.entity.v1.ConstructionOrder.building_id:type_name -> cityio.entity.v1.BuildingId
+	9,  // 12: cityio.entity.v1.ConstructionOrder.type:type_name -> cityio.entity.v1.BuildingType
+	6,  // 13: cityio.entity.v1.ConstructionOrder.coords:type_name -> cityio.entity.v1.Coordinates
+	14, // [14:14] is the sub-list for method output_type
+	14, // [14:14] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_cityio_entity_v1_city_proto_init() }
@@ -250,13 +563,14 @@ func file_cityio_entity_v1_city_proto_init() {
 	}
 	file_cityio_entity_v1_common_proto_init()
 	file_cityio_entity_v1_city_proto_msgTypes[0].OneofWrappers = []any{}
+	file_cityio_entity_v1_city_proto_msgTypes[2].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cityio_entity_v1_city_proto_rawDesc), len(file_cityio_entity_v1_city_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
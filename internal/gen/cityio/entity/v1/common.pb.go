@@ -21,7 +21,7 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// CityType distinguishes player capitals from neutral towns.
+// CityType distinguishes player cities from neutral towns.
 type CityType int32
 
 const (
@@ -71,7 +71,9 @@ func (CityType) EnumDescriptor() ([]byte, []int) {
 	return file_cityio_entity_v1_common_proto_rawDescGZIP(), []int{0}
 }
 
-// BuildingType identifies a kind of building.
+// BuildingType identifies a kind of building. Building types are loaded from
+// a definition file; the enum names the original types and type_id fields
+// carry the rest.
 type BuildingType int32
 
 const (
@@ -133,6 +135,118 @@ func (BuildingType) EnumDescriptor() ([]byte, []int) {
 	return file_cityio_entity_v1_common_proto_rawDescGZIP(), []int{1}
 }
 
+// Terrain is the ground a map tile is made of, fixed when the world is
+// generated. Cities are never founded on water and farms can't be built on
+// mountains.
+type Terrain int32
+
+const (
+	Terrain_TERRAIN_UNSPECIFIED Terrain = 0
+	Terrain_TERRAIN_GRASSLAND   Terrain = 1
+	Terrain_TERRAIN_FOREST      Terrain = 2
+	Terrain_TERRAIN_HILLS       Terrain = 3
+	Terrain_TERRAIN_MOUNTAINS   Terrain = 4
+	Terrain_TERRAIN_WATER       Terrain = 5
+)
+
+// Enum value maps for Terrain.
+var (
+	Terrain_name = map[int32]string{
+		0: "TERRAIN_UNSPECIFIED",
+		1: "TERRAIN_GRASSLAND",
+		2: "TERRAIN_FOREST",
+		3: "TERRAIN_HILLS",
+		4: "TERRAIN_MOUNTAINS",
+		5: "TERRAIN_WATER",
+	}
+	Terrain_value = map[string]int32{
+		"TERRAIN_UNSPECIFIED": 0,
+		"TERRAIN_GRASSLAND":   1,
+		"TERRAIN_FOREST":      2,
+		"TERRAIN_HILLS":       3,
+		"TERRAIN_MOUNTAINS":   4,
+		"TERRAIN_WATER":       5,
+	}
+)
+
+func (x Terrain) Enum() *Terrain {
+	p := new(Terrain)
+	*p = x
+	return p
+}
+
+func (x Terrain) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Terrain) Descriptor() protoreflect.EnumDescriptor {
+	return file_cityio_entity_v1_common_proto_enumTypes[2].Descriptor()
+}
+
+func (Terrain) Type() protoreflect.EnumType {
+	return &file_cityio_entity_v1_common_proto_enumTypes[2]
+}
+
+func (x Terrain) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Terrain.Descriptor instead.
+func (Terrain) EnumDescriptor() ([]byte, []int) {
+	return file_cityio_entity_v1_common_proto_rawDescGZIP(), []int{2}
+}
+
+// DepositKind is a natural resource lying under a map tile. Mines must be
+// placed on gold veins and farms on fertile soil.
+type DepositKind int32
+
+const (
+	DepositKind_DEPOSIT_KIND_UNSPECIFIED  DepositKind = 0
+	DepositKind_DEPOSIT_KIND_GOLD_VEIN    DepositKind = 1
+	DepositKind_DEPOSIT_KIND_FERTILE_SOIL DepositKind = 2
+)
+
+// Enum value maps for DepositKind.
+var (
+	DepositKind_name = map[int32]string{
+		0: "DEPOSIT_KIND_UNSPECIFIED",
+		1: "DEPOSIT_KIND_GOLD_VEIN",
+		2: "DEPOSIT_KIND_FERTILE_SOIL",
+	}
+	DepositKind_value = map[string]int32{
+		"DEPOSIT_KIND_UNSPECIFIED":  0,
+		"DEPOSIT_KIND_GOLD_VEIN":    1,
+		"DEPOSIT_KIND_FERTILE_SOIL": 2,
+	}
+)
+
+func (x DepositKind) Enum() *DepositKind {
+	p := new(DepositKind)
+	*p = x
+	return p
+}
+
+func (x DepositKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DepositKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_cityio_entity_v1_common_proto_enumTypes[3].Descriptor()
+}
+
+func (DepositKind) Type() protoreflect.EnumType {
+	return &file_cityio_entity_v1_common_proto_enumTypes[3]
+}
+
+func (x DepositKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DepositKind.Descriptor instead.
+func (DepositKind) EnumDescriptor() ([]byte, []int) {
+	return file_cityio_entity_v1_common_proto_rawDescGZIP(), []int{3}
+}
+
 type UserId struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
@@ -265,6 +379,280 @@ func (x *BuildingId) GetValue() string {
 	return ""
 }
 
+type ArmyId struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArmyId) Reset() {
+	*x = ArmyId{}
+	mi := &file_cityio_entity_v1_common_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArmyId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArmyId) ProtoMessage() {}
+
+func (x *ArmyId) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_entity_v1_common_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArmyId.ProtoReflect.Descriptor instead.
+func (*ArmyId) Descriptor() ([]byte, []int) {
+	return file_cityio_entity_v1_common_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ArmyId) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type CaravanId struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CaravanId) Reset() {
+	*x = CaravanId{}
+	mi := &file_cityio_entity_v1_common_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CaravanId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaravanId) ProtoMessage() {}
+
+func (x *CaravanId) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_entity_v1_common_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaravanId.ProtoReflect.Descriptor instead.
+func (*CaravanId) Descriptor() ([]byte, []int) {
+	return file_cityio_entity_v1_common_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CaravanId) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type AllianceId struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllianceId) Reset() {
+	*x = AllianceId{}
+	mi := &file_cityio_entity_v1_common_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllianceId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllianceId) ProtoMessage() {}
+
+func (x *AllianceId) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_entity_v1_common_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllianceId.ProtoReflect.Descriptor instead.
+func (*AllianceId) Descriptor() ([]byte, []int) {
+	return file_cityio_entity_v1_common_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AllianceId) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type BattleReportId struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BattleReportId) Reset() {
+	*x = BattleReportId{}
+	mi := &file_cityio_entity_v1_common_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BattleReportId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BattleReportId) ProtoMessage() {}
+
+func (x *BattleReportId) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_entity_v1_common_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BattleReportId.ProtoReflect.Descriptor instead.
+func (*BattleReportId) Descriptor() ([]byte, []int) {
+	return file_cityio_entity_v1_common_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BattleReportId) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type ChatMessageId struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatMessageId) Reset() {
+	*x = ChatMessageId{}
+	mi := &file_cityio_entity_v1_common_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatMessageId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatMessageId) ProtoMessage() {}
+
+func (x *ChatMessageId) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_entity_v1_common_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatMessageId.ProtoReflect.Descriptor instead.
+func (*ChatMessageId) Descriptor() ([]byte, []int) {
+	return file_cityio_entity_v1_common_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ChatMessageId) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// Deposit is a resource under a map tile. Richness multiplies the production
+// of the building working it; 1 is an ordinary deposit.
+type Deposit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          DepositKind            `protobuf:"varint,1,opt,name=kind,proto3,enum=cityio.entity.v1.DepositKind" json:"kind,omitempty"`
+	Richness      float64                `protobuf:"fixed64,2,opt,name=richness,proto3" json:"richness,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Deposit) Reset() {
+	*x = Deposit{}
+	mi := &file_cityio_entity_v1_common_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Deposit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Deposit) ProtoMessage() {}
+
+func (x *Deposit) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_entity_v1_common_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Deposit.ProtoReflect.Descriptor instead.
+func (*Deposit) Descriptor() ([]byte, []int) {
+	return file_cityio_entity_v1_common_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Deposit) GetKind() DepositKind {
+	if x != nil {
+		return x.Kind
+	}
+	return DepositKind_DEPOSIT_KIND_UNSPECIFIED
+}
+
+func (x *Deposit) GetRichness() float64 {
+	if x != nil {
+		return x.Richness
+	}
+	return 0
+}
+
 // Coordinates is a position on the game map.
 type Coordinates struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -276,7 +664,7 @@ type Coordinates struct {
 
 func (x *Coordinates) Reset() {
 	*x = Coordinates{}
-	mi := &file_cityio_entity_v1_common_proto_msgTypes[3]
+	mi := &file_cityio_entity_v1_common_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -288,7 +676,7 @@ func (x *Coordinates) String() string {
 func (*Coordinates) ProtoMessage() {}
 
 func (x *Coordinates) ProtoReflect() protoreflect.Message {
-	mi := &file_cityio_entity_v1_common_proto_msgTypes[3]
+	mi := &file_cityio_entity_v1_common_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -301,7 +689,7 @@ func (x *Coordinates) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Coordinates.ProtoReflect.Descriptor instead.
 func (*Coordinates) Descriptor() ([]byte, []int) {
-	return file_cityio_entity_v1_common_proto_rawDescGZIP(), []int{3}
+	return file_cityio_entity_v1_common_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *Coordinates) GetX() int32 {
@@ -331,7 +719,7 @@ type Rate struct {
 
 func (x *Rate) Reset() {
 	*x = Rate{}
-	mi := &file_cityio_entity_v1_common_proto_msgTypes[4]
+	mi := &file_cityio_entity_v1_common_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -343,7 +731,7 @@ func (x *Rate) String() string {
 func (*Rate) ProtoMessage() {}
 
 func (x *Rate) ProtoReflect() protoreflect.Message {
-	mi := &file_cityio_entity_v1_common_proto_msgTypes[4]
+	mi := &file_cityio_entity_v1_common_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -356,7 +744,7 @@ func (x *Rate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Rate.ProtoReflect.Descriptor instead.
 func (*Rate) Descriptor() ([]byte, []int) {
-	return file_cityio_entity_v1_common_proto_rawDescGZIP(), []int{4}
+	return file_cityio_entity_v1_common_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *Rate) GetValue() int64 {
@@ -384,7 +772,21 @@ const file_cityio_entity_v1_common_proto_rawDesc = "" +
 	"\x05value\x18\x01 \x01(\tR\x05value\"\"\n" +
 	"\n" +
 	"BuildingId\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\")\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\"\x1e\n" +
+	"\x06ArmyId\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\"!\n" +
+	"\tCaravanId\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\"\"\n" +
+	"\n" +
+	"AllianceId\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\"&\n" +
+	"\x0eBattleReportId\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\"%\n" +
+	"\rChatMessageId\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\"X\n" +
+	"\aDeposit\x121\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x1d.cityio.entity.v1.DepositKindR\x04kind\x12\x1a\n" +
+	"\brichness\x18\x02 \x01(\x01R\brichness\")\n" +
 	"\vCoordinates\x12\f\n" +
 	"\x01x\x18\x01 \x01(\x05R\x01x\x12\f\n" +
 	"\x01y\x18\x02 \x01(\x05R\x01y\"2\n" +
@@ -402,7 +804,18 @@ const file_cityio_entity_v1_common_proto_rawDesc = "" +
 	"\x16BUILDING_TYPE_BARRACKS\x10\x03\x12\x17\n" +
 	"\x13BUILDING_TYPE_HOUSE\x10\x04\x12\x16\n" +
 	"\x12BUILDING_TYPE_FARM\x10\x05\x12\x16\n" +
-	"\x12BUILDING_TYPE_MINE\x10\x06B\xb4\x01\n" +
+	"\x12BUILDING_TYPE_MINE\x10\x06*\x8a\x01\n" +
+	"\aTerrain\x12\x17\n" +
+	"\x13TERRAIN_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11TERRAIN_GRASSLAND\x10\x01\x12\x12\n" +
+	"\x0eTERRAIN_FOREST\x10\x02\x12\x11\n" +
+	"\rTERRAIN_HILLS\x10\x03\x12\x15\n" +
+	"\x11TERRAIN_MOUNTAINS\x10\x04\x12\x11\n" +
+	"\rTERRAIN_WATER\x10\x05*f\n" +
+	"\vDepositKind\x12\x1c\n" +
+	"\x18DEPOSIT_KIND_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16DEPOSIT_KIND_GOLD_VEIN\x10\x01\x12\x1d\n" +
+	"\x19DEPOSIT_KIND_FERTILE_SOIL\x10\x02B\xb4\x01\n" +
 	"\x14com.cityio.entity.v1B\vCommonProtoP\x01Z-cityio/internal/gen/cityio/entity/v1;entityv1\xa2\x02\x03CEX\xaa\x02\x10Cityio.Entity.V1\xca\x02\x10Cityio\\Entity\\V1\xe2\x02\x1cCityio\\Entity\\V1\\GPBMetadata\xea\x02\x12Cityio::Entity::V1b\x06proto3"
 
 var (
@@ -417,23 +830,32 @@ func file_cityio_entity_v1_common_proto_rawDescGZIP() []byte {
 	return file_cityio_entity_v1_common_proto_rawDescData
 }
 
-var file_cityio_entity_v1_common_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_cityio_entity_v1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_cityio_entity_v1_common_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_cityio_entity_v1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_cityio_entity_v1_common_proto_goTypes = []any{
-	(CityType)(0),       // 0: cityio.entity.v1.CityType
-	(BuildingType)(0),   // 1: cityio.entity.v1.BuildingType
-	(*UserId)(nil),      // 2: cityio.entity.v1.UserId
-	(*CityId)(nil),      // 3: cityio.entity.v1.CityId
-	(*BuildingId)(nil),  // 4: cityio.entity.v1.BuildingId
-	(*Coordinates)(nil), // 5: cityio.entity.v1.Coordinates
-	(*Rate)(nil),        // 6: cityio.entity.v1.Rate
+	(CityType)(0),          // 0: cityio.entity.v1.CityType
+	(BuildingType)(0),      // 1: cityio.entity.v1.BuildingType
+	(Terrain)(0),           // 2: cityio.entity.v1.Terrain
+	(DepositKind)(0),       // 3: cityio.entity.v1.DepositKind
+	(*UserId)(nil),         // 4: cityio.entity.v1.UserId
+	(*CityId)(nil),         // 5: cityio.entity.v1.CityId
+	(*BuildingId)(nil),     // 6: cityio.entity.v1.BuildingId
+	(*ArmyId)(nil),         // 7: cityio.entity.v1.ArmyId
+	(*CaravanId)(nil),      // 8: cityio.entity.v1.CaravanId
+	(*AllianceId)(nil),     // 9: cityio.entity.v1.AllianceId
+	(*BattleReportId)(nil), // 10: cityio.entity.v1.BattleReportId
+	(*ChatMessageId)(nil),  // 11: cityio.entity.v1.ChatMessageId
+	(*Deposit)(nil),        // 12: cityio.entity.v1.Deposit
+	(*Coordinates)(nil),    // 13: cityio.entity.v1.Coordinates
+	(*Rate)(nil),           // 14: cityio.entity.v1.Rate
 }
 var file_cityio_entity_v1_common_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	3, // 0: cityio.entity.v1.Deposit.kind:type_name -> cityio.entity.v1.DepositKind
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_cityio_entity_v1_common_proto_init() }
@@ -446,8 +868,8 @@ func file_cityio_entity_v1_common_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cityio_entity_v1_common_proto_rawDesc), len(file_cityio_entity_v1_common_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   5,
+			NumEnums:      4,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,461 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: cityio/entity/v1/error.proto
+
+package entityv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// TileOccupied is attached when a building is placed on a tile that already
+// holds one.
+type TileOccupied struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Coords        *Coordinates           `protobuf:"bytes,1,opt,name=coords,proto3" json:"coords,omitempty"`
+	BuildingId    *BuildingId            `protobuf:"bytes,2,opt,name=building_id,json=buildingId,proto3" json:"building_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TileOccupied) Reset() {
+	*x = TileOccupied{}
+	mi := &file_cityio_entity_v1_error_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TileOccupied) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TileOccupied) ProtoMessage() {}
+
+func (x *TileOccupied) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_entity_v1_error_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TileOccupied.ProtoReflect.Descriptor instead.
+func (*TileOccupied) Descriptor() ([]byte, []int) {
+	return file_cityio_entity_v1_error_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TileOccupied) GetCoords() *Coordinates {
+	if x != nil {
+		return x.Coords
+	}
+	return nil
+}
+
+func (x *TileOccupied) GetBuildingId() *BuildingId {
+	if x != nil {
+		return x.BuildingId
+	}
+	return nil
+}
+
+// OutOfCityBounds is attached when a building is placed outside the city's
+// block.
+type OutOfCityBounds struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CityId        *CityId                `protobuf:"bytes,1,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
+	Coords        *Coordinates           `protobuf:"bytes,2,opt,name=coords,proto3" json:"coords,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OutOfCityBounds) Reset() {
+	*x = OutOfCityBounds{}
+	mi := &file_cityio_entity_v1_error_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OutOfCityBounds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutOfCityBounds) ProtoMessage() {}
+
+func (x *OutOfCityBounds) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_entity_v1_error_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutOfCityBounds.ProtoReflect.Descriptor instead.
+func (*OutOfCityBounds) Descriptor() ([]byte, []int) {
+	return file_cityio_entity_v1_error_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *OutOfCityBounds) GetCityId() *CityId {
+	if x != nil {
+		return x.CityId
+	}
+	return nil
+}
+
+func (x *OutOfCityBounds) GetCoords() *Coordinates {
+	if x != nil {
+		return x.Coords
+	}
+	return nil
+}
+
+// TerrainNotAllowed is attached when a building is placed on terrain that
+// forbids its type.
+type TerrainNotAllowed struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Coords        *Coordinates           `protobuf:"bytes,1,opt,name=coords,proto3" json:"coords,omitempty"`
+	Terrain       Terrain                `protobuf:"varint,2,opt,name=terrain,proto3,enum=cityio.entity.v1.Terrain" json:"terrain,omitempty"`
+	BuildingType  BuildingType           `protobuf:"varint,3,opt,name=building_type,json=buildingType,proto3,enum=cityio.entity.v1.BuildingType" json:"building_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TerrainNotAllowed) Reset() {
+	*x = TerrainNotAllowed{}
+	mi := &file_cityio_entity_v1_error_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TerrainNotAllowed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerrainNotAllowed) ProtoMessage() {}
+
+func (x *TerrainNotAllowed) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_entity_v1_error_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerrainNotAllowed.ProtoReflect.Descriptor instead.
+func (*TerrainNotAllowed) Descriptor() ([]byte, []int) {
+	return file_cityio_entity_v1_error_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TerrainNotAllowed) GetCoords() *Coordinates {
+	if x != nil {
+		return x.Coords
+	}
+	return nil
+}
+
+func (x *TerrainNotAllowed) GetTerrain() Terrain {
+	if x != nil {
+		return x.Terrain
+	}
+	return Terrain_TERRAIN_UNSPECIFIED
+}
+
+func (x *TerrainNotAllowed) GetBuildingType() BuildingType {
+	if x != nil {
+		return x.BuildingType
+	}
+	return BuildingType_BUILDING_TYPE_UNSPECIFIED
+}
+
+// MissingDeposit is attached when a mine or farm is placed on a tile without
+// the deposit it works.
+type MissingDeposit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Coords        *Coordinates           `protobuf:"bytes,1,opt,name=coords,proto3" json:"coords,omitempty"`
+	BuildingType  BuildingType           `protobuf:"varint,2,opt,name=building_type,json=buildingType,proto3,enum=cityio.entity.v1.BuildingType" json:"building_type,omitempty"`
+	Required      DepositKind            `protobuf:"varint,3,opt,name=required,proto3,enum=cityio.entity.v1.DepositKind" json:"required,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MissingDeposit) Reset() {
+	*x = MissingDeposit{}
+	mi := &file_cityio_entity_v1_error_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MissingDeposit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MissingDeposit) ProtoMessage() {}
+
+func (x *MissingDeposit) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_entity_v1_error_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MissingDeposit.ProtoReflect.Descriptor instead.
+func (*MissingDeposit) Descriptor() ([]byte, []int) {
+	return file_cityio_entity_v1_error_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MissingDeposit) GetCoords() *Coordinates {
+	if x != nil {
+		return x.Coords
+	}
+	return nil
+}
+
+func (x *MissingDeposit) GetBuildingType() BuildingType {
+	if x != nil {
+		return x.BuildingType
+	}
+	return BuildingType_BUILDING_TYPE_UNSPECIFIED
+}
+
+func (x *MissingDeposit) GetRequired() DepositKind {
+	if x != nil {
+		return x.Required
+	}
+	return DepositKind_DEPOSIT_KIND_UNSPECIFIED
+}
+
+// InsufficientResources is attached when the player can't afford a command.
+// Each field is how much more of that resource is needed.
+type InsufficientResources struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MissingGold   int64                  `protobuf:"varint,1,opt,name=missing_gold,json=missingGold,proto3" json:"missing_gold,omitempty"`
+	MissingFood   int64                  `protobuf:"varint,2,opt,name=missing_food,json=missingFood,proto3" json:"missing_food,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InsufficientResources) Reset() {
+	*x = InsufficientResources{}
+	mi := &file_cityio_entity_v1_error_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InsufficientResources) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsufficientResources) ProtoMessage() {}
+
+func (x *InsufficientResources) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_entity_v1_error_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsufficientResources.ProtoReflect.Descriptor instead.
+func (*InsufficientResources) Descriptor() ([]byte, []int) {
+	return file_cityio_entity_v1_error_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *InsufficientResources) GetMissingGold() int64 {
+	if x != nil {
+		return x.MissingGold
+	}
+	return 0
+}
+
+func (x *InsufficientResources) GetMissingFood() int64 {
+	if x != nil {
+		return x.MissingFood
+	}
+	return 0
+}
+
+// MissingPrerequisite is attached when research is started on a tech whose
+// prerequisite hasn't been researched.
+type MissingPrerequisite struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tech          string                 `protobuf:"bytes,1,opt,name=tech,proto3" json:"tech,omitempty"`
+	Missing       string                 `protobuf:"bytes,2,opt,name=missing,proto3" json:"missing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MissingPrerequisite) Reset() {
+	*x = MissingPrerequisite{}
+	mi := &file_cityio_entity_v1_error_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MissingPrerequisite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MissingPrerequisite) ProtoMessage() {}
+
+func (x *MissingPrerequisite) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_entity_v1_error_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MissingPrerequisite.ProtoReflect.Descriptor instead.
+func (*MissingPrerequisite) Descriptor() ([]byte, []int) {
+	return file_cityio_entity_v1_error_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MissingPrerequisite) GetTech() string {
+	if x != nil {
+		return x.Tech
+	}
+	return ""
+}
+
+func (x *MissingPrerequisite) GetMissing() string {
+	if x != nil {
+		return x.Missing
+	}
+	return ""
+}
+
+var File_cityio_entity_v1_error_proto protoreflect.FileDescriptor
+
+const file_cityio_entity_v1_error_proto_rawDesc = "" +
+	"\n" +
+	"\x1ccityio/entity/v1/error.proto\x12\x10cityio.entity.v1\x1a\x1dcityio/entity/v1/common.proto\"\x84\x01\n" +
+	"\fTileOccupied\x125\n" +
+	"\x06coords\x18\x01 \x01(\v2\x1d.cityio.entity.v1.CoordinatesR\x06coords\x12=\n" +
+	"\vbuilding_id\x18\x02 \x01(\v2\x1c.cityio.entity.v1.BuildingIdR\n" +
+	"buildingId\"{\n" +
+	"\x0fOutOfCityBounds\x121\n" +
+	"\acity_id\x18\x01 \x01(\v2\x18.cityio.entity.v1.CityIdR\x06cityId\x125\n" +
+	"\x06coords\x18\x02 \x01(\v2\x1d.cityio.entity.v1.CoordinatesR\x06coords\"\xc4\x01\n" +
+	"\x11TerrainNotAllowed\x125\n" +
+	"\x06coords\x18\x01 \x01(\v2\x1d.cityio.entity.v1.CoordinatesR\x06coords\x123\n" +
+	"\aterrain\x18\x02 \x01(\x0e2\x19.cityio.entity.v1.TerrainR\aterrain\x12C\n" +
+	"\rbuilding_type\x18\x03 \x01(\x0e2\x1e.cityio.entity.v1.BuildingTypeR\fbuildingType\"\xc7\x01\n" +
+	"\x0eMissingDeposit\x125\n" +
+	"\x06coords\x18\x01 \x01(\v2\x1d.cityio.entity.v1.CoordinatesR\x06coords\x12C\n" +
+	"\rbuilding_type\x18\x02 \x01(\x0e2\x1e.cityio.entity.v1.BuildingTypeR\fbuildingType\x129\n" +
+	"\brequired\x18\x03 \x01(\x0e2\x1d.cityio.entity.v1.DepositKindR\brequired\"]\n" +
+	"\x15InsufficientResources\x12!\n" +
+	"\fmissing_gold\x18\x01 \x01(\x03R\vmissingGold\x12!\n" +
+	"\fmissing_food\x18\x02 \x01(\x03R\vmissingFood\"C\n" +
+	"\x13MissingPrerequisite\x12\x12\n" +
+	"\x04tech\x18\x01 \x01(\tR\x04tech\x12\x18\n" +
+	"\amissing\x18\x02 \x01(\tR\amissingB\xb3\x01\n" +
+	"\x14com.cityio.entity.v1B\n" +
+	"ErrorProtoP\x01Z-cityio/internal/gen/cityio/entity/v1;entityv1\xa2\x02\x03CEX\xaa\x02\x10Cityio.Entity.V1\xca\x02\x10Cityio\\Entity\\V1\xe2\x02\x1cCityio\\Entity\\V1\\GPBMetadata\xea\x02\x12Cityio::Entity::V1b\x06proto3"
+
+var (
+	file_cityio_entity_v1_error_proto_rawDescOnce sync.Once
+	file_cityio_entity_v1_error_proto_rawDescData []byte
+)
+
+func file_cityio_entity_v1_error_proto_rawDescGZIP() []byte {
+	file_cityio_entity_v1_error_proto_rawDescOnce.Do(func() {
+		file_cityio_entity_v1_error_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cityio_entity_v1_error_proto_rawDesc), len(file_cityio_entity_v1_error_proto_rawDesc)))
+	})
+	return file_cityio_entity_v1_error_proto_rawDescData
+}
+
+var file_cityio_entity_v1_error_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_cityio_entity_v1_error_proto_goTypes = []any{
+	(*TileOccupied)(nil),          // 0: cityio.entity.v1.TileOccupied
+	(*OutOfCityBounds)(nil),       // 1: cityio.entity.v1.OutOfCityBounds
+	(*TerrainNotAllowed)(nil),     // 2: cityio.entity.v1.TerrainNotAllowed
+	(*MissingDeposit)(nil),        // 3: cityio.entity.v1.MissingDeposit
+	(*InsufficientResources)(nil), // 4: cityio.entity.v1.InsufficientResources
+	(*MissingPrerequisite)(nil),   // 5: cityio.entity.v1.MissingPrerequisite
+	(*Coordinates)(nil),           // 6: cityio.entity.v1.Coordinates
+	(*BuildingId)(nil),            // 7: cityio.entity.v1.BuildingId
+	(*CityId)(nil),                // 8: cityio.entity.v1.CityId
+	(Terrain)(0),                  // 9: cityio.entity.v1.Terrain
+	(BuildingType)(0),             // 10: cityio.entity.v1.BuildingType
+	(DepositKind)(0),              // 11: cityio.entity.v1.DepositKind
+}
+var file_cityio_entity_v1_error_proto_depIdxs = []int32{
+	6,  // 0: cityio.entity.v1.TileOccupied.coords:type_name -> cityio.entity.v1.Coordinates
+	7,  // 1: cityio.entity.v1.TileOccupied.building_id:type_name -> cityio.entity.v1.BuildingId
+	8,  // 2: cityio.entity.v1.OutOfCityBounds.city_id:type_name -> cityio.entity.v1.CityId
+	6,  // 3: cityio.entity.v1.OutOfCityBounds.coords:type_name -> cityio.entity.v1.Coordinates
+	6,  // 4: cityio.entity.v1.TerrainNotAllowed.coords:type_name -> cityio.entity.v1.Coordinates
+	9,  // 5: cityio.entity.v1.TerrainNotAllowed.terrain:type_name -> cityio.entity.v1.Terrain
+	10, // 6: cityio.entity.v1.TerrainNotAllowed.building_type:type_name -> cityio.entity.v1.BuildingType
+	6,  // 7: cityio.entity.v1.MissingDeposit.coords:type_name -> cityio.entity.v1.Coordinates
+	10, // 8: cityio.entity.v1.MissingDeposit.building_type:type_name -> cityio.entity.v1.BuildingType
+	11, // 9: cityio.entity.v1.MissingDeposit.required:type_name -> cityio.entity.v1.DepositKind
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_cityio_entity_v1_error_proto_init() }
+func file_cityio_entity_v1_error_proto_init() {
+	if File_cityio_entity_v1_error_proto != nil {
+		return
+	}
+	file_cityio_entity_v1_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cityio_entity_v1_error_proto_rawDesc), len(file_cityio_entity_v1_error_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cityio_entity_v1_error_proto_goTypes,
+		DependencyIndexes: file_cityio_entity_v1_error_proto_depIdxs,
+		MessageInfos:      file_cityio_entity_v1_error_proto_msgTypes,
+	}.Build()
+	File_cityio_entity_v1_error_proto = out.File
+	file_cityio_entity_v1_error_proto_goTypes = nil
+	file_cityio_entity_v1_error_proto_depIdxs = nil
+}
@@ -0,0 +1,452 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: cityio/entity/v1/market.proto
+
+package entityv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// OrderSide is whether a market order buys or sells the market's resource.
+type OrderSide int32
+
+const (
+	OrderSide_ORDER_SIDE_UNSPECIFIED OrderSide = 0
+	OrderSide_ORDER_SIDE_BUY         OrderSide = 1
+	OrderSide_ORDER_SIDE_SELL        OrderSide = 2
+)
+
+// Enum value maps for OrderSide.
+var (
+	OrderSide_name = map[int32]string{
+		0: "ORDER_SIDE_UNSPECIFIED",
+		1: "ORDER_SIDE_BUY",
+		2: "ORDER_SIDE_SELL",
+	}
+	OrderSide_value = map[string]int32{
+		"ORDER_SIDE_UNSPECIFIED": 0,
+		"ORDER_SIDE_BUY":         1,
+		"ORDER_SIDE_SELL":        2,
+	}
+)
+
+func (x OrderSide) Enum() *OrderSide {
+	p := new(OrderSide)
+	*p = x
+	return p
+}
+
+func (x OrderSide) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrderSide) Descriptor() protoreflect.EnumDescriptor {
+	return file_cityio_entity_v1_market_proto_enumTypes[0].Descriptor()
+}
+
+func (OrderSide) Type() protoreflect.EnumType {
+	return &file_cityio_entity_v1_market_proto_enumTypes[0]
+}
+
+func (x OrderSide) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrderSide.Descriptor instead.
+func (OrderSide) EnumDescriptor() ([]byte, []int) {
+	return file_cityio_entity_v1_market_proto_rawDescGZIP(), []int{0}
+}
+
+// MarketOrder is a limit order. price is in the market's currency per unit
+// of its resource; remaining is the part not yet filled.
+type MarketOrder struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	MarketId      string                 `protobuf:"bytes,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	UserId        *UserId                `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Side          OrderSide              `protobuf:"varint,4,opt,name=side,proto3,enum=cityio.entity.v1.OrderSide" json:"side,omitempty"`
+	Price         int64                  `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	Quantity      int64                  `protobuf:"varint,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Remaining     int64                  `protobuf:"varint,7,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarketOrder) Reset() {
+	*x = MarketOrder{}
+	mi := &file_cityio_entity_v1_market_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarketOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketOrder) ProtoMessage() {}
+
+func (x *MarketOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_entity_v1_market_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketOrder.ProtoReflect.Descriptor instead.
+func (*MarketOrder) Descriptor() ([]byte, []int) {
+	return file_cityio_entity_v1_market_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MarketOrder) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *MarketOrder) GetMarketId() string {
+	if x != nil {
+		return x.MarketId
+	}
+	return ""
+}
+
+func (x *MarketOrder) GetUserId() *UserId {
+	if x != nil {
+		return x.UserId
+	}
+	return nil
+}
+
+func (x *MarketOrder) GetSide() OrderSide {
+	if x != nil {
+		return x.Side
+	}
+	return OrderSide_ORDER_SIDE_UNSPECIFIED
+}
+
+func (x *MarketOrder) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *MarketOrder) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *MarketOrder) GetRemaining() int64 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *MarketOrder) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Trade is a match between a buy and a sell order, executed at the price of
+// the order that was resting in the book.
+type Trade struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TradeId       string                 `protobuf:"bytes,1,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`
+	MarketId      string                 `protobuf:"bytes,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Buyer         *UserId                `protobuf:"bytes,3,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	Seller        *UserId                `protobuf:"bytes,4,opt,name=seller,proto3" json:"seller,omitempty"`
+	Price         int64                  `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	Quantity      int64                  `protobuf:"varint,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	ExecutedAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=executed_at,json=executedAt,proto3" json:"executed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Trade) Reset() {
+	*x = Trade{}
+	mi := &file_cityio_entity_v1_market_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Trade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Trade) ProtoMessage() {}
+
+func (x *Trade) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_entity_v1_market_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Trade.ProtoReflect.Descriptor instead.
+func (*Trade) Descriptor() ([]byte, []int) {
+	return file_cityio_entity_v1_market_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Trade) GetTradeId() string {
+	if x != nil {
+		return x.TradeId
+	}
+	return ""
+}
+
+func (x *Trade) GetMarketId() string {
+	if x != nil {
+		return x.MarketId
+	}
+	return ""
+}
+
+func (x *Trade) GetBuyer() *UserId {
+	if x != nil {
+		return x.Buyer
+	}
+	return nil
+}
+
+func (x *Trade) GetSeller() *UserId {
+	if x != nil {
+		return x.Seller
+	}
+	return nil
+}
+
+func (x *Trade) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Trade) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *Trade) GetExecutedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExecutedAt
+	}
+	return nil
+}
+
+// MarketTicker is the public state of a market. The prices are unset while
+// the book side is empty or nothing has traded yet.
+type MarketTicker struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	MarketId  string                 `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	BestBid   *int64                 `protobuf:"varint,2,opt,name=best_bid,json=bestBid,proto3,oneof" json:"best_bid,omitempty"`
+	BestAsk   *int64                 `protobuf:"varint,3,opt,name=best_ask,json=bestAsk,proto3,oneof" json:"best_ask,omitempty"`
+	LastPrice *int64                 `protobuf:"varint,4,opt,name=last_price,json=lastPrice,proto3,oneof" json:"last_price,omitempty"`
+	// recent_trades are the latest trades, newest first.
+	RecentTrades  []*Trade `protobuf:"bytes,5,rep,name=recent_trades,json=recentTrades,proto3" json:"recent_trades,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarketTicker) Reset() {
+	*x = MarketTicker{}
+	mi := &file_cityio_entity_v1_market_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarketTicker) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketTicker) ProtoMessage() {}
+
+func (x *MarketTicker) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_entity_v1_market_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarketTicker.ProtoReflect.Descriptor instead.
+func (*MarketTicker) Descriptor() ([]byte, []int) {
+	return file_cityio_entity_v1_market_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MarketTicker) GetMarketId() string {
+	if x != nil {
+		return x.MarketId
+	}
+	return ""
+}
+
+func (x *MarketTicker) GetBestBid() int64 {
+	if x != nil && x.BestBid != nil {
+		return *x.BestBid
+	}
+	return 0
+}
+
+func (x *MarketTicker) GetBestAsk() int64 {
+	if x != nil && x.BestAsk != nil {
+		return *x.BestAsk
+	}
+	return 0
+}
+
+func (x *MarketTicker) GetLastPrice() int64 {
+	if x != nil && x.LastPrice != nil {
+		return *x.LastPrice
+	}
+	return 0
+}
+
+func (x *MarketTicker) GetRecentTrades() []*Trade {
+	if x != nil {
+		return x.RecentTrades
+	}
+	return nil
+}
+
+var File_cityio_entity_v1_market_proto protoreflect.FileDescriptor
+
+const file_cityio_entity_v1_market_proto_rawDesc = "" +
+	"\n" +
+	"\x1dcityio/entity/v1/market.proto\x12\x10cityio.entity.v1\x1a\x1dcityio/entity/v1/common.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb4\x02\n" +
+	"\vMarketOrder\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x1b\n" +
+	"\tmarket_id\x18\x02 \x01(\tR\bmarketId\x121\n" +
+	"\auser_id\x18\x03 \x01(\v2\x18.cityio.entity.v1.UserIdR\x06userId\x12/\n" +
+	"\x04side\x18\x04 \x01(\x0e2\x1b.cityio.entity.v1.OrderSideR\x04side\x12\x14\n" +
+	"\x05price\x18\x05 \x01(\x03R\x05price\x12\x1a\n" +
+	"\bquantity\x18\x06 \x01(\x03R\bquantity\x12\x1c\n" +
+	"\tremaining\x18\a \x01(\x03R\tremaining\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x90\x02\n" +
+	"\x05Trade\x12\x19\n" +
+	"\btrade_id\x18\x01 \x01(\tR\atradeId\x12\x1b\n" +
+	"\tmarket_id\x18\x02 \x01(\tR\bmarketId\x12.\n" +
+	"\x05buyer\x18\x03 \x01(\v2\x18.cityio.entity.v1.UserIdR\x05buyer\x120\n" +
+	"\x06seller\x18\x04 \x01(\v2\x18.cityio.entity.v1.UserIdR\x06seller\x12\x14\n" +
+	"\x05price\x18\x05 \x01(\x03R\x05price\x12\x1a\n" +
+	"\bquantity\x18\x06 \x01(\x03R\bquantity\x12;\n" +
+	"\vexecuted_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"executedAt\"\xf6\x01\n" +
+	"\fMarketTicker\x12\x1b\n" +
+	"\tmarket_id\x18\x01 \x01(\tR\bmarketId\x12\x1e\n" +
+	"\bbest_bid\x18\x02 \x01(\x03H\x00R\abestBid\x88\x01\x01\x12\x1e\n" +
+	"\bbest_ask\x18\x03 \x01(\x03H\x01R\abestAsk\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"last_price\x18\x04 \x01(\x03H\x02R\tlastPrice\x88\x01\x01\x12<\n" +
+	"\rrecent_trades\x18\x05 \x03(\v2\x17.cityio.entity.v1.TradeR\frecentTradesB\v\n" +
+	"\t_best_bidB\v\n" +
+	"\t_best_askB\r\n" +
+	"\v_last_price*P\n" +
+	"\tOrderSide\x12\x1a\n" +
+	"\x16ORDER_SIDE_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eORDER_SIDE_BUY\x10\x01\x12\x13\n" +
+	"\x0fORDER_SIDE_SELL\x10\x02B\xb4\x01\n" +
+	"\x14com.cityio.entity.v1B\vMarketProtoP\x01Z-cityio/internal/gen/cityio/entity/v1;entityv1\xa2\x02\x03CEX\xaa\x02\x10Cityio.Entity.V1\xca\x02\x10Cityio\\Entity\\V1\xe2\x02\x1cCityio\\Entity\\V1\\GPBMetadata\xea\x02\x12Cityio::Entity::V1b\x06proto3"
+
+var (
+	file_cityio_entity_v1_market_proto_rawDescOnce sync.Once
+	file_cityio_entity_v1_market_proto_rawDescData []byte
+)
+
+func file_cityio_entity_v1_market_proto_rawDescGZIP() []byte {
+	file_cityio_entity_v1_market_proto_rawDescOnce.Do(func() {
+		file_cityio_entity_v1_market_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cityio_entity_v1_market_proto_rawDesc), len(file_cityio_entity_v1_market_proto_rawDesc)))
+	})
+	return file_cityio_entity_v1_market_proto_rawDescData
+}
+
+var file_cityio_entity_v1_market_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_cityio_entity_v1_market_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_cityio_entity_v1_market_proto_goTypes = []any{
+	(OrderSide)(0),                // 0: cityio.entity.v1.OrderSide
+	(*MarketOrder)(nil),           // 1: cityio.entity.v1.MarketOrder
+	(*Trade)(nil),                 // 2: cityio.entity.v1.Trade
+	(*MarketTicker)(nil),          // 3: cityio.entity.v1.MarketTicker
+	(*UserId)(nil),                // 4: cityio.entity.v1.UserId
+	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
+}
+var file_cityio_entity_v1_market_proto_depIdxs = []int32{
+	4, // 0: cityio.entity.v1.MarketOrder.user_id:type_name -> cityio.entity.v1.UserId
+	0, // 1: cityio.entity.v1.MarketOrder.side:type_name -> cityio.entity.v1.OrderSide
+	5, // 2: cityio.entity.v1.MarketOrder.created_at:type_name -> google.protobuf.Timestamp
+	4, // 3: cityio.entity.v1.Trade.buyer:type_name -> cityio.entity.v1.UserId
+	4, // 4: cityio.entity.v1.Trade.seller:type_name -> cityio.entity.v1.UserId
+	5, // 5: cityio.entity.v1.Trade.executed_at:type_name -> google.protobuf.Timestamp
+	2, // 6: cityio.entity.v1.MarketTicker.recent_trades:type_name -> cityio.entity.v1.Trade
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_cityio_entity_v1_market_proto_init() }
+func file_cityio_entity_v1_market_proto_init() {
+	if File_cityio_entity_v1_market_proto != nil {
+		return
+	}
+	file_cityio_entity_v1_common_proto_init()
+	file_cityio_entity_v1_market_proto_msgTypes[2].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cityio_entity_v1_market_proto_rawDesc), len(file_cityio_entity_v1_market_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cityio_entity_v1_market_proto_goTypes,
+		DependencyIndexes: file_cityio_entity_v1_market_proto_depIdxs,
+		EnumInfos:         file_cityio_entity_v1_market_proto_enumTypes,
+		MessageInfos:      file_cityio_entity_v1_market_proto_msgTypes,
+	}.Build()
+	File_cityio_entity_v1_market_proto = out.File
+	file_cityio_entity_v1_market_proto_goTypes = nil
+	file_cityio_entity_v1_market_proto_depIdxs = nil
+}
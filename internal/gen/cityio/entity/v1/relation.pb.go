@@ -0,0 +1,274 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: cityio/entity/v1/relation.proto
+
+package entityv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// RelationState is the diplomatic standing between two players. Armies only
+// fight and capture the cities of players they are at war with, and only
+// armies at war intercept caravans. Peace and non-aggression pacts are
+// treaties both sides agree to.
+type RelationState int32
+
+const (
+	RelationState_RELATION_STATE_UNSPECIFIED    RelationState = 0
+	RelationState_RELATION_STATE_NEUTRAL        RelationState = 1
+	RelationState_RELATION_STATE_PEACE          RelationState = 2
+	RelationState_RELATION_STATE_NON_AGGRESSION RelationState = 3
+	RelationState_RELATION_STATE_WAR            RelationState = 4
+)
+
+// Enum value maps for RelationState.
+var (
+	RelationState_name = map[int32]string{
+		0: "RELATION_STATE_UNSPECIFIED",
+		1: "RELATION_STATE_NEUTRAL",
+		2: "RELATION_STATE_PEACE",
+		3: "RELATION_STATE_NON_AGGRESSION",
+		4: "RELATION_STATE_WAR",
+	}
+	RelationState_value = map[string]int32{
+		"RELATION_STATE_UNSPECIFIED":    0,
+		"RELATION_STATE_NEUTRAL":        1,
+		"RELATION_STATE_PEACE":          2,
+		"RELATION_STATE_NON_AGGRESSION": 3,
+		"RELATION_STATE_WAR":            4,
+	}
+)
+
+func (x RelationState) Enum() *RelationState {
+	p := new(RelationState)
+	*p = x
+	return p
+}
+
+func (x RelationState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RelationState) Descriptor() protoreflect.EnumDescriptor {
+	return file_cityio_entity_v1_relation_proto_enumTypes[0].Descriptor()
+}
+
+func (RelationState) Type() protoreflect.EnumType {
+	return &file_cityio_entity_v1_relation_proto_enumTypes[0]
+}
+
+func (x RelationState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RelationState.Descriptor instead.
+func (RelationState) EnumDescriptor() ([]byte, []int) {
+	return file_cityio_entity_v1_relation_proto_rawDescGZIP(), []int{0}
+}
+
+// Relation is the standing between the receiver and another player. A pair
+// that never had dealings is neutral.
+type Relation struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	UserId      *UserId                `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OtherUserId *UserId                `protobuf:"bytes,2,opt,name=other_user_id,json=otherUserId,proto3" json:"other_user_id,omitempty"`
+	State       RelationState          `protobuf:"varint,3,opt,name=state,proto3,enum=cityio.entity.v1.RelationState" json:"state,omitempty"`
+	// pending_state replaces state at effective_at, e.g. a declared war or a
+	// cancelled treaty. Unset when nothing is pending.
+	PendingState RelationState          `protobuf:"varint,4,opt,name=pending_state,json=pendingState,proto3,enum=cityio.entity.v1.RelationState" json:"pending_state,omitempty"`
+	EffectiveAt  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=effective_at,json=effectiveAt,proto3" json:"effective_at,omitempty"`
+	// proposed_state is the treaty on the table, proposed by proposed_by.
+	// Unset when nothing is proposed.
+	ProposedState RelationState          `protobuf:"varint,6,opt,name=proposed_state,json=proposedState,proto3,enum=cityio.entity.v1.RelationState" json:"proposed_state,omitempty"`
+	ProposedBy    *UserId                `protobuf:"bytes,7,opt,name=proposed_by,json=proposedBy,proto3" json:"proposed_by,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Relation) Reset() {
+	*x = Relation{}
+	mi := &file_cityio_entity_v1_relation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Relation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Relation) ProtoMessage() {}
+
+func (x *Relation) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_entity_v1_relation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Relation.ProtoReflect.Descriptor instead.
+func (*Relation) Descriptor() ([]byte, []int) {
+	return file_cityio_entity_v1_relation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Relation) GetUserId() *UserId {
+	if x != nil {
+		return x.UserId
+	}
+	return nil
+}
+
+func (x *Relation) GetOtherUserId() *UserId {
+	if x != nil {
+		return x.OtherUserId
+	}
+	return nil
+}
+
+func (x *Relation) GetState() RelationState {
+	if x != nil {
+		return x.State
+	}
+	return RelationState_RELATION_STATE_UNSPECIFIED
+}
+
+func (x *Relation) GetPendingState() RelationState {
+	if x != nil {
+		return x.PendingState
+	}
+	return RelationState_RELATION_STATE_UNSPECIFIED
+}
+
+func (x *Relation) GetEffectiveAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveAt
+	}
+	return nil
+}
+
+func (x *Relation) GetProposedState() RelationState {
+	if x != nil {
+		return x.ProposedState
+	}
+	return RelationState_RELATION_STATE_UNSPECIFIED
+}
+
+func (x *Relation) GetProposedBy() *UserId {
+	if x != nil {
+		return x.ProposedBy
+	}
+	return nil
+}
+
+func (x *Relation) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+var File_cityio_entity_v1_relation_proto protoreflect.FileDescriptor
+
+const file_cityio_entity_v1_relation_proto_rawDesc = "" +
+	"\n" +
+	"\x1fcityio/entity/v1/relation.proto\x12\x10cityio.entity.v1\x1a\x1dcityio/entity/v1/common.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf5\x03\n" +
+	"\bRelation\x121\n" +
+	"\auser_id\x18\x01 \x01(\v2\x18.cityio.entity.v1.UserIdR\x06userId\x12<\n" +
+	"\rother_user_id\x18\x02 \x01(\v2\x18.cityio.entity.v1.UserIdR\votherUserId\x125\n" +
+	"\x05state\x18\x03 \x01(\x0e2\x1f.cityio.entity.v1.RelationStateR\x05state\x12D\n" +
+	"\rpending_state\x18\x04 \x01(\x0e2\x1f.cityio.entity.v1.RelationStateR\fpendingState\x12=\n" +
+	"\feffective_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\veffectiveAt\x12F\n" +
+	"\x0eproposed_state\x18\x06 \x01(\x0e2\x1f.cityio.entity.v1.RelationStateR\rproposedState\x129\n" +
+	"\vproposed_by\x18\a \x01(\v2\x18.cityio.entity.v1.UserIdR\n" +
+	"proposedBy\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt*\xa0\x01\n" +
+	"\rRelationState\x12\x1e\n" +
+	"\x1aRELATION_STATE_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16RELATION_STATE_NEUTRAL\x10\x01\x12\x18\n" +
+	"\x14RELATION_STATE_PEACE\x10\x02\x12!\n" +
+	"\x1dRELATION_STATE_NON_AGGRESSION\x10\x03\x12\x16\n" +
+	"\x12RELATION_STATE_WAR\x10\x04B\xb6\x01\n" +
+	"\x14com.cityio.entity.v1B\rRelationProtoP\x01Z-cityio/internal/gen/cityio/entity/v1;entityv1\xa2\x02\x03CEX\xaa\x02\x10Cityio.Entity.V1\xca\x02\x10Cityio\\Entity\\V1\xe2\x02\x1cCityio\\Entity\\V1\\GPBMetadata\xea\x02\x12Cityio::Entity::V1b\x06proto3"
+
+var (
+	file_cityio_entity_v1_relation_proto_rawDescOnce sync.Once
+	file_cityio_entity_v1_relation_proto_rawDescData []byte
+)
+
+func file_cityio_entity_v1_relation_proto_rawDescGZIP() []byte {
+	file_cityio_entity_v1_relation_proto_rawDescOnce.Do(func() {
+		file_cityio_entity_v1_relation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cityio_entity_v1_relation_proto_rawDesc), len(file_cityio_entity_v1_relation_proto_rawDesc)))
+	})
+	return file_cityio_entity_v1_relation_proto_rawDescData
+}
+
+var file_cityio_entity_v1_relation_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_cityio_entity_v1_relation_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_cityio_entity_v1_relation_proto_goTypes = []any{
+	(RelationState)(0),            // 0: cityio.entity.v1.RelationState
+	(*Relation)(nil),              // 1: cityio.entity.v1.Relation
+	(*UserId)(nil),                // 2: cityio.entity.v1.UserId
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+}
+var file_cityio_entity_v1_relation_proto_depIdxs = []int32{
+	2, // 0: cityio.entity.v1.Relation.user_id:type_name -> cityio.entity.v1.UserId
+	2, // 1: cityio.entity.v1.Relation.other_user_id:type_name -> cityio.entity.v1.UserId
+	0, // 2: cityio.entity.v1.Relation.state:type_name -> cityio.entity.v1.RelationState
+	0, // 3: cityio.entity.v1.Relation.pending_state:type_name -> cityio.entity.v1.RelationState
+	3, // 4: cityio.entity.v1.Relation.effective_at:type_name -> google.protobuf.Timestamp
+	0, // 5: cityio.entity.v1.Relation.proposed_state:type_name -> cityio.entity.v1.RelationState
+	2, // 6: cityio.entity.v1.Relation.proposed_by:type_name -> cityio.entity.v1.UserId
+	3, // 7: cityio.entity.v1.Relation.updated_at:type_name -> google.protobuf.Timestamp
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_cityio_entity_v1_relation_proto_init() }
+func file_cityio_entity_v1_relation_proto_init() {
+	if File_cityio_entity_v1_relation_proto != nil {
+		return
+	}
+	file_cityio_entity_v1_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cityio_entity_v1_relation_proto_rawDesc), len(file_cityio_entity_v1_relation_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cityio_entity_v1_relation_proto_goTypes,
+		DependencyIndexes: file_cityio_entity_v1_relation_proto_depIdxs,
+		EnumInfos:         file_cityio_entity_v1_relation_proto_enumTypes,
+		MessageInfos:      file_cityio_entity_v1_relation_proto_msgTypes,
+	}.Build()
+	File_cityio_entity_v1_relation_proto = out.File
+	file_cityio_entity_v1_relation_proto_goTypes = nil
+	file_cityio_entity_v1_relation_proto_depIdxs = nil
+}
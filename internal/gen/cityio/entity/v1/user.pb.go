@@ -9,6 +9,7 @@ package entityv1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -21,16 +22,78 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// FoodAllocationPolicy decides how a user's food pool is split when the
+// importing cities ask for more than it holds.
+type FoodAllocationPolicy int32
+
+const (
+	FoodAllocationPolicy_FOOD_ALLOCATION_POLICY_UNSPECIFIED FoodAllocationPolicy = 0
+	// Capital first, then other cities by population, largest first.
+	FoodAllocationPolicy_FOOD_ALLOCATION_POLICY_CAPITAL_FIRST FoodAllocationPolicy = 1
+	// Every city receives the same fraction of its request.
+	FoodAllocationPolicy_FOOD_ALLOCATION_POLICY_PROPORTIONAL FoodAllocationPolicy = 2
+	// Cities by import_priority, highest first; ties fall back to capital first.
+	FoodAllocationPolicy_FOOD_ALLOCATION_POLICY_PRIORITY FoodAllocationPolicy = 3
+)
+
+// Enum value maps for FoodAllocationPolicy.
+var (
+	FoodAllocationPolicy_name = map[int32]string{
+		0: "FOOD_ALLOCATION_POLICY_UNSPECIFIED",
+		1: "FOOD_ALLOCATION_POLICY_CAPITAL_FIRST",
+		2: "FOOD_ALLOCATION_POLICY_PROPORTIONAL",
+		3: "FOOD_ALLOCATION_POLICY_PRIORITY",
+	}
+	FoodAllocationPolicy_value = map[string]int32{
+		"FOOD_ALLOCATION_POLICY_UNSPECIFIED":   0,
+		"FOOD_ALLOCATION_POLICY_CAPITAL_FIRST": 1,
+		"FOOD_ALLOCATION_POLICY_PROPORTIONAL":  2,
+		"FOOD_ALLOCATION_POLICY_PRIORITY":      3,
+	}
+)
+
+func (x FoodAllocationPolicy) Enum() *FoodAllocationPolicy {
+	p := new(FoodAllocationPolicy)
+	*p = x
+	return p
+}
+
+func (x FoodAllocationPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FoodAllocationPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_cityio_entity_v1_user_proto_enumTypes[0].Descriptor()
+}
+
+func (FoodAllocationPolicy) Type() protoreflect.EnumType {
+	return &file_cityio_entity_v1_user_proto_enumTypes[0]
+}
+
+func (x FoodAllocationPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FoodAllocationPolicy.Descriptor instead.
+func (FoodAllocationPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_cityio_entity_v1_user_proto_rawDescGZIP(), []int{0}
+}
+
 // User is a player account. The password is never exposed over the wire.
 type User struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        *UserId                `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
-	Gold          int64                  `protobuf:"varint,4,opt,name=gold,proto3" json:"gold,omitempty"`
-	Food          int64                  `protobuf:"varint,5,opt,name=food,proto3" json:"food,omitempty"`
-	FoodIncome    *Rate                  `protobuf:"bytes,6,opt,name=food_income,json=foodIncome,proto3" json:"food_income,omitempty"`
-	FoodUpkeep    *Rate                  `protobuf:"bytes,7,opt,name=food_upkeep,json=foodUpkeep,proto3" json:"food_upkeep,omitempty"`
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	UserId               *UserId                `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email                string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Username             string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Gold                 int64                  `protobuf:"varint,4,opt,name=gold,proto3" json:"gold,omitempty"`
+	Food                 int64                  `protobuf:"varint,5,opt,name=food,proto3" json:"food,omitempty"`
+	FoodIncome           *Rate                  `protobuf:"bytes,6,opt,name=food_income,json=foodIncome,proto3" json:"food_income,omitempty"`
+	FoodUpkeep           *Rate                  `protobuf:"bytes,7,opt,name=food_upkeep,json=foodUpkeep,proto3" json:"food_upkeep,omitempty"`
+	FoodAllocationPolicy FoodAllocationPolicy   `protobuf:"varint,8,opt,name=food_allocation_policy,json=foodAllocationPolicy,proto3,enum=cityio.entity.v1.FoodAllocationPolicy" json:"food_allocation_policy,omitempty"`
+	// techs are the techs the user has finished researching, in completion
+	// order. research is the one in progress, if any.
+	Techs         []string  `protobuf:"bytes,9,rep,name=techs,proto3" json:"techs,omitempty"`
+	Research      *Research `protobuf:"bytes,10,opt,name=research,proto3,oneof" json:"research,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -114,11 +177,93 @@ func (x *User) GetFoodUpkeep() *Rate {
 	return nil
 }
 
+func (x *User) GetFoodAllocationPolicy() FoodAllocationPolicy {
+	if x != nil {
+		return x.FoodAllocationPolicy
+	}
+	return FoodAllocationPolicy_FOOD_ALLOCATION_POLICY_UNSPECIFIED
+}
+
+func (x *User) GetTechs() []string {
+	if x != nil {
+		return x.Techs
+	}
+	return nil
+}
+
+func (x *User) GetResearch() *Research {
+	if x != nil {
+		return x.Research
+	}
+	return nil
+}
+
+// Research is a tech being researched. It completes at research_end.
+type Research struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tech          string                 `protobuf:"bytes,1,opt,name=tech,proto3" json:"tech,omitempty"`
+	ResearchStart *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=research_start,json=researchStart,proto3" json:"research_start,omitempty"`
+	ResearchEnd   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=research_end,json=researchEnd,proto3" json:"research_end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Research) Reset() {
+	*x = Research{}
+	mi := &file_cityio_entity_v1_user_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Research) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Research) ProtoMessage() {}
+
+func (x *Research) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_entity_v1_user_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Research.ProtoReflect.Descriptor instead.
+func (*Research) Descriptor() ([]byte, []int) {
+	return file_cityio_entity_v1_user_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Research) GetTech() string {
+	if x != nil {
+		return x.Tech
+	}
+	return ""
+}
+
+func (x *Research) GetResearchStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResearchStart
+	}
+	return nil
+}
+
+func (x *Research) GetResearchEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResearchEnd
+	}
+	return nil
+}
+
 var File_cityio_entity_v1_user_proto protoreflect.FileDescriptor
 
 const file_cityio_entity_v1_user_proto_rawDesc = "" +
 	"\n" +
-	"\x1bcityio/entity/v1/user.proto\x12\x10cityio.entity.v1\x1a\x1dcityio/entity/v1/common.proto\"\x85\x02\n" +
+	"\x1bcityio/entity/v1/user.proto\x12\x10cityio.entity.v1\x1a\x1dcityio/entity/v1/common.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc3\x03\n" +
 	"\x04User\x121\n" +
 	"\auser_id\x18\x01 \x01(\v2\x18.cityio.entity.v1.UserIdR\x06userId\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1a\n" +
@@ -128,7 +273,21 @@ const file_cityio_entity_v1_user_proto_rawDesc = "" +
 	"\vfood_income\x18\x06 \x01(\v2\x16.cityio.entity.v1.RateR\n" +
 	"foodIncome\x127\n" +
 	"\vfood_upkeep\x18\a \x01(\v2\x16.cityio.entity.v1.RateR\n" +
-	"foodUpkeepB\xb2\x01\n" +
+	"foodUpkeep\x12\\\n" +
+	"\x16food_allocation_policy\x18\b \x01(\x0e2&.cityio.entity.v1.FoodAllocationPolicyR\x14foodAllocationPolicy\x12\x14\n" +
+	"\x05techs\x18\t \x03(\tR\x05techs\x12;\n" +
+	"\bresearch\x18\n" +
+	" \x01(\v2\x1a.cityio.entity.v1.ResearchH\x00R\bresearch\x88\x01\x01B\v\n" +
+	"\t_research\"\xa0\x01\n" +
+	"\bResearch\x12\x12\n" +
+	"\x04tech\x18\x01 \x01(\tR\x04tech\x12A\n" +
+	"\x0eresearch_start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\rresearchStart\x12=\n" +
+	"\fresearch_end\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vresearchEnd*\xb6\x01\n" +
+	"\x14FoodAllocationPolicy\x12&\n" +
+	"\"FOOD_ALLOCATION_POLICY_UNSPECIFIED\x10\x00\x12(\n" +
+	"$FOOD_ALLOCATION_POLICY_CAPITAL_FIRST\x10\x01\x12'\n" +
+	"#FOOD_ALLOCATION_POLICY_PROPORTIONAL\x10\x02\x12#\n" +
+	"\x1fFOOD_ALLOCATION_POLICY_PRIORITY\x10\x03B\xb2\x01\n" +
 	"\x14com.cityio.entity.v1B\tUserProtoP\x01Z-cityio/internal/gen/cityio/entity/v1;entityv1\xa2\x02\x03CEX\xaa\x02\x10Cityio.Entity.V1\xca\x02\x10Cityio\\Entity\\V1\xe2\x02\x1cCityio\\Entity\\V1\\GPBMetadata\xea\x02\x12Cityio::Entity::V1b\x06proto3"
 
 var (
@@ -143,21 +302,29 @@ func file_cityio_entity_v1_user_proto_rawDescGZIP() []byte {
 	return file_cityio_entity_v1_user_proto_rawDescData
 }
 
-var file_cityio_entity_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_cityio_entity_v1_user_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_cityio_entity_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_cityio_entity_v1_user_proto_goTypes = []any{
-	(*User)(nil),   // 0: cityio.entity.v1.User
-	(*UserId)(nil), // 1: cityio.entity.v1.UserId
-	(*Rate)(nil),   // 2: cityio.entity.v1.Rate
+	(FoodAllocationPolicy)(0),     // 0: cityio.entity.v1.FoodAllocationPolicy
+	(*User)(nil),                  // 1: cityio.entity.v1.User
+	(*Research)(nil),              // 2: cityio.entity.v1.Research
+	(*UserId)(nil),                // 3: cityio.entity.v1.UserId
+	(*Rate)(nil),                  // 4: cityio.entity.v1.Rate
+	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
 }
 var file_cityio_entity_v1_user_proto_depIdxs = []int32{
-	1, // 0: cityio.entity.v1.User.user_id:type_name -> cityio.entity.v1.UserId
-	2, // 1: cityio.entity.v1.User.food_income:type_name -> cityio.entity.v1.Rate
-	2, // 2: cityio.entity.v1.User.food_upkeep:type_name -> cityio.entity.v1.Rate
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	3, // 0: cityio.entity.v1.User.user_id:type_name -> cityio.entity.v1.UserId
+	4, // 1: cityio.entity.v1.User.food_income:type_name -> cityio.entity.v1.Rate
+	4, // 2: cityio.entity.v1.User.food_upkeep:type_name -> cityio.entity.v1.Rate
+	0, // 3: cityio.entity.v1.User.food_allocation_policy:type_name -> cityio.entity.v1.FoodAllocationPolicy
+	2, // 4: cityio.entity.v1.User.research:type_name -> cityio.entity.v1.Research
+	5, // 5: cityio.entity.v1.Research.research_start:type_name -> google.protobuf.Timestamp
+	5, // 6: cityio.entity.v1.Research.research_end:type_name -> google.protobuf.Timestamp
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_cityio_entity_v1_user_proto_init() }
@@ -166,18 +333,20 @@ func file_cityio_entity_v1_user_proto_init() {
 		return
 	}
 	file_cityio_entity_v1_common_proto_init()
+	file_cityio_entity_v1_user_proto_msgTypes[0].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cityio_entity_v1_user_proto_rawDesc), len(file_cityio_entity_v1_user_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   1,
+			NumEnums:      1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_cityio_entity_v1_user_proto_goTypes,
 		DependencyIndexes: file_cityio_entity_v1_user_proto_depIdxs,
+		EnumInfos:         file_cityio_entity_v1_user_proto_enumTypes,
 		MessageInfos:      file_cityio_entity_v1_user_proto_msgTypes,
 	}.Build()
 	File_cityio_entity_v1_user_proto = out.File
@@ -0,0 +1,461 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: cityio/entity/v1/world.proto
+
+package entityv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// WorldState is where the world is in its season lifecycle.
+type WorldState int32
+
+const (
+	WorldState_WORLD_STATE_UNSPECIFIED WorldState = 0
+	WorldState_WORLD_STATE_ACTIVE      WorldState = 1
+	// WORLD_STATE_ENDING is a season that has ended; the world is archived and
+	// regenerated when the server next starts.
+	WorldState_WORLD_STATE_ENDING WorldState = 2
+)
+
+// Enum value maps for WorldState.
+var (
+	WorldState_name = map[int32]string{
+		0: "WORLD_STATE_UNSPECIFIED",
+		1: "WORLD_STATE_ACTIVE",
+		2: "WORLD_STATE_ENDING",
+	}
+	WorldState_value = map[string]int32{
+		"WORLD_STATE_UNSPECIFIED": 0,
+		"WORLD_STATE_ACTIVE":      1,
+		"WORLD_STATE_ENDING":      2,
+	}
+)
+
+func (x WorldState) Enum() *WorldState {
+	p := new(WorldState)
+	*p = x
+	return p
+}
+
+func (x WorldState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WorldState) Descriptor() protoreflect.EnumDescriptor {
+	return file_cityio_entity_v1_world_proto_enumTypes[0].Descriptor()
+}
+
+func (WorldState) Type() protoreflect.EnumType {
+	return &file_cityio_entity_v1_world_proto_enumTypes[0]
+}
+
+func (x WorldState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorldState.Descriptor instead.
+func (WorldState) EnumDescriptor() ([]byte, []int) {
+	return file_cityio_entity_v1_world_proto_rawDescGZIP(), []int{0}
+}
+
+// SeasonEndReason records why a season ended.
+type SeasonEndReason int32
+
+const (
+	SeasonEndReason_SEASON_END_REASON_UNSPECIFIED SeasonEndReason = 0
+	SeasonEndReason_SEASON_END_REASON_ADMIN       SeasonEndReason = 1
+	SeasonEndReason_SEASON_END_REASON_EXPIRED     SeasonEndReason = 2
+)
+
+// Enum value maps for SeasonEndReason.
+var (
+	SeasonEndReason_name = map[int32]string{
+		0: "SEASON_END_REASON_UNSPECIFIED",
+		1: "SEASON_END_REASON_ADMIN",
+		2: "SEASON_END_REASON_EXPIRED",
+	}
+	SeasonEndReason_value = map[string]int32{
+		"SEASON_END_REASON_UNSPECIFIED": 0,
+		"SEASON_END_REASON_ADMIN":       1,
+		"SEASON_END_REASON_EXPIRED":     2,
+	}
+)
+
+func (x SeasonEndReason) Enum() *SeasonEndReason {
+	p := new(SeasonEndReason)
+	*p = x
+	return p
+}
+
+func (x SeasonEndReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SeasonEndReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_cityio_entity_v1_world_proto_enumTypes[1].Descriptor()
+}
+
+func (SeasonEndReason) Type() protoreflect.EnumType {
+	return &file_cityio_entity_v1_world_proto_enumTypes[1]
+}
+
+func (x SeasonEndReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SeasonEndReason.Descriptor instead.
+func (SeasonEndReason) EnumDescriptor() ([]byte, []int) {
+	return file_cityio_entity_v1_world_proto_rawDescGZIP(), []int{1}
+}
+
+// World is the season currently being played. The seed stays private until
+// the season is archived.
+type World struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Season          int32                  `protobuf:"varint,1,opt,name=season,proto3" json:"season,omitempty"`
+	State           WorldState             `protobuf:"varint,2,opt,name=state,proto3,enum=cityio.entity.v1.WorldState" json:"state,omitempty"`
+	SeasonStartedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=season_started_at,json=seasonStartedAt,proto3" json:"season_started_at,omitempty"`
+	SeasonEndsAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=season_ends_at,json=seasonEndsAt,proto3" json:"season_ends_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *World) Reset() {
+	*x = World{}
+	mi := &file_cityio_entity_v1_world_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *World) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*World) ProtoMessage() {}
+
+func (x *World) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_entity_v1_world_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use World.ProtoReflect.Descriptor instead.
+func (*World) Descriptor() ([]byte, []int) {
+	return file_cityio_entity_v1_world_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *World) GetSeason() int32 {
+	if x != nil {
+		return x.Season
+	}
+	return 0
+}
+
+func (x *World) GetState() WorldState {
+	if x != nil {
+		return x.State
+	}
+	return WorldState_WORLD_STATE_UNSPECIFIED
+}
+
+func (x *World) GetSeasonStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SeasonStartedAt
+	}
+	return nil
+}
+
+func (x *World) GetSeasonEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SeasonEndsAt
+	}
+	return nil
+}
+
+// Season is a finished, archived season.
+type Season struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Season        int32                  `protobuf:"varint,1,opt,name=season,proto3" json:"season,omitempty"`
+	Seed          int64                  `protobuf:"varint,2,opt,name=seed,proto3" json:"seed,omitempty"`
+	EndReason     SeasonEndReason        `protobuf:"varint,3,opt,name=end_reason,json=endReason,proto3,enum=cityio.entity.v1.SeasonEndReason" json:"end_reason,omitempty"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	EndedAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Season) Reset() {
+	*x = Season{}
+	mi := &file_cityio_entity_v1_world_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Season) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Season) ProtoMessage() {}
+
+func (x *Season) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_entity_v1_world_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Season.ProtoReflect.Descriptor instead.
+func (*Season) Descriptor() ([]byte, []int) {
+	return file_cityio_entity_v1_world_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Season) GetSeason() int32 {
+	if x != nil {
+		return x.Season
+	}
+	return 0
+}
+
+func (x *Season) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *Season) GetEndReason() SeasonEndReason {
+	if x != nil {
+		return x.EndReason
+	}
+	return SeasonEndReason_SEASON_END_REASON_UNSPECIFIED
+}
+
+func (x *Season) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *Season) GetEndedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndedAt
+	}
+	return nil
+}
+
+// SeasonStanding is one player's final placing in an archived season, ranked
+// by the population they held when it ended.
+type SeasonStanding struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rank          int32                  `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	UserId        *UserId                `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Cities        int32                  `protobuf:"varint,4,opt,name=cities,proto3" json:"cities,omitempty"`
+	Population    float64                `protobuf:"fixed64,5,opt,name=population,proto3" json:"population,omitempty"`
+	Gold          int64                  `protobuf:"varint,6,opt,name=gold,proto3" json:"gold,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeasonStanding) Reset() {
+	*x = SeasonStanding{}
+	mi := &file_cityio_entity_v1_world_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeasonStanding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeasonStanding) ProtoMessage() {}
+
+func (x *SeasonStanding) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_entity_v1_world_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeasonStanding.ProtoReflect.Descriptor instead.
+func (*SeasonStanding) Descriptor() ([]byte, []int) {
+	return file_cityio_entity_v1_world_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SeasonStanding) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *SeasonStanding) GetUserId() *UserId {
+	if x != nil {
+		return x.UserId
+	}
+	return nil
+}
+
+func (x *SeasonStanding) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *SeasonStanding) GetCities() int32 {
+	if x != nil {
+		return x.Cities
+	}
+	return 0
+}
+
+func (x *SeasonStanding) GetPopulation() float64 {
+	if x != nil {
+		return x.Population
+	}
+	return 0
+}
+
+func (x *SeasonStanding) GetGold() int64 {
+	if x != nil {
+		return x.Gold
+	}
+	return 0
+}
+
+var File_cityio_entity_v1_world_proto protoreflect.FileDescriptor
+
+const file_cityio_entity_v1_world_proto_rawDesc = "" +
+	"\n" +
+	"\x1ccityio/entity/v1/world.proto\x12\x10cityio.entity.v1\x1a\x1dcityio/entity/v1/common.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xdd\x01\n" +
+	"\x05World\x12\x16\n" +
+	"\x06season\x18\x01 \x01(\x05R\x06season\x122\n" +
+	"\x05state\x18\x02 \x01(\x0e2\x1c.cityio.entity.v1.WorldStateR\x05state\x12F\n" +
+	"\x11season_started_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x0fseasonStartedAt\x12@\n" +
+	"\x0eseason_ends_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\fseasonEndsAt\"\xe8\x01\n" +
+	"\x06Season\x12\x16\n" +
+	"\x06season\x18\x01 \x01(\x05R\x06season\x12\x12\n" +
+	"\x04seed\x18\x02 \x01(\x03R\x04seed\x12@\n" +
+	"\n" +
+	"end_reason\x18\x03 \x01(\x0e2!.cityio.entity.v1.SeasonEndReasonR\tendReason\x129\n" +
+	"\n" +
+	"started_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x125\n" +
+	"\bended_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aendedAt\"\xbf\x01\n" +
+	"\x0eSeasonStanding\x12\x12\n" +
+	"\x04rank\x18\x01 \x01(\x05R\x04rank\x121\n" +
+	"\auser_id\x18\x02 \x01(\v2\x18.cityio.entity.v1.UserIdR\x06userId\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x16\n" +
+	"\x06cities\x18\x04 \x01(\x05R\x06cities\x12\x1e\n" +
+	"\n" +
+	"population\x18\x05 \x01(\x01R\n" +
+	"population\x12\x12\n" +
+	"\x04gold\x18\x06 \x01(\x03R\x04gold*Y\n" +
+	"\n" +
+	"WorldState\x12\x1b\n" +
+	"\x17WORLD_STATE_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12WORLD_STATE_ACTIVE\x10\x01\x12\x16\n" +
+	"\x12WORLD_STATE_ENDING\x10\x02*p\n" +
+	"\x0fSeasonEndReason\x12!\n" +
+	"\x1dSEASON_END_REASON_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17SEASON_END_REASON_ADMIN\x10\x01\x12\x1d\n" +
+	"\x19SEASON_END_REASON_EXPIRED\x10\x02B\xb3\x01\n" +
+	"\x14com.cityio.entity.v1B\n" +
+	"WorldProtoP\x01Z-cityio/internal/gen/cityio/entity/v1;entityv1\xa2\x02\x03CEX\xaa\x02\x10Cityio.Entity.V1\xca\x02\x10Cityio\\Entity\\V1\xe2\x02\x1cCityio\\Entity\\V1\\GPBMetadata\xea\x02\x12Cityio::Entity::V1b\x06proto3"
+
+var (
+	file_cityio_entity_v1_world_proto_rawDescOnce sync.Once
+	file_cityio_entity_v1_world_proto_rawDescData []byte
+)
+
+func file_cityio_entity_v1_world_proto_rawDescGZIP() []byte {
+	file_cityio_entity_v1_world_proto_rawDescOnce.Do(func() {
+		file_cityio_entity_v1_world_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cityio_entity_v1_world_proto_rawDesc), len(file_cityio_entity_v1_world_proto_rawDesc)))
+	})
+	return file_cityio_entity_v1_world_proto_rawDescData
+}
+
+var file_cityio_entity_v1_world_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_cityio_entity_v1_world_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_cityio_entity_v1_world_proto_goTypes = []any{
+	(WorldState)(0),               // 0: cityio.entity.v1.WorldState
+	(SeasonEndReason)(0),          // 1: cityio.entity.v1.SeasonEndReason
+	(*World)(nil),                 // 2: cityio.entity.v1.World
+	(*Season)(nil),                // 3: cityio.entity.v1.Season
+	(*SeasonStanding)(nil),        // 4: cityio.entity.v1.SeasonStanding
+	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
+	(*UserId)(nil),                // 6: cityio.entity.v1.UserId
+}
+var file_cityio_entity_v1_world_proto_depIdxs = []int32{
+	0, // 0: cityio.entity.v1.World.state:type_name -> cityio.entity.v1.WorldState
+	5, // 1: cityio.entity.v1.World.season_started_at:type_name -> google.protobuf.Timestamp
+	5, // 2: cityio.entity.v1.World.season_ends_at:type_name -> google.protobuf.Timestamp
+	1, // 3: cityio.entity.v1.Season.end_reason:type_name -> cityio.entity.v1.SeasonEndReason
+	5, // 4: cityio.entity.v1.Season.started_at:type_name -> google.protobuf.Timestamp
+	5, // 5: cityio.entity.v1.Season.ended_at:type_name -> google.protobuf.Timestamp
+	6, // 6: cityio.entity.v1.SeasonStanding.user_id:type_name -> cityio.entity.v1.UserId
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_cityio_entity_v1_world_proto_init() }
+func file_cityio_entity_v1_world_proto_init() {
+	if File_cityio_entity_v1_world_proto != nil {
+		return
+	}
+	file_cityio_entity_v1_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cityio_entity_v1_world_proto_rawDesc), len(file_cityio_entity_v1_world_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cityio_entity_v1_world_proto_goTypes,
+		DependencyIndexes: file_cityio_entity_v1_world_proto_depIdxs,
+		EnumInfos:         file_cityio_entity_v1_world_proto_enumTypes,
+		MessageInfos:      file_cityio_entity_v1_world_proto_msgTypes,
+	}.Build()
+	File_cityio_entity_v1_world_proto = out.File
+	file_cityio_entity_v1_world_proto_goTypes = nil
+	file_cityio_entity_v1_world_proto_depIdxs = nil
+}
@@ -0,0 +1,466 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: cityio/service/v1/admin.proto
+
+package servicev1
+
+import (
+	v1 "cityio/internal/gen/cityio/entity/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EndSeasonRequest ends the current season. The server shuts down once the
+// season is marked, exiting with status 3; the next start archives it and
+// generates a new world, so the server must run under a restart policy.
+type EndSeasonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EndSeasonRequest) Reset() {
+	*x = EndSeasonRequest{}
+	mi := &file_cityio_service_v1_admin_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndSeasonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndSeasonRequest) ProtoMessage() {}
+
+func (x *EndSeasonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_admin_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndSeasonRequest.ProtoReflect.Descriptor instead.
+func (*EndSeasonRequest) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_admin_proto_rawDescGZIP(), []int{0}
+}
+
+type EndSeasonResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	World         *v1.World              `protobuf:"bytes,1,opt,name=world,proto3" json:"world,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EndSeasonResponse) Reset() {
+	*x = EndSeasonResponse{}
+	mi := &file_cityio_service_v1_admin_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndSeasonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndSeasonResponse) ProtoMessage() {}
+
+func (x *EndSeasonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_admin_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndSeasonResponse.ProtoReflect.Descriptor instead.
+func (*EndSeasonResponse) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_admin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EndSeasonResponse) GetWorld() *v1.World {
+	if x != nil {
+		return x.World
+	}
+	return nil
+}
+
+// ReloadBalanceRequest rereads the balance and building definition files,
+// as SIGHUP does. Invalid files are rejected and the current values kept.
+type ReloadBalanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReloadBalanceRequest) Reset() {
+	*x = ReloadBalanceRequest{}
+	mi := &file_cityio_service_v1_admin_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReloadBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadBalanceRequest) ProtoMessage() {}
+
+func (x *ReloadBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_admin_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadBalanceRequest.ProtoReflect.Descriptor instead.
+func (*ReloadBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_admin_proto_rawDescGZIP(), []int{2}
+}
+
+type ReloadBalanceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// version is the config version GetGameConfig now reports.
+	Version       string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReloadBalanceResponse) Reset() {
+	*x = ReloadBalanceResponse{}
+	mi := &file_cityio_service_v1_admin_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReloadBalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadBalanceResponse) ProtoMessage() {}
+
+func (x *ReloadBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_admin_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadBalanceResponse.ProtoReflect.Descriptor instead.
+func (*ReloadBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_admin_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ReloadBalanceResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+// MuteUserRequest bars a player from chat for duration, replacing any mute
+// they already have.
+type MuteUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *v1.UserId             `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Duration      *durationpb.Duration   `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MuteUserRequest) Reset() {
+	*x = MuteUserRequest{}
+	mi := &file_cityio_service_v1_admin_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MuteUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteUserRequest) ProtoMessage() {}
+
+func (x *MuteUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_admin_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteUserRequest.ProtoReflect.Descriptor instead.
+func (*MuteUserRequest) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_admin_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MuteUserRequest) GetUserId() *v1.UserId {
+	if x != nil {
+		return x.UserId
+	}
+	return nil
+}
+
+func (x *MuteUserRequest) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *MuteUserRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type MuteUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MuteUserResponse) Reset() {
+	*x = MuteUserResponse{}
+	mi := &file_cityio_service_v1_admin_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MuteUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteUserResponse) ProtoMessage() {}
+
+func (x *MuteUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_admin_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteUserResponse.ProtoReflect.Descriptor instead.
+func (*MuteUserResponse) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_admin_proto_rawDescGZIP(), []int{5}
+}
+
+type UnmuteUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *v1.UserId             `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnmuteUserRequest) Reset() {
+	*x = UnmuteUserRequest{}
+	mi := &file_cityio_service_v1_admin_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnmuteUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnmuteUserRequest) ProtoMessage() {}
+
+func (x *UnmuteUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_admin_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnmuteUserRequest.ProtoReflect.Descriptor instead.
+func (*UnmuteUserRequest) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_admin_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UnmuteUserRequest) GetUserId() *v1.UserId {
+	if x != nil {
+		return x.UserId
+	}
+	return nil
+}
+
+type UnmuteUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnmuteUserResponse) Reset() {
+	*x = UnmuteUserResponse{}
+	mi := &file_cityio_service_v1_admin_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnmuteUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnmuteUserResponse) ProtoMessage() {}
+
+func (x *UnmuteUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_admin_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnmuteUserResponse.ProtoReflect.Descriptor instead.
+func (*UnmuteUserResponse) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_admin_proto_rawDescGZIP(), []int{7}
+}
+
+var File_cityio_service_v1_admin_proto protoreflect.FileDescriptor
+
+const file_cityio_service_v1_admin_proto_rawDesc = "" +
+	"\n" +
+	"\x1dcityio/service/v1/admin.proto\x12\x11cityio.service.v1\x1a\x1dcityio/entity/v1/common.proto\x1a\x1ccityio/entity/v1/world.proto\x1a\x1egoogle/protobuf/duration.proto\"\x12\n" +
+	"\x10EndSeasonRequest\"B\n" +
+	"\x11EndSeasonResponse\x12-\n" +
+	"\x05world\x18\x01 \x01(\v2\x17.cityio.entity.v1.WorldR\x05world\"\x16\n" +
+	"\x14ReloadBalanceRequest\"1\n" +
+	"\x15ReloadBalanceResponse\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\"\x93\x01\n" +
+	"\x0fMuteUserRequest\x121\n" +
+	"\auser_id\x18\x01 \x01(\v2\x18.cityio.entity.v1.UserIdR\x06userId\x125\n" +
+	"\bduration\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\bduration\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\x12\n" +
+	"\x10MuteUserResponse\"F\n" +
+	"\x11UnmuteUserRequest\x121\n" +
+	"\auser_id\x18\x01 \x01(\v2\x18.cityio.entity.v1.UserIdR\x06userId\"\x14\n" +
+	"\x12UnmuteUserResponse2\xfa\x02\n" +
+	"\fAdminService\x12V\n" +
+	"\tEndSeason\x12#.cityio.service.v1.EndSeasonRequest\x1a$.cityio.service.v1.EndSeasonResponse\x12b\n" +
+	"\rReloadBalance\x12'.cityio.service.v1.ReloadBalanceRequest\x1a(.cityio.service.v1.ReloadBalanceResponse\x12S\n" +
+	"\bMuteUser\x12\".cityio.service.v1.MuteUserRequest\x1a#.cityio.service.v1.MuteUserResponse\x12Y\n" +
+	"\n" +
+	"UnmuteUser\x12$.cityio.service.v1.UnmuteUserRequest\x1a%.cityio.service.v1.UnmuteUserResponseB\xba\x01\n" +
+	"\x15com.cityio.service.v1B\n" +
+	"AdminProtoP\x01Z/cityio/internal/gen/cityio/service/v1;servicev1\xa2\x02\x03CSX\xaa\x02\x11Cityio.Service.V1\xca\x02\x11Cityio\\Service\\V1\xe2\x02\x1dCityio\\Service\\V1\\GPBMetadata\xea\x02\x13Cityio::Service::V1b\x06proto3"
+
+var (
+	file_cityio_service_v1_admin_proto_rawDescOnce sync.Once
+	file_cityio_service_v1_admin_proto_rawDescData []byte
+)
+
+func file_cityio_service_v1_admin_proto_rawDescGZIP() []byte {
+	file_cityio_service_v1_admin_proto_rawDescOnce.Do(func() {
+		file_cityio_service_v1_admin_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cityio_service_v1_admin_proto_rawDesc), len(file_cityio_service_v1_admin_proto_rawDesc)))
+	})
+	return file_cityio_service_v1_admin_proto_rawDescData
+}
+
+var file_cityio_service_v1_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_cityio_service_v1_admin_proto_goTypes = []any{
+	(*EndSeasonRequest)(nil),      // 0: cityio.service.v1.EndSeasonRequest
+	(*EndSeasonResponse)(nil),     // 1: cityio.service.v1.EndSeasonResponse
+	(*ReloadBalanceRequest)(nil),  // 2: cityio.service.v1.ReloadBalanceRequest
+	(*ReloadBalanceResponse)(nil), // 3: cityio.service.v1.ReloadBalanceResponse
+	(*MuteUserRequest)(nil),       // 4: cityio.service.v1.MuteUserRequest
+	(*MuteUserResponse)(nil),      // 5: cityio.service.v1.MuteUserResponse
+	(*UnmuteUserRequest)(nil),     // 6: cityio.service.v1.UnmuteUserRequest
+	(*UnmuteUserResponse)(nil),    // 7: cityio.service.v1.UnmuteUserResponse
+	(*v1.World)(nil),              // 8: cityio.entity.v1.World
+	(*v1.UserId)(nil),             // 9: cityio.entity.v1.UserId
+	(*durationpb.Duration)(nil),   // 10: google.protobuf.Duration
+}
+var file_cityio_service_v1_admin_proto_depIdxs = []int32{
+	8,  // 0: cityio.service.v1.EndSeasonResponse.world:type_name -> cityio.entity.v1.World
+	9,  // 1: cityio.service.v1.MuteUserRequest.user_id:type_name -> cityio.entity.v1.UserId
+	10, // 2: cityio.service.v1.MuteUserRequest.duration:type_name -> google.protobuf.Duration
+	9,  // 3: cityio.service.v1.UnmuteUserRequest.user_id:type_name -> cityio.entity.v1.UserId
+	0,  // 4: cityio.service.v1.AdminService.EndSeason:input_type -> cityio.service.v1.EndSeasonRequest
+	2,  // 5: cityio.service.v1.AdminService.ReloadBalance:input_type -> cityio.service.v1.ReloadBalanceRequest
+	4,  // 6: cityio.service.v1.AdminService.MuteUser:input_type -> cityio.service.v1.MuteUserRequest
+	6,  // 7: cityio.service.v1.AdminService.UnmuteUser:input_type -> cityio.service.v1.UnmuteUserRequest
+	1,  // 8: cityio.service.v1.AdminService.EndSeason:output_type -> cityio.service.v1.EndSeasonResponse
+	3,  // 9: cityio.service.v1.AdminService.ReloadBalance:output_type -> cityio.service.v1.ReloadBalanceResponse
+	5,  // 10: cityio.service.v1.AdminService.MuteUser:output_type -> cityio.service.v1.MuteUserResponse
+	7,  // 11: cityio.service.v1.AdminService.UnmuteUser:output_type -> cityio.service.v1.UnmuteUserResponse
+	8,  // [8:12] is the sub-list for method output_type
+	4,  // [4:8] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_cityio_service_v1_admin_proto_init() }
+func file_cityio_service_v1_admin_proto_init() {
+	if File_cityio_service_v1_admin_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cityio_service_v1_admin_proto_rawDesc), len(file_cityio_service_v1_admin_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_cityio_service_v1_admin_proto_goTypes,
+		DependencyIndexes: file_cityio_service_v1_admin_proto_depIdxs,
+		MessageInfos:      file_cityio_service_v1_admin_proto_msgTypes,
+	}.Build()
+	File_cityio_service_v1_admin_proto = out.File
+	file_cityio_service_v1_admin_proto_goTypes = nil
+	file_cityio_service_v1_admin_proto_depIdxs = nil
+}
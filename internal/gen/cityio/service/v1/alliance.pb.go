@@ -0,0 +1,849 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: cityio/service/v1/alliance.proto
+
+package servicev1
+
+import (
+	v1 "cityio/internal/gen/cityio/entity/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateAllianceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAllianceRequest) Reset() {
+	*x = CreateAllianceRequest{}
+	mi := &file_cityio_service_v1_alliance_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAllianceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAllianceRequest) ProtoMessage() {}
+
+func (x *CreateAllianceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_alliance_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAllianceRequest.ProtoReflect.Descriptor instead.
+func (*CreateAllianceRequest) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_alliance_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateAllianceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateAllianceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alliance      *v1.Alliance           `protobuf:"bytes,1,opt,name=alliance,proto3" json:"alliance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAllianceResponse) Reset() {
+	*x = CreateAllianceResponse{}
+	mi := &file_cityio_service_v1_alliance_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAllianceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAllianceResponse) ProtoMessage() {}
+
+func (x *CreateAllianceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_alliance_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAllianceResponse.ProtoReflect.Descriptor instead.
+func (*CreateAllianceResponse) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_alliance_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateAllianceResponse) GetAlliance() *v1.Alliance {
+	if x != nil {
+		return x.Alliance
+	}
+	return nil
+}
+
+type GetAllianceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// alliance_id defaults to the caller's own alliance when unset.
+	AllianceId    *v1.AllianceId `protobuf:"bytes,1,opt,name=alliance_id,json=allianceId,proto3" json:"alliance_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllianceRequest) Reset() {
+	*x = GetAllianceRequest{}
+	mi := &file_cityio_service_v1_alliance_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllianceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllianceRequest) ProtoMessage() {}
+
+func (x *GetAllianceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_alliance_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllianceRequest.ProtoReflect.Descriptor instead.
+func (*GetAllianceRequest) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_alliance_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetAllianceRequest) GetAllianceId() *v1.AllianceId {
+	if x != nil {
+		return x.AllianceId
+	}
+	return nil
+}
+
+type GetAllianceResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Alliance *v1.Alliance           `protobuf:"bytes,1,opt,name=alliance,proto3" json:"alliance,omitempty"`
+	// invites are the alliance's standing invites, shown to its members only.
+	Invites       []*v1.AllianceInvite `protobuf:"bytes,2,rep,name=invites,proto3" json:"invites,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllianceResponse) Reset() {
+	*x = GetAllianceResponse{}
+	mi := &file_cityio_service_v1_alliance_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllianceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllianceResponse) ProtoMessage() {}
+
+func (x *GetAllianceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_alliance_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllianceResponse.ProtoReflect.Descriptor instead.
+func (*GetAllianceResponse) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_alliance_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetAllianceResponse) GetAlliance() *v1.Alliance {
+	if x != nil {
+		return x.Alliance
+	}
+	return nil
+}
+
+func (x *GetAllianceResponse) GetInvites() []*v1.AllianceInvite {
+	if x != nil {
+		return x.Invites
+	}
+	return nil
+}
+
+type InviteToAllianceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *v1.UserId             `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteToAllianceRequest) Reset() {
+	*x = InviteToAllianceRequest{}
+	mi := &file_cityio_service_v1_alliance_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteToAllianceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteToAllianceRequest) ProtoMessage() {}
+
+func (x *InviteToAllianceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_alliance_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteToAllianceRequest.ProtoReflect.Descriptor instead.
+func (*InviteToAllianceRequest) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_alliance_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *InviteToAllianceRequest) GetUserId() *v1.UserId {
+	if x != nil {
+		return x.UserId
+	}
+	return nil
+}
+
+type InviteToAllianceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invite        *v1.AllianceInvite     `protobuf:"bytes,1,opt,name=invite,proto3" json:"invite,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteToAllianceResponse) Reset() {
+	*x = InviteToAllianceResponse{}
+	mi := &file_cityio_service_v1_alliance_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteToAllianceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteToAllianceResponse) ProtoMessage() {}
+
+func (x *InviteToAllianceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_alliance_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteToAllianceResponse.ProtoReflect.Descriptor instead.
+func (*InviteToAllianceResponse) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_alliance_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *InviteToAllianceResponse) GetInvite() *v1.AllianceInvite {
+	if x != nil {
+		return x.Invite
+	}
+	return nil
+}
+
+type ListAllianceInvitesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAllianceInvitesRequest) Reset() {
+	*x = ListAllianceInvitesRequest{}
+	mi := &file_cityio_service_v1_alliance_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAllianceInvitesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllianceInvitesRequest) ProtoMessage() {}
+
+func (x *ListAllianceInvitesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_alliance_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllianceInvitesRequest.ProtoReflect.Descriptor instead.
+func (*ListAllianceInvitesRequest) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_alliance_proto_rawDescGZIP(), []int{6}
+}
+
+type ListAllianceInvitesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// invites are the caller's standing invites from any alliance.
+	Invites       []*v1.AllianceInvite `protobuf:"bytes,1,rep,name=invites,proto3" json:"invites,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAllianceInvitesResponse) Reset() {
+	*x = ListAllianceInvitesResponse{}
+	mi := &file_cityio_service_v1_alliance_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAllianceInvitesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllianceInvitesResponse) ProtoMessage() {}
+
+func (x *ListAllianceInvitesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_alliance_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllianceInvitesResponse.ProtoReflect.Descriptor instead.
+func (*ListAllianceInvitesResponse) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_alliance_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListAllianceInvitesResponse) GetInvites() []*v1.AllianceInvite {
+	if x != nil {
+		return x.Invites
+	}
+	return nil
+}
+
+type AcceptAllianceInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AllianceId    *v1.AllianceId         `protobuf:"bytes,1,opt,name=alliance_id,json=allianceId,proto3" json:"alliance_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptAllianceInviteRequest) Reset() {
+	*x = AcceptAllianceInviteRequest{}
+	mi := &file_cityio_service_v1_alliance_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptAllianceInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptAllianceInviteRequest) ProtoMessage() {}
+
+func (x *AcceptAllianceInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_alliance_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptAllianceInviteRequest.ProtoReflect.Descriptor instead.
+func (*AcceptAllianceInviteRequest) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_alliance_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AcceptAllianceInviteRequest) GetAllianceId() *v1.AllianceId {
+	if x != nil {
+		return x.AllianceId
+	}
+	return nil
+}
+
+type AcceptAllianceInviteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alliance      *v1.Alliance           `protobuf:"bytes,1,opt,name=alliance,proto3" json:"alliance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptAllianceInviteResponse) Reset() {
+	*x = AcceptAllianceInviteResponse{}
+	mi := &file_cityio_service_v1_alliance_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptAllianceInviteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptAllianceInviteResponse) ProtoMessage() {}
+
+func (x *AcceptAllianceInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_alliance_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptAllianceInviteResponse.ProtoReflect.Descriptor instead.
+func (*AcceptAllianceInviteResponse) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_alliance_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AcceptAllianceInviteResponse) GetAlliance() *v1.Alliance {
+	if x != nil {
+		return x.Alliance
+	}
+	return nil
+}
+
+type LeaveAllianceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveAllianceRequest) Reset() {
+	*x = LeaveAllianceRequest{}
+	mi := &file_cityio_service_v1_alliance_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveAllianceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveAllianceRequest) ProtoMessage() {}
+
+func (x *LeaveAllianceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_alliance_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveAllianceRequest.ProtoReflect.Descriptor instead.
+func (*LeaveAllianceRequest) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_alliance_proto_rawDescGZIP(), []int{10}
+}
+
+type LeaveAllianceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveAllianceResponse) Reset() {
+	*x = LeaveAllianceResponse{}
+	mi := &file_cityio_service_v1_alliance_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveAllianceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveAllianceResponse) ProtoMessage() {}
+
+func (x *LeaveAllianceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_alliance_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveAllianceResponse.ProtoReflect.Descriptor instead.
+func (*LeaveAllianceResponse) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_alliance_proto_rawDescGZIP(), []int{11}
+}
+
+type KickAllianceMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *v1.UserId             `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KickAllianceMemberRequest) Reset() {
+	*x = KickAllianceMemberRequest{}
+	mi := &file_cityio_service_v1_alliance_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KickAllianceMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickAllianceMemberRequest) ProtoMessage() {}
+
+func (x *KickAllianceMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_alliance_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickAllianceMemberRequest.ProtoReflect.Descriptor instead.
+func (*KickAllianceMemberRequest) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_alliance_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *KickAllianceMemberRequest) GetUserId() *v1.UserId {
+	if x != nil {
+		return x.UserId
+	}
+	return nil
+}
+
+type KickAllianceMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KickAllianceMemberResponse) Reset() {
+	*x = KickAllianceMemberResponse{}
+	mi := &file_cityio_service_v1_alliance_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KickAllianceMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickAllianceMemberResponse) ProtoMessage() {}
+
+func (x *KickAllianceMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_alliance_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickAllianceMemberResponse.ProtoReflect.Descriptor instead.
+func (*KickAllianceMemberResponse) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_alliance_proto_rawDescGZIP(), []int{13}
+}
+
+type SetAllianceRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *v1.UserId             `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          v1.AllianceRole        `protobuf:"varint,2,opt,name=role,proto3,enum=cityio.entity.v1.AllianceRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAllianceRoleRequest) Reset() {
+	*x = SetAllianceRoleRequest{}
+	mi := &file_cityio_service_v1_alliance_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAllianceRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAllianceRoleRequest) ProtoMessage() {}
+
+func (x *SetAllianceRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_alliance_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAllianceRoleRequest.ProtoReflect.Descriptor instead.
+func (*SetAllianceRoleRequest) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_alliance_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SetAllianceRoleRequest) GetUserId() *v1.UserId {
+	if x != nil {
+		return x.UserId
+	}
+	return nil
+}
+
+func (x *SetAllianceRoleRequest) GetRole() v1.AllianceRole {
+	if x != nil {
+		return x.Role
+	}
+	return v1.AllianceRole(0)
+}
+
+type SetAllianceRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAllianceRoleResponse) Reset() {
+	*x = SetAllianceRoleResponse{}
+	mi := &file_cityio_service_v1_alliance_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAllianceRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAllianceRoleResponse) ProtoMessage() {}
+
+func (x *SetAllianceRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_alliance_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAllianceRoleResponse.ProtoReflect.Descriptor instead.
+func (*SetAllianceRoleResponse) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_alliance_proto_rawDescGZIP(), []int{15}
+}
+
+var File_cityio_service_v1_alliance_proto protoreflect.FileDescriptor
+
+const file_cityio_service_v1_alliance_proto_rawDesc = "" +
+	"\n" +
+	" cityio/service/v1/alliance.proto\x12\x11cityio.service.v1\x1a\x1fcityio/entity/v1/alliance.proto\x1a\x1dcityio/entity/v1/common.proto\"+\n" +
+	"\x15CreateAllianceRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"P\n" +
+	"\x16CreateAllianceResponse\x126\n" +
+	"\balliance\x18\x01 \x01(\v2\x1a.cityio.entity.v1.AllianceR\balliance\"S\n" +
+	"\x12GetAllianceRequest\x12=\n" +
+	"\valliance_id\x18\x01 \x01(\v2\x1c.cityio.entity.v1.AllianceIdR\n" +
+	"allianceId\"\x89\x01\n" +
+	"\x13GetAllianceResponse\x126\n" +
+	"\balliance\x18\x01 \x01(\v2\x1a.cityio.entity.v1.AllianceR\balliance\x12:\n" +
+	"\ainvites\x18\x02 \x03(\v2 .cityio.entity.v1.AllianceInviteR\ainvites\"L\n" +
+	"\x17InviteToAllianceRequest\x121\n" +
+	"\auser_id\x18\x01 \x01(\v2\x18.cityio.entity.v1.UserIdR\x06userId\"T\n" +
+	"\x18InviteToAllianceResponse\x128\n" +
+	"\x06invite\x18\x01 \x01(\v2 .cityio.entity.v1.AllianceInviteR\x06invite\"\x1c\n" +
+	"\x1aListAllianceInvitesRequest\"Y\n" +
+	"\x1bListAllianceInvitesResponse\x12:\n" +
+	"\ainvites\x18\x01 \x03(\v2 .cityio.entity.v1.AllianceInviteR\ainvites\"\\\n" +
+	"\x1bAcceptAllianceInviteRequest\x12=\n" +
+	"\valliance_id\x18\x01 \x01(\v2\x1c.cityio.entity.v1.AllianceIdR\n" +
+	"allianceId\"V\n" +
+	"\x1cAcceptAllianceInviteResponse\x126\n" +
+	"\balliance\x18\x01 \x01(\v2\x1a.cityio.entity.v1.AllianceR\balliance\"\x16\n" +
+	"\x14LeaveAllianceRequest\"\x17\n" +
+	"\x15LeaveAllianceResponse\"N\n" +
+	"\x19KickAllianceMemberRequest\x121\n" +
+	"\auser_id\x18\x01 \x01(\v2\x18.cityio.entity.v1.UserIdR\x06userId\"\x1c\n" +
+	"\x1aKickAllianceMemberResponse\"\x7f\n" +
+	"\x16SetAllianceRoleRequest\x121\n" +
+	"\auser_id\x18\x01 \x01(\v2\x18.cityio.entity.v1.UserIdR\x06userId\x122\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x1e.cityio.entity.v1.AllianceRoleR\x04role\"\x19\n" +
+	"\x17SetAllianceRoleResponse2\xf3\x06\n" +
+	"\x0fAllianceService\x12e\n" +
+	"\x0eCreateAlliance\x12(.cityio.service.v1.CreateAllianceRequest\x1a).cityio.service.v1.CreateAllianceResponse\x12\\\n" +
+	"\vGetAlliance\x12%.cityio.service.v1.GetAllianceRequest\x1a&.cityio.service.v1.GetAllianceResponse\x12k\n" +
+	"\x10InviteToAlliance\x12*.cityio.service.v1.InviteToAllianceRequest\x1a+.cityio.service.v1.InviteToAllianceResponse\x12t\n" +
+	"\x13ListAllianceInvites\x12-.cityio.service.v1.ListAllianceInvitesRequest\x1a..cityio.service.v1.ListAllianceInvitesResponse\x12w\n" +
+	"\x14AcceptAllianceInvite\x12..cityio.service.v1.AcceptAllianceInviteRequest\x1a/.cityio.service.v1.AcceptAllianceInviteResponse\x12b\n" +
+	"\rLeaveAlliance\x12'.cityio.service.v1.LeaveAllianceRequest\x1a(.cityio.service.v1.LeaveAllianceResponse\x12q\n" +
+	"\x12KickAllianceMember\x12,.cityio.service.v1.KickAllianceMemberRequest\x1a-.cityio.service.v1.KickAllianceMemberResponse\x12h\n" +
+	"\x0fSetAllianceRole\x12).cityio.service.v1.SetAllianceRoleRequest\x1a*.cityio.service.v1.SetAllianceRoleResponseB\xbd\x01\n" +
+	"\x15com.cityio.service.v1B\rAllianceProtoP\x01Z/cityio/internal/gen/cityio/service/v1;servicev1\xa2\x02\x03CSX\xaa\x02\x11Cityio.Service.V1\xca\x02\x11Cityio\\Service\\V1\xe2\x02\x1dCityio\\Service\\V1\\GPBMetadata\xea\x02\x13Cityio::Service::V1b\x06proto3"
+
+var (
+	file_cityio_service_v1_alliance_proto_rawDescOnce sync.Once
+	file_cityio_service_v1_alliance_proto_rawDescData []byte
+)
+
+func file_cityio_service_v1_alliance_proto_rawDescGZIP() []byte {
+	file_cityio_service_v1_alliance_proto_rawDescOnce.Do(func() {
+		file_cityio_service_v1_alliance_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cityio_service_v1_alliance_proto_rawDesc), len(file_cityio_service_v1_alliance_proto_rawDesc)))
+	})
+	return file_cityio_service_v1_alliance_proto_rawDescData
+}
+
+var file_cityio_service_v1_alliance_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_cityio_service_v1_alliance_proto_goTypes = []any{
+	(*CreateAllianceRequest)(nil),        // 0: cityio.service.v1.CreateAllianceRequest
+	(*CreateAllianceResponse)(nil),       // 1: cityio.service.v1.CreateAllianceResponse
+	(*GetAllianceRequest)(nil),           // 2: cityio.service.v1.GetAllianceRequest
+	(*GetAllianceResponse)(nil),          // 3: cityio.service.v1.GetAllianceResponse
+	(*InviteToAllianceRequest)(nil),      // 4: cityio.service.v1.InviteToAllianceRequest
+	(*InviteToAllianceResponse)(nil),     // 5: cityio.service.v1.InviteToAllianceResponse
+	(*ListAllianceInvitesRequest)(nil),   // 6: cityio.service.v1.ListAllianceInvitesRequest
+	(*ListAllianceInvitesResponse)(nil),  // 7: cityio.service.v1.ListAllianceInvitesResponse
+	(*AcceptAllianceInviteRequest)(nil),  // 8: cityio.service.v1.AcceptAllianceInviteRequest
+	(*AcceptAllianceInviteResponse)(nil), // 9: cityio.service.v1.AcceptAllianceInviteResponse
+	(*LeaveAllianceRequest)(nil),         // 10: cityio.service.v1.LeaveAllianceRequest
+	(*LeaveAllianceResponse)(nil),        // 11: cityio.service.v1.LeaveAllianceResponse
+	(*KickAllianceMemberRequest)(nil),    // 12: cityio.service.v1.KickAllianceMemberRequest
+	(*KickAllianceMemberResponse)(nil),   // 13: cityio.service.v1.KickAllianceMemberResponse
+	(*SetAllianceRoleRequest)(nil),       // 14: cityio.service.v1.SetAllianceRoleRequest
+	(*SetAllianceRoleResponse)(nil),      // 15: cityio.service.v1.SetAllianceRoleResponse
+	(*v1.Alliance)(nil),                  // 16: cityio.entity.v1.Alliance
+	(*v1.AllianceId)(nil),                // 17: cityio.entity.v1.AllianceId
+	(*v1.AllianceInvite)(nil),            // 18: cityio.entity.v1.AllianceInvite
+	(*v1.UserId)(nil),                    // 19: cityio.entity.v1.UserId
+	(v1.AllianceRole)(0),                 // 20: cityio.entity.v1.AllianceRole
+}
+var file_cityio_service_v1_alliance_proto_depIdxs = []int32{
+	16, // 0: cityio.service.v1.CreateAllianceResponse.alliance:type_name -> cityio.entity.v1.Alliance
+	17, // 1: cityio.service.v1.GetAllianceRequest.alliance_id:type_name -> cityio.entity.v1.AllianceId
+	16, // 2: cityio.service.v1.GetAllianceResponse.alliance:type_name -> cityio.entity.v1.Alliance
+	18, // 3: cityio.service.v1.GetAllianceResponse.invites:type_name -> cityio.entity.v1.AllianceInvite
+	19, // 4: cityio.service.v1.InviteToAllianceRequest.user_id:type_name -> cityio.entity.v1.UserId
+	18, // 5: cityio.service.v1.InviteToAllianceResponse.invite:type_name -> cityio.entity.v1.AllianceInvite
+	18, // 6: cityio.service.v1.ListAllianceInvitesResponse.invites:type_name -> cityio.entity.v1.AllianceInvite
+	17, // 7: cityio.service.v1.AcceptAllianceInviteRequest.alliance_id:type_name -> cityio.entity.v1.AllianceId
+	16, // 8: cityio.service.v1.AcceptAllianceInviteResponse.alliance:type_name -> cityio.entity.v1.Alliance
+	19, // 9: cityio.service.v1.KickAllianceMemberRequest.user_id:type_name -> cityio.entity.v1.UserId
+	19, // 10: cityio.service.v1.SetAllianceRoleRequest.user_id:type_name -> cityio.entity.v1.UserId
+	20, // 11: cityio.service.v1.SetAllianceRoleRequest.role:type_name -> cityio.entity.v1.AllianceRole
+	0,  // 12: cityio.service.v1.AllianceService.CreateAlliance:input_type -> cityio.service.v1.CreateAllianceRequest
+	2,  // 13: cityio.service.v1.AllianceService.GetAlliance:input_type -> cityio.service.v1.GetAllianceRequest
+	4,  // 14: cityio.service.v1.AllianceService.InviteToAlliance:input_type -> cityio.service.v1.InviteToAllianceRequest
+	6,  // 15: cityio.service.v1.AllianceService.ListAllianceInvites:input_type -> cityio.service.v1.ListAllianceInvitesRequest
+	8,  // 16: cityio.service.v1.AllianceService.AcceptAllianceInvite:input_type -> cityio.service.v1.AcceptAllianceInviteRequest
+	10, // 17: cityio.service.v1.AllianceService.LeaveAlliance:input_type -> cityio.service.v1.LeaveAllianceRequest
+	12, // 18: cityio.service.v1.AllianceService.KickAllianceMember:input_type -> cityio.service.v1.KickAllianceMemberRequest
+	14, // 19: cityio.service.v1.AllianceService.SetAllianceRole:input_type -> cityio.service.v1.SetAllianceRoleRequest
+	1,  // 20: cityio.service.v1.AllianceService.CreateAlliance:output_type -> cityio.service.v1.CreateAllianceResponse
+	3,  // 21: cityio.service.v1.AllianceService.GetAlliance:output_type -> cityio.service.v1.GetAllianceResponse
+	5,  // 22: cityio.service.v1.AllianceService.InviteToAlliance:output_type -> cityio.service.v1.InviteToAllianceResponse
+	7,  // 23: cityio.service.v1.AllianceService.ListAllianceInvites:output_type -> cityio.service.v1.ListAllianceInvitesResponse
+	9,  // 24: cityio.service.v1.AllianceService.AcceptAllianceInvite:output_type -> cityio.service.v1.AcceptAllianceInviteResponse
+	11, // 25: cityio.service.v1.AllianceService.LeaveAlliance:output_type -> cityio.service.v1.LeaveAllianceResponse
+	13, // 26: cityio.service.v1.AllianceService.KickAllianceMember:output_type -> cityio.service.v1.KickAllianceMemberResponse
+	15, // 27: cityio.service.v1.AllianceService.SetAllianceRole:output_type -> cityio.service.v1.SetAllianceRoleResponse
+	20, // [20:28] is the sub-list for method output_type
+	12, // [12:20] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_cityio_service_v1_alliance_proto_init() }
+func file_cityio_service_v1_alliance_proto_init() {
+	if File_cityio_service_v1_alliance_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cityio_service_v1_alliance_proto_rawDesc), len(file_cityio_service_v1_alliance_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   16,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_cityio_service_v1_alliance_proto_goTypes,
+		DependencyIndexes: file_cityio_service_v1_alliance_proto_depIdxs,
+		MessageInfos:      file_cityio_service_v1_alliance_proto_msgTypes,
+	}.Build()
+	File_cityio_service_v1_alliance_proto = out.File
+	file_cityio_service_v1_alliance_proto_goTypes = nil
+	file_cityio_service_v1_alliance_proto_depIdxs = nil
+}
@@ -0,0 +1,418 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: cityio/service/v1/army.proto
+
+package servicev1
+
+import (
+	v1 "cityio/internal/gen/cityio/entity/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// MoveArmyRequest either re-routes an existing army (army_id set) or raises a
+// new one from a city's garrison (city_id and troops set) and sends it to
+// destination.
+type MoveArmyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ArmyId        *v1.ArmyId             `protobuf:"bytes,1,opt,name=army_id,json=armyId,proto3,oneof" json:"army_id,omitempty"`
+	CityId        *v1.CityId             `protobuf:"bytes,2,opt,name=city_id,json=cityId,proto3,oneof" json:"city_id,omitempty"`
+	Troops        int64                  `protobuf:"varint,3,opt,name=troops,proto3" json:"troops,omitempty"`
+	Destination   *v1.Coordinates        `protobuf:"bytes,4,opt,name=destination,proto3" json:"destination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveArmyRequest) Reset() {
+	*x = MoveArmyRequest{}
+	mi := &file_cityio_service_v1_army_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveArmyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveArmyRequest) ProtoMessage() {}
+
+func (x *MoveArmyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_army_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveArmyRequest.ProtoReflect.Descriptor instead.
+func (*MoveArmyRequest) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_army_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MoveArmyRequest) GetArmyId() *v1.ArmyId {
+	if x != nil {
+		return x.ArmyId
+	}
+	return nil
+}
+
+func (x *MoveArmyRequest) GetCityId() *v1.CityId {
+	if x != nil {
+		return x.CityId
+	}
+	return nil
+}
+
+func (x *MoveArmyRequest) GetTroops() int64 {
+	if x != nil {
+		return x.Troops
+	}
+	return 0
+}
+
+func (x *MoveArmyRequest) GetDestination() *v1.Coordinates {
+	if x != nil {
+		return x.Destination
+	}
+	return nil
+}
+
+type MoveArmyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Army          *v1.Army               `protobuf:"bytes,1,opt,name=army,proto3" json:"army,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveArmyResponse) Reset() {
+	*x = MoveArmyResponse{}
+	mi := &file_cityio_service_v1_army_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveArmyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveArmyResponse) ProtoMessage() {}
+
+func (x *MoveArmyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_army_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveArmyResponse.ProtoReflect.Descriptor instead.
+func (*MoveArmyResponse) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_army_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MoveArmyResponse) GetArmy() *v1.Army {
+	if x != nil {
+		return x.Army
+	}
+	return nil
+}
+
+type GetArmyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ArmyId        *v1.ArmyId             `protobuf:"bytes,1,opt,name=army_id,json=armyId,proto3" json:"army_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetArmyRequest) Reset() {
+	*x = GetArmyRequest{}
+	mi := &file_cityio_service_v1_army_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetArmyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArmyRequest) ProtoMessage() {}
+
+func (x *GetArmyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_army_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArmyRequest.ProtoReflect.Descriptor instead.
+func (*GetArmyRequest) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_army_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetArmyRequest) GetArmyId() *v1.ArmyId {
+	if x != nil {
+		return x.ArmyId
+	}
+	return nil
+}
+
+type GetArmyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Army          *v1.Army               `protobuf:"bytes,1,opt,name=army,proto3" json:"army,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetArmyResponse) Reset() {
+	*x = GetArmyResponse{}
+	mi := &file_cityio_service_v1_army_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetArmyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArmyResponse) ProtoMessage() {}
+
+func (x *GetArmyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_army_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArmyResponse.ProtoReflect.Descriptor instead.
+func (*GetArmyResponse) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_army_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetArmyResponse) GetArmy() *v1.Army {
+	if x != nil {
+		return x.Army
+	}
+	return nil
+}
+
+type ListArmiesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListArmiesRequest) Reset() {
+	*x = ListArmiesRequest{}
+	mi := &file_cityio_service_v1_army_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListArmiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListArmiesRequest) ProtoMessage() {}
+
+func (x *ListArmiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_army_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListArmiesRequest.ProtoReflect.Descriptor instead.
+func (*ListArmiesRequest) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_army_proto_rawDescGZIP(), []int{4}
+}
+
+type ListArmiesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ArmyIds       []*v1.ArmyId           `protobuf:"bytes,1,rep,name=army_ids,json=armyIds,proto3" json:"army_ids,omitempty"`
+	Entities      *v1.EntityBag          `protobuf:"bytes,2,opt,name=entities,proto3" json:"entities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListArmiesResponse) Reset() {
+	*x = ListArmiesResponse{}
+	mi := &file_cityio_service_v1_army_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListArmiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListArmiesResponse) ProtoMessage() {}
+
+func (x *ListArmiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_army_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListArmiesResponse.ProtoReflect.Descriptor instead.
+func (*ListArmiesResponse) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_army_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListArmiesResponse) GetArmyIds() []*v1.ArmyId {
+	if x != nil {
+		return x.ArmyIds
+	}
+	return nil
+}
+
+func (x *ListArmiesResponse) GetEntities() *v1.EntityBag {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+var File_cityio_service_v1_army_proto protoreflect.FileDescriptor
+
+const file_cityio_service_v1_army_proto_rawDesc = "" +
+	"\n" +
+	"\x1ccityio/service/v1/army.proto\x12\x11cityio.service.v1\x1a\x1dcityio/entity/v1/common.proto\x1a\x1bcityio/entity/v1/army.proto\x1a\x1acityio/entity/v1/bag.proto\"\xf2\x01\n" +
+	"\x0fMoveArmyRequest\x126\n" +
+	"\aarmy_id\x18\x01 \x01(\v2\x18.cityio.entity.v1.ArmyIdH\x00R\x06armyId\x88\x01\x01\x126\n" +
+	"\acity_id\x18\x02 \x01(\v2\x18.cityio.entity.v1.CityIdH\x01R\x06cityId\x88\x01\x01\x12\x16\n" +
+	"\x06troops\x18\x03 \x01(\x03R\x06troops\x12?\n" +
+	"\vdestination\x18\x04 \x01(\v2\x1d.cityio.entity.v1.CoordinatesR\vdestinationB\n" +
+	"\n" +
+	"\b_army_idB\n" +
+	"\n" +
+	"\b_city_id\">\n" +
+	"\x10MoveArmyResponse\x12*\n" +
+	"\x04army\x18\x01 \x01(\v2\x16.cityio.entity.v1.ArmyR\x04army\"C\n" +
+	"\x0eGetArmyRequest\x121\n" +
+	"\aarmy_id\x18\x01 \x01(\v2\x18.cityio.entity.v1.ArmyIdR\x06armyId\"=\n" +
+	"\x0fGetArmyResponse\x12*\n" +
+	"\x04army\x18\x01 \x01(\v2\x16.cityio.entity.v1.ArmyR\x04army\"\x13\n" +
+	"\x11ListArmiesRequest\"\x82\x01\n" +
+	"\x12ListArmiesResponse\x123\n" +
+	"\barmy_ids\x18\x01 \x03(\v2\x18.cityio.entity.v1.ArmyIdR\aarmyIds\x127\n" +
+	"\bentities\x18\x02 \x01(\v2\x1b.cityio.entity.v1.EntityBagR\bentities2\x8f\x02\n" +
+	"\vArmyService\x12S\n" +
+	"\bMoveArmy\x12\".cityio.service.v1.MoveArmyRequest\x1a#.cityio.service.v1.MoveArmyResponse\x12P\n" +
+	"\aGetArmy\x12!.cityio.service.v1.GetArmyRequest\x1a\".cityio.service.v1.GetArmyResponse\x12Y\n" +
+	"\n" +
+	"ListArmies\x12$.cityio.service.v1.ListArmiesRequest\x1a%.cityio.service.v1.ListArmiesResponseB\xb9\x01\n" +
+	"\x15com.cityio.service.v1B\tArmyProtoP\x01Z/cityio/internal/gen/cityio/service/v1;servicev1\xa2\x02\x03CSX\xaa\x02\x11Cityio.Service.V1\xca\x02\x11Cityio\\Service\\V1\xe2\x02\x1dCityio\\Service\\V1\\GPBMetadata\xea\x02\x13Cityio::Service::V1b\x06proto3"
+
+var (
+	file_cityio_service_v1_army_proto_rawDescOnce sync.Once
+	file_cityio_service_v1_army_proto_rawDescData []byte
+)
+
+func file_cityio_service_v1_army_proto_rawDescGZIP() []byte {
+	file_cityio_service_v1_army_proto_rawDescOnce.Do(func() {
+		file_cityio_service_v1_army_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cityio_service_v1_army_proto_rawDesc), len(file_cityio_service_v1_army_proto_rawDesc)))
+	})
+	return file_cityio_service_v1_army_proto_rawDescData
+}
+
+var file_cityio_service_v1_army_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_cityio_service_v1_army_proto_goTypes = []any{
+	(*MoveArmyRequest)(nil),    // 0: cityio.service.v1.MoveArmyRequest
+	(*MoveArmyResponse)(nil),   // 1: cityio.service.v1.MoveArmyResponse
+	(*GetArmyRequest)(nil),     // 2: cityio.service.v1.GetArmyRequest
+	(*GetArmyResponse)(nil),    // 3: cityio.service.v1.GetArmyResponse
+	(*ListArmiesRequest)(nil),  // 4: cityio.service.v1.ListArmiesRequest
+	(*ListArmiesResponse)(nil), // 5: cityio.service.v1.ListArmiesResponse
+	(*v1.ArmyId)(nil),          // 6: cityio.entity.v1.ArmyId
+	(*v1.CityId)(nil),          // 7: cityio.entity.v1.CityId
+	(*v1.Coordinates)(nil),     // 8: cityio.entity.v1.Coordinates
+	(*v1.Army)(nil),            // 9: cityio.entity.v1.Army
+	(*v1.EntityBag)(nil),       // 10: cityio.entity.v1.EntityBag
+}
+var file_cityio_service_v1_army_proto_depIdxs = []int32{
+	6,  // 0: cityio.service.v1.MoveArmyRequest.army_id:type_name -> cityio.entity.v1.ArmyId
+	7,  // 1: cityio.service.v1.MoveArmyRequest.city_id:type_name -> cityio.entity.v1.CityId
+	8,  // 2: cityio.service.v1.MoveArmyRequest.destination:type_name -> cityio.entity.v1.Coordinates
+	9,  // 3: cityio.service.v1.MoveArmyResponse.army:type_name -> cityio.entity.v1.Army
+	6,  // 4: cityio.service.v1.GetArmyRequest.army_id:type_name -> cityio.entity.v1.ArmyId
+	9,  // 5: cityio.service.v1.GetArmyResponse.army:type_name -> cityio.entity.v1.Army
+	6,  // 6: cityio.service.v1.ListArmiesResponse.army_ids:type_name -> cityio.entity.v1.ArmyId
+	10, // 7: cityio.service.v1.ListArmiesResponse.entities:type_name -> cityio.entity.v1.EntityBag
+	0,  // 8: cityio.service.v1.ArmyService.MoveArmy:input_type -> cityio.service.v1.MoveArmyRequest
+	2,  // 9: cityio.service.v1.ArmyService.GetArmy:input_type -> cityio.service.v1.GetArmyRequest
+	4,  // 10: cityio.service.v1.ArmyService.ListArmies:input_type -> cityio.service.v1.ListArmiesRequest
+	1,  // 11: cityio.service.v1.ArmyService.MoveArmy:output_type -> cityio.service.v1.MoveArmyResponse
+	3,  // 12: cityio.service.v1.ArmyService.GetArmy:output_type -> cityio.service.v1.GetArmyResponse
+	5,  // 13: cityio.service.v1.ArmyService.ListArmies:output_type -> cityio.service.v1.ListArmiesResponse
+	11, // [11:14] is the sub-list for method output_type
+	8,  // [8:11] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_cityio_service_v1_army_proto_init() }
+func file_cityio_service_v1_army_proto_init() {
+	if File_cityio_service_v1_army_proto != nil {
+		return
+	}
+	file_cityio_service_v1_army_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cityio_service_v1_army_proto_rawDesc), len(file_cityio_service_v1_army_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_cityio_service_v1_army_proto_goTypes,
+		DependencyIndexes: file_cityio_service_v1_army_proto_depIdxs,
+		MessageInfos:      file_cityio_service_v1_army_proto_msgTypes,
+	}.Build()
+	File_cityio_service_v1_army_proto = out.File
+	file_cityio_service_v1_army_proto_goTypes = nil
+	file_cityio_service_v1_army_proto_depIdxs = nil
+}
@@ -0,0 +1,280 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: cityio/service/v1/battle.proto
+
+package servicev1
+
+import (
+	v1 "cityio/internal/gen/cityio/entity/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetBattleReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReportId      *v1.BattleReportId     `protobuf:"bytes,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBattleReportRequest) Reset() {
+	*x = GetBattleReportRequest{}
+	mi := &file_cityio_service_v1_battle_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBattleReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBattleReportRequest) ProtoMessage() {}
+
+func (x *GetBattleReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_battle_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBattleReportRequest.ProtoReflect.Descriptor instead.
+func (*GetBattleReportRequest) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_battle_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetBattleReportRequest) GetReportId() *v1.BattleReportId {
+	if x != nil {
+		return x.ReportId
+	}
+	return nil
+}
+
+type GetBattleReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Report        *v1.BattleReport       `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBattleReportResponse) Reset() {
+	*x = GetBattleReportResponse{}
+	mi := &file_cityio_service_v1_battle_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBattleReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBattleReportResponse) ProtoMessage() {}
+
+func (x *GetBattleReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_battle_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBattleReportResponse.ProtoReflect.Descriptor instead.
+func (*GetBattleReportResponse) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_battle_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetBattleReportResponse) GetReport() *v1.BattleReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+// ListBattleReportsRequest returns the caller's most recent battles, as
+// attacker or defender, newest first. limit defaults to 50 and is capped at
+// 200.
+type ListBattleReportsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBattleReportsRequest) Reset() {
+	*x = ListBattleReportsRequest{}
+	mi := &file_cityio_service_v1_battle_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBattleReportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBattleReportsRequest) ProtoMessage() {}
+
+func (x *ListBattleReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_battle_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBattleReportsRequest.ProtoReflect.Descriptor instead.
+func (*ListBattleReportsRequest) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_battle_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListBattleReportsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListBattleReportsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reports       []*v1.BattleReport     `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBattleReportsResponse) Reset() {
+	*x = ListBattleReportsResponse{}
+	mi := &file_cityio_service_v1_battle_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBattleReportsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBattleReportsResponse) ProtoMessage() {}
+
+func (x *ListBattleReportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_battle_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBattleReportsResponse.ProtoReflect.Descriptor instead.
+func (*ListBattleReportsResponse) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_battle_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListBattleReportsResponse) GetReports() []*v1.BattleReport {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+var File_cityio_service_v1_battle_proto protoreflect.FileDescriptor
+
+const file_cityio_service_v1_battle_proto_rawDesc = "" +
+	"\n" +
+	"\x1ecityio/service/v1/battle.proto\x12\x11cityio.service.v1\x1a\x1dcityio/entity/v1/common.proto\x1a\x1dcityio/entity/v1/battle.proto\"W\n" +
+	"\x16GetBattleReportRequest\x12=\n" +
+	"\treport_id\x18\x01 \x01(\v2 .cityio.entity.v1.BattleReportIdR\breportId\"Q\n" +
+	"\x17GetBattleReportResponse\x126\n" +
+	"\x06report\x18\x01 \x01(\v2\x1e.cityio.entity.v1.BattleReportR\x06report\"0\n" +
+	"\x18ListBattleReportsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\"U\n" +
+	"\x19ListBattleReportsResponse\x128\n" +
+	"\areports\x18\x01 \x03(\v2\x1e.cityio.entity.v1.BattleReportR\areports2\xe9\x01\n" +
+	"\rBattleService\x12h\n" +
+	"\x0fGetBattleReport\x12).cityio.service.v1.GetBattleReportRequest\x1a*.cityio.service.v1.GetBattleReportResponse\x12n\n" +
+	"\x11ListBattleReports\x12+.cityio.service.v1.ListBattleReportsRequest\x1a,.cityio.service.v1.ListBattleReportsResponseB\xbb\x01\n" +
+	"\x15com.cityio.service.v1B\vBattleProtoP\x01Z/cityio/internal/gen/cityio/service/v1;servicev1\xa2\x02\x03CSX\xaa\x02\x11Cityio.Service.V1\xca\x02\x11Cityio\\Service\\V1\xe2\x02\x1dCityio\\Service\\V1\\GPBMetadata\xea\x02\x13Cityio::Service::V1b\x06proto3"
+
+var (
+	file_cityio_service_v1_battle_proto_rawDescOnce sync.Once
+	file_cityio_service_v1_battle_proto_rawDescData []byte
+)
+
+func file_cityio_service_v1_battle_proto_rawDescGZIP() []byte {
+	file_cityio_service_v1_battle_proto_rawDescOnce.Do(func() {
+		file_cityio_service_v1_battle_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cityio_service_v1_battle_proto_rawDesc), len(file_cityio_service_v1_battle_proto_rawDesc)))
+	})
+	return file_cityio_service_v1_battle_proto_rawDescData
+}
+
+var file_cityio_service_v1_battle_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_cityio_service_v1_battle_proto_goTypes = []any{
+	(*GetBattleReportRequest)(nil),    // 0: cityio.service.v1.GetBattleReportRequest
+	(*GetBattleReportResponse)(nil),   // 1: cityio.service.v1.GetBattleReportResponse
+	(*ListBattleReportsRequest)(nil),  // 2: cityio.service.v1.ListBattleReportsRequest
+	(*ListBattleReportsResponse)(nil), // 3: cityio.service.v1.ListBattleReportsResponse
+	(*v1.BattleReportId)(nil),         // 4: cityio.entity.v1.BattleReportId
+	(*v1.BattleReport)(nil),           // 5: cityio.entity.v1.BattleReport
+}
+var file_cityio_service_v1_battle_proto_depIdxs = []int32{
+	4, // 0: cityio.service.v1.GetBattleReportRequest.report_id:type_name -> cityio.entity.v1.BattleReportId
+	5, // 1: cityio.service.v1.GetBattleReportResponse.report:type_name -> cityio.entity.v1.BattleReport
+	5, // 2: cityio.service.v1.ListBattleReportsResponse.reports:type_name -> cityio.entity.v1.BattleReport
+	0, // 3: cityio.service.v1.BattleService.GetBattleReport:input_type -> cityio.service.v1.GetBattleReportRequest
+	2, // 4: cityio.service.v1.BattleService.ListBattleReports:input_type -> cityio.service.v1.ListBattleReportsRequest
+	1, // 5: cityio.service.v1.BattleService.GetBattleReport:output_type -> cityio.service.v1.GetBattleReportResponse
+	3, // 6: cityio.service.v1.BattleService.ListBattleReports:output_type -> cityio.service.v1.ListBattleReportsResponse
+	5, // [5:7] is the sub-list for method output_type
+	3, // [3:5] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_cityio_service_v1_battle_proto_init() }
+func file_cityio_service_v1_battle_proto_init() {
+	if File_cityio_service_v1_battle_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cityio_service_v1_battle_proto_rawDesc), len(file_cityio_service_v1_battle_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_cityio_service_v1_battle_proto_goTypes,
+		DependencyIndexes: file_cityio_service_v1_battle_proto_depIdxs,
+		MessageInfos:      file_cityio_service_v1_battle_proto_msgTypes,
+	}.Build()
+	File_cityio_service_v1_battle_proto = out.File
+	file_cityio_service_v1_battle_proto_goTypes = nil
+	file_cityio_service_v1_battle_proto_depIdxs = nil
+}
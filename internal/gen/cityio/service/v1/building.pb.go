@@ -10,6 +10,7 @@ import (
 	v1 "cityio/internal/gen/cityio/entity/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -23,10 +24,13 @@ const (
 )
 
 type CreateBuildingRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CityId        *v1.CityId             `protobuf:"bytes,1,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
-	Type          v1.BuildingType        `protobuf:"varint,2,opt,name=type,proto3,enum=cityio.entity.v1.BuildingType" json:"type,omitempty"`
-	Coords        *v1.Coordinates        `protobuf:"bytes,3,opt,name=coords,proto3" json:"coords,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	CityId *v1.CityId             `protobuf:"bytes,1,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
+	Type   v1.BuildingType        `protobuf:"varint,2,opt,name=type,proto3,enum=cityio.entity.v1.BuildingType" json:"type,omitempty"`
+	Coords *v1.Coordinates        `protobuf:"bytes,3,opt,name=coords,proto3" json:"coords,omitempty"`
+	// type_id names the building type as in GetGameConfig and takes precedence
+	// over type when set. Types the enum doesn't name can only be built by id.
+	TypeId        string `protobuf:"bytes,4,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -82,6 +86,13 @@ func (x *CreateBuildingRequest) GetCoords() *v1.Coordinates {
 	return nil
 }
 
+func (x *CreateBuildingRequest) GetTypeId() string {
+	if x != nil {
+		return x.TypeId
+	}
+	return ""
+}
+
 type CreateBuildingResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Building      *v1.Building           `protobuf:"bytes,1,opt,name=building,proto3" json:"building,omitempty"`
@@ -294,6 +305,212 @@ func (*UpgradeBuildingResponse) Descriptor() ([]byte, []int) {
 	return file_cityio_service_v1_building_proto_rawDescGZIP(), []int{5}
 }
 
+type CancelConstructionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BuildingId    *v1.BuildingId         `protobuf:"bytes,1,opt,name=building_id,json=buildingId,proto3" json:"building_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelConstructionRequest) Reset() {
+	*x = CancelConstructionRequest{}
+	mi := &file_cityio_service_v1_building_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelConstructionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelConstructionRequest) ProtoMessage() {}
+
+func (x *CancelConstructionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_building_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelConstructionRequest.ProtoReflect.Descriptor instead.
+func (*CancelConstructionRequest) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_building_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CancelConstructionRequest) GetBuildingId() *v1.BuildingId {
+	if x != nil {
+		return x.BuildingId
+	}
+	return nil
+}
+
+type CancelConstructionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// refund is the gold returned to the player.
+	Refund int64 `protobuf:"varint,1,opt,name=refund,proto3" json:"refund,omitempty"`
+	// removed is true when the building was still being built for the first
+	// time and no longer exists.
+	Removed       bool `protobuf:"varint,2,opt,name=removed,proto3" json:"removed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelConstructionResponse) Reset() {
+	*x = CancelConstructionResponse{}
+	mi := &file_cityio_service_v1_building_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelConstructionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelConstructionResponse) ProtoMessage() {}
+
+func (x *CancelConstructionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_building_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelConstructionResponse.ProtoReflect.Descriptor instead.
+func (*CancelConstructionResponse) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_building_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CancelConstructionResponse) GetRefund() int64 {
+	if x != nil {
+		return x.Refund
+	}
+	return 0
+}
+
+func (x *CancelConstructionResponse) GetRemoved() bool {
+	if x != nil {
+		return x.Removed
+	}
+	return false
+}
+
+type SpeedUpConstructionRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	BuildingId *v1.BuildingId         `protobuf:"bytes,1,opt,name=building_id,json=buildingId,proto3" json:"building_id,omitempty"`
+	// skip is how much construction time to buy off. Unset, or longer than
+	// what remains, finishes the construction.
+	Skip          *durationpb.Duration `protobuf:"bytes,2,opt,name=skip,proto3" json:"skip,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpeedUpConstructionRequest) Reset() {
+	*x = SpeedUpConstructionRequest{}
+	mi := &file_cityio_service_v1_building_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpeedUpConstructionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpeedUpConstructionRequest) ProtoMessage() {}
+
+func (x *SpeedUpConstructionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_building_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpeedUpConstructionRequest.ProtoReflect.Descriptor instead.
+func (*SpeedUpConstructionRequest) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_building_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SpeedUpConstructionRequest) GetBuildingId() *v1.BuildingId {
+	if x != nil {
+		return x.BuildingId
+	}
+	return nil
+}
+
+func (x *SpeedUpConstructionRequest) GetSkip() *durationpb.Duration {
+	if x != nil {
+		return x.Skip
+	}
+	return nil
+}
+
+type SpeedUpConstructionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// cost is the gold charged.
+	Cost          int64        `protobuf:"varint,1,opt,name=cost,proto3" json:"cost,omitempty"`
+	Building      *v1.Building `protobuf:"bytes,2,opt,name=building,proto3" json:"building,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpeedUpConstructionResponse) Reset() {
+	*x = SpeedUpConstructionResponse{}
+	mi := &file_cityio_service_v1_building_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpeedUpConstructionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpeedUpConstructionResponse) ProtoMessage() {}
+
+func (x *SpeedUpConstructionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_building_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpeedUpConstructionResponse.ProtoReflect.Descriptor instead.
+func (*SpeedUpConstructionResponse) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_building_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SpeedUpConstructionResponse) GetCost() int64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *SpeedUpConstructionResponse) GetBuilding() *v1.Building {
+	if x != nil {
+		return x.Building
+	}
+	return nil
+}
+
 type DeleteBuildingRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	BuildingId    *v1.BuildingId         `protobuf:"bytes,1,opt,name=building_id,json=buildingId,proto3" json:"building_id,omitempty"`
@@ -303,7 +520,7 @@ type DeleteBuildingRequest struct {
 
 func (x *DeleteBuildingRequest) Reset() {
 	*x = DeleteBuildingRequest{}
-	mi := &file_cityio_service_v1_building_proto_msgTypes[6]
+	mi := &file_cityio_service_v1_building_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -315,7 +532,7 @@ func (x *DeleteBuildingRequest) String() string {
 func (*DeleteBuildingRequest) ProtoMessage() {}
 
 func (x *DeleteBuildingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cityio_service_v1_building_proto_msgTypes[6]
+	mi := &file_cityio_service_v1_building_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -328,7 +545,7 @@ func (x *DeleteBuildingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteBuildingRequest.ProtoReflect.Descriptor instead.
 func (*DeleteBuildingRequest) Descriptor() ([]byte, []int) {
-	return file_cityio_service_v1_building_proto_rawDescGZIP(), []int{6}
+	return file_cityio_service_v1_building_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *DeleteBuildingRequest) GetBuildingId() *v1.BuildingId {
@@ -346,7 +563,7 @@ type DeleteBuildingResponse struct {
 
 func (x *DeleteBuildingResponse) Reset() {
 	*x = DeleteBuildingResponse{}
-	mi := &file_cityio_service_v1_building_proto_msgTypes[7]
+	mi := &file_cityio_service_v1_building_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -358,7 +575,7 @@ func (x *DeleteBuildingResponse) String() string {
 func (*DeleteBuildingResponse) ProtoMessage() {}
 
 func (x *DeleteBuildingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cityio_service_v1_building_proto_msgTypes[7]
+	mi := &file_cityio_service_v1_building_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -371,7 +588,103 @@ func (x *DeleteBuildingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteBuildingResponse.ProtoReflect.Descriptor instead.
 func (*DeleteBuildingResponse) Descriptor() ([]byte, []int) {
-	return file_cityio_service_v1_building_proto_rawDescGZIP(), []int{7}
+	return file_cityio_service_v1_building_proto_rawDescGZIP(), []int{11}
+}
+
+type TrainTroopsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BuildingId    *v1.BuildingId         `protobuf:"bytes,1,opt,name=building_id,json=buildingId,proto3" json:"building_id,omitempty"`
+	Troops        int64                  `protobuf:"varint,2,opt,name=troops,proto3" json:"troops,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrainTroopsRequest) Reset() {
+	*x = TrainTroopsRequest{}
+	mi := &file_cityio_service_v1_building_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrainTroopsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrainTroopsRequest) ProtoMessage() {}
+
+func (x *TrainTroopsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_building_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrainTroopsRequest.ProtoReflect.Descriptor instead.
+func (*TrainTroopsRequest) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_building_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *TrainTroopsRequest) GetBuildingId() *v1.BuildingId {
+	if x != nil {
+		return x.BuildingId
+	}
+	return nil
+}
+
+func (x *TrainTroopsRequest) GetTroops() int64 {
+	if x != nil {
+		return x.Troops
+	}
+	return 0
+}
+
+type TrainTroopsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Building      *v1.Building           `protobuf:"bytes,1,opt,name=building,proto3" json:"building,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrainTroopsResponse) Reset() {
+	*x = TrainTroopsResponse{}
+	mi := &file_cityio_service_v1_building_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrainTroopsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrainTroopsResponse) ProtoMessage() {}
+
+func (x *TrainTroopsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_building_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrainTroopsResponse.ProtoReflect.Descriptor instead.
+func (*TrainTroopsResponse) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_building_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TrainTroopsResponse) GetBuilding() *v1.Building {
+	if x != nil {
+		return x.Building
+	}
+	return nil
 }
 
 type ListBuildingsRequest struct {
@@ -383,7 +696,7 @@ type ListBuildingsRequest struct {
 
 func (x *ListBuildingsRequest) Reset() {
 	*x = ListBuildingsRequest{}
-	mi := &file_cityio_service_v1_building_proto_msgTypes[8]
+	mi := &file_cityio_service_v1_building_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -395,7 +708,7 @@ func (x *ListBuildingsRequest) String() string {
 func (*ListBuildingsRequest) ProtoMessage() {}
 
 func (x *ListBuildingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cityio_service_v1_building_proto_msgTypes[8]
+	mi := &file_cityio_service_v1_building_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -408,7 +721,7 @@ func (x *ListBuildingsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBuildingsRequest.ProtoReflect.Descriptor instead.
 func (*ListBuildingsRequest) Descriptor() ([]byte, []int) {
-	return file_cityio_service_v1_building_proto_rawDescGZIP(), []int{8}
+	return file_cityio_service_v1_building_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ListBuildingsRequest) GetCityId() *v1.CityId {
@@ -427,7 +740,7 @@ type ListBuildingsResponse struct {
 
 func (x *ListBuildingsResponse) Reset() {
 	*x = ListBuildingsResponse{}
-	mi := &file_cityio_service_v1_building_proto_msgTypes[9]
+	mi := &file_cityio_service_v1_building_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -439,7 +752,7 @@ func (x *ListBuildingsResponse) String() string {
 func (*ListBuildingsResponse) ProtoMessage() {}
 
 func (x *ListBuildingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cityio_service_v1_building_proto_msgTypes[9]
+	mi := &file_cityio_service_v1_building_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -452,7 +765,7 @@ func (x *ListBuildingsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBuildingsResponse.ProtoReflect.Descriptor instead.
 func (*ListBuildingsResponse) Descriptor() ([]byte, []int) {
-	return file_cityio_service_v1_building_proto_rawDescGZIP(), []int{9}
+	return file_cityio_service_v1_building_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ListBuildingsResponse) GetBuildings() []*v1.Building {
@@ -466,11 +779,12 @@ var File_cityio_service_v1_building_proto protoreflect.FileDescriptor
 
 const file_cityio_service_v1_building_proto_rawDesc = "" +
 	"\n" +
-	" cityio/service/v1/building.proto\x12\x11cityio.service.v1\x1a\x1dcityio/entity/v1/common.proto\x1a\x1fcityio/entity/v1/building.proto\"\xb5\x01\n" +
+	" cityio/service/v1/building.proto\x12\x11cityio.service.v1\x1a\x1dcityio/entity/v1/common.proto\x1a\x1fcityio/entity/v1/building.proto\x1a\x1egoogle/protobuf/duration.proto\"\xce\x01\n" +
 	"\x15CreateBuildingRequest\x121\n" +
 	"\acity_id\x18\x01 \x01(\v2\x18.cityio.entity.v1.CityIdR\x06cityId\x122\n" +
 	"\x04type\x18\x02 \x01(\x0e2\x1e.cityio.entity.v1.BuildingTypeR\x04type\x125\n" +
-	"\x06coords\x18\x03 \x01(\v2\x1d.cityio.entity.v1.CoordinatesR\x06coords\"P\n" +
+	"\x06coords\x18\x03 \x01(\v2\x1d.cityio.entity.v1.CoordinatesR\x06coords\x12\x17\n" +
+	"\atype_id\x18\x04 \x01(\tR\x06typeId\"P\n" +
 	"\x16CreateBuildingResponse\x126\n" +
 	"\bbuilding\x18\x01 \x01(\v2\x1a.cityio.entity.v1.BuildingR\bbuilding\"S\n" +
 	"\x12GetBuildingRequest\x12=\n" +
@@ -481,21 +795,43 @@ const file_cityio_service_v1_building_proto_rawDesc = "" +
 	"\x16UpgradeBuildingRequest\x12=\n" +
 	"\vbuilding_id\x18\x01 \x01(\v2\x1c.cityio.entity.v1.BuildingIdR\n" +
 	"buildingId\"\x19\n" +
-	"\x17UpgradeBuildingResponse\"V\n" +
+	"\x17UpgradeBuildingResponse\"Z\n" +
+	"\x19CancelConstructionRequest\x12=\n" +
+	"\vbuilding_id\x18\x01 \x01(\v2\x1c.cityio.entity.v1.BuildingIdR\n" +
+	"buildingId\"N\n" +
+	"\x1aCancelConstructionResponse\x12\x16\n" +
+	"\x06refund\x18\x01 \x01(\x03R\x06refund\x12\x18\n" +
+	"\aremoved\x18\x02 \x01(\bR\aremoved\"\x8a\x01\n" +
+	"\x1aSpeedUpConstructionRequest\x12=\n" +
+	"\vbuilding_id\x18\x01 \x01(\v2\x1c.cityio.entity.v1.BuildingIdR\n" +
+	"buildingId\x12-\n" +
+	"\x04skip\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x04skip\"i\n" +
+	"\x1bSpeedUpConstructionResponse\x12\x12\n" +
+	"\x04cost\x18\x01 \x01(\x03R\x04cost\x126\n" +
+	"\bbuilding\x18\x02 \x01(\v2\x1a.cityio.entity.v1.BuildingR\bbuilding\"V\n" +
 	"\x15DeleteBuildingRequest\x12=\n" +
 	"\vbuilding_id\x18\x01 \x01(\v2\x1c.cityio.entity.v1.BuildingIdR\n" +
 	"buildingId\"\x18\n" +
-	"\x16DeleteBuildingResponse\"I\n" +
+	"\x16DeleteBuildingResponse\"k\n" +
+	"\x12TrainTroopsRequest\x12=\n" +
+	"\vbuilding_id\x18\x01 \x01(\v2\x1c.cityio.entity.v1.BuildingIdR\n" +
+	"buildingId\x12\x16\n" +
+	"\x06troops\x18\x02 \x01(\x03R\x06troops\"M\n" +
+	"\x13TrainTroopsResponse\x126\n" +
+	"\bbuilding\x18\x01 \x01(\v2\x1a.cityio.entity.v1.BuildingR\bbuilding\"I\n" +
 	"\x14ListBuildingsRequest\x121\n" +
 	"\acity_id\x18\x01 \x01(\v2\x18.cityio.entity.v1.CityIdR\x06cityId\"Q\n" +
 	"\x15ListBuildingsResponse\x128\n" +
-	"\tbuildings\x18\x01 \x03(\v2\x1a.cityio.entity.v1.BuildingR\tbuildings2\x8b\x04\n" +
+	"\tbuildings\x18\x01 \x03(\v2\x1a.cityio.entity.v1.BuildingR\tbuildings2\xd2\x06\n" +
 	"\x0fBuildingService\x12e\n" +
 	"\x0eCreateBuilding\x12(.cityio.service.v1.CreateBuildingRequest\x1a).cityio.service.v1.CreateBuildingResponse\x12\\\n" +
 	"\vGetBuilding\x12%.cityio.service.v1.GetBuildingRequest\x1a&.cityio.service.v1.GetBuildingResponse\x12h\n" +
-	"\x0fUpgradeBuilding\x12).cityio.service.v1.UpgradeBuildingRequest\x1a*.cityio.service.v1.UpgradeBuildingResponse\x12e\n" +
+	"\x0fUpgradeBuilding\x12).cityio.service.v1.UpgradeBuildingRequest\x1a*.cityio.service.v1.UpgradeBuildingResponse\x12q\n" +
+	"\x12CancelConstruction\x12,.cityio.service.v1.CancelConstructionRequest\x1a-.cityio.service.v1.CancelConstructionResponse\x12t\n" +
+	"\x13SpeedUpConstruction\x12-.cityio.service.v1.SpeedUpConstructionRequest\x1a..cityio.service.v1.SpeedUpConstructionResponse\x12e\n" +
 	"\x0eDeleteBuilding\x12(.cityio.service.v1.DeleteBuildingRequest\x1a).cityio.service.v1.DeleteBuildingResponse\x12b\n" +
-	"\rListBuildings\x12'.cityio.service.v1.ListBuildingsRequest\x1a(.cityio.service.v1.ListBuildingsResponseB\xbd\x01\n" +
+	"\rListBuildings\x12'.cityio.service.v1.ListBuildingsRequest\x1a(.cityio.service.v1.ListBuildingsResponse\x12\\\n" +
+	"\vTrainTroops\x12%.cityio.service.v1.TrainTroopsRequest\x1a&.cityio.service.v1.TrainTroopsResponseB\xbd\x01\n" +
 	"\x15com.cityio.service.v1B\rBuildingProtoP\x01Z/cityio/internal/gen/cityio/service/v1;servicev1\xa2\x02\x03CSX\xaa\x02\x11Cityio.Service.V1\xca\x02\x11Cityio\\Service\\V1\xe2\x02\x1dCityio\\Service\\V1\\GPBMetadata\xea\x02\x13Cityio::Service::V1b\x06proto3"
 
 var (
@@ -510,50 +846,69 @@ func file_cityio_service_v1_building_proto_rawDescGZIP() []byte {
 	return file_cityio_service_v1_building_proto_rawDescData
 }
 
-var file_cityio_service_v1_building_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_cityio_service_v1_building_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_cityio_service_v1_building_proto_goTypes = []any{
-	(*CreateBuildingRequest)(nil),   // 0: cityio.service.v1.CreateBuildingRequest
-	(*CreateBuildingResponse)(nil),  // 1: cityio.service.v1.CreateBuildingResponse
-	(*GetBuildingRequest)(nil),      // 2: cityio.service.v1.GetBuildingRequest
-	(*GetBuildingResponse)(nil),     // 3: cityio.service.v1.GetBuildingResponse
-	(*UpgradeBuildingRequest)(nil),  // 4: cityio.service.v1.UpgradeBuildingRequest
-	(*UpgradeBuildingResponse)(nil), // 5: cityio.service.v1.UpgradeBuildingResponse
-	(*DeleteBuildingRequest)(nil),   // 6: cityio.service.v1.DeleteBuildingRequest
-	(*DeleteBuildingResponse)(nil),  // 7: cityio.service.v1.DeleteBuildingResponse
-	(*ListBuildingsRequest)(nil),    // 8: cityio.service.v1.ListBuildingsRequest
-	(*ListBuildingsResponse)(nil),   // 9: cityio.service.v1.ListBuildingsResponse
-	(*v1.CityId)(nil),               // 10: cityio.entity.v1.CityId
-	(v1.BuildingType)(0),            // 11: cityio.entity.v1.BuildingType
-	(*v1.Coordinates)(nil),          // 12: cityio.entity.v1.Coordinates
-	(*v1.Building)(nil),             // 13: cityio.entity.v1.Building
-	(*v1.BuildingId)(nil),           // 14: cityio.entity.v1.BuildingId
+	(*CreateBuildingRequest)(nil),       // 0: cityio.service.v1.CreateBuildingRequest
+	(*CreateBuildingResponse)(nil),      // 1: cityio.service.v1.CreateBuildingResponse
+	(*GetBuildingRequest)(nil),          // 2: cityio.service.v1.GetBuildingRequest
+	(*GetBuildingResponse)(nil),         // 3: cityio.service.v1.GetBuildingResponse
+	(*UpgradeBuildingRequest)(nil),      // 4: cityio.service.v1.UpgradeBuildingRequest
+	(*UpgradeBuildingResponse)(nil),     // 5: cityio.service.v1.UpgradeBuildingResponse
+	(*CancelConstructionRequest)(nil),   // 6: cityio.service.v1.CancelConstructionRequest
+	(*CancelConstructionResponse)(nil),  // 7: cityio.service.v1.CancelConstructionResponse
+	(*SpeedUpConstructionRequest)(nil),  // 8: cityio.service.v1.SpeedUpConstructionRequest
+	(*SpeedUpConstructionResponse)(nil), // 9: cityio.service.v1.SpeedUpConstructionResponse
+	(*DeleteBuildingRequest)(nil),       // 10: cityio.service.v1.DeleteBuildingRequest
+	(*DeleteBuildingResponse)(nil),      // 11: cityio.service.v1.DeleteBuildingResponse
+	(*TrainTroopsRequest)(nil),          // 12: cityio.service.v1.TrainTroopsRequest
+	(*TrainTroopsResponse)(nil),         // 13: cityio.service.v1.TrainTroopsResponse
+	(*ListBuildingsRequest)(nil),        // 14: cityio.service.v1.ListBuildingsRequest
+	(*ListBuildingsResponse)(nil),       // 15: cityio.service.v1.ListBuildingsResponse
+	(*v1.CityId)(nil),                   // 16: cityio.entity.v1.CityId
+	(v1.BuildingType)(0),                // 17: cityio.entity.v1.BuildingType
+	(*v1.Coordinates)(nil),              // 18: cityio.entity.v1.Coordinates
+	(*v1.Building)(nil),                 // 19: cityio.entity.v1.Building
+	(*v1.BuildingId)(nil),               // 20: cityio.entity.v1.BuildingId
+	(*durationpb.Duration)(nil),         // 21: google.protobuf.Duration
 }
 var file_cityio_service_v1_building_proto_depIdxs = []int32{
-	10, // 0: cityio.service.v1.CreateBuildingRequest.city_id:type_name -> cityio.entity.v1.CityId
-	11, // 1: cityio.service.v1.CreateBuildingRequest.type:type_name -> cityio.entity.v1.BuildingType
-	12, // 2: cityio.service.v1.CreateBuildingRequest.coords:type_name -> cityio.entity.v1.Coordinates
-	13, // 3: cityio.service.v1.CreateBuildingResponse.building:type_name -> cityio.entity.v1.Building
-	14, // 4: cityio.service.v1.GetBuildingRequest.building_id:type_name -> cityio.entity.v1.BuildingId
-	13, // 5: cityio.service.v1.GetBuildingResponse.building:type_name -> cityio.entity.v1.Building
-	14, // 6: cityio.service.v1.UpgradeBuildingRequest.building_id:type_name -> cityio.entity.v1.BuildingId
-	14, // 7: cityio.service.v1.DeleteBuildingRequest.building_id:type_name -> cityio.entity.v1.BuildingId
-	10, // 8: cityio.service.v1.ListBuildingsRequest.city_id:type_name -> cityio.entity.v1.CityId
-	13, // 9: cityio.service.v1.ListBuildingsResponse.buildings:type_name -> cityio.entity.v1.Building
-	0,  // 10: cityio.service.v1.BuildingService.CreateBuilding:input_type -> cityio.service.v1.CreateBuildingRequest
-	2,  // 11: cityio.service.v1.BuildingService.GetBuilding:input_type -> cityio.service.v1.GetBuildingRequest
-	4,  // 12: cityio.service.v1.BuildingService.UpgradeBuilding:input_type -> cityio.service.v1.UpgradeBuildingRequest
-	6,  // 13: cityio.service.v1.BuildingService.DeleteBuilding:input_type -> cityio.service.v1.DeleteBuildingRequest
-	8,  // 14: cityio.service.v1.BuildingService.ListBuildings:input_type -> cityio.service.v1.ListBuildingsRequest
-	1,  // 15: cityio.service.v1.BuildingService.CreateBuilding:output_type -> cityio.service.v1.CreateBuildingResponse
-	3,  // 16: cityio.service.v1.BuildingService.GetBuilding:output_type -> cityio.service.v1.GetBuildingResponse
-	5,  // 17: cityio.service.v1.BuildingService.UpgradeBuilding:output_type -> cityio.service.v1.UpgradeBuildingResponse
-	7,  // 18: cityio.service.v1.BuildingService.DeleteBuilding:output_type -> cityio.service.v1.DeleteBuildingResponse
-	9,  // 19: cityio.service.v1.BuildingService.ListBuildings:output_type -> cityio.service.v1.ListBuildingsResponse
-	15, // [15:20] is the sub-list for method output_type
-	10, // [10:15] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	16, // 0: cityio.service.v1.CreateBuildingRequest.city_id:type_name -> cityio.entity.v1.CityId
+	17, // 1: cityio.service.v1.CreateBuildingRequest.type:type_name -> cityio.entity.v1.BuildingType
+	18, // 2: cityio.service.v1.CreateBuildingRequest.coords:type_name -> cityio.entity.v1.Coordinates
+	19, // 3: cityio.service.v1.CreateBuildingResponse.building:type_name -> cityio.entity.v1.Building
+	20, // 4: cityio.service.v1.GetBuildingRequest.building_id:type_name -> cityio.entity.v1.BuildingId
+	19, // 5: cityio.service.v1.GetBuildingResponse.building:type_name -> cityio.entity.v1.Building
+	20, // 6: cityio.service.v1.UpgradeBuildingRequest.building_id:type_name -> cityio.entity.v1.BuildingId
+	20, // 7: cityio.service.v1.CancelConstructionRequest.building_id:type_name -> cityio.entity.v1.BuildingId
+	20, // 8: cityio.service.v1.SpeedUpConstructionRequest.building_id:type_name -> cityio.entity.v1.BuildingId
+	21, // 9: cityio.service.v1.SpeedUpConstructionRequest.skip:type_name -> google.protobuf.Duration
+	19, // 10: cityio.service.v1.SpeedUpConstructionResponse.building:type_name -> cityio.entity.v1.Building
+	20, // 11: cityio.service.v1.DeleteBuildingRequest.building_id:type_name -> cityio.entity.v1.BuildingId
+	20, // 12: cityio.service.v1.TrainTroopsRequest.building_id:type_name -> cityio.entity.v1.BuildingId
+	19, // 13: cityio.service.v1.TrainTroopsResponse.building:type_name -> cityio.entity.v1.Building
+	16, // 14: cityio.service.v1.ListBuildingsRequest.city_id:type_name -> cityio.entity.v1.CityId
+	19, // 15: cityio.service.v1.ListBuildingsResponse.buildings:type_name -> cityio.entity.v1.Building
+	0,  // 16: cityio.service.v1.BuildingService.CreateBuilding:input_type -> cityio.service.v1.CreateBuildingRequest
+	2,  // 17: cityio.service.v1.BuildingService.GetBuilding:input_type -> cityio.service.v1.GetBuildingRequest
+	4,  // 18: cityio.service.v1.BuildingService.UpgradeBuilding:input_type -> cityio.service.v1.UpgradeBuildingRequest
+	6,  // 19: cityio.service.v1.BuildingService.CancelConstruction:input_type -> cityio.service.v1.CancelConstructionRequest
+	8,  // 20: cityio.service.v1.BuildingService.SpeedUpConstruction:input_type -> cityio.service.v1.SpeedUpConstructionRequest
+	10, // 21: cityio.service.v1.BuildingService.DeleteBuilding:input_type -> cityio.service.v1.DeleteBuildingRequest
+	14, // 22: cityio.service.v1.BuildingService.ListBuildings:input_type -> cityio.service.v1.ListBuildingsRequest
+	12, // 23: cityio.service.v1.BuildingService.TrainTroops:input_type -> cityio.service.v1.TrainTroopsRequest
+	1,  // 24: cityio.service.v1.BuildingService.CreateBuilding:output_type -> cityio.service.v1.CreateBuildingResponse
+	3,  // 25: cityio.service.v1.BuildingService.GetBuilding:output_type -> cityio.service.v1.GetBuildingResponse
+	5,  // 26: cityio.service.v1.BuildingService.UpgradeBuilding:output_type -> cityio.service.v1.UpgradeBuildingResponse
+	7,  // 27: cityio.service.v1.BuildingService.CancelConstruction:output_type -> cityio.service.v1.CancelConstructionResponse
+	9,  // 28: cityio.service.v1.BuildingService.SpeedUpConstruction:output_type -> cityio.service.v1.SpeedUpConstructionResponse
+	11, // 29: cityio.service.v1.BuildingService.DeleteBuilding:output_type -> cityio.service.v1.DeleteBuildingResponse
+	15, // 30: cityio.service.v1.BuildingService.ListBuildings:output_type -> cityio.service.v1.ListBuildingsResponse
+	13, // 31: cityio.service.v1.BuildingService.TrainTroops:output_type -> cityio.service.v1.TrainTroopsResponse
+	24, // [24:32] is the sub-list for method output_type
+	16, // [16:24] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_cityio_service_v1_building_proto_init() }
@@ -567,7 +922,7 @@ func file_cityio_service_v1_building_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cityio_service_v1_building_proto_rawDesc), len(file_cityio_service_v1_building_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
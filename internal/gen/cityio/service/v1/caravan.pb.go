@@ -0,0 +1,415 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: cityio/service/v1/caravan.proto
+
+package servicev1
+
+import (
+	v1 "cityio/internal/gen/cityio/entity/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SendCaravanRequest loads food from the food store of one of the caller's
+// cities, and gold from the caller's treasury, and sends it to
+// destination_city_id. A city stores its own surplus up to
+// BalanceConfig.caravan_store_capacity; surplus beyond that goes to the
+// owner's food pool, which caravans cannot draw on.
+type SendCaravanRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	CityId            *v1.CityId             `protobuf:"bytes,1,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
+	DestinationCityId *v1.CityId             `protobuf:"bytes,2,opt,name=destination_city_id,json=destinationCityId,proto3" json:"destination_city_id,omitempty"`
+	Gold              int64                  `protobuf:"varint,3,opt,name=gold,proto3" json:"gold,omitempty"`
+	Food              int64                  `protobuf:"varint,4,opt,name=food,proto3" json:"food,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SendCaravanRequest) Reset() {
+	*x = SendCaravanRequest{}
+	mi := &file_cityio_service_v1_caravan_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendCaravanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendCaravanRequest) ProtoMessage() {}
+
+func (x *SendCaravanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_caravan_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendCaravanRequest.ProtoReflect.Descriptor instead.
+func (*SendCaravanRequest) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_caravan_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SendCaravanRequest) GetCityId() *v1.CityId {
+	if x != nil {
+		return x.CityId
+	}
+	return nil
+}
+
+func (x *SendCaravanRequest) GetDestinationCityId() *v1.CityId {
+	if x != nil {
+		return x.DestinationCityId
+	}
+	return nil
+}
+
+func (x *SendCaravanRequest) GetGold() int64 {
+	if x != nil {
+		return x.Gold
+	}
+	return 0
+}
+
+func (x *SendCaravanRequest) GetFood() int64 {
+	if x != nil {
+		return x.Food
+	}
+	return 0
+}
+
+type SendCaravanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Caravan       *v1.Caravan            `protobuf:"bytes,1,opt,name=caravan,proto3" json:"caravan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendCaravanResponse) Reset() {
+	*x = SendCaravanResponse{}
+	mi := &file_cityio_service_v1_caravan_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendCaravanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendCaravanResponse) ProtoMessage() {}
+
+func (x *SendCaravanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_caravan_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendCaravanResponse.ProtoReflect.Descriptor instead.
+func (*SendCaravanResponse) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_caravan_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SendCaravanResponse) GetCaravan() *v1.Caravan {
+	if x != nil {
+		return x.Caravan
+	}
+	return nil
+}
+
+type GetCaravanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CaravanId     *v1.CaravanId          `protobuf:"bytes,1,opt,name=caravan_id,json=caravanId,proto3" json:"caravan_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCaravanRequest) Reset() {
+	*x = GetCaravanRequest{}
+	mi := &file_cityio_service_v1_caravan_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCaravanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCaravanRequest) ProtoMessage() {}
+
+func (x *GetCaravanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_caravan_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCaravanRequest.ProtoReflect.Descriptor instead.
+func (*GetCaravanRequest) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_caravan_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetCaravanRequest) GetCaravanId() *v1.CaravanId {
+	if x != nil {
+		return x.CaravanId
+	}
+	return nil
+}
+
+type GetCaravanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Caravan       *v1.Caravan            `protobuf:"bytes,1,opt,name=caravan,proto3" json:"caravan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCaravanResponse) Reset() {
+	*x = GetCaravanResponse{}
+	mi := &file_cityio_service_v1_caravan_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCaravanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCaravanResponse) ProtoMessage() {}
+
+func (x *GetCaravanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_caravan_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCaravanResponse.ProtoReflect.Descriptor instead.
+func (*GetCaravanResponse) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_caravan_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetCaravanResponse) GetCaravan() *v1.Caravan {
+	if x != nil {
+		return x.Caravan
+	}
+	return nil
+}
+
+type ListCaravansRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCaravansRequest) Reset() {
+	*x = ListCaravansRequest{}
+	mi := &file_cityio_service_v1_caravan_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCaravansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCaravansRequest) ProtoMessage() {}
+
+func (x *ListCaravansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_caravan_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCaravansRequest.ProtoReflect.Descriptor instead.
+func (*ListCaravansRequest) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_caravan_proto_rawDescGZIP(), []int{4}
+}
+
+type ListCaravansResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CaravanIds    []*v1.CaravanId        `protobuf:"bytes,1,rep,name=caravan_ids,json=caravanIds,proto3" json:"caravan_ids,omitempty"`
+	Entities      *v1.EntityBag          `protobuf:"bytes,2,opt,name=entities,proto3" json:"entities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCaravansResponse) Reset() {
+	*x = ListCaravansResponse{}
+	mi := &file_cityio_service_v1_caravan_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCaravansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCaravansResponse) ProtoMessage() {}
+
+func (x *ListCaravansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_caravan_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCaravansResponse.ProtoReflect.Descriptor instead.
+func (*ListCaravansResponse) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_caravan_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListCaravansResponse) GetCaravanIds() []*v1.CaravanId {
+	if x != nil {
+		return x.CaravanIds
+	}
+	return nil
+}
+
+func (x *ListCaravansResponse) GetEntities() *v1.EntityBag {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+var File_cityio_service_v1_caravan_proto protoreflect.FileDescriptor
+
+const file_cityio_service_v1_caravan_proto_rawDesc = "" +
+	"\n" +
+	"\x1fcityio/service/v1/caravan.proto\x12\x11cityio.service.v1\x1a\x1dcityio/entity/v1/common.proto\x1a\x1ecityio/entity/v1/caravan.proto\x1a\x1acityio/entity/v1/bag.proto\"\xb9\x01\n" +
+	"\x12SendCaravanRequest\x121\n" +
+	"\acity_id\x18\x01 \x01(\v2\x18.cityio.entity.v1.CityIdR\x06cityId\x12H\n" +
+	"\x13destination_city_id\x18\x02 \x01(\v2\x18.cityio.entity.v1.CityIdR\x11destinationCityId\x12\x12\n" +
+	"\x04gold\x18\x03 \x01(\x03R\x04gold\x12\x12\n" +
+	"\x04food\x18\x04 \x01(\x03R\x04food\"J\n" +
+	"\x13SendCaravanResponse\x123\n" +
+	"\acaravan\x18\x01 \x01(\v2\x19.cityio.entity.v1.CaravanR\acaravan\"O\n" +
+	"\x11GetCaravanRequest\x12:\n" +
+	"\n" +
+	"caravan_id\x18\x01 \x01(\v2\x1b.cityio.entity.v1.CaravanIdR\tcaravanId\"I\n" +
+	"\x12GetCaravanResponse\x123\n" +
+	"\acaravan\x18\x01 \x01(\v2\x19.cityio.entity.v1.CaravanR\acaravan\"\x15\n" +
+	"\x13ListCaravansRequest\"\x8d\x01\n" +
+	"\x14ListCaravansResponse\x12<\n" +
+	"\vcaravan_ids\x18\x01 \x03(\v2\x1b.cityio.entity.v1.CaravanIdR\n" +
+	"caravanIds\x127\n" +
+	"\bentities\x18\x02 \x01(\v2\x1b.cityio.entity.v1.EntityBagR\bentities2\xaa\x02\n" +
+	"\x0eCaravanService\x12\\\n" +
+	"\vSendCaravan\x12%.cityio.service.v1.SendCaravanRequest\x1a&.cityio.service.v1.SendCaravanResponse\x12Y\n" +
+	"\n" +
+	"GetCaravan\x12$.cityio.service.v1.GetCaravanRequest\x1a%.cityio.service.v1.GetCaravanResponse\x12_\n" +
+	"\fListCaravans\x12&.cityio.service.v1.ListCaravansRequest\x1a'.cityio.service.v1.ListCaravansResponseB\xbc\x01\n" +
+	"\x15com.cityio.service.v1B\fCaravanProtoP\x01Z/cityio/internal/gen/cityio/service/v1;servicev1\xa2\x02\x03CSX\xaa\x02\x11Cityio.Service.V1\xca\x02\x11Cityio\\Service\\V1\xe2\x02\x1dCityio\\Service\\V1\\GPBMetadata\xea\x02\x13Cityio::Service::V1b\x06proto3"
+
+var (
+	file_cityio_service_v1_caravan_proto_rawDescOnce sync.Once
+	file_cityio_service_v1_caravan_proto_rawDescData []byte
+)
+
+func file_cityio_service_v1_caravan_proto_rawDescGZIP() []byte {
+	file_cityio_service_v1_caravan_proto_rawDescOnce.Do(func() {
+		file_cityio_service_v1_caravan_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cityio_service_v1_caravan_proto_rawDesc), len(file_cityio_service_v1_caravan_proto_rawDesc)))
+	})
+	return file_cityio_service_v1_caravan_proto_rawDescData
+}
+
+var file_cityio_service_v1_caravan_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_cityio_service_v1_caravan_proto_goTypes = []any{
+	(*SendCaravanRequest)(nil),   // 0: cityio.service.v1.SendCaravanRequest
+	(*SendCaravanResponse)(nil),  // 1: cityio.service.v1.SendCaravanResponse
+	(*GetCaravanRequest)(nil),    // 2: cityio.service.v1.GetCaravanRequest
+	(*GetCaravanResponse)(nil),   // 3: cityio.service.v1.GetCaravanResponse
+	(*ListCaravansRequest)(nil),  // 4: cityio.service.v1.ListCaravansRequest
+	(*ListCaravansResponse)(nil), // 5: cityio.service.v1.ListCaravansResponse
+	(*v1.CityId)(nil),            // 6: cityio.entity.v1.CityId
+	(*v1.Caravan)(nil),           // 7: cityio.entity.v1.Caravan
+	(*v1.CaravanId)(nil),         // 8: cityio.entity.v1.CaravanId
+	(*v1.EntityBag)(nil),         // 9: cityio.entity.v1.EntityBag
+}
+var file_cityio_service_v1_caravan_proto_depIdxs = []int32{
+	6,  // 0: cityio.service.v1.SendCaravanRequest.city_id:type_name -> cityio.entity.v1.CityId
+	6,  // 1: cityio.service.v1.SendCaravanRequest.destination_city_id:type_name -> cityio.entity.v1.CityId
+	7,  // 2: cityio.service.v1.SendCaravanResponse.caravan:type_name -> cityio.entity.v1.Caravan
+	8,  // 3: cityio.service.v1.GetCaravanRequest.caravan_id:type_name -> cityio.entity.v1.CaravanId
+	7,  // 4: cityio.service.v1.GetCaravanResponse.caravan:type_name -> cityio.entity.v1.Caravan
+	8,  // 5: cityio.service.v1.ListCaravansResponse.caravan_ids:type_name -> cityio.entity.v1.CaravanId
+	9,  // 6: cityio.service.v1.ListCaravansResponse.entities:type_name -> cityio.entity.v1.EntityBag
+	0,  // 7: cityio.service.v1.CaravanService.SendCaravan:input_type -> cityio.service.v1.SendCaravanRequest
+	2,  // 8: cityio.service.v1.CaravanService.GetCaravan:input_type -> cityio.service.v1.GetCaravanRequest
+	4,  // 9: cityio.service.v1.CaravanService.ListCaravans:input_type -> cityio.service.v1.ListCaravansRequest
+	1,  // 10: cityio.service.v1.CaravanService.SendCaravan:output_type -> cityio.service.v1.SendCaravanResponse
+	3,  // 11: cityio.service.v1.CaravanService.GetCaravan:output_type -> cityio.service.v1.GetCaravanResponse
+	5,  // 12: cityio.service.v1.CaravanService.ListCaravans:output_type -> cityio.service.v1.ListCaravansResponse
+	10, // [10:13] is the sub-list for method output_type
+	7,  // [7:10] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_cityio_service_v1_caravan_proto_init() }
+func file_cityio_service_v1_caravan_proto_init() {
+	if File_cityio_service_v1_caravan_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cityio_service_v1_caravan_proto_rawDesc), len(file_cityio_service_v1_caravan_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_cityio_service_v1_caravan_proto_goTypes,
+		DependencyIndexes: file_cityio_service_v1_caravan_proto_depIdxs,
+		MessageInfos:      file_cityio_service_v1_caravan_proto_msgTypes,
+	}.Build()
+	File_cityio_service_v1_caravan_proto = out.File
+	file_cityio_service_v1_caravan_proto_goTypes = nil
+	file_cityio_service_v1_caravan_proto_depIdxs = nil
+}
@@ -0,0 +1,424 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: cityio/service/v1/chat.proto
+
+package servicev1
+
+import (
+	v1 "cityio/internal/gen/cityio/entity/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SendMessageRequest posts body to a channel. The alliance channel is always
+// the caller's own alliance, so its alliance_id may be left unset.
+type SendMessageRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Channel *v1.ChatChannel        `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// body is trimmed and must be between 1 and 500 characters.
+	Body          string `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendMessageRequest) Reset() {
+	*x = SendMessageRequest{}
+	mi := &file_cityio_service_v1_chat_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendMessageRequest) ProtoMessage() {}
+
+func (x *SendMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_chat_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendMessageRequest.ProtoReflect.Descriptor instead.
+func (*SendMessageRequest) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_chat_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SendMessageRequest) GetChannel() *v1.ChatChannel {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+func (x *SendMessageRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type SendMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *v1.ChatMessage        `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendMessageResponse) Reset() {
+	*x = SendMessageResponse{}
+	mi := &file_cityio_service_v1_chat_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendMessageResponse) ProtoMessage() {}
+
+func (x *SendMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_chat_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendMessageResponse.ProtoReflect.Descriptor instead.
+func (*SendMessageResponse) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_chat_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SendMessageResponse) GetMessage() *v1.ChatMessage {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+// SubscribeRequest streams the messages the caller receives from now on.
+// kinds limits the stream to those channel kinds; empty means all of them.
+type SubscribeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kinds         []v1.ChatChannelKind   `protobuf:"varint,1,rep,packed,name=kinds,proto3,enum=cityio.entity.v1.ChatChannelKind" json:"kinds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeRequest) Reset() {
+	*x = SubscribeRequest{}
+	mi := &file_cityio_service_v1_chat_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeRequest) ProtoMessage() {}
+
+func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_chat_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_chat_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SubscribeRequest) GetKinds() []v1.ChatChannelKind {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+type SubscribeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *v1.ChatMessage        `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeResponse) Reset() {
+	*x = SubscribeResponse{}
+	mi := &file_cityio_service_v1_chat_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeResponse) ProtoMessage() {}
+
+func (x *SubscribeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_chat_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeResponse) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_chat_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SubscribeResponse) GetMessage() *v1.ChatMessage {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+// ListMessagesRequest returns a channel's history, newest first. limit
+// defaults to 50 and is capped at 200. page_token continues from the
+// next_page_token of the previous page.
+type ListMessagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       *v1.ChatChannel        `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMessagesRequest) Reset() {
+	*x = ListMessagesRequest{}
+	mi := &file_cityio_service_v1_chat_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMessagesRequest) ProtoMessage() {}
+
+func (x *ListMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_chat_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMessagesRequest.ProtoReflect.Descriptor instead.
+func (*ListMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_chat_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListMessagesRequest) GetChannel() *v1.ChatChannel {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+func (x *ListMessagesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListMessagesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListMessagesResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Messages []*v1.ChatMessage      `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	// next_page_token fetches the older messages. Empty on the last page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMessagesResponse) Reset() {
+	*x = ListMessagesResponse{}
+	mi := &file_cityio_service_v1_chat_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMessagesResponse) ProtoMessage() {}
+
+func (x *ListMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_chat_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMessagesResponse.ProtoReflect.Descriptor instead.
+func (*ListMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_chat_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListMessagesResponse) GetMessages() []*v1.ChatMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *ListMessagesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+var File_cityio_service_v1_chat_proto protoreflect.FileDescriptor
+
+const file_cityio_service_v1_chat_proto_rawDesc = "" +
+	"\n" +
+	"\x1ccityio/service/v1/chat.proto\x12\x11cityio.service.v1\x1a\x1bcityio/entity/v1/chat.proto\"a\n" +
+	"\x12SendMessageRequest\x127\n" +
+	"\achannel\x18\x01 \x01(\v2\x1d.cityio.entity.v1.ChatChannelR\achannel\x12\x12\n" +
+	"\x04body\x18\x02 \x01(\tR\x04body\"N\n" +
+	"\x13SendMessageResponse\x127\n" +
+	"\amessage\x18\x01 \x01(\v2\x1d.cityio.entity.v1.ChatMessageR\amessage\"K\n" +
+	"\x10SubscribeRequest\x127\n" +
+	"\x05kinds\x18\x01 \x03(\x0e2!.cityio.entity.v1.ChatChannelKindR\x05kinds\"L\n" +
+	"\x11SubscribeResponse\x127\n" +
+	"\amessage\x18\x01 \x01(\v2\x1d.cityio.entity.v1.ChatMessageR\amessage\"\x83\x01\n" +
+	"\x13ListMessagesRequest\x127\n" +
+	"\achannel\x18\x01 \x01(\v2\x1d.cityio.entity.v1.ChatChannelR\achannel\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"y\n" +
+	"\x14ListMessagesResponse\x129\n" +
+	"\bmessages\x18\x01 \x03(\v2\x1d.cityio.entity.v1.ChatMessageR\bmessages\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken2\xa6\x02\n" +
+	"\vChatService\x12\\\n" +
+	"\vSendMessage\x12%.cityio.service.v1.SendMessageRequest\x1a&.cityio.service.v1.SendMessageResponse\x12X\n" +
+	"\tSubscribe\x12#.cityio.service.v1.SubscribeRequest\x1a$.cityio.service.v1.SubscribeResponse0\x01\x12_\n" +
+	"\fListMessages\x12&.cityio.service.v1.ListMessagesRequest\x1a'.cityio.service.v1.ListMessagesResponseB\xb9\x01\n" +
+	"\x15com.cityio.service.v1B\tChatProtoP\x01Z/cityio/internal/gen/cityio/service/v1;servicev1\xa2\x02\x03CSX\xaa\x02\x11Cityio.Service.V1\xca\x02\x11Cityio\\Service\\V1\xe2\x02\x1dCityio\\Service\\V1\\GPBMetadata\xea\x02\x13Cityio::Service::V1b\x06proto3"
+
+var (
+	file_cityio_service_v1_chat_proto_rawDescOnce sync.Once
+	file_cityio_service_v1_chat_proto_rawDescData []byte
+)
+
+func file_cityio_service_v1_chat_proto_rawDescGZIP() []byte {
+	file_cityio_service_v1_chat_proto_rawDescOnce.Do(func() {
+		file_cityio_service_v1_chat_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cityio_service_v1_chat_proto_rawDesc), len(file_cityio_service_v1_chat_proto_rawDesc)))
+	})
+	return file_cityio_service_v1_chat_proto_rawDescData
+}
+
+var file_cityio_service_v1_chat_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_cityio_service_v1_chat_proto_goTypes = []any{
+	(*SendMessageRequest)(nil),   // 0: cityio.service.v1.SendMessageRequest
+	(*SendMessageResponse)(nil),  // 1: cityio.service.v1.SendMessageResponse
+	(*SubscribeRequest)(nil),     // 2: cityio.service.v1.SubscribeRequest
+	(*SubscribeResponse)(nil),    // 3: cityio.service.v1.SubscribeResponse
+	(*ListMessagesRequest)(nil),  // 4: cityio.service.v1.ListMessagesRequest
+	(*ListMessagesResponse)(nil), // 5: cityio.service.v1.ListMessagesResponse
+	(*v1.ChatChannel)(nil),       // 6: cityio.entity.v1.ChatChannel
+	(*v1.ChatMessage)(nil),       // 7: cityio.entity.v1.ChatMessage
+	(v1.ChatChannelKind)(0),      // 8: cityio.entity.v1.ChatChannelKind
+}
+var file_cityio_service_v1_chat_proto_depIdxs = []int32{
+	6, // 0: cityio.service.v1.SendMessageRequest.channel:type_name -> cityio.entity.v1.ChatChannel
+	7, // 1: cityio.service.v1.SendMessageResponse.message:type_name -> cityio.entity.v1.ChatMessage
+	8, // 2: cityio.service.v1.SubscribeRequest.kinds:type_name -> cityio.entity.v1.ChatChannelKind
+	7, // 3: cityio.service.v1.SubscribeResponse.message:type_name -> cityio.entity.v1.ChatMessage
+	6, // 4: cityio.service.v1.ListMessagesRequest.channel:type_name -> cityio.entity.v1.ChatChannel
+	7, // 5: cityio.service.v1.ListMessagesResponse.messages:type_name -> cityio.entity.v1.ChatMessage
+	0, // 6: cityio.service.v1.ChatService.SendMessage:input_type -> cityio.service.v1.SendMessageRequest
+	2, // 7: cityio.service.v1.ChatService.Subscribe:input_type -> cityio.service.v1.SubscribeRequest
+	4, // 8: cityio.service.v1.ChatService.ListMessages:input_type -> cityio.service.v1.ListMessagesRequest
+	1, // 9: cityio.service.v1.ChatService.SendMessage:output_type -> cityio.service.v1.SendMessageResponse
+	3, // 10: cityio.service.v1.ChatService.Subscribe:output_type -> cityio.service.v1.SubscribeResponse
+	5, // 11: cityio.service.v1.ChatService.ListMessages:output_type -> cityio.service.v1.ListMessagesResponse
+	9, // [9:12] is the sub-list for method output_type
+	6, // [6:9] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_cityio_service_v1_chat_proto_init() }
+func file_cityio_service_v1_chat_proto_init() {
+	if File_cityio_service_v1_chat_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cityio_service_v1_chat_proto_rawDesc), len(file_cityio_service_v1_chat_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_cityio_service_v1_chat_proto_goTypes,
+		DependencyIndexes: file_cityio_service_v1_chat_proto_depIdxs,
+		MessageInfos:      file_cityio_service_v1_chat_proto_msgTypes,
+	}.Build()
+	File_cityio_service_v1_chat_proto = out.File
+	file_cityio_service_v1_chat_proto_goTypes = nil
+	file_cityio_service_v1_chat_proto_depIdxs = nil
+}
@@ -310,6 +310,556 @@ func (x *ListCitiesResponse) GetEntities() *v1.EntityBag {
 	return nil
 }
 
+type SetImportPriorityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CityId        *v1.CityId             `protobuf:"bytes,1,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
+	Priority      int32                  `protobuf:"varint,2,opt,name=priority,proto3" json:"priority,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetImportPriorityRequest) Reset() {
+	*x = SetImportPriorityRequest{}
+	mi := &file_cityio_service_v1_city_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetImportPriorityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetImportPriorityRequest) ProtoMessage() {}
+
+func (x *SetImportPriorityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_city_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetImportPriorityRequest.ProtoReflect.Descriptor instead.
+func (*SetImportPriorityRequest) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_city_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SetImportPriorityRequest) GetCityId() *v1.CityId {
+	if x != nil {
+		return x.CityId
+	}
+	return nil
+}
+
+func (x *SetImportPriorityRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+type SetImportPriorityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetImportPriorityResponse) Reset() {
+	*x = SetImportPriorityResponse{}
+	mi := &file_cityio_service_v1_city_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetImportPriorityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetImportPriorityResponse) ProtoMessage() {}
+
+func (x *SetImportPriorityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_city_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetImportPriorityResponse.ProtoReflect.Descriptor instead.
+func (*SetImportPriorityResponse) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_city_proto_rawDescGZIP(), []int{7}
+}
+
+type SetTaxRateRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	CityId *v1.CityId             `protobuf:"bytes,1,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
+	// tax_rate is in percent, from 0 to the server's maximum.
+	TaxRate       int32 `protobuf:"varint,2,opt,name=tax_rate,json=taxRate,proto3" json:"tax_rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTaxRateRequest) Reset() {
+	*x = SetTaxRateRequest{}
+	mi := &file_cityio_service_v1_city_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTaxRateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTaxRateRequest) ProtoMessage() {}
+
+func (x *SetTaxRateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_city_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTaxRateRequest.ProtoReflect.Descriptor instead.
+func (*SetTaxRateRequest) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_city_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SetTaxRateRequest) GetCityId() *v1.CityId {
+	if x != nil {
+		return x.CityId
+	}
+	return nil
+}
+
+func (x *SetTaxRateRequest) GetTaxRate() int32 {
+	if x != nil {
+		return x.TaxRate
+	}
+	return 0
+}
+
+type SetTaxRateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTaxRateResponse) Reset() {
+	*x = SetTaxRateResponse{}
+	mi := &file_cityio_service_v1_city_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTaxRateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTaxRateResponse) ProtoMessage() {}
+
+func (x *SetTaxRateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_city_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTaxRateResponse.ProtoReflect.Descriptor instead.
+func (*SetTaxRateResponse) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_city_proto_rawDescGZIP(), []int{9}
+}
+
+// NewBuildingOrder queues a new building of the given type on a tile.
+type NewBuildingOrder struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Type   v1.BuildingType        `protobuf:"varint,1,opt,name=type,proto3,enum=cityio.entity.v1.BuildingType" json:"type,omitempty"`
+	Coords *v1.Coordinates        `protobuf:"bytes,2,opt,name=coords,proto3" json:"coords,omitempty"`
+	// type_id takes precedence over type when set; see CreateBuildingRequest.
+	TypeId        string `protobuf:"bytes,3,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NewBuildingOrder) Reset() {
+	*x = NewBuildingOrder{}
+	mi := &file_cityio_service_v1_city_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NewBuildingOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewBuildingOrder) ProtoMessage() {}
+
+func (x *NewBuildingOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_city_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewBuildingOrder.ProtoReflect.Descriptor instead.
+func (*NewBuildingOrder) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_city_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *NewBuildingOrder) GetType() v1.BuildingType {
+	if x != nil {
+		return x.Type
+	}
+	return v1.BuildingType(0)
+}
+
+func (x *NewBuildingOrder) GetCoords() *v1.Coordinates {
+	if x != nil {
+		return x.Coords
+	}
+	return nil
+}
+
+func (x *NewBuildingOrder) GetTypeId() string {
+	if x != nil {
+		return x.TypeId
+	}
+	return ""
+}
+
+type EnqueueConstructionRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	CityId *v1.CityId             `protobuf:"bytes,1,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
+	// Types that are valid to be assigned to Order:
+	//
+	//	*EnqueueConstructionRequest_Build
+	//	*EnqueueConstructionRequest_Upgrade
+	Order         isEnqueueConstructionRequest_Order `protobuf_oneof:"order"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnqueueConstructionRequest) Reset() {
+	*x = EnqueueConstructionRequest{}
+	mi := &file_cityio_service_v1_city_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnqueueConstructionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnqueueConstructionRequest) ProtoMessage() {}
+
+func (x *EnqueueConstructionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_city_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnqueueConstructionRequest.ProtoReflect.Descriptor instead.
+func (*EnqueueConstructionRequest) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_city_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *EnqueueConstructionRequest) GetCityId() *v1.CityId {
+	if x != nil {
+		return x.CityId
+	}
+	return nil
+}
+
+func (x *EnqueueConstructionRequest) GetOrder() isEnqueueConstructionRequest_Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *EnqueueConstructionRequest) GetBuild() *NewBuildingOrder {
+	if x != nil {
+		if x, ok := x.Order.(*EnqueueConstructionRequest_Build); ok {
+			return x.Build
+		}
+	}
+	return nil
+}
+
+func (x *EnqueueConstructionRequest) GetUpgrade() *v1.BuildingId {
+	if x != nil {
+		if x, ok := x.Order.(*EnqueueConstructionRequest_Upgrade); ok {
+			return x.Upgrade
+		}
+	}
+	return nil
+}
+
+type isEnqueueConstructionRequest_Order interface {
+	isEnqueueConstructionRequest_Order()
+}
+
+type EnqueueConstructionRequest_Build struct {
+	Build *NewBuildingOrder `protobuf:"bytes,2,opt,name=build,proto3,oneof"`
+}
+
+type EnqueueConstructionRequest_Upgrade struct {
+	Upgrade *v1.BuildingId `protobuf:"bytes,3,opt,name=upgrade,proto3,oneof"`
+}
+
+func (*EnqueueConstructionRequest_Build) isEnqueueConstructionRequest_Order() {}
+
+func (*EnqueueConstructionRequest_Upgrade) isEnqueueConstructionRequest_Order() {}
+
+type EnqueueConstructionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *v1.ConstructionOrder  `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnqueueConstructionResponse) Reset() {
+	*x = EnqueueConstructionResponse{}
+	mi := &file_cityio_service_v1_city_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnqueueConstructionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnqueueConstructionResponse) ProtoMessage() {}
+
+func (x *EnqueueConstructionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_city_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnqueueConstructionResponse.ProtoReflect.Descriptor instead.
+func (*EnqueueConstructionResponse) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_city_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *EnqueueConstructionResponse) GetOrder() *v1.ConstructionOrder {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+type ReorderConstructionQueueRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	CityId *v1.CityId             `protobuf:"bytes,1,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
+	// order_ids is the new queue order and must name every queued order once.
+	OrderIds      []string `protobuf:"bytes,2,rep,name=order_ids,json=orderIds,proto3" json:"order_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderConstructionQueueRequest) Reset() {
+	*x = ReorderConstructionQueueRequest{}
+	mi := &file_cityio_service_v1_city_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderConstructionQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderConstructionQueueRequest) ProtoMessage() {}
+
+func (x *ReorderConstructionQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_city_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderConstructionQueueRequest.ProtoReflect.Descriptor instead.
+func (*ReorderConstructionQueueRequest) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_city_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ReorderConstructionQueueRequest) GetCityId() *v1.CityId {
+	if x != nil {
+		return x.CityId
+	}
+	return nil
+}
+
+func (x *ReorderConstructionQueueRequest) GetOrderIds() []string {
+	if x != nil {
+		return x.OrderIds
+	}
+	return nil
+}
+
+type ReorderConstructionQueueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderConstructionQueueResponse) Reset() {
+	*x = ReorderConstructionQueueResponse{}
+	mi := &file_cityio_service_v1_city_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderConstructionQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderConstructionQueueResponse) ProtoMessage() {}
+
+func (x *ReorderConstructionQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_city_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderConstructionQueueResponse.ProtoReflect.Descriptor instead.
+func (*ReorderConstructionQueueResponse) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_city_proto_rawDescGZIP(), []int{14}
+}
+
+type CancelConstructionOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CityId        *v1.CityId             `protobuf:"bytes,1,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
+	OrderId       string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelConstructionOrderRequest) Reset() {
+	*x = CancelConstructionOrderRequest{}
+	mi := &file_cityio_service_v1_city_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelConstructionOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelConstructionOrderRequest) ProtoMessage() {}
+
+func (x *CancelConstructionOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_city_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelConstructionOrderRequest.ProtoReflect.Descriptor instead.
+func (*CancelConstructionOrderRequest) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_city_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CancelConstructionOrderRequest) GetCityId() *v1.CityId {
+	if x != nil {
+		return x.CityId
+	}
+	return nil
+}
+
+func (x *CancelConstructionOrderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type CancelConstructionOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelConstructionOrderResponse) Reset() {
+	*x = CancelConstructionOrderResponse{}
+	mi := &file_cityio_service_v1_city_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelConstructionOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelConstructionOrderResponse) ProtoMessage() {}
+
+func (x *CancelConstructionOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_city_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelConstructionOrderResponse.ProtoReflect.Descriptor instead.
+func (*CancelConstructionOrderResponse) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_city_proto_rawDescGZIP(), []int{16}
+}
+
 var File_cityio_service_v1_city_proto protoreflect.FileDescriptor
 
 const file_cityio_service_v1_city_proto_rawDesc = "" +
@@ -330,13 +880,46 @@ const file_cityio_service_v1_city_proto_rawDesc = "" +
 	"\x11ListCitiesRequest\"\x82\x01\n" +
 	"\x12ListCitiesResponse\x123\n" +
 	"\bcity_ids\x18\x01 \x03(\v2\x18.cityio.entity.v1.CityIdR\acityIds\x127\n" +
-	"\bentities\x18\x02 \x01(\v2\x1b.cityio.entity.v1.EntityBagR\bentities2\x95\x02\n" +
+	"\bentities\x18\x02 \x01(\v2\x1b.cityio.entity.v1.EntityBagR\bentities\"i\n" +
+	"\x18SetImportPriorityRequest\x121\n" +
+	"\acity_id\x18\x01 \x01(\v2\x18.cityio.entity.v1.CityIdR\x06cityId\x12\x1a\n" +
+	"\bpriority\x18\x02 \x01(\x05R\bpriority\"\x1b\n" +
+	"\x19SetImportPriorityResponse\"a\n" +
+	"\x11SetTaxRateRequest\x121\n" +
+	"\acity_id\x18\x01 \x01(\v2\x18.cityio.entity.v1.CityIdR\x06cityId\x12\x19\n" +
+	"\btax_rate\x18\x02 \x01(\x05R\ataxRate\"\x14\n" +
+	"\x12SetTaxRateResponse\"\x96\x01\n" +
+	"\x10NewBuildingOrder\x122\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1e.cityio.entity.v1.BuildingTypeR\x04type\x125\n" +
+	"\x06coords\x18\x02 \x01(\v2\x1d.cityio.entity.v1.CoordinatesR\x06coords\x12\x17\n" +
+	"\atype_id\x18\x03 \x01(\tR\x06typeId\"\xcf\x01\n" +
+	"\x1aEnqueueConstructionRequest\x121\n" +
+	"\acity_id\x18\x01 \x01(\v2\x18.cityio.entity.v1.CityIdR\x06cityId\x12;\n" +
+	"\x05build\x18\x02 \x01(\v2#.cityio.service.v1.NewBuildingOrderH\x00R\x05build\x128\n" +
+	"\aupgrade\x18\x03 \x01(\v2\x1c.cityio.entity.v1.BuildingIdH\x00R\aupgradeB\a\n" +
+	"\x05order\"X\n" +
+	"\x1bEnqueueConstructionResponse\x129\n" +
+	"\x05order\x18\x01 \x01(\v2#.cityio.entity.v1.ConstructionOrderR\x05order\"q\n" +
+	"\x1fReorderConstructionQueueRequest\x121\n" +
+	"\acity_id\x18\x01 \x01(\v2\x18.cityio.entity.v1.CityIdR\x06cityId\x12\x1b\n" +
+	"\torder_ids\x18\x02 \x03(\tR\borderIds\"\"\n" +
+	" ReorderConstructionQueueResponse\"n\n" +
+	"\x1eCancelConstructionOrderRequest\x121\n" +
+	"\acity_id\x18\x01 \x01(\v2\x18.cityio.entity.v1.CityIdR\x06cityId\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\"!\n" +
+	"\x1fCancelConstructionOrderResponse2\xdf\x06\n" +
 	"\vCityService\x12P\n" +
 	"\aGetCity\x12!.cityio.service.v1.GetCityRequest\x1a\".cityio.service.v1.GetCityResponse\x12Y\n" +
 	"\n" +
 	"CreateCity\x12$.cityio.service.v1.CreateCityRequest\x1a%.cityio.service.v1.CreateCityResponse\x12Y\n" +
 	"\n" +
-	"ListCities\x12$.cityio.service.v1.ListCitiesRequest\x1a%.cityio.service.v1.ListCitiesResponseB\xb9\x01\n" +
+	"ListCities\x12$.cityio.service.v1.ListCitiesRequest\x1a%.cityio.service.v1.ListCitiesResponse\x12n\n" +
+	"\x11SetImportPriority\x12+.cityio.service.v1.SetImportPriorityRequest\x1a,.cityio.service.v1.SetImportPriorityResponse\x12Y\n" +
+	"\n" +
+	"SetTaxRate\x12$.cityio.service.v1.SetTaxRateRequest\x1a%.cityio.service.v1.SetTaxRateResponse\x12t\n" +
+	"\x13EnqueueConstruction\x12-.cityio.service.v1.EnqueueConstructionRequest\x1a..cityio.service.v1.EnqueueConstructionResponse\x12\x83\x01\n" +
+	"\x18ReorderConstructionQueue\x122.cityio.service.v1.ReorderConstructionQueueRequest\x1a3.cityio.service.v1.ReorderConstructionQueueResponse\x12\x80\x01\n" +
+	"\x17CancelConstructionOrder\x121.cityio.service.v1.CancelConstructionOrderRequest\x1a2.cityio.service.v1.CancelConstructionOrderResponseB\xb9\x01\n" +
 	"\x15com.cityio.service.v1B\tCityProtoP\x01Z/cityio/internal/gen/cityio/service/v1;servicev1\xa2\x02\x03CSX\xaa\x02\x11Cityio.Service.V1\xca\x02\x11Cityio\\Service\\V1\xe2\x02\x1dCityio\\Service\\V1\\GPBMetadata\xea\x02\x13Cityio::Service::V1b\x06proto3"
 
 var (
@@ -351,39 +934,74 @@ func file_cityio_service_v1_city_proto_rawDescGZIP() []byte {
 	return file_cityio_service_v1_city_proto_rawDescData
 }
 
-var file_cityio_service_v1_city_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_cityio_service_v1_city_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_cityio_service_v1_city_proto_goTypes = []any{
-	(*GetCityRequest)(nil),     // 0: cityio.service.v1.GetCityRequest
-	(*GetCityResponse)(nil),    // 1: cityio.service.v1.GetCityResponse
-	(*CreateCityRequest)(nil),  // 2: cityio.service.v1.CreateCityRequest
-	(*CreateCityResponse)(nil), // 3: cityio.service.v1.CreateCityResponse
-	(*ListCitiesRequest)(nil),  // 4: cityio.service.v1.ListCitiesRequest
-	(*ListCitiesResponse)(nil), // 5: cityio.service.v1.ListCitiesResponse
-	(*v1.CityId)(nil),          // 6: cityio.entity.v1.CityId
-	(*v1.City)(nil),            // 7: cityio.entity.v1.City
-	(v1.CityType)(0),           // 8: cityio.entity.v1.CityType
-	(*v1.UserId)(nil),          // 9: cityio.entity.v1.UserId
-	(*v1.EntityBag)(nil),       // 10: cityio.entity.v1.EntityBag
+	(*GetCityRequest)(nil),                   // 0: cityio.service.v1.GetCityRequest
+	(*GetCityResponse)(nil),                  // 1: cityio.service.v1.GetCityResponse
+	(*CreateCityRequest)(nil),                // 2: cityio.service.v1.CreateCityRequest
+	(*CreateCityResponse)(nil),               // 3: cityio.service.v1.CreateCityResponse
+	(*ListCitiesRequest)(nil),                // 4: cityio.service.v1.ListCitiesRequest
+	(*ListCitiesResponse)(nil),               // 5: cityio.service.v1.ListCitiesResponse
+	(*SetImportPriorityRequest)(nil),         // 6: cityio.service.v1.SetImportPriorityRequest
+	(*SetImportPriorityResponse)(nil),        // 7: cityio.service.v1.SetImportPriorityResponse
+	(*SetTaxRateRequest)(nil),                // 8: cityio.service.v1.SetTaxRateRequest
+	(*SetTaxRateResponse)(nil),               // 9: cityio.service.v1.SetTaxRateResponse
+	(*NewBuildingOrder)(nil),                 // 10: cityio.service.v1.NewBuildingOrder
+	(*EnqueueConstructionRequest)(nil),       // 11: cityio.service.v1.EnqueueConstructionRequest
+	(*EnqueueConstructionResponse)(nil),      // 12: cityio.service.v1.EnqueueConstructionResponse
+	(*ReorderConstructionQueueRequest)(nil),  // 13: cityio.service.v1.ReorderConstructionQueueRequest
+	(*ReorderConstructionQueueResponse)(nil), // 14: cityio.service.v1.ReorderConstructionQueueResponse
+	(*CancelConstructionOrderRequest)(nil),   // 15: cityio.service.v1.CancelConstructionOrderRequest
+	(*CancelConstructionOrderResponse)(nil),  // 16: cityio.service.v1.CancelConstructionOrderResponse
+	(*v1.CityId)(nil),                        // 17: cityio.entity.v1.CityId
+	(*v1.City)(nil),                          // 18: cityio.entity.v1.City
+	(v1.CityType)(0),                         // 19: cityio.entity.v1.CityType
+	(*v1.UserId)(nil),                        // 20: cityio.entity.v1.UserId
+	(*v1.EntityBag)(nil),                     // 21: cityio.entity.v1.EntityBag
+	(v1.BuildingType)(0),                     // 22: cityio.entity.v1.BuildingType
+	(*v1.Coordinates)(nil),                   // 23: cityio.entity.v1.Coordinates
+	(*v1.BuildingId)(nil),                    // 24: cityio.entity.v1.BuildingId
+	(*v1.ConstructionOrder)(nil),             // 25: cityio.entity.v1.ConstructionOrder
 }
 var file_cityio_service_v1_city_proto_depIdxs = []int32{
-	6,  // 0: cityio.service.v1.GetCityRequest.city_id:type_name -> cityio.entity.v1.CityId
-	7,  // 1: cityio.service.v1.GetCityResponse.city:type_name -> cityio.entity.v1.City
-	8,  // 2: cityio.service.v1.CreateCityRequest.type:type_name -> cityio.entity.v1.CityType
-	9,  // 3: cityio.service.v1.CreateCityRequest.owner:type_name -> cityio.entity.v1.UserId
-	7,  // 4: cityio.service.v1.CreateCityResponse.city:type_name -> cityio.entity.v1.City
-	6,  // 5: cityio.service.v1.ListCitiesResponse.city_ids:type_name -> cityio.entity.v1.CityId
-	10, // 6: cityio.service.v1.ListCitiesResponse.entities:type_name -> cityio.entity.v1.EntityBag
-	0,  // 7: cityio.service.v1.CityService.GetCity:input_type -> cityio.service.v1.GetCityRequest
-	2,  // 8: cityio.service.v1.CityService.CreateCity:input_type -> cityio.service.v1.CreateCityRequest
-	4,  // 9: cityio.service.v1.CityService.ListCities:input_type -> cityio.service.v1.ListCitiesRequest
-	1,  // 10: cityio.service.v1.CityService.GetCity:output_type -> cityio.service.v1.GetCityResponse
-	3,  // 11: cityio.service.v1.CityService.CreateCity:output_type -> cityio.service.v1.CreateCityResponse
-	5,  // 12: cityio.service.v1.CityService.ListCities:output_type -> cityio.service.v1.ListCitiesResponse
-	10, // [10:13] is the sub-list for method output_type
-	7,  // [7:10] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	17, // 0: cityio.service.v1.GetCityRequest.city_id:type_name -> cityio.entity.v1.CityId
+	18, // 1: cityio.service.v1.GetCityResponse.city:type_name -> cityio.entity.v1.City
+	19, // 2: cityio.service.v1.CreateCityRequest.type:type_name -> cityio.entity.v1.CityType
+	20, // 3: cityio.service.v1.CreateCityRequest.owner:type_name -> cityio.entity.v1.UserId
+	18, // 4: cityio.service.v1.CreateCityResponse.city:type_name -> cityio.entity.v1.City
+	17, // 5: cityio.service.v1.ListCitiesResponse.city_ids:type_name -> cityio.entity.v1.CityId
+	21, // 6: cityio.service.v1.ListCitiesResponse.entities:type_name -> cityio.entity.v1.EntityBag
+	17, // 7: cityio.service.v1.SetImportPriorityRequest.city_id:type_name -> cityio.entity.v1.CityId
+	17, // 8: cityio.service.v1.SetTaxRateRequest.city_id:type_name -> cityio.entity.v1.CityId
+	22, // 9: cityio.service.v1.NewBuildingOrder.type:type_name -> cityio.entity.v1.BuildingType
+	23, // 10: cityio.service.v1.NewBuildingOrder.coords:type_name -> cityio.entity.v1.Coordinates
+	17, // 11: cityio.service.v1.EnqueueConstructionRequest.city_id:type_name -> cityio.entity.v1.CityId
+	10, // 12: cityio.service.v1.EnqueueConstructionRequest.build:type_name -> cityio.service.v1.NewBuildingOrder
+	24, // 13: cityio.service.v1.EnqueueConstructionRequest.upgrade:type_name -> cityio.entity.v1.BuildingId
+	25, // 14: cityio.service.v1.EnqueueConstructionResponse.order:type_name -> cityio.entity.v1.ConstructionOrder
+	17, // 15: cityio.service.v1.ReorderConstructionQueueRequest.city_id:type_name -> cityio.entity.v1.CityId
+	17, // 16: cityio.service.v1.CancelConstructionOrderRequest.city_id:type_name -> cityio.entity.v1.CityId
+	0,  // 17: cityio.service.v1.CityService.GetCity:input_type -> cityio.service.v1.GetCityRequest
+	2,  // 18: cityio.service.v1.CityService.CreateCity:input_type -> cityio.service.v1.CreateCityRequest
+	4,  // 19: cityio.service.v1.CityService.ListCities:input_type -> cityio.service.v1.ListCitiesRequest
+	6,  // 20: cityio.service.v1.CityService.SetImportPriority:input_type -> cityio.service.v1.SetImportPriorityRequest
+	8,  // 21: cityio.service.v1.CityService.SetTaxRate:input_type -> cityio.service.v1.SetTaxRateRequest
+	11, // 22: cityio.service.v1.CityService.EnqueueConstruction:input_type -> cityio.service.v1.EnqueueConstructionRequest
+	13, // 23: cityio.service.v1.CityService.ReorderConstructionQueue:input_type -> cityio.service.v1.ReorderConstructionQueueRequest
+	15, // 24: cityio.service.v1.CityService.CancelConstructionOrder:input_type -> cityio.service.v1.CancelConstructionOrderRequest
+	1,  // 25: cityio.service.v1.CityService.GetCity:output_type -> cityio.service.v1.GetCityResponse
+	3,  // 26: cityio.service.v1.CityService.CreateCity:output_type -> cityio.service.v1.CreateCityResponse
+	5,  // 27: cityio.service.v1.CityService.ListCities:output_type -> cityio.service.v1.ListCitiesResponse
+	7,  // 28: cityio.service.v1.CityService.SetImportPriority:output_type -> cityio.service.v1.SetImportPriorityResponse
+	9,  // 29: cityio.service.v1.CityService.SetTaxRate:output_type -> cityio.service.v1.SetTaxRateResponse
+	12, // 30: cityio.service.v1.CityService.EnqueueConstruction:output_type -> cityio.service.v1.EnqueueConstructionResponse
+	14, // 31: cityio.service.v1.CityService.ReorderConstructionQueue:output_type -> cityio.service.v1.ReorderConstructionQueueResponse
+	16, // 32: cityio.service.v1.CityService.CancelConstructionOrder:output_type -> cityio.service.v1.CancelConstructionOrderResponse
+	25, // [25:33] is the sub-list for method output_type
+	17, // [17:25] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_cityio_service_v1_city_proto_init() }
@@ -392,13 +1010,17 @@ func file_cityio_service_v1_city_proto_init() {
 		return
 	}
 	file_cityio_service_v1_city_proto_msgTypes[2].OneofWrappers = []any{}
+	file_cityio_service_v1_city_proto_msgTypes[11].OneofWrappers = []any{
+		(*EnqueueConstructionRequest_Build)(nil),
+		(*EnqueueConstructionRequest_Upgrade)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cityio_service_v1_city_proto_rawDesc), len(file_cityio_service_v1_city_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -23,6 +23,65 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type TechEffectKind int32
+
+const (
+	TechEffectKind_TECH_EFFECT_KIND_UNSPECIFIED TechEffectKind = 0
+	// Raises a building's output of resource by percent.
+	TechEffectKind_TECH_EFFECT_KIND_PRODUCTION TechEffectKind = 1
+	// Changes build and upgrade costs by percent.
+	TechEffectKind_TECH_EFFECT_KIND_COST TechEffectKind = 2
+	// Changes build and upgrade times by percent.
+	TechEffectKind_TECH_EFFECT_KIND_CONSTRUCTION_TIME TechEffectKind = 3
+	// Lets the building be upgraded up to max_level.
+	TechEffectKind_TECH_EFFECT_KIND_MAX_LEVEL TechEffectKind = 4
+)
+
+// Enum value maps for TechEffectKind.
+var (
+	TechEffectKind_name = map[int32]string{
+		0: "TECH_EFFECT_KIND_UNSPECIFIED",
+		1: "TECH_EFFECT_KIND_PRODUCTION",
+		2: "TECH_EFFECT_KIND_COST",
+		3: "TECH_EFFECT_KIND_CONSTRUCTION_TIME",
+		4: "TECH_EFFECT_KIND_MAX_LEVEL",
+	}
+	TechEffectKind_value = map[string]int32{
+		"TECH_EFFECT_KIND_UNSPECIFIED":       0,
+		"TECH_EFFECT_KIND_PRODUCTION":        1,
+		"TECH_EFFECT_KIND_COST":              2,
+		"TECH_EFFECT_KIND_CONSTRUCTION_TIME": 3,
+		"TECH_EFFECT_KIND_MAX_LEVEL":         4,
+	}
+)
+
+func (x TechEffectKind) Enum() *TechEffectKind {
+	p := new(TechEffectKind)
+	*p = x
+	return p
+}
+
+func (x TechEffectKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TechEffectKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_cityio_service_v1_config_proto_enumTypes[0].Descriptor()
+}
+
+func (TechEffectKind) Type() protoreflect.EnumType {
+	return &file_cityio_service_v1_config_proto_enumTypes[0]
+}
+
+func (x TechEffectKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TechEffectKind.Descriptor instead.
+func (TechEffectKind) EnumDescriptor() ([]byte, []int) {
+	return file_cityio_service_v1_config_proto_rawDescGZIP(), []int{0}
+}
+
 // ResourceAmount is a one-shot amount (e.g. a build cost).
 type ResourceAmount struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -136,8 +195,15 @@ type BuildingLevelStats struct {
 	ConstructionTime *durationpb.Duration   `protobuf:"bytes,3,opt,name=construction_time,json=constructionTime,proto3" json:"construction_time,omitempty"`
 	Production       []*ResourceRate        `protobuf:"bytes,4,rep,name=production,proto3" json:"production,omitempty"`
 	Population       float64                `protobuf:"fixed64,5,opt,name=population,proto3" json:"population,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// Barracks only: batches trained in parallel and the time to train one
+	// troop at this level.
+	TrainingSlots     int32                `protobuf:"varint,6,opt,name=training_slots,json=trainingSlots,proto3" json:"training_slots,omitempty"`
+	TroopTrainingTime *durationpb.Duration `protobuf:"bytes,7,opt,name=troop_training_time,json=troopTrainingTime,proto3" json:"troop_training_time,omitempty"`
+	// City and town centers only: constructions the city runs in parallel at
+	// this center level.
+	ConstructionSlots int32 `protobuf:"varint,8,opt,name=construction_slots,json=constructionSlots,proto3" json:"construction_slots,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *BuildingLevelStats) Reset() {
@@ -205,12 +271,61 @@ func (x *BuildingLevelStats) GetPopulation() float64 {
 	return 0
 }
 
+func (x *BuildingLevelStats) GetTrainingSlots() int32 {
+	if x != nil {
+		return x.TrainingSlots
+	}
+	return 0
+}
+
+func (x *BuildingLevelStats) GetTroopTrainingTime() *durationpb.Duration {
+	if x != nil {
+		return x.TroopTrainingTime
+	}
+	return nil
+}
+
+func (x *BuildingLevelStats) GetConstructionSlots() int32 {
+	if x != nil {
+		return x.ConstructionSlots
+	}
+	return 0
+}
+
+// BuildingConfig is one loaded building definition. type is UNSPECIFIED for
+// types added by the definition file that the enum doesn't name; type_id is
+// always set.
 type BuildingConfig struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Type          v1.BuildingType        `protobuf:"varint,1,opt,name=type,proto3,enum=cityio.entity.v1.BuildingType" json:"type,omitempty"`
-	Levels        []*BuildingLevelStats  `protobuf:"bytes,2,rep,name=levels,proto3" json:"levels,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Type   v1.BuildingType        `protobuf:"varint,1,opt,name=type,proto3,enum=cityio.entity.v1.BuildingType" json:"type,omitempty"`
+	Levels []*BuildingLevelStats  `protobuf:"bytes,2,rep,name=levels,proto3" json:"levels,omitempty"`
+	// max_level is the highest level reachable without research; techs with a
+	// max level effect lift it.
+	MaxLevel int32 `protobuf:"varint,3,opt,name=max_level,json=maxLevel,proto3" json:"max_level,omitempty"`
+	// prerequisites must stand in the city before the building is placed.
+	Prerequisites []*BuildingPrerequisite `protobuf:"bytes,4,rep,name=prerequisites,proto3" json:"prerequisites,omitempty"`
+	// max_count is how many of the building a city may hold, indexed by center
+	// level minus one. Empty means unlimited.
+	MaxCount []int32 `protobuf:"varint,5,rep,packed,name=max_count,json=maxCount,proto3" json:"max_count,omitempty"`
+	// capped_by_center is true when the building's level may not exceed the
+	// level of its city's center.
+	CappedByCenter bool   `protobuf:"varint,6,opt,name=capped_by_center,json=cappedByCenter,proto3" json:"capped_by_center,omitempty"`
+	TypeId         string `protobuf:"bytes,7,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`
+	// Behaviour flags: center buildings found cities, produces buildings
+	// credit production every tick, housing buildings add population and
+	// trains buildings accept TrainTroops.
+	Center   bool `protobuf:"varint,8,opt,name=center,proto3" json:"center,omitempty"`
+	Produces bool `protobuf:"varint,9,opt,name=produces,proto3" json:"produces,omitempty"`
+	Housing  bool `protobuf:"varint,10,opt,name=housing,proto3" json:"housing,omitempty"`
+	Trains   bool `protobuf:"varint,11,opt,name=trains,proto3" json:"trains,omitempty"`
+	// deposit is the deposit kind the building must be placed on, unspecified
+	// when it needs none; its yield scales with the deposit's richness.
+	Deposit v1.DepositKind `protobuf:"varint,12,opt,name=deposit,proto3,enum=cityio.entity.v1.DepositKind" json:"deposit,omitempty"`
+	// forbidden_terrain lists terrain the building may not be placed on. Water
+	// is never buildable and is not listed.
+	ForbiddenTerrain []v1.Terrain `protobuf:"varint,13,rep,packed,name=forbidden_terrain,json=forbiddenTerrain,proto3,enum=cityio.entity.v1.Terrain" json:"forbidden_terrain,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *BuildingConfig) Reset() {
@@ -257,26 +372,108 @@ func (x *BuildingConfig) GetLevels() []*BuildingLevelStats {
 	return nil
 }
 
-type GetGameConfigRequest struct {
+func (x *BuildingConfig) GetMaxLevel() int32 {
+	if x != nil {
+		return x.MaxLevel
+	}
+	return 0
+}
+
+func (x *BuildingConfig) GetPrerequisites() []*BuildingPrerequisite {
+	if x != nil {
+		return x.Prerequisites
+	}
+	return nil
+}
+
+func (x *BuildingConfig) GetMaxCount() []int32 {
+	if x != nil {
+		return x.MaxCount
+	}
+	return nil
+}
+
+func (x *BuildingConfig) GetCappedByCenter() bool {
+	if x != nil {
+		return x.CappedByCenter
+	}
+	return false
+}
+
+func (x *BuildingConfig) GetTypeId() string {
+	if x != nil {
+		return x.TypeId
+	}
+	return ""
+}
+
+func (x *BuildingConfig) GetCenter() bool {
+	if x != nil {
+		return x.Center
+	}
+	return false
+}
+
+func (x *BuildingConfig) GetProduces() bool {
+	if x != nil {
+		return x.Produces
+	}
+	return false
+}
+
+func (x *BuildingConfig) GetHousing() bool {
+	if x != nil {
+		return x.Housing
+	}
+	return false
+}
+
+func (x *BuildingConfig) GetTrains() bool {
+	if x != nil {
+		return x.Trains
+	}
+	return false
+}
+
+func (x *BuildingConfig) GetDeposit() v1.DepositKind {
+	if x != nil {
+		return x.Deposit
+	}
+	return v1.DepositKind(0)
+}
+
+func (x *BuildingConfig) GetForbiddenTerrain() []v1.Terrain {
+	if x != nil {
+		return x.ForbiddenTerrain
+	}
+	return nil
+}
+
+// BuildingPrerequisite requires a finished building of type at level or
+// above in the same city. A city center requirement is met by a town center.
+type BuildingPrerequisite struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          v1.BuildingType        `protobuf:"varint,1,opt,name=type,proto3,enum=cityio.entity.v1.BuildingType" json:"type,omitempty"`
+	Level         int32                  `protobuf:"varint,2,opt,name=level,proto3" json:"level,omitempty"`
+	TypeId        string                 `protobuf:"bytes,3,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGameConfigRequest) Reset() {
-	*x = GetGameConfigRequest{}
+func (x *BuildingPrerequisite) Reset() {
+	*x = BuildingPrerequisite{}
 	mi := &file_cityio_service_v1_config_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGameConfigRequest) String() string {
+func (x *BuildingPrerequisite) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGameConfigRequest) ProtoMessage() {}
+func (*BuildingPrerequisite) ProtoMessage() {}
 
-func (x *GetGameConfigRequest) ProtoReflect() protoreflect.Message {
+func (x *BuildingPrerequisite) ProtoReflect() protoreflect.Message {
 	mi := &file_cityio_service_v1_config_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -288,37 +485,60 @@ func (x *GetGameConfigRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGameConfigRequest.ProtoReflect.Descriptor instead.
-func (*GetGameConfigRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BuildingPrerequisite.ProtoReflect.Descriptor instead.
+func (*BuildingPrerequisite) Descriptor() ([]byte, []int) {
 	return file_cityio_service_v1_config_proto_rawDescGZIP(), []int{4}
 }
 
-type GetGameConfigResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	MapSize       int32                  `protobuf:"varint,1,opt,name=map_size,json=mapSize,proto3" json:"map_size,omitempty"`
-	CitySize      int32                  `protobuf:"varint,2,opt,name=city_size,json=citySize,proto3" json:"city_size,omitempty"`
-	VisionRadius  int32                  `protobuf:"varint,3,opt,name=vision_radius,json=visionRadius,proto3" json:"vision_radius,omitempty"`
-	BuildingTick  *durationpb.Duration   `protobuf:"bytes,4,opt,name=building_tick,json=buildingTick,proto3" json:"building_tick,omitempty"`
-	Buildings     []*BuildingConfig      `protobuf:"bytes,5,rep,name=buildings,proto3" json:"buildings,omitempty"`
-	CityTick      *durationpb.Duration   `protobuf:"bytes,6,opt,name=city_tick,json=cityTick,proto3" json:"city_tick,omitempty"`
+func (x *BuildingPrerequisite) GetType() v1.BuildingType {
+	if x != nil {
+		return x.Type
+	}
+	return v1.BuildingType(0)
+}
+
+func (x *BuildingPrerequisite) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *BuildingPrerequisite) GetTypeId() string {
+	if x != nil {
+		return x.TypeId
+	}
+	return ""
+}
+
+// TechEffect is one modifier granted by a tech. Percent effects of the same
+// kind add up across researched techs.
+type TechEffect struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Kind  TechEffectKind         `protobuf:"varint,1,opt,name=kind,proto3,enum=cityio.service.v1.TechEffectKind" json:"kind,omitempty"`
+	// building_type is the building affected; unset affects every building.
+	BuildingType  *v1.BuildingType `protobuf:"varint,2,opt,name=building_type,json=buildingType,proto3,enum=cityio.entity.v1.BuildingType,oneof" json:"building_type,omitempty"`
+	Resource      string           `protobuf:"bytes,3,opt,name=resource,proto3" json:"resource,omitempty"`
+	Percent       float64          `protobuf:"fixed64,4,opt,name=percent,proto3" json:"percent,omitempty"`
+	MaxLevel      int32            `protobuf:"varint,5,opt,name=max_level,json=maxLevel,proto3" json:"max_level,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGameConfigResponse) Reset() {
-	*x = GetGameConfigResponse{}
+func (x *TechEffect) Reset() {
+	*x = TechEffect{}
 	mi := &file_cityio_service_v1_config_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGameConfigResponse) String() string {
+func (x *TechEffect) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGameConfigResponse) ProtoMessage() {}
+func (*TechEffect) ProtoMessage() {}
 
-func (x *GetGameConfigResponse) ProtoReflect() protoreflect.Message {
+func (x *TechEffect) ProtoReflect() protoreflect.Message {
 	mi := &file_cityio_service_v1_config_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -330,85 +550,946 @@ func (x *GetGameConfigResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGameConfigResponse.ProtoReflect.Descriptor instead.
-func (*GetGameConfigResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use TechEffect.ProtoReflect.Descriptor instead.
+func (*TechEffect) Descriptor() ([]byte, []int) {
 	return file_cityio_service_v1_config_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetGameConfigResponse) GetMapSize() int32 {
+func (x *TechEffect) GetKind() TechEffectKind {
 	if x != nil {
-		return x.MapSize
+		return x.Kind
 	}
-	return 0
+	return TechEffectKind_TECH_EFFECT_KIND_UNSPECIFIED
 }
 
-func (x *GetGameConfigResponse) GetCitySize() int32 {
+func (x *TechEffect) GetBuildingType() v1.BuildingType {
+	if x != nil && x.BuildingType != nil {
+		return *x.BuildingType
+	}
+	return v1.BuildingType(0)
+}
+
+func (x *TechEffect) GetResource() string {
 	if x != nil {
-		return x.CitySize
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *TechEffect) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
 	}
 	return 0
 }
 
-func (x *GetGameConfigResponse) GetVisionRadius() int32 {
+func (x *TechEffect) GetMaxLevel() int32 {
 	if x != nil {
-		return x.VisionRadius
+		return x.MaxLevel
 	}
 	return 0
 }
 
-func (x *GetGameConfigResponse) GetBuildingTick() *durationpb.Duration {
+type TechConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Cost          []*ResourceAmount      `protobuf:"bytes,3,rep,name=cost,proto3" json:"cost,omitempty"`
+	Duration      *durationpb.Duration   `protobuf:"bytes,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	Prerequisites []string               `protobuf:"bytes,5,rep,name=prerequisites,proto3" json:"prerequisites,omitempty"`
+	Effects       []*TechEffect          `protobuf:"bytes,6,rep,name=effects,proto3" json:"effects,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TechConfig) Reset() {
+	*x = TechConfig{}
+	mi := &file_cityio_service_v1_config_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TechConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TechConfig) ProtoMessage() {}
+
+func (x *TechConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_config_proto_msgTypes[6]
 	if x != nil {
-		return x.BuildingTick
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TechConfig.ProtoReflect.Descriptor instead.
+func (*TechConfig) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_config_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TechConfig) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TechConfig) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TechConfig) GetCost() []*ResourceAmount {
+	if x != nil {
+		return x.Cost
 	}
 	return nil
 }
 
-func (x *GetGameConfigResponse) GetBuildings() []*BuildingConfig {
+func (x *TechConfig) GetDuration() *durationpb.Duration {
 	if x != nil {
-		return x.Buildings
+		return x.Duration
 	}
 	return nil
 }
 
-func (x *GetGameConfigResponse) GetCityTick() *durationpb.Duration {
+func (x *TechConfig) GetPrerequisites() []string {
 	if x != nil {
-		return x.CityTick
+		return x.Prerequisites
 	}
 	return nil
 }
 
-var File_cityio_service_v1_config_proto protoreflect.FileDescriptor
+func (x *TechConfig) GetEffects() []*TechEffect {
+	if x != nil {
+		return x.Effects
+	}
+	return nil
+}
 
-const file_cityio_service_v1_config_proto_rawDesc = "" +
-	"\n" +
-	"\x1ecityio/service/v1/config.proto\x12\x11cityio.service.v1\x1a\x1dcityio/entity/v1/common.proto\x1a\x1egoogle/protobuf/duration.proto\"D\n" +
-	"\x0eResourceAmount\x12\x1a\n" +
-	"\bresource\x18\x01 \x01(\tR\bresource\x12\x16\n" +
-	"\x06amount\x18\x02 \x01(\x03R\x06amount\"V\n" +
-	"\fResourceRate\x12\x1a\n" +
-	"\bresource\x18\x01 \x01(\tR\bresource\x12*\n" +
-	"\x04rate\x18\x02 \x01(\v2\x16.cityio.entity.v1.RateR\x04rate\"\x8a\x02\n" +
-	"\x12BuildingLevelStats\x12\x14\n" +
-	"\x05level\x18\x01 \x01(\x05R\x05level\x125\n" +
-	"\x04cost\x18\x02 \x03(\v2!.cityio.service.v1.ResourceAmountR\x04cost\x12F\n" +
-	"\x11construction_time\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x10constructionTime\x12?\n" +
-	"\n" +
-	"production\x18\x04 \x03(\v2\x1f.cityio.service.v1.ResourceRateR\n" +
-	"production\x12\x1e\n" +
-	"\n" +
-	"population\x18\x05 \x01(\x01R\n" +
-	"population\"\x83\x01\n" +
-	"\x0eBuildingConfig\x122\n" +
-	"\x04type\x18\x01 \x01(\x0e2\x1e.cityio.entity.v1.BuildingTypeR\x04type\x12=\n" +
-	"\x06levels\x18\x02 \x03(\v2%.cityio.service.v1.BuildingLevelStatsR\x06levels\"\x16\n" +
-	"\x14GetGameConfigRequest\"\xad\x02\n" +
-	"\x15GetGameConfigResponse\x12\x19\n" +
-	"\bmap_size\x18\x01 \x01(\x05R\amapSize\x12\x1b\n" +
-	"\tcity_size\x18\x02 \x01(\x05R\bcitySize\x12#\n" +
-	"\rvision_radius\x18\x03 \x01(\x05R\fvisionRadius\x12>\n" +
-	"\rbuilding_tick\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\fbuildingTick\x12?\n" +
-	"\tbuildings\x18\x05 \x03(\v2!.cityio.service.v1.BuildingConfigR\tbuildings\x126\n" +
-	"\tcity_tick\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\bcityTick2s\n" +
+// SpeedUpPricing prices skipping construction time: gold_per_second for each
+// second skipped, partial seconds rounded up, and never less than
+// minimum_cost.
+type SpeedUpPricing struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GoldPerSecond int64                  `protobuf:"varint,1,opt,name=gold_per_second,json=goldPerSecond,proto3" json:"gold_per_second,omitempty"`
+	MinimumCost   int64                  `protobuf:"varint,2,opt,name=minimum_cost,json=minimumCost,proto3" json:"minimum_cost,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpeedUpPricing) Reset() {
+	*x = SpeedUpPricing{}
+	mi := &file_cityio_service_v1_config_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpeedUpPricing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpeedUpPricing) ProtoMessage() {}
+
+func (x *SpeedUpPricing) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_config_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpeedUpPricing.ProtoReflect.Descriptor instead.
+func (*SpeedUpPricing) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_config_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SpeedUpPricing) GetGoldPerSecond() int64 {
+	if x != nil {
+		return x.GoldPerSecond
+	}
+	return 0
+}
+
+func (x *SpeedUpPricing) GetMinimumCost() int64 {
+	if x != nil {
+		return x.MinimumCost
+	}
+	return 0
+}
+
+type GetGameConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGameConfigRequest) Reset() {
+	*x = GetGameConfigRequest{}
+	mi := &file_cityio_service_v1_config_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGameConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameConfigRequest) ProtoMessage() {}
+
+func (x *GetGameConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_config_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetGameConfigRequest) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_config_proto_rawDescGZIP(), []int{8}
+}
+
+type GetGameConfigResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	MapSize      int32                  `protobuf:"varint,1,opt,name=map_size,json=mapSize,proto3" json:"map_size,omitempty"`
+	CitySize     int32                  `protobuf:"varint,2,opt,name=city_size,json=citySize,proto3" json:"city_size,omitempty"`
+	VisionRadius int32                  `protobuf:"varint,3,opt,name=vision_radius,json=visionRadius,proto3" json:"vision_radius,omitempty"`
+	BuildingTick *durationpb.Duration   `protobuf:"bytes,4,opt,name=building_tick,json=buildingTick,proto3" json:"building_tick,omitempty"`
+	Buildings    []*BuildingConfig      `protobuf:"bytes,5,rep,name=buildings,proto3" json:"buildings,omitempty"`
+	CityTick     *durationpb.Duration   `protobuf:"bytes,6,opt,name=city_tick,json=cityTick,proto3" json:"city_tick,omitempty"`
+	// troop_cost is the per-troop price of barracks training.
+	TroopCost []*ResourceAmount `protobuf:"bytes,7,rep,name=troop_cost,json=troopCost,proto3" json:"troop_cost,omitempty"`
+	SpeedUp   *SpeedUpPricing   `protobuf:"bytes,8,opt,name=speed_up,json=speedUp,proto3" json:"speed_up,omitempty"`
+	// techs is the research tree.
+	Techs []*TechConfig `protobuf:"bytes,9,rep,name=techs,proto3" json:"techs,omitempty"`
+	// buildings_version is the format version of the building definition file
+	// the buildings were loaded from.
+	BuildingsVersion int32 `protobuf:"varint,10,opt,name=buildings_version,json=buildingsVersion,proto3" json:"buildings_version,omitempty"`
+	// version changes whenever the server loads new balance numbers or
+	// building definitions; clients refetch the config when it does.
+	Version string         `protobuf:"bytes,11,opt,name=version,proto3" json:"version,omitempty"`
+	Balance *BalanceConfig `protobuf:"bytes,12,opt,name=balance,proto3" json:"balance,omitempty"`
+	// adjacency is the rule table behind Building.adjacency_bonuses, loaded
+	// with the building definitions.
+	Adjacency     []*AdjacencyRuleConfig `protobuf:"bytes,13,rep,name=adjacency,proto3" json:"adjacency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGameConfigResponse) Reset() {
+	*x = GetGameConfigResponse{}
+	mi := &file_cityio_service_v1_config_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGameConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameConfigResponse) ProtoMessage() {}
+
+func (x *GetGameConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_config_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetGameConfigResponse) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_config_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetGameConfigResponse) GetMapSize() int32 {
+	if x != nil {
+		return x.MapSize
+	}
+	return 0
+}
+
+func (x *GetGameConfigResponse) GetCitySize() int32 {
+	if x != nil {
+		return x.CitySize
+	}
+	return 0
+}
+
+func (x *GetGameConfigResponse) GetVisionRadius() int32 {
+	if x != nil {
+		return x.VisionRadius
+	}
+	return 0
+}
+
+func (x *GetGameConfigResponse) GetBuildingTick() *durationpb.Duration {
+	if x != nil {
+		return x.BuildingTick
+	}
+	return nil
+}
+
+func (x *GetGameConfigResponse) GetBuildings() []*BuildingConfig {
+	if x != nil {
+		return x.Buildings
+	}
+	return nil
+}
+
+func (x *GetGameConfigResponse) GetCityTick() *durationpb.Duration {
+	if x != nil {
+		return x.CityTick
+	}
+	return nil
+}
+
+func (x *GetGameConfigResponse) GetTroopCost() []*ResourceAmount {
+	if x != nil {
+		return x.TroopCost
+	}
+	return nil
+}
+
+func (x *GetGameConfigResponse) GetSpeedUp() *SpeedUpPricing {
+	if x != nil {
+		return x.SpeedUp
+	}
+	return nil
+}
+
+func (x *GetGameConfigResponse) GetTechs() []*TechConfig {
+	if x != nil {
+		return x.Techs
+	}
+	return nil
+}
+
+func (x *GetGameConfigResponse) GetBuildingsVersion() int32 {
+	if x != nil {
+		return x.BuildingsVersion
+	}
+	return 0
+}
+
+func (x *GetGameConfigResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *GetGameConfigResponse) GetBalance() *BalanceConfig {
+	if x != nil {
+		return x.Balance
+	}
+	return nil
+}
+
+func (x *GetGameConfigResponse) GetAdjacency() []*AdjacencyRuleConfig {
+	if x != nil {
+		return x.Adjacency
+	}
+	return nil
+}
+
+// AdjacencyRuleConfig raises resource ("gold", "food" or "population") of a
+// building by percent for every completed neighbour of the neighbour type on
+// the eight tiles around it, counting at most max_stacks neighbours.
+type AdjacencyRuleConfig struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Building        v1.BuildingType        `protobuf:"varint,2,opt,name=building,proto3,enum=cityio.entity.v1.BuildingType" json:"building,omitempty"`
+	BuildingTypeId  string                 `protobuf:"bytes,3,opt,name=building_type_id,json=buildingTypeId,proto3" json:"building_type_id,omitempty"`
+	Neighbour       v1.BuildingType        `protobuf:"varint,4,opt,name=neighbour,proto3,enum=cityio.entity.v1.BuildingType" json:"neighbour,omitempty"`
+	NeighbourTypeId string                 `protobuf:"bytes,5,opt,name=neighbour_type_id,json=neighbourTypeId,proto3" json:"neighbour_type_id,omitempty"`
+	Resource        string                 `protobuf:"bytes,6,opt,name=resource,proto3" json:"resource,omitempty"`
+	Percent         float64                `protobuf:"fixed64,7,opt,name=percent,proto3" json:"percent,omitempty"`
+	MaxStacks       int32                  `protobuf:"varint,8,opt,name=max_stacks,json=maxStacks,proto3" json:"max_stacks,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AdjacencyRuleConfig) Reset() {
+	*x = AdjacencyRuleConfig{}
+	mi := &file_cityio_service_v1_config_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjacencyRuleConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjacencyRuleConfig) ProtoMessage() {}
+
+func (x *AdjacencyRuleConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_config_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjacencyRuleConfig.ProtoReflect.Descriptor instead.
+func (*AdjacencyRuleConfig) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_config_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AdjacencyRuleConfig) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AdjacencyRuleConfig) GetBuilding() v1.BuildingType {
+	if x != nil {
+		return x.Building
+	}
+	return v1.BuildingType(0)
+}
+
+func (x *AdjacencyRuleConfig) GetBuildingTypeId() string {
+	if x != nil {
+		return x.BuildingTypeId
+	}
+	return ""
+}
+
+func (x *AdjacencyRuleConfig) GetNeighbour() v1.BuildingType {
+	if x != nil {
+		return x.Neighbour
+	}
+	return v1.BuildingType(0)
+}
+
+func (x *AdjacencyRuleConfig) GetNeighbourTypeId() string {
+	if x != nil {
+		return x.NeighbourTypeId
+	}
+	return ""
+}
+
+func (x *AdjacencyRuleConfig) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *AdjacencyRuleConfig) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *AdjacencyRuleConfig) GetMaxStacks() int32 {
+	if x != nil {
+		return x.MaxStacks
+	}
+	return 0
+}
+
+// BalanceConfig is the tunable numbers of the game not covered by the other
+// GetGameConfig fields. Rates are per city tick unless named per hour.
+type BalanceConfig struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	PopulationGrowthRate  float64                `protobuf:"fixed64,1,opt,name=population_growth_rate,json=populationGrowthRate,proto3" json:"population_growth_rate,omitempty"`
+	SurplusGrowthBonus    float64                `protobuf:"fixed64,2,opt,name=surplus_growth_bonus,json=surplusGrowthBonus,proto3" json:"surplus_growth_bonus,omitempty"`
+	StarvationDeclineRate float64                `protobuf:"fixed64,3,opt,name=starvation_decline_rate,json=starvationDeclineRate,proto3" json:"starvation_decline_rate,omitempty"`
+	FoodPerPopPerHour     int64                  `protobuf:"varint,4,opt,name=food_per_pop_per_hour,json=foodPerPopPerHour,proto3" json:"food_per_pop_per_hour,omitempty"`
+	MaxTaxRate            int32                  `protobuf:"varint,5,opt,name=max_tax_rate,json=maxTaxRate,proto3" json:"max_tax_rate,omitempty"`
+	TaxGoldPerPopPerHour  float64                `protobuf:"fixed64,6,opt,name=tax_gold_per_pop_per_hour,json=taxGoldPerPopPerHour,proto3" json:"tax_gold_per_pop_per_hour,omitempty"`
+	TaxGrowthPenalty      float64                `protobuf:"fixed64,7,opt,name=tax_growth_penalty,json=taxGrowthPenalty,proto3" json:"tax_growth_penalty,omitempty"`
+	MoraleBase            float64                `protobuf:"fixed64,8,opt,name=morale_base,json=moraleBase,proto3" json:"morale_base,omitempty"`
+	MoraleNeutral         float64                `protobuf:"fixed64,9,opt,name=morale_neutral,json=moraleNeutral,proto3" json:"morale_neutral,omitempty"`
+	MoraleDrift           float64                `protobuf:"fixed64,10,opt,name=morale_drift,json=moraleDrift,proto3" json:"morale_drift,omitempty"`
+	MoraleFoodSurplus     float64                `protobuf:"fixed64,11,opt,name=morale_food_surplus,json=moraleFoodSurplus,proto3" json:"morale_food_surplus,omitempty"`
+	MoraleFoodDeficit     float64                `protobuf:"fixed64,12,opt,name=morale_food_deficit,json=moraleFoodDeficit,proto3" json:"morale_food_deficit,omitempty"`
+	MoralePerTaxPoint     float64                `protobuf:"fixed64,13,opt,name=morale_per_tax_point,json=moralePerTaxPoint,proto3" json:"morale_per_tax_point,omitempty"`
+	MoraleCrowdingStart   float64                `protobuf:"fixed64,14,opt,name=morale_crowding_start,json=moraleCrowdingStart,proto3" json:"morale_crowding_start,omitempty"`
+	MoraleCrowdingPenalty float64                `protobuf:"fixed64,15,opt,name=morale_crowding_penalty,json=moraleCrowdingPenalty,proto3" json:"morale_crowding_penalty,omitempty"`
+	MoraleOutputSwing     float64                `protobuf:"fixed64,16,opt,name=morale_output_swing,json=moraleOutputSwing,proto3" json:"morale_output_swing,omitempty"`
+	MoraleGrowthSwing     float64                `protobuf:"fixed64,17,opt,name=morale_growth_swing,json=moraleGrowthSwing,proto3" json:"morale_growth_swing,omitempty"`
+	UnrestMorale          float64                `protobuf:"fixed64,18,opt,name=unrest_morale,json=unrestMorale,proto3" json:"unrest_morale,omitempty"`
+	RevoltTicks           int32                  `protobuf:"varint,19,opt,name=revolt_ticks,json=revoltTicks,proto3" json:"revolt_ticks,omitempty"`
+	// troop_training_time is per troop at a level-1 barracks.
+	TroopTrainingTime *durationpb.Duration `protobuf:"bytes,20,opt,name=troop_training_time,json=troopTrainingTime,proto3" json:"troop_training_time,omitempty"`
+	// troop_movement_time is per tile crossed.
+	TroopMovementTime        *durationpb.Duration `protobuf:"bytes,21,opt,name=troop_movement_time,json=troopMovementTime,proto3" json:"troop_movement_time,omitempty"`
+	ConstructionCancelRefund float64              `protobuf:"fixed64,22,opt,name=construction_cancel_refund,json=constructionCancelRefund,proto3" json:"construction_cancel_refund,omitempty"`
+	ResearchCancelRefund     float64              `protobuf:"fixed64,23,opt,name=research_cancel_refund,json=researchCancelRefund,proto3" json:"research_cancel_refund,omitempty"`
+	// caravan_movement_time is per tile crossed; caravan_capacity caps gold
+	// and food together.
+	CaravanMovementTime *durationpb.Duration `protobuf:"bytes,24,opt,name=caravan_movement_time,json=caravanMovementTime,proto3" json:"caravan_movement_time,omitempty"`
+	CaravanCapacity     int64                `protobuf:"varint,25,opt,name=caravan_capacity,json=caravanCapacity,proto3" json:"caravan_capacity,omitempty"`
+	// war_declaration_delay is how long a declared war takes to start; the
+	// cancel delays are how long a cancelled treaty stays in force.
+	WarDeclarationDelay *durationpb.Duration `protobuf:"bytes,26,opt,name=war_declaration_delay,json=warDeclarationDelay,proto3" json:"war_declaration_delay,omitempty"`
+	PactCancelDelay     *durationpb.Duration `protobuf:"bytes,27,opt,name=pact_cancel_delay,json=pactCancelDelay,proto3" json:"pact_cancel_delay,omitempty"`
+	PeaceCancelDelay    *durationpb.Duration `protobuf:"bytes,28,opt,name=peace_cancel_delay,json=peaceCancelDelay,proto3" json:"peace_cancel_delay,omitempty"`
+	// terrain_defense multiplies a defender's strength by the ground the
+	// battle is fought on. Terrains not listed fight as open ground, 1.
+	TerrainDefense []*TerrainDefense `protobuf:"bytes,29,rep,name=terrain_defense,json=terrainDefense,proto3" json:"terrain_defense,omitempty"`
+	// caravan_store_capacity is how much of its food surplus a city keeps in
+	// its food store for caravans; the overflow goes to the owner's pool.
+	CaravanStoreCapacity int64 `protobuf:"varint,30,opt,name=caravan_store_capacity,json=caravanStoreCapacity,proto3" json:"caravan_store_capacity,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *BalanceConfig) Reset() {
+	*x = BalanceConfig{}
+	mi := &file_cityio_service_v1_config_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BalanceConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceConfig) ProtoMessage() {}
+
+func (x *BalanceConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_config_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalanceConfig.ProtoReflect.Descriptor instead.
+func (*BalanceConfig) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_config_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BalanceConfig) GetPopulationGrowthRate() float64 {
+	if x != nil {
+		return x.PopulationGrowthRate
+	}
+	return 0
+}
+
+func (x *BalanceConfig) GetSurplusGrowthBonus() float64 {
+	if x != nil {
+		return x.SurplusGrowthBonus
+	}
+	return 0
+}
+
+func (x *BalanceConfig) GetStarvationDeclineRate() float64 {
+	if x != nil {
+		return x.StarvationDeclineRate
+	}
+	return 0
+}
+
+func (x *BalanceConfig) GetFoodPerPopPerHour() int64 {
+	if x != nil {
+		return x.FoodPerPopPerHour
+	}
+	return 0
+}
+
+func (x *BalanceConfig) GetMaxTaxRate() int32 {
+	if x != nil {
+		return x.MaxTaxRate
+	}
+	return 0
+}
+
+func (x *BalanceConfig) GetTaxGoldPerPopPerHour() float64 {
+	if x != nil {
+		return x.TaxGoldPerPopPerHour
+	}
+	return 0
+}
+
+func (x *BalanceConfig) GetTaxGrowthPenalty() float64 {
+	if x != nil {
+		return x.TaxGrowthPenalty
+	}
+	return 0
+}
+
+func (x *BalanceConfig) GetMoraleBase() float64 {
+	if x != nil {
+		return x.MoraleBase
+	}
+	return 0
+}
+
+func (x *BalanceConfig) GetMoraleNeutral() float64 {
+	if x != nil {
+		return x.MoraleNeutral
+	}
+	return 0
+}
+
+func (x *BalanceConfig) GetMoraleDrift() float64 {
+	if x != nil {
+		return x.MoraleDrift
+	}
+	return 0
+}
+
+func (x *BalanceConfig) GetMoraleFoodSurplus() float64 {
+	if x != nil {
+		return x.MoraleFoodSurplus
+	}
+	return 0
+}
+
+func (x *BalanceConfig) GetMoraleFoodDeficit() float64 {
+	if x != nil {
+		return x.MoraleFoodDeficit
+	}
+	return 0
+}
+
+func (x *BalanceConfig) GetMoralePerTaxPoint() float64 {
+	if x != nil {
+		return x.MoralePerTaxPoint
+	}
+	return 0
+}
+
+func (x *BalanceConfig) GetMoraleCrowdingStart() float64 {
+	if x != nil {
+		return x.MoraleCrowdingStart
+	}
+	return 0
+}
+
+func (x *BalanceConfig) GetMoraleCrowdingPenalty() float64 {
+	if x != nil {
+		return x.MoraleCrowdingPenalty
+	}
+	return 0
+}
+
+func (x *BalanceConfig) GetMoraleOutputSwing() float64 {
+	if x != nil {
+		return x.MoraleOutputSwing
+	}
+	return 0
+}
+
+func (x *BalanceConfig) GetMoraleGrowthSwing() float64 {
+	if x != nil {
+		return x.MoraleGrowthSwing
+	}
+	return 0
+}
+
+func (x *BalanceConfig) GetUnrestMorale() float64 {
+	if x != nil {
+		return x.UnrestMorale
+	}
+	return 0
+}
+
+func (x *BalanceConfig) GetRevoltTicks() int32 {
+	if x != nil {
+		return x.RevoltTicks
+	}
+	return 0
+}
+
+func (x *BalanceConfig) GetTroopTrainingTime() *durationpb.Duration {
+	if x != nil {
+		return x.TroopTrainingTime
+	}
+	return nil
+}
+
+func (x *BalanceConfig) GetTroopMovementTime() *durationpb.Duration {
+	if x != nil {
+		return x.TroopMovementTime
+	}
+	return nil
+}
+
+func (x *BalanceConfig) GetConstructionCancelRefund() float64 {
+	if x != nil {
+		return x.ConstructionCancelRefund
+	}
+	return 0
+}
+
+func (x *BalanceConfig) GetResearchCancelRefund() float64 {
+	if x != nil {
+		return x.ResearchCancelRefund
+	}
+	return 0
+}
+
+func (x *BalanceConfig) GetCaravanMovementTime() *durationpb.Duration {
+	if x != nil {
+		return x.CaravanMovementTime
+	}
+	return nil
+}
+
+func (x *BalanceConfig) GetCaravanCapacity() int64 {
+	if x != nil {
+		return x.CaravanCapacity
+	}
+	return 0
+}
+
+func (x *BalanceConfig) GetWarDeclarationDelay() *durationpb.Duration {
+	if x != nil {
+		return x.WarDeclarationDelay
+	}
+	return nil
+}
+
+func (x *BalanceConfig) GetPactCancelDelay() *durationpb.Duration {
+	if x != nil {
+		return x.PactCancelDelay
+	}
+	return nil
+}
+
+func (x *BalanceConfig) GetPeaceCancelDelay() *durationpb.Duration {
+	if x != nil {
+		return x.PeaceCancelDelay
+	}
+	return nil
+}
+
+func (x *BalanceConfig) GetTerrainDefense() []*TerrainDefense {
+	if x != nil {
+		return x.TerrainDefense
+	}
+	return nil
+}
+
+func (x *BalanceConfig) GetCaravanStoreCapacity() int64 {
+	if x != nil {
+		return x.CaravanStoreCapacity
+	}
+	return 0
+}
+
+type TerrainDefense struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Terrain       v1.Terrain             `protobuf:"varint,1,opt,name=terrain,proto3,enum=cityio.entity.v1.Terrain" json:"terrain,omitempty"`
+	Multiplier    float64                `protobuf:"fixed64,2,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TerrainDefense) Reset() {
+	*x = TerrainDefense{}
+	mi := &file_cityio_service_v1_config_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TerrainDefense) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerrainDefense) ProtoMessage() {}
+
+func (x *TerrainDefense) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_config_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerrainDefense.ProtoReflect.Descriptor instead.
+func (*TerrainDefense) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_config_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *TerrainDefense) GetTerrain() v1.Terrain {
+	if x != nil {
+		return x.Terrain
+	}
+	return v1.Terrain(0)
+}
+
+func (x *TerrainDefense) GetMultiplier() float64 {
+	if x != nil {
+		return x.Multiplier
+	}
+	return 0
+}
+
+var File_cityio_service_v1_config_proto protoreflect.FileDescriptor
+
+const file_cityio_service_v1_config_proto_rawDesc = "" +
+	"\n" +
+	"\x1ecityio/service/v1/config.proto\x12\x11cityio.service.v1\x1a\x1dcityio/entity/v1/common.proto\x1a\x1egoogle/protobuf/duration.proto\"D\n" +
+	"\x0eResourceAmount\x12\x1a\n" +
+	"\bresource\x18\x01 \x01(\tR\bresource\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x03R\x06amount\"V\n" +
+	"\fResourceRate\x12\x1a\n" +
+	"\bresource\x18\x01 \x01(\tR\bresource\x12*\n" +
+	"\x04rate\x18\x02 \x01(\v2\x16.cityio.entity.v1.RateR\x04rate\"\xab\x03\n" +
+	"\x12BuildingLevelStats\x12\x14\n" +
+	"\x05level\x18\x01 \x01(\x05R\x05level\x125\n" +
+	"\x04cost\x18\x02 \x03(\v2!.cityio.service.v1.ResourceAmountR\x04cost\x12F\n" +
+	"\x11construction_time\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x10constructionTime\x12?\n" +
+	"\n" +
+	"production\x18\x04 \x03(\v2\x1f.cityio.service.v1.ResourceRateR\n" +
+	"production\x12\x1e\n" +
+	"\n" +
+	"population\x18\x05 \x01(\x01R\n" +
+	"population\x12%\n" +
+	"\x0etraining_slots\x18\x06 \x01(\x05R\rtrainingSlots\x12I\n" +
+	"\x13troop_training_time\x18\a \x01(\v2\x19.google.protobuf.DurationR\x11troopTrainingTime\x12-\n" +
+	"\x12construction_slots\x18\b \x01(\x05R\x11constructionSlots\"\xb6\x04\n" +
+	"\x0eBuildingConfig\x122\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1e.cityio.entity.v1.BuildingTypeR\x04type\x12=\n" +
+	"\x06levels\x18\x02 \x03(\v2%.cityio.service.v1.BuildingLevelStatsR\x06levels\x12\x1b\n" +
+	"\tmax_level\x18\x03 \x01(\x05R\bmaxLevel\x12M\n" +
+	"\rprerequisites\x18\x04 \x03(\v2'.cityio.service.v1.BuildingPrerequisiteR\rprerequisites\x12\x1b\n" +
+	"\tmax_count\x18\x05 \x03(\x05R\bmaxCount\x12(\n" +
+	"\x10capped_by_center\x18\x06 \x01(\bR\x0ecappedByCenter\x12\x17\n" +
+	"\atype_id\x18\a \x01(\tR\x06typeId\x12\x16\n" +
+	"\x06center\x18\b \x01(\bR\x06center\x12\x1a\n" +
+	"\bproduces\x18\t \x01(\bR\bproduces\x12\x18\n" +
+	"\ahousing\x18\n" +
+	" \x01(\bR\ahousing\x12\x16\n" +
+	"\x06trains\x18\v \x01(\bR\x06trains\x127\n" +
+	"\adeposit\x18\f \x01(\x0e2\x1d.cityio.entity.v1.DepositKindR\adeposit\x12F\n" +
+	"\x11forbidden_terrain\x18\r \x03(\x0e2\x19.cityio.entity.v1.TerrainR\x10forbiddenTerrain\"y\n" +
+	"\x14BuildingPrerequisite\x122\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1e.cityio.entity.v1.BuildingTypeR\x04type\x12\x14\n" +
+	"\x05level\x18\x02 \x01(\x05R\x05level\x12\x17\n" +
+	"\atype_id\x18\x03 \x01(\tR\x06typeId\"\xf2\x01\n" +
+	"\n" +
+	"TechEffect\x125\n" +
+	"\x04kind\x18\x01 \x01(\x0e2!.cityio.service.v1.TechEffectKindR\x04kind\x12H\n" +
+	"\rbuilding_type\x18\x02 \x01(\x0e2\x1e.cityio.entity.v1.BuildingTypeH\x00R\fbuildingType\x88\x01\x01\x12\x1a\n" +
+	"\bresource\x18\x03 \x01(\tR\bresource\x12\x18\n" +
+	"\apercent\x18\x04 \x01(\x01R\apercent\x12\x1b\n" +
+	"\tmax_level\x18\x05 \x01(\x05R\bmaxLevelB\x10\n" +
+	"\x0e_building_type\"\xfd\x01\n" +
+	"\n" +
+	"TechConfig\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x125\n" +
+	"\x04cost\x18\x03 \x03(\v2!.cityio.service.v1.ResourceAmountR\x04cost\x125\n" +
+	"\bduration\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\bduration\x12$\n" +
+	"\rprerequisites\x18\x05 \x03(\tR\rprerequisites\x127\n" +
+	"\aeffects\x18\x06 \x03(\v2\x1d.cityio.service.v1.TechEffectR\aeffects\"[\n" +
+	"\x0eSpeedUpPricing\x12&\n" +
+	"\x0fgold_per_second\x18\x01 \x01(\x03R\rgoldPerSecond\x12!\n" +
+	"\fminimum_cost\x18\x02 \x01(\x03R\vminimumCost\"\x16\n" +
+	"\x14GetGameConfigRequest\"\xab\x05\n" +
+	"\x15GetGameConfigResponse\x12\x19\n" +
+	"\bmap_size\x18\x01 \x01(\x05R\amapSize\x12\x1b\n" +
+	"\tcity_size\x18\x02 \x01(\x05R\bcitySize\x12#\n" +
+	"\rvision_radius\x18\x03 \x01(\x05R\fvisionRadius\x12>\n" +
+	"\rbuilding_tick\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\fbuildingTick\x12?\n" +
+	"\tbuildings\x18\x05 \x03(\v2!.cityio.service.v1.BuildingConfigR\tbuildings\x126\n" +
+	"\tcity_tick\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\bcityTick\x12@\n" +
+	"\n" +
+	"troop_cost\x18\a \x03(\v2!.cityio.service.v1.ResourceAmountR\ttroopCost\x12<\n" +
+	"\bspeed_up\x18\b \x01(\v2!.cityio.service.v1.SpeedUpPricingR\aspeedUp\x123\n" +
+	"\x05techs\x18\t \x03(\v2\x1d.cityio.service.v1.TechConfigR\x05techs\x12+\n" +
+	"\x11buildings_version\x18\n" +
+	" \x01(\x05R\x10buildingsVersion\x12\x18\n" +
+	"\aversion\x18\v \x01(\tR\aversion\x12:\n" +
+	"\abalance\x18\f \x01(\v2 .cityio.service.v1.BalanceConfigR\abalance\x12D\n" +
+	"\tadjacency\x18\r \x03(\v2&.cityio.service.v1.AdjacencyRuleConfigR\tadjacency\"\xca\x02\n" +
+	"\x13AdjacencyRuleConfig\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12:\n" +
+	"\bbuilding\x18\x02 \x01(\x0e2\x1e.cityio.entity.v1.BuildingTypeR\bbuilding\x12(\n" +
+	"\x10building_type_id\x18\x03 \x01(\tR\x0ebuildingTypeId\x12<\n" +
+	"\tneighbour\x18\x04 \x01(\x0e2\x1e.cityio.entity.v1.BuildingTypeR\tneighbour\x12*\n" +
+	"\x11neighbour_type_id\x18\x05 \x01(\tR\x0fneighbourTypeId\x12\x1a\n" +
+	"\bresource\x18\x06 \x01(\tR\bresource\x12\x18\n" +
+	"\apercent\x18\a \x01(\x01R\apercent\x12\x1d\n" +
+	"\n" +
+	"max_stacks\x18\b \x01(\x05R\tmaxStacks\"\xdf\f\n" +
+	"\rBalanceConfig\x124\n" +
+	"\x16population_growth_rate\x18\x01 \x01(\x01R\x14populationGrowthRate\x120\n" +
+	"\x14surplus_growth_bonus\x18\x02 \x01(\x01R\x12surplusGrowthBonus\x126\n" +
+	"\x17starvation_decline_rate\x18\x03 \x01(\x01R\x15starvationDeclineRate\x120\n" +
+	"\x15food_per_pop_per_hour\x18\x04 \x01(\x03R\x11foodPerPopPerHour\x12 \n" +
+	"\fmax_tax_rate\x18\x05 \x01(\x05R\n" +
+	"maxTaxRate\x127\n" +
+	"\x19tax_gold_per_pop_per_hour\x18\x06 \x01(\x01R\x14taxGoldPerPopPerHour\x12,\n" +
+	"\x12tax_growth_penalty\x18\a \x01(\x01R\x10taxGrowthPenalty\x12\x1f\n" +
+	"\vmorale_base\x18\b \x01(\x01R\n" +
+	"moraleBase\x12%\n" +
+	"\x0emorale_neutral\x18\t \x01(\x01R\rmoraleNeutral\x12!\n" +
+	"\fmorale_drift\x18\n" +
+	" \x01(\x01R\vmoraleDrift\x12.\n" +
+	"\x13morale_food_surplus\x18\v \x01(\x01R\x11moraleFoodSurplus\x12.\n" +
+	"\x13morale_food_deficit\x18\f \x01(\x01R\x11moraleFoodDeficit\x12/\n" +
+	"\x14morale_per_tax_point\x18\r \x01(\x01R\x11moralePerTaxPoint\x122\n" +
+	"\x15morale_crowding_start\x18\x0e \x01(\x01R\x13moraleCrowdingStart\x126\n" +
+	"\x17morale_crowding_penalty\x18\x0f \x01(\x01R\x15moraleCrowdingPenalty\x12.\n" +
+	"\x13morale_output_swing\x18\x10 \x01(\x01R\x11moraleOutputSwing\x12.\n" +
+	"\x13morale_growth_swing\x18\x11 \x01(\x01R\x11moraleGrowthSwing\x12#\n" +
+	"\runrest_morale\x18\x12 \x01(\x01R\funrestMorale\x12!\n" +
+	"\frevolt_ticks\x18\x13 \x01(\x05R\vrevoltTicks\x12I\n" +
+	"\x13troop_training_time\x18\x14 \x01(\v2\x19.google.protobuf.DurationR\x11troopTrainingTime\x12I\n" +
+	"\x13troop_movement_time\x18\x15 \x01(\v2\x19.google.protobuf.DurationR\x11troopMovementTime\x12<\n" +
+	"\x1aconstruction_cancel_refund\x18\x16 \x01(\x01R\x18constructionCancelRefund\x124\n" +
+	"\x16research_cancel_refund\x18\x17 \x01(\x01R\x14researchCancelRefund\x12M\n" +
+	"\x15caravan_movement_time\x18\x18 \x01(\v2\x19.google.protobuf.DurationR\x13caravanMovementTime\x12)\n" +
+	"\x10caravan_capacity\x18\x19 \x01(\x03R\x0fcaravanCapacity\x12M\n" +
+	"\x15war_declaration_delay\x18\x1a \x01(\v2\x19.google.protobuf.DurationR\x13warDeclarationDelay\x12E\n" +
+	"\x11pact_cancel_delay\x18\x1b \x01(\v2\x19.google.protobuf.DurationR\x0fpactCancelDelay\x12G\n" +
+	"\x12peace_cancel_delay\x18\x1c \x01(\v2\x19.google.protobuf.DurationR\x10peaceCancelDelay\x12J\n" +
+	"\x0fterrain_defense\x18\x1d \x03(\v2!.cityio.service.v1.TerrainDefenseR\x0eterrainDefense\x124\n" +
+	"\x16caravan_store_capacity\x18\x1e \x01(\x03R\x14caravanStoreCapacity\"e\n" +
+	"\x0eTerrainDefense\x123\n" +
+	"\aterrain\x18\x01 \x01(\x0e2\x19.cityio.entity.v1.TerrainR\aterrain\x12\x1e\n" +
+	"\n" +
+	"multiplier\x18\x02 \x01(\x01R\n" +
+	"multiplier*\xb6\x01\n" +
+	"\x0eTechEffectKind\x12 \n" +
+	"\x1cTECH_EFFECT_KIND_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bTECH_EFFECT_KIND_PRODUCTION\x10\x01\x12\x19\n" +
+	"\x15TECH_EFFECT_KIND_COST\x10\x02\x12&\n" +
+	"\"TECH_EFFECT_KIND_CONSTRUCTION_TIME\x10\x03\x12\x1e\n" +
+	"\x1aTECH_EFFECT_KIND_MAX_LEVEL\x10\x042s\n" +
 	"\rConfigService\x12b\n" +
 	"\rGetGameConfig\x12'.cityio.service.v1.GetGameConfigRequest\x1a(.cityio.service.v1.GetGameConfigResponseB\xbb\x01\n" +
 	"\x15com.cityio.service.v1B\vConfigProtoP\x01Z/cityio/internal/gen/cityio/service/v1;servicev1\xa2\x02\x03CSX\xaa\x02\x11Cityio.Service.V1\xca\x02\x11Cityio\\Service\\V1\xe2\x02\x1dCityio\\Service\\V1\\GPBMetadata\xea\x02\x13Cityio::Service::V1b\x06proto3"
@@ -425,35 +1506,71 @@ func file_cityio_service_v1_config_proto_rawDescGZIP() []byte {
 	return file_cityio_service_v1_config_proto_rawDescData
 }
 
-var file_cityio_service_v1_config_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_cityio_service_v1_config_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_cityio_service_v1_config_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_cityio_service_v1_config_proto_goTypes = []any{
-	(*ResourceAmount)(nil),        // 0: cityio.service.v1.ResourceAmount
-	(*ResourceRate)(nil),          // 1: cityio.service.v1.ResourceRate
-	(*BuildingLevelStats)(nil),    // 2: cityio.service.v1.BuildingLevelStats
-	(*BuildingConfig)(nil),        // 3: cityio.service.v1.BuildingConfig
-	(*GetGameConfigRequest)(nil),  // 4: cityio.service.v1.GetGameConfigRequest
-	(*GetGameConfigResponse)(nil), // 5: cityio.service.v1.GetGameConfigResponse
-	(*v1.Rate)(nil),               // 6: cityio.entity.v1.Rate
-	(*durationpb.Duration)(nil),   // 7: google.protobuf.Duration
-	(v1.BuildingType)(0),          // 8: cityio.entity.v1.BuildingType
+	(TechEffectKind)(0),           // 0: cityio.service.v1.TechEffectKind
+	(*ResourceAmount)(nil),        // 1: cityio.service.v1.ResourceAmount
+	(*ResourceRate)(nil),          // 2: cityio.service.v1.ResourceRate
+	(*BuildingLevelStats)(nil),    // 3: cityio.service.v1.BuildingLevelStats
+	(*BuildingConfig)(nil),        // 4: cityio.service.v1.BuildingConfig
+	(*BuildingPrerequisite)(nil),  // 5: cityio.service.v1.BuildingPrerequisite
+	(*TechEffect)(nil),            // 6: cityio.service.v1.TechEffect
+	(*TechConfig)(nil),            // 7: cityio.service.v1.TechConfig
+	(*SpeedUpPricing)(nil),        // 8: cityio.service.v1.SpeedUpPricing
+	(*GetGameConfigRequest)(nil),  // 9: cityio.service.v1.GetGameConfigRequest
+	(*GetGameConfigResponse)(nil), // 10: cityio.service.v1.GetGameConfigResponse
+	(*AdjacencyRuleConfig)(nil),   // 11: cityio.service.v1.AdjacencyRuleConfig
+	(*BalanceConfig)(nil),         // 12: cityio.service.v1.BalanceConfig
+	(*TerrainDefense)(nil),        // 13: cityio.service.v1.TerrainDefense
+	(*v1.Rate)(nil),               // 14: cityio.entity.v1.Rate
+	(*durationpb.Duration)(nil),   // 15: google.protobuf.Duration
+	(v1.BuildingType)(0),          // 16: cityio.entity.v1.BuildingType
+	(v1.DepositKind)(0),           // 17: cityio.entity.v1.DepositKind
+	(v1.Terrain)(0),               // 18: cityio.entity.v1.Terrain
 }
 var file_cityio_service_v1_config_proto_depIdxs = []int32{
-	6,  // 0: cityio.service.v1.ResourceRate.rate:type_name -> cityio.entity.v1.Rate
-	0,  // 1: cityio.service.v1.BuildingLevelStats.cost:type_name -> cityio.service.v1.ResourceAmount
-	7,  // 2: cityio.service.v1.BuildingLevelStats.construction_time:type_name -> google.protobuf.Duration
-	1,  // 3: cityio.service.v1.BuildingLevelStats.production:type_name -> cityio.service.v1.ResourceRate
-	8,  // 4: cityio.service.v1.BuildingConfig.type:type_name -> cityio.entity.v1.BuildingType
-	2,  // 5: cityio.service.v1.BuildingConfig.levels:type_name -> cityio.service.v1.BuildingLevelStats
-	7,  // 6: cityio.service.v1.GetGameConfigResponse.building_tick:type_name -> google.protobuf.Duration
-	3,  // 7: cityio.service.v1.GetGameConfigResponse.buildings:type_name -> cityio.service.v1.BuildingConfig
-	7,  // 8: cityio.service.v1.GetGameConfigResponse.city_tick:type_name -> google.protobuf.Duration
-	4,  // 9: cityio.service.v1.ConfigService.GetGameConfig:input_type -> cityio.service.v1.GetGameConfigRequest
-	5,  // 10: cityio.service.v1.ConfigService.GetGameConfig:output_type -> cityio.service.v1.GetGameConfigResponse
-	10, // [10:11] is the sub-list for method output_type
-	9,  // [9:10] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	14, // 0: cityio.service.v1.ResourceRate.rate:type_name -> cityio.entity.v1.Rate
+	1,  // 1: cityio.service.v1.BuildingLevelStats.cost:type_name -> cityio.service.v1.ResourceAmount
+	15, // 2: cityio.service.v1.BuildingLevelStats.construction_time:type_name -> google.protobuf.Duration
+	2,  // 3: cityio.service.v1.BuildingLevelStats.production:type_name -> cityio.service.v1.ResourceRate
+	15, // 4: cityio.service.v1.BuildingLevelStats.troop_training_time:type_name -> google.protobuf.Duration
+	16, // 5: cityio.service.v1.BuildingConfig.type:type_name -> cityio.entity.v1.BuildingType
+	3,  // 6: cityio.service.v1.BuildingConfig.levels:type_name -> cityio.service.v1.BuildingLevelStats
+	5,  // 7: cityio.service.v1.BuildingConfig.prerequisites:type_name -> cityio.service.v1.BuildingPrerequisite
+	17, // 8: cityio.service.v1.BuildingConfig.deposit:type_name -> cityio.entity.v1.DepositKind
+	18, // 9: cityio.service.v1.BuildingConfig.forbidden_terrain:type_name -> cityio.entity.v1.Terrain
+	16, // 10: cityio.service.v1.BuildingPrerequisite.type:type_name -> cityio.entity.v1.BuildingType
+	0,  // 11: cityio.service.v1.TechEffect.kind:type_name -> cityio.service.v1.TechEffectKind
+	16, // 12: cityio.service.v1.TechEffect.building_type:type_name -> cityio.entity.v1.BuildingType
+	1,  // 13: cityio.service.v1.TechConfig.cost:type_name -> cityio.service.v1.ResourceAmount
+	15, // 14: cityio.service.v1.TechConfig.duration:type_name -> google.protobuf.Duration
+	6,  // 15: cityio.service.v1.TechConfig.effects:type_name -> cityio.service.v1.TechEffect
+	15, // 16: cityio.service.v1.GetGameConfigResponse.building_tick:type_name -> google.protobuf.Duration
+	4,  // 17: cityio.service.v1.GetGameConfigResponse.buildings:type_name -> cityio.service.v1.BuildingConfig
+	15, // 18: cityio.service.v1.GetGameConfigResponse.city_tick:type_name -> google.protobuf.Duration
+	1,  // 19: cityio.service.v1.GetGameConfigResponse.troop_cost:type_name -> cityio.service.v1.ResourceAmount
+	8,  // 20: cityio.service.v1.GetGameConfigResponse.speed_up:type_name -> cityio.service.v1.SpeedUpPricing
+	7,  // 21: cityio.service.v1.GetGameConfigResponse.techs:type_name -> cityio.service.v1.TechConfig
+	12, // 22: cityio.service.v1.GetGameConfigResponse.balance:type_name -> cityio.service.v1.BalanceConfig
+	11, // 23: cityio.service.v1.GetGameConfigResponse.adjacency:type_name -> cityio.service.v1.AdjacencyRuleConfig
+	16, // 24: cityio.service.v1.AdjacencyRuleConfig.building:type_name -> cityio.entity.v1.BuildingType
+	16, // 25: cityio.service.v1.AdjacencyRuleConfig.neighbour:type_name -> cityio.entity.v1.BuildingType
+	15, // 26: cityio.service.v1.BalanceConfig.troop_training_time:type_name -> google.protobuf.Duration
+	15, // 27: cityio.service.v1.BalanceConfig.troop_movement_time:type_name -> google.protobuf.Duration
+	15, // 28: cityio.service.v1.BalanceConfig.caravan_movement_time:type_name -> google.protobuf.Duration
+	15, // 29: cityio.service.v1.BalanceConfig.war_declaration_delay:type_name -> google.protobuf.Duration
+	15, // 30: cityio.service.v1.BalanceConfig.pact_cancel_delay:type_name -> google.protobuf.Duration
+	15, // 31: cityio.service.v1.BalanceConfig.peace_cancel_delay:type_name -> google.protobuf.Duration
+	13, // 32: cityio.service.v1.BalanceConfig.terrain_defense:type_name -> cityio.service.v1.TerrainDefense
+	18, // 33: cityio.service.v1.TerrainDefense.terrain:type_name -> cityio.entity.v1.Terrain
+	9,  // 34: cityio.service.v1.ConfigService.GetGameConfig:input_type -> cityio.service.v1.GetGameConfigRequest
+	10, // 35: cityio.service.v1.ConfigService.GetGameConfig:output_type -> cityio.service.v1.GetGameConfigResponse
+	35, // [35:36] is the sub-list for method output_type
+	34, // [34:35] is the sub-list for method input_type
+	34, // [34:34] is the sub-list for extension type_name
+	34, // [34:34] is the sub-list for extension extendee
+	0,  // [0:34] is the sub-list for field type_name
 }
 
 func init() { file_cityio_service_v1_config_proto_init() }
@@ -461,18 +1578,20 @@ func file_cityio_service_v1_config_proto_init() {
 	if File_cityio_service_v1_config_proto != nil {
 		return
 	}
+	file_cityio_service_v1_config_proto_msgTypes[5].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cityio_service_v1_config_proto_rawDesc), len(file_cityio_service_v1_config_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   6,
+			NumEnums:      1,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_cityio_service_v1_config_proto_goTypes,
 		DependencyIndexes: file_cityio_service_v1_config_proto_depIdxs,
+		EnumInfos:         file_cityio_service_v1_config_proto_enumTypes,
 		MessageInfos:      file_cityio_service_v1_config_proto_msgTypes,
 	}.Build()
 	File_cityio_service_v1_config_proto = out.File
@@ -0,0 +1,778 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: cityio/service/v1/diplomacy.proto
+
+package servicev1
+
+import (
+	v1 "cityio/internal/gen/cityio/entity/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DeclareWarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *v1.UserId             `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeclareWarRequest) Reset() {
+	*x = DeclareWarRequest{}
+	mi := &file_cityio_service_v1_diplomacy_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeclareWarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclareWarRequest) ProtoMessage() {}
+
+func (x *DeclareWarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_diplomacy_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclareWarRequest.ProtoReflect.Descriptor instead.
+func (*DeclareWarRequest) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_diplomacy_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DeclareWarRequest) GetUserId() *v1.UserId {
+	if x != nil {
+		return x.UserId
+	}
+	return nil
+}
+
+type DeclareWarResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Relation      *v1.Relation           `protobuf:"bytes,1,opt,name=relation,proto3" json:"relation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeclareWarResponse) Reset() {
+	*x = DeclareWarResponse{}
+	mi := &file_cityio_service_v1_diplomacy_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeclareWarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclareWarResponse) ProtoMessage() {}
+
+func (x *DeclareWarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_diplomacy_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclareWarResponse.ProtoReflect.Descriptor instead.
+func (*DeclareWarResponse) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_diplomacy_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DeclareWarResponse) GetRelation() *v1.Relation {
+	if x != nil {
+		return x.Relation
+	}
+	return nil
+}
+
+type ProposeTreatyRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId *v1.UserId             `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// state is the treaty proposed: peace or a non-aggression pact.
+	State         v1.RelationState `protobuf:"varint,2,opt,name=state,proto3,enum=cityio.entity.v1.RelationState" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProposeTreatyRequest) Reset() {
+	*x = ProposeTreatyRequest{}
+	mi := &file_cityio_service_v1_diplomacy_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProposeTreatyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposeTreatyRequest) ProtoMessage() {}
+
+func (x *ProposeTreatyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_diplomacy_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposeTreatyRequest.ProtoReflect.Descriptor instead.
+func (*ProposeTreatyRequest) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_diplomacy_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ProposeTreatyRequest) GetUserId() *v1.UserId {
+	if x != nil {
+		return x.UserId
+	}
+	return nil
+}
+
+func (x *ProposeTreatyRequest) GetState() v1.RelationState {
+	if x != nil {
+		return x.State
+	}
+	return v1.RelationState(0)
+}
+
+type ProposeTreatyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Relation      *v1.Relation           `protobuf:"bytes,1,opt,name=relation,proto3" json:"relation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProposeTreatyResponse) Reset() {
+	*x = ProposeTreatyResponse{}
+	mi := &file_cityio_service_v1_diplomacy_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProposeTreatyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposeTreatyResponse) ProtoMessage() {}
+
+func (x *ProposeTreatyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_diplomacy_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposeTreatyResponse.ProtoReflect.Descriptor instead.
+func (*ProposeTreatyResponse) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_diplomacy_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ProposeTreatyResponse) GetRelation() *v1.Relation {
+	if x != nil {
+		return x.Relation
+	}
+	return nil
+}
+
+type AcceptTreatyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *v1.UserId             `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptTreatyRequest) Reset() {
+	*x = AcceptTreatyRequest{}
+	mi := &file_cityio_service_v1_diplomacy_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptTreatyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptTreatyRequest) ProtoMessage() {}
+
+func (x *AcceptTreatyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_diplomacy_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptTreatyRequest.ProtoReflect.Descriptor instead.
+func (*AcceptTreatyRequest) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_diplomacy_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AcceptTreatyRequest) GetUserId() *v1.UserId {
+	if x != nil {
+		return x.UserId
+	}
+	return nil
+}
+
+type AcceptTreatyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Relation      *v1.Relation           `protobuf:"bytes,1,opt,name=relation,proto3" json:"relation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptTreatyResponse) Reset() {
+	*x = AcceptTreatyResponse{}
+	mi := &file_cityio_service_v1_diplomacy_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptTreatyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptTreatyResponse) ProtoMessage() {}
+
+func (x *AcceptTreatyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_diplomacy_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptTreatyResponse.ProtoReflect.Descriptor instead.
+func (*AcceptTreatyResponse) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_diplomacy_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AcceptTreatyResponse) GetRelation() *v1.Relation {
+	if x != nil {
+		return x.Relation
+	}
+	return nil
+}
+
+type DeclineTreatyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *v1.UserId             `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeclineTreatyRequest) Reset() {
+	*x = DeclineTreatyRequest{}
+	mi := &file_cityio_service_v1_diplomacy_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeclineTreatyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineTreatyRequest) ProtoMessage() {}
+
+func (x *DeclineTreatyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_diplomacy_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineTreatyRequest.ProtoReflect.Descriptor instead.
+func (*DeclineTreatyRequest) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_diplomacy_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeclineTreatyRequest) GetUserId() *v1.UserId {
+	if x != nil {
+		return x.UserId
+	}
+	return nil
+}
+
+type DeclineTreatyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Relation      *v1.Relation           `protobuf:"bytes,1,opt,name=relation,proto3" json:"relation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeclineTreatyResponse) Reset() {
+	*x = DeclineTreatyResponse{}
+	mi := &file_cityio_service_v1_diplomacy_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeclineTreatyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineTreatyResponse) ProtoMessage() {}
+
+func (x *DeclineTreatyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_diplomacy_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineTreatyResponse.ProtoReflect.Descriptor instead.
+func (*DeclineTreatyResponse) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_diplomacy_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeclineTreatyResponse) GetRelation() *v1.Relation {
+	if x != nil {
+		return x.Relation
+	}
+	return nil
+}
+
+type CancelTreatyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *v1.UserId             `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelTreatyRequest) Reset() {
+	*x = CancelTreatyRequest{}
+	mi := &file_cityio_service_v1_diplomacy_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelTreatyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelTreatyRequest) ProtoMessage() {}
+
+func (x *CancelTreatyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_diplomacy_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelTreatyRequest.ProtoReflect.Descriptor instead.
+func (*CancelTreatyRequest) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_diplomacy_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CancelTreatyRequest) GetUserId() *v1.UserId {
+	if x != nil {
+		return x.UserId
+	}
+	return nil
+}
+
+type CancelTreatyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Relation      *v1.Relation           `protobuf:"bytes,1,opt,name=relation,proto3" json:"relation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelTreatyResponse) Reset() {
+	*x = CancelTreatyResponse{}
+	mi := &file_cityio_service_v1_diplomacy_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelTreatyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelTreatyResponse) ProtoMessage() {}
+
+func (x *CancelTreatyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_diplomacy_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelTreatyResponse.ProtoReflect.Descriptor instead.
+func (*CancelTreatyResponse) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_diplomacy_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CancelTreatyResponse) GetRelation() *v1.Relation {
+	if x != nil {
+		return x.Relation
+	}
+	return nil
+}
+
+type GetRelationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *v1.UserId             `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRelationRequest) Reset() {
+	*x = GetRelationRequest{}
+	mi := &file_cityio_service_v1_diplomacy_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRelationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRelationRequest) ProtoMessage() {}
+
+func (x *GetRelationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_diplomacy_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRelationRequest.ProtoReflect.Descriptor instead.
+func (*GetRelationRequest) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_diplomacy_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetRelationRequest) GetUserId() *v1.UserId {
+	if x != nil {
+		return x.UserId
+	}
+	return nil
+}
+
+type GetRelationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Relation      *v1.Relation           `protobuf:"bytes,1,opt,name=relation,proto3" json:"relation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRelationResponse) Reset() {
+	*x = GetRelationResponse{}
+	mi := &file_cityio_service_v1_diplomacy_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRelationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRelationResponse) ProtoMessage() {}
+
+func (x *GetRelationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_diplomacy_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRelationResponse.ProtoReflect.Descriptor instead.
+func (*GetRelationResponse) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_diplomacy_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetRelationResponse) GetRelation() *v1.Relation {
+	if x != nil {
+		return x.Relation
+	}
+	return nil
+}
+
+type ListRelationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRelationsRequest) Reset() {
+	*x = ListRelationsRequest{}
+	mi := &file_cityio_service_v1_diplomacy_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRelationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRelationsRequest) ProtoMessage() {}
+
+func (x *ListRelationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_diplomacy_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRelationsRequest.ProtoReflect.Descriptor instead.
+func (*ListRelationsRequest) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_diplomacy_proto_rawDescGZIP(), []int{12}
+}
+
+type ListRelationsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// relations are the caller's relations that aren't plain neutral, most
+	// recently changed first.
+	Relations     []*v1.Relation `protobuf:"bytes,1,rep,name=relations,proto3" json:"relations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRelationsResponse) Reset() {
+	*x = ListRelationsResponse{}
+	mi := &file_cityio_service_v1_diplomacy_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRelationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRelationsResponse) ProtoMessage() {}
+
+func (x *ListRelationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_diplomacy_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRelationsResponse.ProtoReflect.Descriptor instead.
+func (*ListRelationsResponse) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_diplomacy_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListRelationsResponse) GetRelations() []*v1.Relation {
+	if x != nil {
+		return x.Relations
+	}
+	return nil
+}
+
+var File_cityio_service_v1_diplomacy_proto protoreflect.FileDescriptor
+
+const file_cityio_service_v1_diplomacy_proto_rawDesc = "" +
+	"\n" +
+	"!cityio/service/v1/diplomacy.proto\x12\x11cityio.service.v1\x1a\x1dcityio/entity/v1/common.proto\x1a\x1fcityio/entity/v1/relation.proto\"F\n" +
+	"\x11DeclareWarRequest\x121\n" +
+	"\auser_id\x18\x01 \x01(\v2\x18.cityio.entity.v1.UserIdR\x06userId\"L\n" +
+	"\x12DeclareWarResponse\x126\n" +
+	"\brelation\x18\x01 \x01(\v2\x1a.cityio.entity.v1.RelationR\brelation\"\x80\x01\n" +
+	"\x14ProposeTreatyRequest\x121\n" +
+	"\auser_id\x18\x01 \x01(\v2\x18.cityio.entity.v1.UserIdR\x06userId\x125\n" +
+	"\x05state\x18\x02 \x01(\x0e2\x1f.cityio.entity.v1.RelationStateR\x05state\"O\n" +
+	"\x15ProposeTreatyResponse\x126\n" +
+	"\brelation\x18\x01 \x01(\v2\x1a.cityio.entity.v1.RelationR\brelation\"H\n" +
+	"\x13AcceptTreatyRequest\x121\n" +
+	"\auser_id\x18\x01 \x01(\v2\x18.cityio.entity.v1.UserIdR\x06userId\"N\n" +
+	"\x14AcceptTreatyResponse\x126\n" +
+	"\brelation\x18\x01 \x01(\v2\x1a.cityio.entity.v1.RelationR\brelation\"I\n" +
+	"\x14DeclineTreatyRequest\x121\n" +
+	"\auser_id\x18\x01 \x01(\v2\x18.cityio.entity.v1.UserIdR\x06userId\"O\n" +
+	"\x15DeclineTreatyResponse\x126\n" +
+	"\brelation\x18\x01 \x01(\v2\x1a.cityio.entity.v1.RelationR\brelation\"H\n" +
+	"\x13CancelTreatyRequest\x121\n" +
+	"\auser_id\x18\x01 \x01(\v2\x18.cityio.entity.v1.UserIdR\x06userId\"N\n" +
+	"\x14CancelTreatyResponse\x126\n" +
+	"\brelation\x18\x01 \x01(\v2\x1a.cityio.entity.v1.RelationR\brelation\"G\n" +
+	"\x12GetRelationRequest\x121\n" +
+	"\auser_id\x18\x01 \x01(\v2\x18.cityio.entity.v1.UserIdR\x06userId\"M\n" +
+	"\x13GetRelationResponse\x126\n" +
+	"\brelation\x18\x01 \x01(\v2\x1a.cityio.entity.v1.RelationR\brelation\"\x16\n" +
+	"\x14ListRelationsRequest\"Q\n" +
+	"\x15ListRelationsResponse\x128\n" +
+	"\trelations\x18\x01 \x03(\v2\x1a.cityio.entity.v1.RelationR\trelations2\xb9\x05\n" +
+	"\x10DiplomacyService\x12Y\n" +
+	"\n" +
+	"DeclareWar\x12$.cityio.service.v1.DeclareWarRequest\x1a%.cityio.service.v1.DeclareWarResponse\x12b\n" +
+	"\rProposeTreaty\x12'.cityio.service.v1.ProposeTreatyRequest\x1a(.cityio.service.v1.ProposeTreatyResponse\x12_\n" +
+	"\fAcceptTreaty\x12&.cityio.service.v1.AcceptTreatyRequest\x1a'.cityio.service.v1.AcceptTreatyResponse\x12b\n" +
+	"\rDeclineTreaty\x12'.cityio.service.v1.DeclineTreatyRequest\x1a(.cityio.service.v1.DeclineTreatyResponse\x12_\n" +
+	"\fCancelTreaty\x12&.cityio.service.v1.CancelTreatyRequest\x1a'.cityio.service.v1.CancelTreatyResponse\x12\\\n" +
+	"\vGetRelation\x12%.cityio.service.v1.GetRelationRequest\x1a&.cityio.service.v1.GetRelationResponse\x12b\n" +
+	"\rListRelations\x12'.cityio.service.v1.ListRelationsRequest\x1a(.cityio.service.v1.ListRelationsResponseB\xbe\x01\n" +
+	"\x15com.cityio.service.v1B\x0eDiplomacyProtoP\x01Z/cityio/internal/gen/cityio/service/v1;servicev1\xa2\x02\x03CSX\xaa\x02\x11Cityio.Service.V1\xca\x02\x11Cityio\\Service\\V1\xe2\x02\x1dCityio\\Service\\V1\\GPBMetadata\xea\x02\x13Cityio::Service::V1b\x06proto3"
+
+var (
+	file_cityio_service_v1_diplomacy_proto_rawDescOnce sync.Once
+	file_cityio_service_v1_diplomacy_proto_rawDescData []byte
+)
+
+func file_cityio_service_v1_diplomacy_proto_rawDescGZIP() []byte {
+	file_cityio_service_v1_diplomacy_proto_rawDescOnce.Do(func() {
+		file_cityio_service_v1_diplomacy_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cityio_service_v1_diplomacy_proto_rawDesc), len(file_cityio_service_v1_diplomacy_proto_rawDesc)))
+	})
+	return file_cityio_service_v1_diplomacy_proto_rawDescData
+}
+
+var file_cityio_service_v1_diplomacy_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_cityio_service_v1_diplomacy_proto_goTypes = []any{
+	(*DeclareWarRequest)(nil),     // 0: cityio.service.v1.DeclareWarRequest
+	(*DeclareWarResponse)(nil),    // 1: cityio.service.v1.DeclareWarResponse
+	(*ProposeTreatyRequest)(nil),  // 2: cityio.service.v1.ProposeTreatyRequest
+	(*ProposeTreatyResponse)(nil), // 3: cityio.service.v1.ProposeTreatyResponse
+	(*AcceptTreatyRequest)(nil),   // 4: cityio.service.v1.AcceptTreatyRequest
+	(*AcceptTreatyResponse)(nil),  // 5: cityio.service.v1.AcceptTreatyResponse
+	(*DeclineTreatyRequest)(nil),  // 6: cityio.service.v1.DeclineTreatyRequest
+	(*DeclineTreatyResponse)(nil), // 7: cityio.service.v1.DeclineTreatyResponse
+	(*CancelTreatyRequest)(nil),   // 8: cityio.service.v1.CancelTreatyRequest
+	(*CancelTreatyResponse)(nil),  // 9: cityio.service.v1.CancelTreatyResponse
+	(*GetRelationRequest)(nil),    // 10: cityio.service.v1.GetRelationRequest
+	(*GetRelationResponse)(nil),   // 11: cityio.service.v1.GetRelationResponse
+	(*ListRelationsRequest)(nil),  // 12: cityio.service.v1.ListRelationsRequest
+	(*ListRelationsResponse)(nil), // 13: cityio.service.v1.ListRelationsResponse
+	(*v1.UserId)(nil),             // 14: cityio.entity.v1.UserId
+	(*v1.Relation)(nil),           // 15: cityio.entity.v1.Relation
+	(v1.RelationState)(0),         // 16: cityio.entity.v1.RelationState
+}
+var file_cityio_service_v1_diplomacy_proto_depIdxs = []int32{
+	14, // 0: cityio.service.v1.DeclareWarRequest.user_id:type_name -> cityio.entity.v1.UserId
+	15, // 1: cityio.service.v1.DeclareWarResponse.relation:type_name -> cityio.entity.v1.Relation
+	14, // 2: cityio.service.v1.ProposeTreatyRequest.user_id:type_name -> cityio.entity.v1.UserId
+	16, // 3: cityio.service.v1.ProposeTreatyRequest.state:type_name -> cityio.entity.v1.RelationState
+	15, // 4: cityio.service.v1.ProposeTreatyResponse.relation:type_name -> cityio.entity.v1.Relation
+	14, // 5: cityio.service.v1.AcceptTreatyRequest.user_id:type_name -> cityio.entity.v1.UserId
+	15, // 6: cityio.service.v1.AcceptTreatyResponse.relation:type_name -> cityio.entity.v1.Relation
+	14, // 7: cityio.service.v1.DeclineTreatyRequest.user_id:type_name -> cityio.entity.v1.UserId
+	15, // 8: cityio.service.v1.DeclineTreatyResponse.relation:type_name -> cityio.entity.v1.Relation
+	14, // 9: cityio.service.v1.CancelTreatyRequest.user_id:type_name -> cityio.entity.v1.UserId
+	15, // 10: cityio.service.v1.CancelTreatyResponse.relation:type_name -> cityio.entity.v1.Relation
+	14, // 11: cityio.service.v1.GetRelationRequest.user_id:type_name -> cityio.entity.v1.UserId
+	15, // 12: cityio.service.v1.GetRelationResponse.relation:type_name -> cityio.entity.v1.Relation
+	15, // 13: cityio.service.v1.ListRelationsResponse.relations:type_name -> cityio.entity.v1.Relation
+	0,  // 14: cityio.service.v1.DiplomacyService.DeclareWar:input_type -> cityio.service.v1.DeclareWarRequest
+	2,  // 15: cityio.service.v1.DiplomacyService.ProposeTreaty:input_type -> cityio.service.v1.ProposeTreatyRequest
+	4,  // 16: cityio.service.v1.DiplomacyService.AcceptTreaty:input_type -> cityio.service.v1.AcceptTreatyRequest
+	6,  // 17: cityio.service.v1.DiplomacyService.DeclineTreaty:input_type -> cityio.service.v1.DeclineTreatyRequest
+	8,  // 18: cityio.service.v1.DiplomacyService.CancelTreaty:input_type -> cityio.service.v1.CancelTreatyRequest
+	10, // 19: cityio.service.v1.DiplomacyService.GetRelation:input_type -> cityio.service.v1.GetRelationRequest
+	12, // 20: cityio.service.v1.DiplomacyService.ListRelations:input_type -> cityio.service.v1.ListRelationsRequest
+	1,  // 21: cityio.service.v1.DiplomacyService.DeclareWar:output_type -> cityio.service.v1.DeclareWarResponse
+	3,  // 22: cityio.service.v1.DiplomacyService.ProposeTreaty:output_type -> cityio.service.v1.ProposeTreatyResponse
+	5,  // 23: cityio.service.v1.DiplomacyService.AcceptTreaty:output_type -> cityio.service.v1.AcceptTreatyResponse
+	7,  // 24: cityio.service.v1.DiplomacyService.DeclineTreaty:output_type -> cityio.service.v1.DeclineTreatyResponse
+	9,  // 25: cityio.service.v1.DiplomacyService.CancelTreaty:output_type -> cityio.service.v1.CancelTreatyResponse
+	11, // 26: cityio.service.v1.DiplomacyService.GetRelation:output_type -> cityio.service.v1.GetRelationResponse
+	13, // 27: cityio.service.v1.DiplomacyService.ListRelations:output_type -> cityio.service.v1.ListRelationsResponse
+	21, // [21:28] is the sub-list for method output_type
+	14, // [14:21] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
+}
+
+func init() { file_cityio_service_v1_diplomacy_proto_init() }
+func file_cityio_service_v1_diplomacy_proto_init() {
+	if File_cityio_service_v1_diplomacy_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cityio_service_v1_diplomacy_proto_rawDesc), len(file_cityio_service_v1_diplomacy_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_cityio_service_v1_diplomacy_proto_goTypes,
+		DependencyIndexes: file_cityio_service_v1_diplomacy_proto_depIdxs,
+		MessageInfos:      file_cityio_service_v1_diplomacy_proto_msgTypes,
+	}.Build()
+	File_cityio_service_v1_diplomacy_proto = out.File
+	file_cityio_service_v1_diplomacy_proto_goTypes = nil
+	file_cityio_service_v1_diplomacy_proto_depIdxs = nil
+}
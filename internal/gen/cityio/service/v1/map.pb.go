@@ -60,10 +60,13 @@ func (*GetMapRequest) Descriptor() ([]byte, []int) {
 
 // GetMapResponse is the full world snapshot used to bootstrap a client.
 type GetMapResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CityIds       []*v1.CityId           `protobuf:"bytes,1,rep,name=city_ids,json=cityIds,proto3" json:"city_ids,omitempty"`
-	BuildingIds   []*v1.BuildingId       `protobuf:"bytes,2,rep,name=building_ids,json=buildingIds,proto3" json:"building_ids,omitempty"`
-	Entities      *v1.EntityBag          `protobuf:"bytes,3,opt,name=entities,proto3" json:"entities,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	CityIds     []*v1.CityId           `protobuf:"bytes,1,rep,name=city_ids,json=cityIds,proto3" json:"city_ids,omitempty"`
+	BuildingIds []*v1.BuildingId       `protobuf:"bytes,2,rep,name=building_ids,json=buildingIds,proto3" json:"building_ids,omitempty"`
+	Entities    *v1.EntityBag          `protobuf:"bytes,3,opt,name=entities,proto3" json:"entities,omitempty"`
+	// tiles carries the terrain and deposit of every tile the caller can see;
+	// occupancy fields are left unset.
+	Tiles         []*Tile `protobuf:"bytes,4,rep,name=tiles,proto3" json:"tiles,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -119,12 +122,23 @@ func (x *GetMapResponse) GetEntities() *v1.EntityBag {
 	return nil
 }
 
+func (x *GetMapResponse) GetTiles() []*Tile {
+	if x != nil {
+		return x.Tiles
+	}
+	return nil
+}
+
 type Tile struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	X             int32                  `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
 	Y             int32                  `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
 	CityId        *v1.CityId             `protobuf:"bytes,3,opt,name=city_id,json=cityId,proto3,oneof" json:"city_id,omitempty"`
 	BuildingId    *v1.BuildingId         `protobuf:"bytes,4,opt,name=building_id,json=buildingId,proto3,oneof" json:"building_id,omitempty"`
+	ArmyIds       []*v1.ArmyId           `protobuf:"bytes,5,rep,name=army_ids,json=armyIds,proto3" json:"army_ids,omitempty"`
+	Terrain       v1.Terrain             `protobuf:"varint,6,opt,name=terrain,proto3,enum=cityio.entity.v1.Terrain" json:"terrain,omitempty"`
+	Deposit       *v1.Deposit            `protobuf:"bytes,7,opt,name=deposit,proto3,oneof" json:"deposit,omitempty"`
+	CaravanIds    []*v1.CaravanId        `protobuf:"bytes,8,rep,name=caravan_ids,json=caravanIds,proto3" json:"caravan_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -187,6 +201,34 @@ func (x *Tile) GetBuildingId() *v1.BuildingId {
 	return nil
 }
 
+func (x *Tile) GetArmyIds() []*v1.ArmyId {
+	if x != nil {
+		return x.ArmyIds
+	}
+	return nil
+}
+
+func (x *Tile) GetTerrain() v1.Terrain {
+	if x != nil {
+		return x.Terrain
+	}
+	return v1.Terrain(0)
+}
+
+func (x *Tile) GetDeposit() *v1.Deposit {
+	if x != nil {
+		return x.Deposit
+	}
+	return nil
+}
+
+func (x *Tile) GetCaravanIds() []*v1.CaravanId {
+	if x != nil {
+		return x.CaravanIds
+	}
+	return nil
+}
+
 type GetTileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Coords        *v1.Coordinates        `protobuf:"bytes,1,opt,name=coords,proto3" json:"coords,omitempty"`
@@ -280,20 +322,28 @@ var File_cityio_service_v1_map_proto protoreflect.FileDescriptor
 const file_cityio_service_v1_map_proto_rawDesc = "" +
 	"\n" +
 	"\x1bcityio/service/v1/map.proto\x12\x11cityio.service.v1\x1a\x1dcityio/entity/v1/common.proto\x1a\x1acityio/entity/v1/bag.proto\"\x0f\n" +
-	"\rGetMapRequest\"\xbf\x01\n" +
+	"\rGetMapRequest\"\xee\x01\n" +
 	"\x0eGetMapResponse\x123\n" +
 	"\bcity_ids\x18\x01 \x03(\v2\x18.cityio.entity.v1.CityIdR\acityIds\x12?\n" +
 	"\fbuilding_ids\x18\x02 \x03(\v2\x1c.cityio.entity.v1.BuildingIdR\vbuildingIds\x127\n" +
-	"\bentities\x18\x03 \x01(\v2\x1b.cityio.entity.v1.EntityBagR\bentities\"\xba\x01\n" +
+	"\bentities\x18\x03 \x01(\v2\x1b.cityio.entity.v1.EntityBagR\bentities\x12-\n" +
+	"\x05tiles\x18\x04 \x03(\v2\x17.cityio.service.v1.TileR\x05tiles\"\xa8\x03\n" +
 	"\x04Tile\x12\f\n" +
 	"\x01x\x18\x01 \x01(\x05R\x01x\x12\f\n" +
 	"\x01y\x18\x02 \x01(\x05R\x01y\x126\n" +
 	"\acity_id\x18\x03 \x01(\v2\x18.cityio.entity.v1.CityIdH\x00R\x06cityId\x88\x01\x01\x12B\n" +
 	"\vbuilding_id\x18\x04 \x01(\v2\x1c.cityio.entity.v1.BuildingIdH\x01R\n" +
-	"buildingId\x88\x01\x01B\n" +
+	"buildingId\x88\x01\x01\x123\n" +
+	"\barmy_ids\x18\x05 \x03(\v2\x18.cityio.entity.v1.ArmyIdR\aarmyIds\x123\n" +
+	"\aterrain\x18\x06 \x01(\x0e2\x19.cityio.entity.v1.TerrainR\aterrain\x128\n" +
+	"\adeposit\x18\a \x01(\v2\x19.cityio.entity.v1.DepositH\x02R\adeposit\x88\x01\x01\x12<\n" +
+	"\vcaravan_ids\x18\b \x03(\v2\x1b.cityio.entity.v1.CaravanIdR\n" +
+	"caravanIdsB\n" +
 	"\n" +
 	"\b_city_idB\x0e\n" +
-	"\f_building_id\"G\n" +
+	"\f_building_idB\n" +
+	"\n" +
+	"\b_deposit\"G\n" +
 	"\x0eGetTileRequest\x125\n" +
 	"\x06coords\x18\x01 \x01(\v2\x1d.cityio.entity.v1.CoordinatesR\x06coords\">\n" +
 	"\x0fGetTileResponse\x12+\n" +
@@ -326,25 +376,34 @@ var file_cityio_service_v1_map_proto_goTypes = []any{
 	(*v1.CityId)(nil),       // 5: cityio.entity.v1.CityId
 	(*v1.BuildingId)(nil),   // 6: cityio.entity.v1.BuildingId
 	(*v1.EntityBag)(nil),    // 7: cityio.entity.v1.EntityBag
-	(*v1.Coordinates)(nil),  // 8: cityio.entity.v1.Coordinates
+	(*v1.ArmyId)(nil),       // 8: cityio.entity.v1.ArmyId
+	(v1.Terrain)(0),         // 9: cityio.entity.v1.Terrain
+	(*v1.Deposit)(nil),      // 10: cityio.entity.v1.Deposit
+	(*v1.CaravanId)(nil),    // 11: cityio.entity.v1.CaravanId
+	(*v1.Coordinates)(nil),  // 12: cityio.entity.v1.Coordinates
 }
 var file_cityio_service_v1_map_proto_depIdxs = []int32{
-	5, // 0: cityio.service.v1.GetMapResponse.city_ids:type_name -> cityio.entity.v1.CityId
-	6, // 1: cityio.service.v1.GetMapResponse.building_ids:type_name -> cityio.entity.v1.BuildingId
-	7, // 2: cityio.service.v1.GetMapResponse.entities:type_name -> cityio.entity.v1.EntityBag
-	5, // 3: cityio.service.v1.Tile.city_id:type_name -> cityio.entity.v1.CityId
-	6, // 4: cityio.service.v1.Tile.building_id:type_name -> cityio.entity.v1.BuildingId
-	8, // 5: cityio.service.v1.GetTileRequest.coords:type_name -> cityio.entity.v1.Coordinates
-	2, // 6: cityio.service.v1.GetTileResponse.tile:type_name -> cityio.service.v1.Tile
-	0, // 7: cityio.service.v1.MapService.GetMap:input_type -> cityio.service.v1.GetMapRequest
-	3, // 8: cityio.service.v1.MapService.GetTile:input_type -> cityio.service.v1.GetTileRequest
-	1, // 9: cityio.service.v1.MapService.GetMap:output_type -> cityio.service.v1.GetMapResponse
-	4, // 10: cityio.service.v1.MapService.GetTile:output_type -> cityio.service.v1.GetTileResponse
-	9, // [9:11] is the sub-list for method output_type
-	7, // [7:9] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	5,  // 0: cityio.service.v1.GetMapResponse.city_ids:type_name -> cityio.entity.v1.CityId
+	6,  // 1: cityio.service.v1.GetMapResponse.building_ids:type_name -> cityio.entity.v1.BuildingId
+	7,  // 2: cityio.service.v1.GetMapResponse.entities:type_name -> cityio.entity.v1.EntityBag
+	2,  // 3: cityio.service.v1.GetMapResponse.tiles:type_name -> cityio.service.v1.Tile
+	5,  // 4: cityio.service.v1.Tile.city_id:type_name -> cityio.entity.v1.CityId
+	6,  // 5: cityio.service.v1.Tile.building_id:type_name -> cityio.entity.v1.BuildingId
+	8,  // 6: cityio.service.v1.Tile.army_ids:type_name -> cityio.entity.v1.ArmyId
+	9,  // 7: cityio.service.v1.Tile.terrain:type_name -> cityio.entity.v1.Terrain
+	10, // 8: cityio.service.v1.Tile.deposit:type_name -> cityio.entity.v1.Deposit
+	11, // 9: cityio.service.v1.Tile.caravan_ids:type_name -> cityio.entity.v1.CaravanId
+	12, // 10: cityio.service.v1.GetTileRequest.coords:type_name -> cityio.entity.v1.Coordinates
+	2,  // 11: cityio.service.v1.GetTileResponse.tile:type_name -> cityio.service.v1.Tile
+	0,  // 12: cityio.service.v1.MapService.GetMap:input_type -> cityio.service.v1.GetMapRequest
+	3,  // 13: cityio.service.v1.MapService.GetTile:input_type -> cityio.service.v1.GetTileRequest
+	1,  // 14: cityio.service.v1.MapService.GetMap:output_type -> cityio.service.v1.GetMapResponse
+	4,  // 15: cityio.service.v1.MapService.GetTile:output_type -> cityio.service.v1.GetTileResponse
+	14, // [14:16] is the sub-list for method output_type
+	12, // [12:14] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_cityio_service_v1_map_proto_init() }
@@ -0,0 +1,525 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: cityio/service/v1/market.proto
+
+package servicev1
+
+import (
+	v1 "cityio/internal/gen/cityio/entity/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PlaceOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MarketId      string                 `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Side          v1.OrderSide           `protobuf:"varint,2,opt,name=side,proto3,enum=cityio.entity.v1.OrderSide" json:"side,omitempty"`
+	Price         int64                  `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	Quantity      int64                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaceOrderRequest) Reset() {
+	*x = PlaceOrderRequest{}
+	mi := &file_cityio_service_v1_market_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaceOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceOrderRequest) ProtoMessage() {}
+
+func (x *PlaceOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_market_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceOrderRequest.ProtoReflect.Descriptor instead.
+func (*PlaceOrderRequest) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_market_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PlaceOrderRequest) GetMarketId() string {
+	if x != nil {
+		return x.MarketId
+	}
+	return ""
+}
+
+func (x *PlaceOrderRequest) GetSide() v1.OrderSide {
+	if x != nil {
+		return x.Side
+	}
+	return v1.OrderSide(0)
+}
+
+func (x *PlaceOrderRequest) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *PlaceOrderRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type PlaceOrderResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// order.remaining is zero when the order filled completely on placement.
+	Order         *v1.MarketOrder `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	Trades        []*v1.Trade     `protobuf:"bytes,2,rep,name=trades,proto3" json:"trades,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaceOrderResponse) Reset() {
+	*x = PlaceOrderResponse{}
+	mi := &file_cityio_service_v1_market_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaceOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceOrderResponse) ProtoMessage() {}
+
+func (x *PlaceOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_market_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceOrderResponse.ProtoReflect.Descriptor instead.
+func (*PlaceOrderResponse) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_market_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PlaceOrderResponse) GetOrder() *v1.MarketOrder {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *PlaceOrderResponse) GetTrades() []*v1.Trade {
+	if x != nil {
+		return x.Trades
+	}
+	return nil
+}
+
+type CancelOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MarketId      string                 `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	OrderId       string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelOrderRequest) Reset() {
+	*x = CancelOrderRequest{}
+	mi := &file_cityio_service_v1_market_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderRequest) ProtoMessage() {}
+
+func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_market_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderRequest.ProtoReflect.Descriptor instead.
+func (*CancelOrderRequest) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_market_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CancelOrderRequest) GetMarketId() string {
+	if x != nil {
+		return x.MarketId
+	}
+	return ""
+}
+
+func (x *CancelOrderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type CancelOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *v1.MarketOrder        `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelOrderResponse) Reset() {
+	*x = CancelOrderResponse{}
+	mi := &file_cityio_service_v1_market_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderResponse) ProtoMessage() {}
+
+func (x *CancelOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_market_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderResponse.ProtoReflect.Descriptor instead.
+func (*CancelOrderResponse) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_market_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CancelOrderResponse) GetOrder() *v1.MarketOrder {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+type ListOrdersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// market_id limits the listing to one market; empty lists every market.
+	MarketId      string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrdersRequest) Reset() {
+	*x = ListOrdersRequest{}
+	mi := &file_cityio_service_v1_market_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrdersRequest) ProtoMessage() {}
+
+func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_market_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ListOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_market_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListOrdersRequest) GetMarketId() string {
+	if x != nil {
+		return x.MarketId
+	}
+	return ""
+}
+
+type ListOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Orders        []*v1.MarketOrder      `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrdersResponse) Reset() {
+	*x = ListOrdersResponse{}
+	mi := &file_cityio_service_v1_market_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrdersResponse) ProtoMessage() {}
+
+func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_market_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ListOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_market_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListOrdersResponse) GetOrders() []*v1.MarketOrder {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+type StreamTickerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MarketId      string                 `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamTickerRequest) Reset() {
+	*x = StreamTickerRequest{}
+	mi := &file_cityio_service_v1_market_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamTickerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamTickerRequest) ProtoMessage() {}
+
+func (x *StreamTickerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_market_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamTickerRequest.ProtoReflect.Descriptor instead.
+func (*StreamTickerRequest) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_market_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *StreamTickerRequest) GetMarketId() string {
+	if x != nil {
+		return x.MarketId
+	}
+	return ""
+}
+
+type StreamTickerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ticker        *v1.MarketTicker       `protobuf:"bytes,1,opt,name=ticker,proto3" json:"ticker,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamTickerResponse) Reset() {
+	*x = StreamTickerResponse{}
+	mi := &file_cityio_service_v1_market_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamTickerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamTickerResponse) ProtoMessage() {}
+
+func (x *StreamTickerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_market_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamTickerResponse.ProtoReflect.Descriptor instead.
+func (*StreamTickerResponse) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_market_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *StreamTickerResponse) GetTicker() *v1.MarketTicker {
+	if x != nil {
+		return x.Ticker
+	}
+	return nil
+}
+
+var File_cityio_service_v1_market_proto protoreflect.FileDescriptor
+
+const file_cityio_service_v1_market_proto_rawDesc = "" +
+	"\n" +
+	"\x1ecityio/service/v1/market.proto\x12\x11cityio.service.v1\x1a\x1dcityio/entity/v1/market.proto\"\x93\x01\n" +
+	"\x11PlaceOrderRequest\x12\x1b\n" +
+	"\tmarket_id\x18\x01 \x01(\tR\bmarketId\x12/\n" +
+	"\x04side\x18\x02 \x01(\x0e2\x1b.cityio.entity.v1.OrderSideR\x04side\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\x03R\x05price\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x03R\bquantity\"z\n" +
+	"\x12PlaceOrderResponse\x123\n" +
+	"\x05order\x18\x01 \x01(\v2\x1d.cityio.entity.v1.MarketOrderR\x05order\x12/\n" +
+	"\x06trades\x18\x02 \x03(\v2\x17.cityio.entity.v1.TradeR\x06trades\"L\n" +
+	"\x12CancelOrderRequest\x12\x1b\n" +
+	"\tmarket_id\x18\x01 \x01(\tR\bmarketId\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\"J\n" +
+	"\x13CancelOrderResponse\x123\n" +
+	"\x05order\x18\x01 \x01(\v2\x1d.cityio.entity.v1.MarketOrderR\x05order\"0\n" +
+	"\x11ListOrdersRequest\x12\x1b\n" +
+	"\tmarket_id\x18\x01 \x01(\tR\bmarketId\"K\n" +
+	"\x12ListOrdersResponse\x125\n" +
+	"\x06orders\x18\x01 \x03(\v2\x1d.cityio.entity.v1.MarketOrderR\x06orders\"2\n" +
+	"\x13StreamTickerRequest\x12\x1b\n" +
+	"\tmarket_id\x18\x01 \x01(\tR\bmarketId\"N\n" +
+	"\x14StreamTickerResponse\x126\n" +
+	"\x06ticker\x18\x01 \x01(\v2\x1e.cityio.entity.v1.MarketTickerR\x06ticker2\x86\x03\n" +
+	"\rMarketService\x12Y\n" +
+	"\n" +
+	"PlaceOrder\x12$.cityio.service.v1.PlaceOrderRequest\x1a%.cityio.service.v1.PlaceOrderResponse\x12\\\n" +
+	"\vCancelOrder\x12%.cityio.service.v1.CancelOrderRequest\x1a&.cityio.service.v1.CancelOrderResponse\x12Y\n" +
+	"\n" +
+	"ListOrders\x12$.cityio.service.v1.ListOrdersRequest\x1a%.cityio.service.v1.ListOrdersResponse\x12a\n" +
+	"\fStreamTicker\x12&.cityio.service.v1.StreamTickerRequest\x1a'.cityio.service.v1.StreamTickerResponse0\x01B\xbb\x01\n" +
+	"\x15com.cityio.service.v1B\vMarketProtoP\x01Z/cityio/internal/gen/cityio/service/v1;servicev1\xa2\x02\x03CSX\xaa\x02\x11Cityio.Service.V1\xca\x02\x11Cityio\\Service\\V1\xe2\x02\x1dCityio\\Service\\V1\\GPBMetadata\xea\x02\x13Cityio::Service::V1b\x06proto3"
+
+var (
+	file_cityio_service_v1_market_proto_rawDescOnce sync.Once
+	file_cityio_service_v1_market_proto_rawDescData []byte
+)
+
+func file_cityio_service_v1_market_proto_rawDescGZIP() []byte {
+	file_cityio_service_v1_market_proto_rawDescOnce.Do(func() {
+		file_cityio_service_v1_market_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cityio_service_v1_market_proto_rawDesc), len(file_cityio_service_v1_market_proto_rawDesc)))
+	})
+	return file_cityio_service_v1_market_proto_rawDescData
+}
+
+var file_cityio_service_v1_market_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_cityio_service_v1_market_proto_goTypes = []any{
+	(*PlaceOrderRequest)(nil),    // 0: cityio.service.v1.PlaceOrderRequest
+	(*PlaceOrderResponse)(nil),   // 1: cityio.service.v1.PlaceOrderResponse
+	(*CancelOrderRequest)(nil),   // 2: cityio.service.v1.CancelOrderRequest
+	(*CancelOrderResponse)(nil),  // 3: cityio.service.v1.CancelOrderResponse
+	(*ListOrdersRequest)(nil),    // 4: cityio.service.v1.ListOrdersRequest
+	(*ListOrdersResponse)(nil),   // 5: cityio.service.v1.ListOrdersResponse
+	(*StreamTickerRequest)(nil),  // 6: cityio.service.v1.StreamTickerRequest
+	(*StreamTickerResponse)(nil), // 7: cityio.service.v1.StreamTickerResponse
+	(v1.OrderSide)(0),            // 8: cityio.entity.v1.OrderSide
+	(*v1.MarketOrder)(nil),       // 9: cityio.entity.v1.MarketOrder
+	(*v1.Trade)(nil),             // 10: cityio.entity.v1.Trade
+	(*v1.MarketTicker)(nil),      // 11: cityio.entity.v1.MarketTicker
+}
+var file_cityio_service_v1_market_proto_depIdxs = []int32{
+	8,  // 0: cityio.service.v1.PlaceOrderRequest.side:type_name -> cityio.entity.v1.OrderSide
+	9,  // 1: cityio.service.v1.PlaceOrderResponse.order:type_name -> cityio.entity.v1.MarketOrder
+	10, // 2: cityio.service.v1.PlaceOrderResponse.trades:type_name -> cityio.entity.v1.Trade
+	9,  // 3: cityio.service.v1.CancelOrderResponse.order:type_name -> cityio.entity.v1.MarketOrder
+	9,  // 4: cityio.service.v1.ListOrdersResponse.orders:type_name -> cityio.entity.v1.MarketOrder
+	11, // 5: cityio.service.v1.StreamTickerResponse.ticker:type_name -> cityio.entity.v1.MarketTicker
+	0,  // 6: cityio.service.v1.MarketService.PlaceOrder:input_type -> cityio.service.v1.PlaceOrderRequest
+	2,  // 7: cityio.service.v1.MarketService.CancelOrder:input_type -> cityio.service.v1.CancelOrderRequest
+	4,  // 8: cityio.service.v1.MarketService.ListOrders:input_type -> cityio.service.v1.ListOrdersRequest
+	6,  // 9: cityio.service.v1.MarketService.StreamTicker:input_type -> cityio.service.v1.StreamTickerRequest
+	1,  // 10: cityio.service.v1.MarketService.PlaceOrder:output_type -> cityio.service.v1.PlaceOrderResponse
+	3,  // 11: cityio.service.v1.MarketService.CancelOrder:output_type -> cityio.service.v1.CancelOrderResponse
+	5,  // 12: cityio.service.v1.MarketService.ListOrders:output_type -> cityio.service.v1.ListOrdersResponse
+	7,  // 13: cityio.service.v1.MarketService.StreamTicker:output_type -> cityio.service.v1.StreamTickerResponse
+	10, // [10:14] is the sub-list for method output_type
+	6,  // [6:10] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_cityio_service_v1_market_proto_init() }
+func file_cityio_service_v1_market_proto_init() {
+	if File_cityio_service_v1_market_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cityio_service_v1_market_proto_rawDesc), len(file_cityio_service_v1_market_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_cityio_service_v1_market_proto_goTypes,
+		DependencyIndexes: file_cityio_service_v1_market_proto_depIdxs,
+		MessageInfos:      file_cityio_service_v1_market_proto_msgTypes,
+	}.Build()
+	File_cityio_service_v1_market_proto = out.File
+	file_cityio_service_v1_market_proto_goTypes = nil
+	file_cityio_service_v1_market_proto_depIdxs = nil
+}
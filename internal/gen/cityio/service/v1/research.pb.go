@@ -0,0 +1,366 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: cityio/service/v1/research.proto
+
+package servicev1
+
+import (
+	v1 "cityio/internal/gen/cityio/entity/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetResearchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetResearchRequest) Reset() {
+	*x = GetResearchRequest{}
+	mi := &file_cityio_service_v1_research_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResearchRequest) ProtoMessage() {}
+
+func (x *GetResearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_research_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResearchRequest.ProtoReflect.Descriptor instead.
+func (*GetResearchRequest) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_research_proto_rawDescGZIP(), []int{0}
+}
+
+type GetResearchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Techs         []string               `protobuf:"bytes,1,rep,name=techs,proto3" json:"techs,omitempty"`
+	Research      *v1.Research           `protobuf:"bytes,2,opt,name=research,proto3,oneof" json:"research,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetResearchResponse) Reset() {
+	*x = GetResearchResponse{}
+	mi := &file_cityio_service_v1_research_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResearchResponse) ProtoMessage() {}
+
+func (x *GetResearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_research_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResearchResponse.ProtoReflect.Descriptor instead.
+func (*GetResearchResponse) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_research_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetResearchResponse) GetTechs() []string {
+	if x != nil {
+		return x.Techs
+	}
+	return nil
+}
+
+func (x *GetResearchResponse) GetResearch() *v1.Research {
+	if x != nil {
+		return x.Research
+	}
+	return nil
+}
+
+type StartResearchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tech          string                 `protobuf:"bytes,1,opt,name=tech,proto3" json:"tech,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartResearchRequest) Reset() {
+	*x = StartResearchRequest{}
+	mi := &file_cityio_service_v1_research_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartResearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartResearchRequest) ProtoMessage() {}
+
+func (x *StartResearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_research_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartResearchRequest.ProtoReflect.Descriptor instead.
+func (*StartResearchRequest) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_research_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StartResearchRequest) GetTech() string {
+	if x != nil {
+		return x.Tech
+	}
+	return ""
+}
+
+type StartResearchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Research      *v1.Research           `protobuf:"bytes,1,opt,name=research,proto3" json:"research,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartResearchResponse) Reset() {
+	*x = StartResearchResponse{}
+	mi := &file_cityio_service_v1_research_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartResearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartResearchResponse) ProtoMessage() {}
+
+func (x *StartResearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_research_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartResearchResponse.ProtoReflect.Descriptor instead.
+func (*StartResearchResponse) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_research_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *StartResearchResponse) GetResearch() *v1.Research {
+	if x != nil {
+		return x.Research
+	}
+	return nil
+}
+
+type CancelResearchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelResearchRequest) Reset() {
+	*x = CancelResearchRequest{}
+	mi := &file_cityio_service_v1_research_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelResearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelResearchRequest) ProtoMessage() {}
+
+func (x *CancelResearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_research_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelResearchRequest.ProtoReflect.Descriptor instead.
+func (*CancelResearchRequest) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_research_proto_rawDescGZIP(), []int{4}
+}
+
+type CancelResearchResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// refund is the gold returned to the player.
+	Refund        int64 `protobuf:"varint,1,opt,name=refund,proto3" json:"refund,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelResearchResponse) Reset() {
+	*x = CancelResearchResponse{}
+	mi := &file_cityio_service_v1_research_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelResearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelResearchResponse) ProtoMessage() {}
+
+func (x *CancelResearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_research_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelResearchResponse.ProtoReflect.Descriptor instead.
+func (*CancelResearchResponse) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_research_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CancelResearchResponse) GetRefund() int64 {
+	if x != nil {
+		return x.Refund
+	}
+	return 0
+}
+
+var File_cityio_service_v1_research_proto protoreflect.FileDescriptor
+
+const file_cityio_service_v1_research_proto_rawDesc = "" +
+	"\n" +
+	" cityio/service/v1/research.proto\x12\x11cityio.service.v1\x1a\x1bcityio/entity/v1/user.proto\"\x14\n" +
+	"\x12GetResearchRequest\"u\n" +
+	"\x13GetResearchResponse\x12\x14\n" +
+	"\x05techs\x18\x01 \x03(\tR\x05techs\x12;\n" +
+	"\bresearch\x18\x02 \x01(\v2\x1a.cityio.entity.v1.ResearchH\x00R\bresearch\x88\x01\x01B\v\n" +
+	"\t_research\"*\n" +
+	"\x14StartResearchRequest\x12\x12\n" +
+	"\x04tech\x18\x01 \x01(\tR\x04tech\"O\n" +
+	"\x15StartResearchResponse\x126\n" +
+	"\bresearch\x18\x01 \x01(\v2\x1a.cityio.entity.v1.ResearchR\bresearch\"\x17\n" +
+	"\x15CancelResearchRequest\"0\n" +
+	"\x16CancelResearchResponse\x12\x16\n" +
+	"\x06refund\x18\x01 \x01(\x03R\x06refund2\xba\x02\n" +
+	"\x0fResearchService\x12\\\n" +
+	"\vGetResearch\x12%.cityio.service.v1.GetResearchRequest\x1a&.cityio.service.v1.GetResearchResponse\x12b\n" +
+	"\rStartResearch\x12'.cityio.service.v1.StartResearchRequest\x1a(.cityio.service.v1.StartResearchResponse\x12e\n" +
+	"\x0eCancelResearch\x12(.cityio.service.v1.CancelResearchRequest\x1a).cityio.service.v1.CancelResearchResponseB\xbd\x01\n" +
+	"\x15com.cityio.service.v1B\rResearchProtoP\x01Z/cityio/internal/gen/cityio/service/v1;servicev1\xa2\x02\x03CSX\xaa\x02\x11Cityio.Service.V1\xca\x02\x11Cityio\\Service\\V1\xe2\x02\x1dCityio\\Service\\V1\\GPBMetadata\xea\x02\x13Cityio::Service::V1b\x06proto3"
+
+var (
+	file_cityio_service_v1_research_proto_rawDescOnce sync.Once
+	file_cityio_service_v1_research_proto_rawDescData []byte
+)
+
+func file_cityio_service_v1_research_proto_rawDescGZIP() []byte {
+	file_cityio_service_v1_research_proto_rawDescOnce.Do(func() {
+		file_cityio_service_v1_research_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cityio_service_v1_research_proto_rawDesc), len(file_cityio_service_v1_research_proto_rawDesc)))
+	})
+	return file_cityio_service_v1_research_proto_rawDescData
+}
+
+var file_cityio_service_v1_research_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_cityio_service_v1_research_proto_goTypes = []any{
+	(*GetResearchRequest)(nil),     // 0: cityio.service.v1.GetResearchRequest
+	(*GetResearchResponse)(nil),    // 1: cityio.service.v1.GetResearchResponse
+	(*StartResearchRequest)(nil),   // 2: cityio.service.v1.StartResearchRequest
+	(*StartResearchResponse)(nil),  // 3: cityio.service.v1.StartResearchResponse
+	(*CancelResearchRequest)(nil),  // 4: cityio.service.v1.CancelResearchRequest
+	(*CancelResearchResponse)(nil), // 5: cityio.service.v1.CancelResearchResponse
+	(*v1.Research)(nil),            // 6: cityio.entity.v1.Research
+}
+var file_cityio_service_v1_research_proto_depIdxs = []int32{
+	6, // 0: cityio.service.v1.GetResearchResponse.research:type_name -> cityio.entity.v1.Research
+	6, // 1: cityio.service.v1.StartResearchResponse.research:type_name -> cityio.entity.v1.Research
+	0, // 2: cityio.service.v1.ResearchService.GetResearch:input_type -> cityio.service.v1.GetResearchRequest
+	2, // 3: cityio.service.v1.ResearchService.StartResearch:input_type -> cityio.service.v1.StartResearchRequest
+	4, // 4: cityio.service.v1.ResearchService.CancelResearch:input_type -> cityio.service.v1.CancelResearchRequest
+	1, // 5: cityio.service.v1.ResearchService.GetResearch:output_type -> cityio.service.v1.GetResearchResponse
+	3, // 6: cityio.service.v1.ResearchService.StartResearch:output_type -> cityio.service.v1.StartResearchResponse
+	5, // 7: cityio.service.v1.ResearchService.CancelResearch:output_type -> cityio.service.v1.CancelResearchResponse
+	5, // [5:8] is the sub-list for method output_type
+	2, // [2:5] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_cityio_service_v1_research_proto_init() }
+func file_cityio_service_v1_research_proto_init() {
+	if File_cityio_service_v1_research_proto != nil {
+		return
+	}
+	file_cityio_service_v1_research_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cityio_service_v1_research_proto_rawDesc), len(file_cityio_service_v1_research_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_cityio_service_v1_research_proto_goTypes,
+		DependencyIndexes: file_cityio_service_v1_research_proto_depIdxs,
+		MessageInfos:      file_cityio_service_v1_research_proto_msgTypes,
+	}.Build()
+	File_cityio_service_v1_research_proto = out.File
+	file_cityio_service_v1_research_proto_goTypes = nil
+	file_cityio_service_v1_research_proto_depIdxs = nil
+}
@@ -0,0 +1,193 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: cityio/service/v1/admin.proto
+
+package servicev1connect
+
+import (
+	v1 "cityio/internal/gen/cityio/service/v1"
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// AdminServiceName is the fully-qualified name of the AdminService service.
+	AdminServiceName = "cityio.service.v1.AdminService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// AdminServiceEndSeasonProcedure is the fully-qualified name of the AdminService's EndSeason RPC.
+	AdminServiceEndSeasonProcedure = "/cityio.service.v1.AdminService/EndSeason"
+	// AdminServiceReloadBalanceProcedure is the fully-qualified name of the AdminService's
+	// ReloadBalance RPC.
+	AdminServiceReloadBalanceProcedure = "/cityio.service.v1.AdminService/ReloadBalance"
+	// AdminServiceMuteUserProcedure is the fully-qualified name of the AdminService's MuteUser RPC.
+	AdminServiceMuteUserProcedure = "/cityio.service.v1.AdminService/MuteUser"
+	// AdminServiceUnmuteUserProcedure is the fully-qualified name of the AdminService's UnmuteUser RPC.
+	AdminServiceUnmuteUserProcedure = "/cityio.service.v1.AdminService/UnmuteUser"
+)
+
+// AdminServiceClient is a client for the cityio.service.v1.AdminService service.
+type AdminServiceClient interface {
+	EndSeason(context.Context, *connect.Request[v1.EndSeasonRequest]) (*connect.Response[v1.EndSeasonResponse], error)
+	ReloadBalance(context.Context, *connect.Request[v1.ReloadBalanceRequest]) (*connect.Response[v1.ReloadBalanceResponse], error)
+	MuteUser(context.Context, *connect.Request[v1.MuteUserRequest]) (*connect.Response[v1.MuteUserResponse], error)
+	UnmuteUser(context.Context, *connect.Request[v1.UnmuteUserRequest]) (*connect.Response[v1.UnmuteUserResponse], error)
+}
+
+// NewAdminServiceClient constructs a client for the cityio.service.v1.AdminService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAdminServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AdminServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	adminServiceMethods := v1.File_cityio_service_v1_admin_proto.Services().ByName("AdminService").Methods()
+	return &adminServiceClient{
+		endSeason: connect.NewClient[v1.EndSeasonRequest, v1.EndSeasonResponse](
+			httpClient,
+			baseURL+AdminServiceEndSeasonProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("EndSeason")),
+			connect.WithClientOptions(opts...),
+		),
+		reloadBalance: connect.NewClient[v1.ReloadBalanceRequest, v1.ReloadBalanceResponse](
+			httpClient,
+			baseURL+AdminServiceReloadBalanceProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ReloadBalance")),
+			connect.WithClientOptions(opts...),
+		),
+		muteUser: connect.NewClient[v1.MuteUserRequest, v1.MuteUserResponse](
+			httpClient,
+			baseURL+AdminServiceMuteUserProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("MuteUser")),
+			connect.WithClientOptions(opts...),
+		),
+		unmuteUser: connect.NewClient[v1.UnmuteUserRequest, v1.UnmuteUserResponse](
+			httpClient,
+			baseURL+AdminServiceUnmuteUserProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("UnmuteUser")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// adminServiceClient implements AdminServiceClient.
+type adminServiceClient struct {
+	endSeason     *connect.Client[v1.EndSeasonRequest, v1.EndSeasonResponse]
+	reloadBalance *connect.Client[v1.ReloadBalanceRequest, v1.ReloadBalanceResponse]
+	muteUser      *connect.Client[v1.MuteUserRequest, v1.MuteUserResponse]
+	unmuteUser    *connect.Client[v1.UnmuteUserRequest, v1.UnmuteUserResponse]
+}
+
+// EndSeason calls cityio.service.v1.AdminService.EndSeason.
+func (c *adminServiceClient) EndSeason(ctx context.Context, req *connect.Request[v1.EndSeasonRequest]) (*connect.Response[v1.EndSeasonResponse], error) {
+	return c.endSeason.CallUnary(ctx, req)
+}
+
+// ReloadBalance calls cityio.service.v1.AdminService.ReloadBalance.
+func (c *adminServiceClient) ReloadBalance(ctx context.Context, req *connect.Request[v1.ReloadBalanceRequest]) (*connect.Response[v1.ReloadBalanceResponse], error) {
+	return c.reloadBalance.CallUnary(ctx, req)
+}
+
+// MuteUser calls cityio.service.v1.AdminService.MuteUser.
+func (c *adminServiceClient) MuteUser(ctx context.Context, req *connect.Request[v1.MuteUserRequest]) (*connect.Response[v1.MuteUserResponse], error) {
+	return c.muteUser.CallUnary(ctx, req)
+}
+
+// UnmuteUser calls cityio.service.v1.AdminService.UnmuteUser.
+func (c *adminServiceClient) UnmuteUser(ctx context.Context, req *connect.Request[v1.UnmuteUserRequest]) (*connect.Response[v1.UnmuteUserResponse], error) {
+	return c.unmuteUser.CallUnary(ctx, req)
+}
+
+// AdminServiceHandler is an implementation of the cityio.service.v1.AdminService service.
+type AdminServiceHandler interface {
+	EndSeason(context.Context, *connect.Request[v1.EndSeasonRequest]) (*connect.Response[v1.EndSeasonResponse], error)
+	ReloadBalance(context.Context, *connect.Request[v1.ReloadBalanceRequest]) (*connect.Response[v1.ReloadBalanceResponse], error)
+	MuteUser(context.Context, *connect.Request[v1.MuteUserRequest]) (*connect.Response[v1.MuteUserResponse], error)
+	UnmuteUser(context.Context, *connect.Request[v1.UnmuteUserRequest]) (*connect.Response[v1.UnmuteUserResponse], error)
+}
+
+// NewAdminServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAdminServiceHandler(svc AdminServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	adminServiceMethods := v1.File_cityio_service_v1_admin_proto.Services().ByName("AdminService").Methods()
+	adminServiceEndSeasonHandler := connect.NewUnaryHandler(
+		AdminServiceEndSeasonProcedure,
+		svc.EndSeason,
+		connect.WithSchema(adminServiceMethods.ByName("EndSeason")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceReloadBalanceHandler := connect.NewUnaryHandler(
+		AdminServiceReloadBalanceProcedure,
+		svc.ReloadBalance,
+		connect.WithSchema(adminServiceMethods.ByName("ReloadBalance")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceMuteUserHandler := connect.NewUnaryHandler(
+		AdminServiceMuteUserProcedure,
+		svc.MuteUser,
+		connect.WithSchema(adminServiceMethods.ByName("MuteUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceUnmuteUserHandler := connect.NewUnaryHandler(
+		AdminServiceUnmuteUserProcedure,
+		svc.UnmuteUser,
+		connect.WithSchema(adminServiceMethods.ByName("UnmuteUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/cityio.service.v1.AdminService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AdminServiceEndSeasonProcedure:
+			adminServiceEndSeasonHandler.ServeHTTP(w, r)
+		case AdminServiceReloadBalanceProcedure:
+			adminServiceReloadBalanceHandler.ServeHTTP(w, r)
+		case AdminServiceMuteUserProcedure:
+			adminServiceMuteUserHandler.ServeHTTP(w, r)
+		case AdminServiceUnmuteUserProcedure:
+			adminServiceUnmuteUserHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAdminServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAdminServiceHandler struct{}
+
+func (UnimplementedAdminServiceHandler) EndSeason(context.Context, *connect.Request[v1.EndSeasonRequest]) (*connect.Response[v1.EndSeasonResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cityio.service.v1.AdminService.EndSeason is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ReloadBalance(context.Context, *connect.Request[v1.ReloadBalanceRequest]) (*connect.Response[v1.ReloadBalanceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cityio.service.v1.AdminService.ReloadBalance is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) MuteUser(context.Context, *connect.Request[v1.MuteUserRequest]) (*connect.Response[v1.MuteUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cityio.service.v1.AdminService.MuteUser is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) UnmuteUser(context.Context, *connect.Request[v1.UnmuteUserRequest]) (*connect.Response[v1.UnmuteUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cityio.service.v1.AdminService.UnmuteUser is not implemented"))
+}
@@ -0,0 +1,328 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: cityio/service/v1/alliance.proto
+
+package servicev1connect
+
+import (
+	v1 "cityio/internal/gen/cityio/service/v1"
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// AllianceServiceName is the fully-qualified name of the AllianceService service.
+	AllianceServiceName = "cityio.service.v1.AllianceService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// AllianceServiceCreateAllianceProcedure is the fully-qualified name of the AllianceService's
+	// CreateAlliance RPC.
+	AllianceServiceCreateAllianceProcedure = "/cityio.service.v1.AllianceService/CreateAlliance"
+	// AllianceServiceGetAllianceProcedure is the fully-qualified name of the AllianceService's
+	// GetAlliance RPC.
+	AllianceServiceGetAllianceProcedure = "/cityio.service.v1.AllianceService/GetAlliance"
+	// AllianceServiceInviteToAllianceProcedure is the fully-qualified name of the AllianceService's
+	// InviteToAlliance RPC.
+	AllianceServiceInviteToAllianceProcedure = "/cityio.service.v1.AllianceService/InviteToAlliance"
+	// AllianceServiceListAllianceInvitesProcedure is the fully-qualified name of the AllianceService's
+	// ListAllianceInvites RPC.
+	AllianceServiceListAllianceInvitesProcedure = "/cityio.service.v1.AllianceService/ListAllianceInvites"
+	// AllianceServiceAcceptAllianceInviteProcedure is the fully-qualified name of the AllianceService's
+	// AcceptAllianceInvite RPC.
+	AllianceServiceAcceptAllianceInviteProcedure = "/cityio.service.v1.AllianceService/AcceptAllianceInvite"
+	// AllianceServiceLeaveAllianceProcedure is the fully-qualified name of the AllianceService's
+	// LeaveAlliance RPC.
+	AllianceServiceLeaveAllianceProcedure = "/cityio.service.v1.AllianceService/LeaveAlliance"
+	// AllianceServiceKickAllianceMemberProcedure is the fully-qualified name of the AllianceService's
+	// KickAllianceMember RPC.
+	AllianceServiceKickAllianceMemberProcedure = "/cityio.service.v1.AllianceService/KickAllianceMember"
+	// AllianceServiceSetAllianceRoleProcedure is the fully-qualified name of the AllianceService's
+	// SetAllianceRole RPC.
+	AllianceServiceSetAllianceRoleProcedure = "/cityio.service.v1.AllianceService/SetAllianceRole"
+)
+
+// AllianceServiceClient is a client for the cityio.service.v1.AllianceService service.
+type AllianceServiceClient interface {
+	// CreateAlliance founds an alliance led by the caller.
+	CreateAlliance(context.Context, *connect.Request[v1.CreateAllianceRequest]) (*connect.Response[v1.CreateAllianceResponse], error)
+	GetAlliance(context.Context, *connect.Request[v1.GetAllianceRequest]) (*connect.Response[v1.GetAllianceResponse], error)
+	InviteToAlliance(context.Context, *connect.Request[v1.InviteToAllianceRequest]) (*connect.Response[v1.InviteToAllianceResponse], error)
+	ListAllianceInvites(context.Context, *connect.Request[v1.ListAllianceInvitesRequest]) (*connect.Response[v1.ListAllianceInvitesResponse], error)
+	// AcceptAllianceInvite joins the caller to the alliance and drops their
+	// other invites.
+	AcceptAllianceInvite(context.Context, *connect.Request[v1.AcceptAllianceInviteRequest]) (*connect.Response[v1.AcceptAllianceInviteResponse], error)
+	// LeaveAlliance takes the caller out of their alliance. A leader who leaves
+	// hands over to the longest-serving officer, or else member; the last
+	// member to leave disbands the alliance.
+	LeaveAlliance(context.Context, *connect.Request[v1.LeaveAllianceRequest]) (*connect.Response[v1.LeaveAllianceResponse], error)
+	KickAllianceMember(context.Context, *connect.Request[v1.KickAllianceMemberRequest]) (*connect.Response[v1.KickAllianceMemberResponse], error)
+	// SetAllianceRole changes a member's role. Making someone leader hands the
+	// lead over and makes the caller an officer.
+	SetAllianceRole(context.Context, *connect.Request[v1.SetAllianceRoleRequest]) (*connect.Response[v1.SetAllianceRoleResponse], error)
+}
+
+// NewAllianceServiceClient constructs a client for the cityio.service.v1.AllianceService service.
+// By default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped
+// responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAllianceServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AllianceServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	allianceServiceMethods := v1.File_cityio_service_v1_alliance_proto.Services().ByName("AllianceService").Methods()
+	return &allianceServiceClient{
+		createAlliance: connect.NewClient[v1.CreateAllianceRequest, v1.CreateAllianceResponse](
+			httpClient,
+			baseURL+AllianceServiceCreateAllianceProcedure,
+			connect.WithSchema(allianceServiceMethods.ByName("CreateAlliance")),
+			connect.WithClientOptions(opts...),
+		),
+		getAlliance: connect.NewClient[v1.GetAllianceRequest, v1.GetAllianceResponse](
+			httpClient,
+			baseURL+AllianceServiceGetAllianceProcedure,
+			connect.WithSchema(allianceServiceMethods.ByName("GetAlliance")),
+			connect.WithClientOptions(opts...),
+		),
+		inviteToAlliance: connect.NewClient[v1.InviteToAllianceRequest, v1.InviteToAllianceResponse](
+			httpClient,
+			baseURL+AllianceServiceInviteToAllianceProcedure,
+			connect.WithSchema(allianceServiceMethods.ByName("InviteToAlliance")),
+			connect.WithClientOptions(opts...),
+		),
+		listAllianceInvites: connect.NewClient[v1.ListAllianceInvitesRequest, v1.ListAllianceInvitesResponse](
+			httpClient,
+			baseURL+AllianceServiceListAllianceInvitesProcedure,
+			connect.WithSchema(allianceServiceMethods.ByName("ListAllianceInvites")),
+			connect.WithClientOptions(opts...),
+		),
+		acceptAllianceInvite: connect.NewClient[v1.AcceptAllianceInviteRequest, v1.AcceptAllianceInviteResponse](
+			httpClient,
+			baseURL+AllianceServiceAcceptAllianceInviteProcedure,
+			connect.WithSchema(allianceServiceMethods.ByName("AcceptAllianceInvite")),
+			connect.WithClientOptions(opts...),
+		),
+		leaveAlliance: connect.NewClient[v1.LeaveAllianceRequest, v1.LeaveAllianceResponse](
+			httpClient,
+			baseURL+AllianceServiceLeaveAllianceProcedure,
+			connect.WithSchema(allianceServiceMethods.ByName("LeaveAlliance")),
+			connect.WithClientOptions(opts...),
+		),
+		kickAllianceMember: connect.NewClient[v1.KickAllianceMemberRequest, v1.KickAllianceMemberResponse](
+			httpClient,
+			baseURL+AllianceServiceKickAllianceMemberProcedure,
+			connect.WithSchema(allianceServiceMethods.ByName("KickAllianceMember")),
+			connect.WithClientOptions(opts...),
+		),
+		setAllianceRole: connect.NewClient[v1.SetAllianceRoleRequest, v1.SetAllianceRoleResponse](
+			httpClient,
+			baseURL+AllianceServiceSetAllianceRoleProcedure,
+			connect.WithSchema(allianceServiceMethods.ByName("SetAllianceRole")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// allianceServiceClient implements AllianceServiceClient.
+type allianceServiceClient struct {
+	createAlliance       *connect.Client[v1.CreateAllianceRequest, v1.CreateAllianceResponse]
+	getAlliance          *connect.Client[v1.GetAllianceRequest, v1.GetAllianceResponse]
+	inviteToAlliance     *connect.Client[v1.InviteToAllianceRequest, v1.InviteToAllianceResponse]
+	listAllianceInvites  *connect.Client[v1.ListAllianceInvitesRequest, v1.ListAllianceInvitesResponse]
+	acceptAllianceInvite *connect.Client[v1.AcceptAllianceInviteRequest, v1.AcceptAllianceInviteResponse]
+	leaveAlliance        *connect.Client[v1.LeaveAllianceRequest, v1.LeaveAllianceResponse]
+	kickAllianceMember   *connect.Client[v1.KickAllianceMemberRequest, v1.KickAllianceMemberResponse]
+	setAllianceRole      *connect.Client[v1.SetAllianceRoleRequest, v1.SetAllianceRoleResponse]
+}
+
+// CreateAlliance calls cityio.service.v1.AllianceService.CreateAlliance.
+func (c *allianceServiceClient) CreateAlliance(ctx context.Context, req *connect.Request[v1.CreateAllianceRequest]) (*connect.Response[v1.CreateAllianceResponse], error) {
+	return c.createAlliance.CallUnary(ctx, req)
+}
+
+// GetAlliance calls cityio.service.v1.AllianceService.GetAlliance.
+func (c *allianceServiceClient) GetAlliance(ctx context.Context, req *connect.Request[v1.GetAllianceRequest]) (*connect.Response[v1.GetAllianceResponse], error) {
+	return c.getAlliance.CallUnary(ctx, req)
+}
+
+// InviteToAlliance calls cityio.service.v1.AllianceService.InviteToAlliance.
+func (c *allianceServiceClient) InviteToAlliance(ctx context.Context, req *connect.Request[v1.InviteToAllianceRequest]) (*connect.Response[v1.InviteToAllianceResponse], error) {
+	return c.inviteToAlliance.CallUnary(ctx, req)
+}
+
+// ListAllianceInvites calls cityio.service.v1.AllianceService.ListAllianceInvites.
+func (c *allianceServiceClient) ListAllianceInvites(ctx context.Context, req *connect.Request[v1.ListAllianceInvitesRequest]) (*connect.Response[v1.ListAllianceInvitesResponse], error) {
+	return c.listAllianceInvites.CallUnary(ctx, req)
+}
+
+// AcceptAllianceInvite calls cityio.service.v1.AllianceService.AcceptAllianceInvite.
+func (c *allianceServiceClient) AcceptAllianceInvite(ctx context.Context, req *connect.Request[v1.AcceptAllianceInviteRequest]) (*connect.Response[v1.AcceptAllianceInviteResponse], error) {
+	return c.acceptAllianceInvite.CallUnary(ctx, req)
+}
+
+// LeaveAlliance calls cityio.service.v1.AllianceService.LeaveAlliance.
+func (c *allianceServiceClient) LeaveAlliance(ctx context.Context, req *connect.Request[v1.LeaveAllianceRequest]) (*connect.Response[v1.LeaveAllianceResponse], error) {
+	return c.leaveAlliance.CallUnary(ctx, req)
+}
+
+// KickAllianceMember calls cityio.service.v1.AllianceService.KickAllianceMember.
+func (c *allianceServiceClient) KickAllianceMember(ctx context.Context, req *connect.Request[v1.KickAllianceMemberRequest]) (*connect.Response[v1.KickAllianceMemberResponse], error) {
+	return c.kickAllianceMember.CallUnary(ctx, req)
+}
+
+// SetAllianceRole calls cityio.service.v1.AllianceService.SetAllianceRole.
+func (c *allianceServiceClient) SetAllianceRole(ctx context.Context, req *connect.Request[v1.SetAllianceRoleRequest]) (*connect.Response[v1.SetAllianceRoleResponse], error) {
+	return c.setAllianceRole.CallUnary(ctx, req)
+}
+
+// AllianceServiceHandler is an implementation of the cityio.service.v1.AllianceService service.
+type AllianceServiceHandler interface {
+	// CreateAlliance founds an alliance led by the caller.
+	CreateAlliance(context.Context, *connect.Request[v1.CreateAllianceRequest]) (*connect.Response[v1.CreateAllianceResponse], error)
+	GetAlliance(context.Context, *connect.Request[v1.GetAllianceRequest]) (*connect.Response[v1.GetAllianceResponse], error)
+	InviteToAlliance(context.Context, *connect.Request[v1.InviteToAllianceRequest]) (*connect.Response[v1.InviteToAllianceResponse], error)
+	ListAllianceInvites(context.Context, *connect.Request[v1.ListAllianceInvitesRequest]) (*connect.Response[v1.ListAllianceInvitesResponse], error)
+	// AcceptAllianceInvite joins the caller to the alliance and drops their
+	// other invites.
+	AcceptAllianceInvite(context.Context, *connect.Request[v1.AcceptAllianceInviteRequest]) (*connect.Response[v1.AcceptAllianceInviteResponse], error)
+	// LeaveAlliance takes the caller out of their alliance. A leader who leaves
+	// hands over to the longest-serving officer, or else member; the last
+	// member to leave disbands the alliance.
+	LeaveAlliance(context.Context, *connect.Request[v1.LeaveAllianceRequest]) (*connect.Response[v1.LeaveAllianceResponse], error)
+	KickAllianceMember(context.Context, *connect.Request[v1.KickAllianceMemberRequest]) (*connect.Response[v1.KickAllianceMemberResponse], error)
+	// SetAllianceRole changes a member's role. Making someone leader hands the
+	// lead over and makes the caller an officer.
+	SetAllianceRole(context.Context, *connect.Request[v1.SetAllianceRoleRequest]) (*connect.Response[v1.SetAllianceRoleResponse], error)
+}
+
+// NewAllianceServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAllianceServiceHandler(svc AllianceServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	allianceServiceMethods := v1.File_cityio_service_v1_alliance_proto.Services().ByName("AllianceService").Methods()
+	allianceServiceCreateAllianceHandler := connect.NewUnaryHandler(
+		AllianceServiceCreateAllianceProcedure,
+		svc.CreateAlliance,
+		connect.WithSchema(allianceServiceMethods.ByName("CreateAlliance")),
+		connect.WithHandlerOptions(opts...),
+	)
+	allianceServiceGetAllianceHandler := connect.NewUnaryHandler(
+		AllianceServiceGetAllianceProcedure,
+		svc.GetAlliance,
+		connect.WithSchema(allianceServiceMethods.ByName("GetAlliance")),
+		connect.WithHandlerOptions(opts...),
+	)
+	allianceServiceInviteToAllianceHandler := connect.NewUnaryHandler(
+		AllianceServiceInviteToAllianceProcedure,
+		svc.InviteToAlliance,
+		connect.WithSchema(allianceServiceMethods.ByName("InviteToAlliance")),
+		connect.WithHandlerOptions(opts...),
+	)
+	allianceServiceListAllianceInvitesHandler := connect.NewUnaryHandler(
+		AllianceServiceListAllianceInvitesProcedure,
+		svc.ListAllianceInvites,
+		connect.WithSchema(allianceServiceMethods.ByName("ListAllianceInvites")),
+		connect.WithHandlerOptions(opts...),
+	)
+	allianceServiceAcceptAllianceInviteHandler := connect.NewUnaryHandler(
+		AllianceServiceAcceptAllianceInviteProcedure,
+		svc.AcceptAllianceInvite,
+		connect.WithSchema(allianceServiceMethods.ByName("AcceptAllianceInvite")),
+		connect.WithHandlerOptions(opts...),
+	)
+	allianceServiceLeaveAllianceHandler := connect.NewUnaryHandler(
+		AllianceServiceLeaveAllianceProcedure,
+		svc.LeaveAlliance,
+		connect.WithSchema(allianceServiceMethods.ByName("LeaveAlliance")),
+		connect.WithHandlerOptions(opts...),
+	)
+	allianceServiceKickAllianceMemberHandler := connect.NewUnaryHandler(
+		AllianceServiceKickAllianceMemberProcedure,
+		svc.KickAllianceMember,
+		connect.WithSchema(allianceServiceMethods.ByName("KickAllianceMember")),
+		connect.WithHandlerOptions(opts...),
+	)
+	allianceServiceSetAllianceRoleHandler := connect.NewUnaryHandler(
+		AllianceServiceSetAllianceRoleProcedure,
+		svc.SetAllianceRole,
+		connect.WithSchema(allianceServiceMethods.ByName("SetAllianceRole")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/cityio.service.v1.AllianceService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AllianceServiceCreateAllianceProcedure:
+			allianceServiceCreateAllianceHandler.ServeHTTP(w, r)
+		case AllianceServiceGetAllianceProcedure:
+			allianceServiceGetAllianceHandler.ServeHTTP(w, r)
+		case AllianceServiceInviteToAllianceProcedure:
+			allianceServiceInviteToAllianceHandler.ServeHTTP(w, r)
+		case AllianceServiceListAllianceInvitesProcedure:
+			allianceServiceListAllianceInvitesHandler.ServeHTTP(w, r)
+		case AllianceServiceAcceptAllianceInviteProcedure:
+			allianceServiceAcceptAllianceInviteHandler.ServeHTTP(w, r)
+		case AllianceServiceLeaveAllianceProcedure:
+			allianceServiceLeaveAllianceHandler.ServeHTTP(w, r)
+		case AllianceServiceKickAllianceMemberProcedure:
+			allianceServiceKickAllianceMemberHandler.ServeHTTP(w, r)
+		case AllianceServiceSetAllianceRoleProcedure:
+			allianceServiceSetAllianceRoleHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAllianceServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAllianceServiceHandler struct{}
+
+func (UnimplementedAllianceServiceHandler) CreateAlliance(context.Context, *connect.Request[v1.CreateAllianceRequest]) (*connect.Response[v1.CreateAllianceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cityio.service.v1.AllianceService.CreateAlliance is not implemented"))
+}
+
+func (UnimplementedAllianceServiceHandler) GetAlliance(context.Context, *connect.Request[v1.GetAllianceRequest]) (*connect.Response[v1.GetAllianceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cityio.service.v1.AllianceService.GetAlliance is not implemented"))
+}
+
+func (UnimplementedAllianceServiceHandler) InviteToAlliance(context.Context, *connect.Request[v1.InviteToAllianceRequest]) (*connect.Response[v1.InviteToAllianceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cityio.service.v1.AllianceService.InviteToAlliance is not implemented"))
+}
+
+func (UnimplementedAllianceServiceHandler) ListAllianceInvites(context.Context, *connect.Request[v1.ListAllianceInvitesRequest]) (*connect.Response[v1.ListAllianceInvitesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cityio.service.v1.AllianceService.ListAllianceInvites is not implemented"))
+}
+
+func (UnimplementedAllianceServiceHandler) AcceptAllianceInvite(context.Context, *connect.Request[v1.AcceptAllianceInviteRequest]) (*connect.Response[v1.AcceptAllianceInviteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cityio.service.v1.AllianceService.AcceptAllianceInvite is not implemented"))
+}
+
+func (UnimplementedAllianceServiceHandler) LeaveAlliance(context.Context, *connect.Request[v1.LeaveAllianceRequest]) (*connect.Response[v1.LeaveAllianceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cityio.service.v1.AllianceService.LeaveAlliance is not implemented"))
+}
+
+func (UnimplementedAllianceServiceHandler) KickAllianceMember(context.Context, *connect.Request[v1.KickAllianceMemberRequest]) (*connect.Response[v1.KickAllianceMemberResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cityio.service.v1.AllianceService.KickAllianceMember is not implemented"))
+}
+
+func (UnimplementedAllianceServiceHandler) SetAllianceRole(context.Context, *connect.Request[v1.SetAllianceRoleRequest]) (*connect.Response[v1.SetAllianceRoleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cityio.service.v1.AllianceService.SetAllianceRole is not implemented"))
+}
@@ -0,0 +1,164 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: cityio/service/v1/army.proto
+
+package servicev1connect
+
+import (
+	v1 "cityio/internal/gen/cityio/service/v1"
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ArmyServiceName is the fully-qualified name of the ArmyService service.
+	ArmyServiceName = "cityio.service.v1.ArmyService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ArmyServiceMoveArmyProcedure is the fully-qualified name of the ArmyService's MoveArmy RPC.
+	ArmyServiceMoveArmyProcedure = "/cityio.service.v1.ArmyService/MoveArmy"
+	// ArmyServiceGetArmyProcedure is the fully-qualified name of the ArmyService's GetArmy RPC.
+	ArmyServiceGetArmyProcedure = "/cityio.service.v1.ArmyService/GetArmy"
+	// ArmyServiceListArmiesProcedure is the fully-qualified name of the ArmyService's ListArmies RPC.
+	ArmyServiceListArmiesProcedure = "/cityio.service.v1.ArmyService/ListArmies"
+)
+
+// ArmyServiceClient is a client for the cityio.service.v1.ArmyService service.
+type ArmyServiceClient interface {
+	MoveArmy(context.Context, *connect.Request[v1.MoveArmyRequest]) (*connect.Response[v1.MoveArmyResponse], error)
+	GetArmy(context.Context, *connect.Request[v1.GetArmyRequest]) (*connect.Response[v1.GetArmyResponse], error)
+	ListArmies(context.Context, *connect.Request[v1.ListArmiesRequest]) (*connect.Response[v1.ListArmiesResponse], error)
+}
+
+// NewArmyServiceClient constructs a client for the cityio.service.v1.ArmyService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewArmyServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ArmyServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	armyServiceMethods := v1.File_cityio_service_v1_army_proto.Services().ByName("ArmyService").Methods()
+	return &armyServiceClient{
+		moveArmy: connect.NewClient[v1.MoveArmyRequest, v1.MoveArmyResponse](
+			httpClient,
+			baseURL+ArmyServiceMoveArmyProcedure,
+			connect.WithSchema(armyServiceMethods.ByName("MoveArmy")),
+			connect.WithClientOptions(opts...),
+		),
+		getArmy: connect.NewClient[v1.GetArmyRequest, v1.GetArmyResponse](
+			httpClient,
+			baseURL+ArmyServiceGetArmyProcedure,
+			connect.WithSchema(armyServiceMethods.ByName("GetArmy")),
+			connect.WithClientOptions(opts...),
+		),
+		listArmies: connect.NewClient[v1.ListArmiesRequest, v1.ListArmiesResponse](
+			httpClient,
+			baseURL+ArmyServiceListArmiesProcedure,
+			connect.WithSchema(armyServiceMethods.ByName("ListArmies")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// armyServiceClient implements ArmyServiceClient.
+type armyServiceClient struct {
+	moveArmy   *connect.Client[v1.MoveArmyRequest, v1.MoveArmyResponse]
+	getArmy    *connect.Client[v1.GetArmyRequest, v1.GetArmyResponse]
+	listArmies *connect.Client[v1.ListArmiesRequest, v1.ListArmiesResponse]
+}
+
+// MoveArmy calls cityio.service.v1.ArmyService.MoveArmy.
+func (c *armyServiceClient) MoveArmy(ctx context.Context, req *connect.Request[v1.MoveArmyRequest]) (*connect.Response[v1.MoveArmyResponse], error) {
+	return c.moveArmy.CallUnary(ctx, req)
+}
+
+// GetArmy calls cityio.service.v1.ArmyService.GetArmy.
+func (c *armyServiceClient) GetArmy(ctx context.Context, req *connect.Request[v1.GetArmyRequest]) (*connect.Response[v1.GetArmyResponse], error) {
+	return c.getArmy.CallUnary(ctx, req)
+}
+
+// ListArmies calls cityio.service.v1.ArmyService.ListArmies.
+func (c *armyServiceClient) ListArmies(ctx context.Context, req *connect.Request[v1.ListArmiesRequest]) (*connect.Response[v1.ListArmiesResponse], error) {
+	return c.listArmies.CallUnary(ctx, req)
+}
+
+// ArmyServiceHandler is an implementation of the cityio.service.v1.ArmyService service.
+type ArmyServiceHandler interface {
+	MoveArmy(context.Context, *connect.Request[v1.MoveArmyRequest]) (*connect.Response[v1.MoveArmyResponse], error)
+	GetArmy(context.Context, *connect.Request[v1.GetArmyRequest]) (*connect.Response[v1.GetArmyResponse], error)
+	ListArmies(context.Context, *connect.Request[v1.ListArmiesRequest]) (*connect.Response[v1.ListArmiesResponse], error)
+}
+
+// NewArmyServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewArmyServiceHandler(svc ArmyServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	armyServiceMethods := v1.File_cityio_service_v1_army_proto.Services().ByName("ArmyService").Methods()
+	armyServiceMoveArmyHandler := connect.NewUnaryHandler(
+		ArmyServiceMoveArmyProcedure,
+		svc.MoveArmy,
+		connect.WithSchema(armyServiceMethods.ByName("MoveArmy")),
+		connect.WithHandlerOptions(opts...),
+	)
+	armyServiceGetArmyHandler := connect.NewUnaryHandler(
+		ArmyServiceGetArmyProcedure,
+		svc.GetArmy,
+		connect.WithSchema(armyServiceMethods.ByName("GetArmy")),
+		connect.WithHandlerOptions(opts...),
+	)
+	armyServiceListArmiesHandler := connect.NewUnaryHandler(
+		ArmyServiceListArmiesProcedure,
+		svc.ListArmies,
+		connect.WithSchema(armyServiceMethods.ByName("ListArmies")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/cityio.service.v1.ArmyService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ArmyServiceMoveArmyProcedure:
+			armyServiceMoveArmyHandler.ServeHTTP(w, r)
+		case ArmyServiceGetArmyProcedure:
+			armyServiceGetArmyHandler.ServeHTTP(w, r)
+		case ArmyServiceListArmiesProcedure:
+			armyServiceListArmiesHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedArmyServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedArmyServiceHandler struct{}
+
+func (UnimplementedArmyServiceHandler) MoveArmy(context.Context, *connect.Request[v1.MoveArmyRequest]) (*connect.Response[v1.MoveArmyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cityio.service.v1.ArmyService.MoveArmy is not implemented"))
+}
+
+func (UnimplementedArmyServiceHandler) GetArmy(context.Context, *connect.Request[v1.GetArmyRequest]) (*connect.Response[v1.GetArmyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cityio.service.v1.ArmyService.GetArmy is not implemented"))
+}
+
+func (UnimplementedArmyServiceHandler) ListArmies(context.Context, *connect.Request[v1.ListArmiesRequest]) (*connect.Response[v1.ListArmiesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cityio.service.v1.ArmyService.ListArmies is not implemented"))
+}
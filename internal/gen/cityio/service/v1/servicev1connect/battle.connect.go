@@ -0,0 +1,138 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: cityio/service/v1/battle.proto
+
+package servicev1connect
+
+import (
+	v1 "cityio/internal/gen/cityio/service/v1"
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// BattleServiceName is the fully-qualified name of the BattleService service.
+	BattleServiceName = "cityio.service.v1.BattleService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// BattleServiceGetBattleReportProcedure is the fully-qualified name of the BattleService's
+	// GetBattleReport RPC.
+	BattleServiceGetBattleReportProcedure = "/cityio.service.v1.BattleService/GetBattleReport"
+	// BattleServiceListBattleReportsProcedure is the fully-qualified name of the BattleService's
+	// ListBattleReports RPC.
+	BattleServiceListBattleReportsProcedure = "/cityio.service.v1.BattleService/ListBattleReports"
+)
+
+// BattleServiceClient is a client for the cityio.service.v1.BattleService service.
+type BattleServiceClient interface {
+	GetBattleReport(context.Context, *connect.Request[v1.GetBattleReportRequest]) (*connect.Response[v1.GetBattleReportResponse], error)
+	ListBattleReports(context.Context, *connect.Request[v1.ListBattleReportsRequest]) (*connect.Response[v1.ListBattleReportsResponse], error)
+}
+
+// NewBattleServiceClient constructs a client for the cityio.service.v1.BattleService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewBattleServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) BattleServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	battleServiceMethods := v1.File_cityio_service_v1_battle_proto.Services().ByName("BattleService").Methods()
+	return &battleServiceClient{
+		getBattleReport: connect.NewClient[v1.GetBattleReportRequest, v1.GetBattleReportResponse](
+			httpClient,
+			baseURL+BattleServiceGetBattleReportProcedure,
+			connect.WithSchema(battleServiceMethods.ByName("GetBattleReport")),
+			connect.WithClientOptions(opts...),
+		),
+		listBattleReports: connect.NewClient[v1.ListBattleReportsRequest, v1.ListBattleReportsResponse](
+			httpClient,
+			baseURL+BattleServiceListBattleReportsProcedure,
+			connect.WithSchema(battleServiceMethods.ByName("ListBattleReports")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// battleServiceClient implements BattleServiceClient.
+type battleServiceClient struct {
+	getBattleReport   *connect.Client[v1.GetBattleReportRequest, v1.GetBattleReportResponse]
+	listBattleReports *connect.Client[v1.ListBattleReportsRequest, v1.ListBattleReportsResponse]
+}
+
+// GetBattleReport calls cityio.service.v1.BattleService.GetBattleReport.
+func (c *battleServiceClient) GetBattleReport(ctx context.Context, req *connect.Request[v1.GetBattleReportRequest]) (*connect.Response[v1.GetBattleReportResponse], error) {
+	return c.getBattleReport.CallUnary(ctx, req)
+}
+
+// ListBattleReports calls cityio.service.v1.BattleService.ListBattleReports.
+func (c *battleServiceClient) ListBattleReports(ctx context.Context, req *connect.Request[v1.ListBattleReportsRequest]) (*connect.Response[v1.ListBattleReportsResponse], error) {
+	return c.listBattleReports.CallUnary(ctx, req)
+}
+
+// BattleServiceHandler is an implementation of the cityio.service.v1.BattleService service.
+type BattleServiceHandler interface {
+	GetBattleReport(context.Context, *connect.Request[v1.GetBattleReportRequest]) (*connect.Response[v1.GetBattleReportResponse], error)
+	ListBattleReports(context.Context, *connect.Request[v1.ListBattleReportsRequest]) (*connect.Response[v1.ListBattleReportsResponse], error)
+}
+
+// NewBattleServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewBattleServiceHandler(svc BattleServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	battleServiceMethods := v1.File_cityio_service_v1_battle_proto.Services().ByName("BattleService").Methods()
+	battleServiceGetBattleReportHandler := connect.NewUnaryHandler(
+		BattleServiceGetBattleReportProcedure,
+		svc.GetBattleReport,
+		connect.WithSchema(battleServiceMethods.ByName("GetBattleReport")),
+		connect.WithHandlerOptions(opts...),
+	)
+	battleServiceListBattleReportsHandler := connect.NewUnaryHandler(
+		BattleServiceListBattleReportsProcedure,
+		svc.ListBattleReports,
+		connect.WithSchema(battleServiceMethods.ByName("ListBattleReports")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/cityio.service.v1.BattleService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case BattleServiceGetBattleReportProcedure:
+			battleServiceGetBattleReportHandler.ServeHTTP(w, r)
+		case BattleServiceListBattleReportsProcedure:
+			battleServiceListBattleReportsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedBattleServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedBattleServiceHandler struct{}
+
+func (UnimplementedBattleServiceHandler) GetBattleReport(context.Context, *connect.Request[v1.GetBattleReportRequest]) (*connect.Response[v1.GetBattleReportResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cityio.service.v1.BattleService.GetBattleReport is not implemented"))
+}
+
+func (UnimplementedBattleServiceHandler) ListBattleReports(context.Context, *connect.Request[v1.ListBattleReportsRequest]) (*connect.Response[v1.ListBattleReportsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cityio.service.v1.BattleService.ListBattleReports is not implemented"))
+}
@@ -42,21 +42,46 @@ const (
 	// BuildingServiceUpgradeBuildingProcedure is the fully-qualified name of the BuildingService's
 	// UpgradeBuilding RPC.
 	BuildingServiceUpgradeBuildingProcedure = "/cityio.service.v1.BuildingService/UpgradeBuilding"
+	// BuildingServiceCancelConstructionProcedure is the fully-qualified name of the BuildingService's
+	// CancelConstruction RPC.
+	BuildingServiceCancelConstructionProcedure = "/cityio.service.v1.BuildingService/CancelConstruction"
+	// BuildingServiceSpeedUpConstructionProcedure is the fully-qualified name of the BuildingService's
+	// SpeedUpConstruction RPC.
+	BuildingServiceSpeedUpConstructionProcedure = "/cityio.service.v1.BuildingService/SpeedUpConstruction"
 	// BuildingServiceDeleteBuildingProcedure is the fully-qualified name of the BuildingService's
 	// DeleteBuilding RPC.
 	BuildingServiceDeleteBuildingProcedure = "/cityio.service.v1.BuildingService/DeleteBuilding"
 	// BuildingServiceListBuildingsProcedure is the fully-qualified name of the BuildingService's
 	// ListBuildings RPC.
 	BuildingServiceListBuildingsProcedure = "/cityio.service.v1.BuildingService/ListBuildings"
+	// BuildingServiceTrainTroopsProcedure is the fully-qualified name of the BuildingService's
+	// TrainTroops RPC.
+	BuildingServiceTrainTroopsProcedure = "/cityio.service.v1.BuildingService/TrainTroops"
 )
 
 // BuildingServiceClient is a client for the cityio.service.v1.BuildingService service.
 type BuildingServiceClient interface {
+	// CreateBuilding places a level-1 building inside one of the caller's
+	// cities and charges its cost. Rejections carry a detail: OutOfCityBounds
+	// (INVALID_ARGUMENT), TileOccupied (ALREADY_EXISTS) or InsufficientResources
+	// (FAILED_PRECONDITION). A city with every construction slot taken rejects
+	// it with RESOURCE_EXHAUSTED; queue the order instead.
 	CreateBuilding(context.Context, *connect.Request[v1.CreateBuildingRequest]) (*connect.Response[v1.CreateBuildingResponse], error)
 	GetBuilding(context.Context, *connect.Request[v1.GetBuildingRequest]) (*connect.Response[v1.GetBuildingResponse], error)
+	// UpgradeBuilding starts the building's next level and charges its cost. Like
+	// CreateBuilding it needs a free construction slot in the city.
 	UpgradeBuilding(context.Context, *connect.Request[v1.UpgradeBuildingRequest]) (*connect.Response[v1.UpgradeBuildingResponse], error)
+	// CancelConstruction abandons a building's active construction and
+	// refunds part of its cost, less the longer it has been running.
+	CancelConstruction(context.Context, *connect.Request[v1.CancelConstructionRequest]) (*connect.Response[v1.CancelConstructionResponse], error)
+	// SpeedUpConstruction shortens or finishes an active construction for gold,
+	// priced per GetGameConfigResponse.speed_up.
+	SpeedUpConstruction(context.Context, *connect.Request[v1.SpeedUpConstructionRequest]) (*connect.Response[v1.SpeedUpConstructionResponse], error)
 	DeleteBuilding(context.Context, *connect.Request[v1.DeleteBuildingRequest]) (*connect.Response[v1.DeleteBuildingResponse], error)
 	ListBuildings(context.Context, *connect.Request[v1.ListBuildingsRequest]) (*connect.Response[v1.ListBuildingsResponse], error)
+	// TrainTroops charges the owner gold and food up front and queues the batch.
+	// Finished troops join the city's garrison.
+	TrainTroops(context.Context, *connect.Request[v1.TrainTroopsRequest]) (*connect.Response[v1.TrainTroopsResponse], error)
 }
 
 // NewBuildingServiceClient constructs a client for the cityio.service.v1.BuildingService service.
@@ -88,6 +113,18 @@ func NewBuildingServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(buildingServiceMethods.ByName("UpgradeBuilding")),
 			connect.WithClientOptions(opts...),
 		),
+		cancelConstruction: connect.NewClient[v1.CancelConstructionRequest, v1.CancelConstructionResponse](
+			httpClient,
+			baseURL+BuildingServiceCancelConstructionProcedure,
+			connect.WithSchema(buildingServiceMethods.ByName("CancelConstruction")),
+			connect.WithClientOptions(opts...),
+		),
+		speedUpConstruction: connect.NewClient[v1.SpeedUpConstructionRequest, v1.SpeedUpConstructionResponse](
+			httpClient,
+			baseURL+BuildingServiceSpeedUpConstructionProcedure,
+			connect.WithSchema(buildingServiceMethods.ByName("SpeedUpConstruction")),
+			connect.WithClientOptions(opts...),
+		),
 		deleteBuilding: connect.NewClient[v1.DeleteBuildingRequest, v1.DeleteBuildingResponse](
 			httpClient,
 			baseURL+BuildingServiceDeleteBuildingProcedure,
@@ -100,16 +137,25 @@ func NewBuildingServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(buildingServiceMethods.ByName("ListBuildings")),
 			connect.WithClientOptions(opts...),
 		),
+		trainTroops: connect.NewClient[v1.TrainTroopsRequest, v1.TrainTroopsResponse](
+			httpClient,
+			baseURL+BuildingServiceTrainTroopsProcedure,
+			connect.WithSchema(buildingServiceMethods.ByName("TrainTroops")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // buildingServiceClient implements BuildingServiceClient.
 type buildingServiceClient struct {
-	createBuilding  *connect.Client[v1.CreateBuildingRequest, v1.CreateBuildingResponse]
-	getBuilding     *connect.Client[v1.GetBuildingRequest, v1.GetBuildingResponse]
-	upgradeBuilding *connect.Client[v1.UpgradeBuildingRequest, v1.UpgradeBuildingResponse]
-	deleteBuilding  *connect.Client[v1.DeleteBuildingRequest, v1.DeleteBuildingResponse]
-	listBuildings   *connect.Client[v1.ListBuildingsRequest, v1.ListBuildingsResponse]
+	createBuilding      *connect.Client[v1.CreateBuildingRequest, v1.CreateBuildingResponse]
+	getBuilding         *connect.Client[v1.GetBuildingRequest, v1.GetBuildingResponse]
+	upgradeBuilding     *connect.Client[v1.UpgradeBuildingRequest, v1.UpgradeBuildingResponse]
+	cancelConstruction  *connect.Client[v1.CancelConstructionRequest, v1.CancelConstructionResponse]
+	speedUpConstruction *connect.Client[v1.SpeedUpConstructionRequest, v1.SpeedUpConstructionResponse]
+	deleteBuilding      *connect.Client[v1.DeleteBuildingRequest, v1.DeleteBuildingResponse]
+	listBuildings       *connect.Client[v1.ListBuildingsRequest, v1.ListBuildingsResponse]
+	trainTroops         *connect.Client[v1.TrainTroopsRequest, v1.TrainTroopsResponse]
 }
 
 // CreateBuilding calls cityio.service.v1.BuildingService.CreateBuilding.
@@ -127,6 +173,16 @@ func (c *buildingServiceClient) UpgradeBuilding(ctx context.Context, req *connec
 	return c.upgradeBuilding.CallUnary(ctx, req)
 }
 
+// CancelConstruction calls cityio.service.v1.BuildingService.CancelConstruction.
+func (c *buildingServiceClient) CancelConstruction(ctx context.Context, req *connect.Request[v1.CancelConstructionRequest]) (*connect.Response[v1.CancelConstructionResponse], error) {
+	return c.cancelConstruction.CallUnary(ctx, req)
+}
+
+// SpeedUpConstruction calls cityio.service.v1.BuildingService.SpeedUpConstruction.
+func (c *buildingServiceClient) SpeedUpConstruction(ctx context.Context, req *connect.Request[v1.SpeedUpConstructionRequest]) (*connect.Response[v1.SpeedUpConstructionResponse], error) {
+	return c.speedUpConstruction.CallUnary(ctx, req)
+}
+
 // DeleteBuilding calls cityio.service.v1.BuildingService.DeleteBuilding.
 func (c *buildingServiceClient) DeleteBuilding(ctx context.Context, req *connect.Request[v1.DeleteBuildingRequest]) (*connect.Response[v1.DeleteBuildingResponse], error) {
 	return c.deleteBuilding.CallUnary(ctx, req)
@@ -137,13 +193,34 @@ func (c *buildingServiceClient) ListBuildings(ctx context.Context, req *connect.
 	return c.listBuildings.CallUnary(ctx, req)
 }
 
+// TrainTroops calls cityio.service.v1.BuildingService.TrainTroops.
+func (c *buildingServiceClient) TrainTroops(ctx context.Context, req *connect.Request[v1.TrainTroopsRequest]) (*connect.Response[v1.TrainTroopsResponse], error) {
+	return c.trainTroops.CallUnary(ctx, req)
+}
+
 // BuildingServiceHandler is an implementation of the cityio.service.v1.BuildingService service.
 type BuildingServiceHandler interface {
+	// CreateBuilding places a level-1 building inside one of the caller's
+	// cities and charges its cost. Rejections carry a detail: OutOfCityBounds
+	// (INVALID_ARGUMENT), TileOccupied (ALREADY_EXISTS) or InsufficientResources
+	// (FAILED_PRECONDITION). A city with every construction slot taken rejects
+	// it with RESOURCE_EXHAUSTED; queue the order instead.
 	CreateBuilding(context.Context, *connect.Request[v1.CreateBuildingRequest]) (*connect.Response[v1.CreateBuildingResponse], error)
 	GetBuilding(context.Context, *connect.Request[v1.GetBuildingRequest]) (*connect.Response[v1.GetBuildingResponse], error)
+	// UpgradeBuilding starts the building's next level and charges its cost. Like
+	// CreateBuilding it needs a free construction slot in the city.
 	UpgradeBuilding(context.Context, *connect.Request[v1.UpgradeBuildingRequest]) (*connect.Response[v1.UpgradeBuildingResponse], error)
+	// CancelConstruction abandons a building's active construction and
+	// refunds part of its cost, less the longer it has been running.
+	CancelConstruction(context.Context, *connect.Request[v1.CancelConstructionRequest]) (*connect.Response[v1.CancelConstructionResponse], error)
+	// SpeedUpConstruction shortens or finishes an active construction for gold,
+	// priced per GetGameConfigResponse.speed_up.
+	SpeedUpConstruction(context.Context, *connect.Request[v1.SpeedUpConstructionRequest]) (*connect.Response[v1.SpeedUpConstructionResponse], error)
 	DeleteBuilding(context.Context, *connect.Request[v1.DeleteBuildingRequest]) (*connect.Response[v1.DeleteBuildingResponse], error)
 	ListBuildings(context.Context, *connect.Request[v1.ListBuildingsRequest]) (*connect.Response[v1.ListBuildingsResponse], error)
+	// TrainTroops charges the owner gold and food up front and queues the batch.
+	// Finished troops join the city's garrison.
+	TrainTroops(context.Context, *connect.Request[v1.TrainTroopsRequest]) (*connect.Response[v1.TrainTroopsResponse], error)
 }
 
 // NewBuildingServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -171,6 +248,18 @@ func NewBuildingServiceHandler(svc BuildingServiceHandler, opts ...connect.Handl
 		connect.WithSchema(buildingServiceMethods.ByName("UpgradeBuilding")),
 		connect.WithHandlerOptions(opts...),
 	)
+	buildingServiceCancelConstructionHandler := connect.NewUnaryHandler(
+		BuildingServiceCancelConstructionProcedure,
+		svc.CancelConstruction,
+		connect.WithSchema(buildingServiceMethods.ByName("CancelConstruction")),
+		connect.WithHandlerOptions(opts...),
+	)
+	buildingServiceSpeedUpConstructionHandler := connect.NewUnaryHandler(
+		BuildingServiceSpeedUpConstructionProcedure,
+		svc.SpeedUpConstruction,
+		connect.WithSchema(buildingServiceMethods.ByName("SpeedUpConstruction")),
+		connect.WithHandlerOptions(opts...),
+	)
 	buildingServiceDeleteBuildingHandler := connect.NewUnaryHandler(
 		BuildingServiceDeleteBuildingProcedure,
 		svc.DeleteBuilding,
@@ -183,6 +272,12 @@ func NewBuildingServiceHandler(svc BuildingServiceHandler, opts ...connect.Handl
 		connect.WithSchema(buildingServiceMethods.ByName("ListBuildings")),
 		connect.WithHandlerOptions(opts...),
 	)
+	buildingServiceTrainTroopsHandler := connect.NewUnaryHandler(
+		BuildingServiceTrainTroopsProcedure,
+		svc.TrainTroops,
+		connect.WithSchema(buildingServiceMethods.ByName("TrainTroops")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/cityio.service.v1.BuildingService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case BuildingServiceCreateBuildingProcedure:
@@ -191,10 +286,16 @@ func NewBuildingServiceHandler(svc BuildingServiceHandler, opts ...connect.Handl
 			buildingServiceGetBuildingHandler.ServeHTTP(w, r)
 		case BuildingServiceUpgradeBuildingProcedure:
 			buildingServiceUpgradeBuildingHandler.ServeHTTP(w, r)
+		case BuildingServiceCancelConstructionProcedure:
+			buildingServiceCancelConstructionHandler.ServeHTTP(w, r)
+		case BuildingServiceSpeedUpConstructionProcedure:
+			buildingServiceSpeedUpConstructionHandler.ServeHTTP(w, r)
 		case BuildingServiceDeleteBuildingProcedure:
 			buildingServiceDeleteBuildingHandler.ServeHTTP(w, r)
 		case BuildingServiceListBuildingsProcedure:
 			buildingServiceListBuildingsHandler.ServeHTTP(w, r)
+		case BuildingServiceTrainTroopsProcedure:
+			buildingServiceTrainTroopsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -216,6 +317,14 @@ func (UnimplementedBuildingServiceHandler) UpgradeBuilding(context.Context, *con
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cityio.service.v1.BuildingService.UpgradeBuilding is not implemented"))
 }
 
+func (UnimplementedBuildingServiceHandler) CancelConstruction(context.Context, *connect.Request[v1.CancelConstructionRequest]) (*connect.Response[v1.CancelConstructionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cityio.service.v1.BuildingService.CancelConstruction is not implemented"))
+}
+
+func (UnimplementedBuildingServiceHandler) SpeedUpConstruction(context.Context, *connect.Request[v1.SpeedUpConstructionRequest]) (*connect.Response[v1.SpeedUpConstructionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cityio.service.v1.BuildingService.SpeedUpConstruction is not implemented"))
+}
+
 func (UnimplementedBuildingServiceHandler) DeleteBuilding(context.Context, *connect.Request[v1.DeleteBuildingRequest]) (*connect.Response[v1.DeleteBuildingResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cityio.service.v1.BuildingService.DeleteBuilding is not implemented"))
 }
@@ -223,3 +332,7 @@ func (UnimplementedBuildingServiceHandler) DeleteBuilding(context.Context, *conn
 func (UnimplementedBuildingServiceHandler) ListBuildings(context.Context, *connect.Request[v1.ListBuildingsRequest]) (*connect.Response[v1.ListBuildingsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cityio.service.v1.BuildingService.ListBuildings is not implemented"))
 }
+
+func (UnimplementedBuildingServiceHandler) TrainTroops(context.Context, *connect.Request[v1.TrainTroopsRequest]) (*connect.Response[v1.TrainTroopsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cityio.service.v1.BuildingService.TrainTroops is not implemented"))
+}
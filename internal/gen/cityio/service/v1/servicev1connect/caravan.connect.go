@@ -0,0 +1,167 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: cityio/service/v1/caravan.proto
+
+package servicev1connect
+
+import (
+	v1 "cityio/internal/gen/cityio/service/v1"
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// CaravanServiceName is the fully-qualified name of the CaravanService service.
+	CaravanServiceName = "cityio.service.v1.CaravanService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// CaravanServiceSendCaravanProcedure is the fully-qualified name of the CaravanService's
+	// SendCaravan RPC.
+	CaravanServiceSendCaravanProcedure = "/cityio.service.v1.CaravanService/SendCaravan"
+	// CaravanServiceGetCaravanProcedure is the fully-qualified name of the CaravanService's GetCaravan
+	// RPC.
+	CaravanServiceGetCaravanProcedure = "/cityio.service.v1.CaravanService/GetCaravan"
+	// CaravanServiceListCaravansProcedure is the fully-qualified name of the CaravanService's
+	// ListCaravans RPC.
+	CaravanServiceListCaravansProcedure = "/cityio.service.v1.CaravanService/ListCaravans"
+)
+
+// CaravanServiceClient is a client for the cityio.service.v1.CaravanService service.
+type CaravanServiceClient interface {
+	SendCaravan(context.Context, *connect.Request[v1.SendCaravanRequest]) (*connect.Response[v1.SendCaravanResponse], error)
+	GetCaravan(context.Context, *connect.Request[v1.GetCaravanRequest]) (*connect.Response[v1.GetCaravanResponse], error)
+	ListCaravans(context.Context, *connect.Request[v1.ListCaravansRequest]) (*connect.Response[v1.ListCaravansResponse], error)
+}
+
+// NewCaravanServiceClient constructs a client for the cityio.service.v1.CaravanService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewCaravanServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) CaravanServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	caravanServiceMethods := v1.File_cityio_service_v1_caravan_proto.Services().ByName("CaravanService").Methods()
+	return &caravanServiceClient{
+		sendCaravan: connect.NewClient[v1.SendCaravanRequest, v1.SendCaravanResponse](
+			httpClient,
+			baseURL+CaravanServiceSendCaravanProcedure,
+			connect.WithSchema(caravanServiceMethods.ByName("SendCaravan")),
+			connect.WithClientOptions(opts...),
+		),
+		getCaravan: connect.NewClient[v1.GetCaravanRequest, v1.GetCaravanResponse](
+			httpClient,
+			baseURL+CaravanServiceGetCaravanProcedure,
+			connect.WithSchema(caravanServiceMethods.ByName("GetCaravan")),
+			connect.WithClientOptions(opts...),
+		),
+		listCaravans: connect.NewClient[v1.ListCaravansRequest, v1.ListCaravansResponse](
+			httpClient,
+			baseURL+CaravanServiceListCaravansProcedure,
+			connect.WithSchema(caravanServiceMethods.ByName("ListCaravans")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// caravanServiceClient implements CaravanServiceClient.
+type caravanServiceClient struct {
+	sendCaravan  *connect.Client[v1.SendCaravanRequest, v1.SendCaravanResponse]
+	getCaravan   *connect.Client[v1.GetCaravanRequest, v1.GetCaravanResponse]
+	listCaravans *connect.Client[v1.ListCaravansRequest, v1.ListCaravansResponse]
+}
+
+// SendCaravan calls cityio.service.v1.CaravanService.SendCaravan.
+func (c *caravanServiceClient) SendCaravan(ctx context.Context, req *connect.Request[v1.SendCaravanRequest]) (*connect.Response[v1.SendCaravanResponse], error) {
+	return c.sendCaravan.CallUnary(ctx, req)
+}
+
+// GetCaravan calls cityio.service.v1.CaravanService.GetCaravan.
+func (c *caravanServiceClient) GetCaravan(ctx context.Context, req *connect.Request[v1.GetCaravanRequest]) (*connect.Response[v1.GetCaravanResponse], error) {
+	return c.getCaravan.CallUnary(ctx, req)
+}
+
+// ListCaravans calls cityio.service.v1.CaravanService.ListCaravans.
+func (c *caravanServiceClient) ListCaravans(ctx context.Context, req *connect.Request[v1.ListCaravansRequest]) (*connect.Response[v1.ListCaravansResponse], error) {
+	return c.listCaravans.CallUnary(ctx, req)
+}
+
+// CaravanServiceHandler is an implementation of the cityio.service.v1.CaravanService service.
+type CaravanServiceHandler interface {
+	SendCaravan(context.Context, *connect.Request[v1.SendCaravanRequest]) (*connect.Response[v1.SendCaravanResponse], error)
+	GetCaravan(context.Context, *connect.Request[v1.GetCaravanRequest]) (*connect.Response[v1.GetCaravanResponse], error)
+	ListCaravans(context.Context, *connect.Request[v1.ListCaravansRequest]) (*connect.Response[v1.ListCaravansResponse], error)
+}
+
+// NewCaravanServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewCaravanServiceHandler(svc CaravanServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	caravanServiceMethods := v1.File_cityio_service_v1_caravan_proto.Services().ByName("CaravanService").Methods()
+	caravanServiceSendCaravanHandler := connect.NewUnaryHandler(
+		CaravanServiceSendCaravanProcedure,
+		svc.SendCaravan,
+		connect.WithSchema(caravanServiceMethods.ByName("SendCaravan")),
+		connect.WithHandlerOptions(opts...),
+	)
+	caravanServiceGetCaravanHandler := connect.NewUnaryHandler(
+		CaravanServiceGetCaravanProcedure,
+		svc.GetCaravan,
+		connect.WithSchema(caravanServiceMethods.ByName("GetCaravan")),
+		connect.WithHandlerOptions(opts...),
+	)
+	caravanServiceListCaravansHandler := connect.NewUnaryHandler(
+		CaravanServiceListCaravansProcedure,
+		svc.ListCaravans,
+		connect.WithSchema(caravanServiceMethods.ByName("ListCaravans")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/cityio.service.v1.CaravanService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case CaravanServiceSendCaravanProcedure:
+			caravanServiceSendCaravanHandler.ServeHTTP(w, r)
+		case CaravanServiceGetCaravanProcedure:
+			caravanServiceGetCaravanHandler.ServeHTTP(w, r)
+		case CaravanServiceListCaravansProcedure:
+			caravanServiceListCaravansHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedCaravanServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedCaravanServiceHandler struct{}
+
+func (UnimplementedCaravanServiceHandler) SendCaravan(context.Context, *connect.Request[v1.SendCaravanRequest]) (*connect.Response[v1.SendCaravanResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cityio.service.v1.CaravanService.SendCaravan is not implemented"))
+}
+
+func (UnimplementedCaravanServiceHandler) GetCaravan(context.Context, *connect.Request[v1.GetCaravanRequest]) (*connect.Response[v1.GetCaravanResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cityio.service.v1.CaravanService.GetCaravan is not implemented"))
+}
+
+func (UnimplementedCaravanServiceHandler) ListCaravans(context.Context, *connect.Request[v1.ListCaravansRequest]) (*connect.Response[v1.ListCaravansResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cityio.service.v1.CaravanService.ListCaravans is not implemented"))
+}
@@ -0,0 +1,171 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: cityio/service/v1/chat.proto
+
+package servicev1connect
+
+import (
+	v1 "cityio/internal/gen/cityio/service/v1"
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ChatServiceName is the fully-qualified name of the ChatService service.
+	ChatServiceName = "cityio.service.v1.ChatService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ChatServiceSendMessageProcedure is the fully-qualified name of the ChatService's SendMessage RPC.
+	ChatServiceSendMessageProcedure = "/cityio.service.v1.ChatService/SendMessage"
+	// ChatServiceSubscribeProcedure is the fully-qualified name of the ChatService's Subscribe RPC.
+	ChatServiceSubscribeProcedure = "/cityio.service.v1.ChatService/Subscribe"
+	// ChatServiceListMessagesProcedure is the fully-qualified name of the ChatService's ListMessages
+	// RPC.
+	ChatServiceListMessagesProcedure = "/cityio.service.v1.ChatService/ListMessages"
+)
+
+// ChatServiceClient is a client for the cityio.service.v1.ChatService service.
+type ChatServiceClient interface {
+	// SendMessage posts a message and delivers it to the channel's
+	// subscribers. A muted player is refused with PERMISSION_DENIED and one
+	// sending too fast with RESOURCE_EXHAUSTED.
+	SendMessage(context.Context, *connect.Request[v1.SendMessageRequest]) (*connect.Response[v1.SendMessageResponse], error)
+	Subscribe(context.Context, *connect.Request[v1.SubscribeRequest]) (*connect.ServerStreamForClient[v1.SubscribeResponse], error)
+	ListMessages(context.Context, *connect.Request[v1.ListMessagesRequest]) (*connect.Response[v1.ListMessagesResponse], error)
+}
+
+// NewChatServiceClient constructs a client for the cityio.service.v1.ChatService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewChatServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ChatServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	chatServiceMethods := v1.File_cityio_service_v1_chat_proto.Services().ByName("ChatService").Methods()
+	return &chatServiceClient{
+		sendMessage: connect.NewClient[v1.SendMessageRequest, v1.SendMessageResponse](
+			httpClient,
+			baseURL+ChatServiceSendMessageProcedure,
+			connect.WithSchema(chatServiceMethods.ByName("SendMessage")),
+			connect.WithClientOptions(opts...),
+		),
+		subscribe: connect.NewClient[v1.SubscribeRequest, v1.SubscribeResponse](
+			httpClient,
+			baseURL+ChatServiceSubscribeProcedure,
+			connect.WithSchema(chatServiceMethods.ByName("Subscribe")),
+			connect.WithClientOptions(opts...),
+		),
+		listMessages: connect.NewClient[v1.ListMessagesRequest, v1.ListMessagesResponse](
+			httpClient,
+			baseURL+ChatServiceListMessagesProcedure,
+			connect.WithSchema(chatServiceMethods.ByName("ListMessages")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// chatServiceClient implements ChatServiceClient.
+type chatServiceClient struct {
+	sendMessage  *connect.Client[v1.SendMessageRequest, v1.SendMessageResponse]
+	subscribe    *connect.Client[v1.SubscribeRequest, v1.SubscribeResponse]
+	listMessages *connect.Client[v1.ListMessagesRequest, v1.ListMessagesResponse]
+}
+
+// SendMessage calls cityio.service.v1.ChatService.SendMessage.
+func (c *chatServiceClient) SendMessage(ctx context.Context, req *connect.Request[v1.SendMessageRequest]) (*connect.Response[v1.SendMessageResponse], error) {
+	return c.sendMessage.CallUnary(ctx, req)
+}
+
+// Subscribe calls cityio.service.v1.ChatService.Subscribe.
+func (c *chatServiceClient) Subscribe(ctx context.Context, req *connect.Request[v1.SubscribeRequest]) (*connect.ServerStreamForClient[v1.SubscribeResponse], error) {
+	return c.subscribe.CallServerStream(ctx, req)
+}
+
+// ListMessages calls cityio.service.v1.ChatService.ListMessages.
+func (c *chatServiceClient) ListMessages(ctx context.Context, req *connect.Request[v1.ListMessagesRequest]) (*connect.Response[v1.ListMessagesResponse], error) {
+	return c.listMessages.CallUnary(ctx, req)
+}
+
+// ChatServiceHandler is an implementation of the cityio.service.v1.ChatService service.
+type ChatServiceHandler interface {
+	// SendMessage posts a message and delivers it to the channel's
+	// subscribers. A muted player is refused with PERMISSION_DENIED and one
+	// sending too fast with RESOURCE_EXHAUSTED.
+	SendMessage(context.Context, *connect.Request[v1.SendMessageRequest]) (*connect.Response[v1.SendMessageResponse], error)
+	Subscribe(context.Context, *connect.Request[v1.SubscribeRequest], *connect.ServerStream[v1.SubscribeResponse]) error
+	ListMessages(context.Context, *connect.Request[v1.ListMessagesRequest]) (*connect.Response[v1.ListMessagesResponse], error)
+}
+
+// NewChatServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewChatServiceHandler(svc ChatServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	chatServiceMethods := v1.File_cityio_service_v1_chat_proto.Services().ByName("ChatService").Methods()
+	chatServiceSendMessageHandler := connect.NewUnaryHandler(
+		ChatServiceSendMessageProcedure,
+		svc.SendMessage,
+		connect.WithSchema(chatServiceMethods.ByName("SendMessage")),
+		connect.WithHandlerOptions(opts...),
+	)
+	chatServiceSubscribeHandler := connect.NewServerStreamHandler(
+		ChatServiceSubscribeProcedure,
+		svc.Subscribe,
+		connect.WithSchema(chatServiceMethods.ByName("Subscribe")),
+		connect.WithHandlerOptions(opts...),
+	)
+	chatServiceListMessagesHandler := connect.NewUnaryHandler(
+		ChatServiceListMessagesProcedure,
+		svc.ListMessages,
+		connect.WithSchema(chatServiceMethods.ByName("ListMessages")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/cityio.service.v1.ChatService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ChatServiceSendMessageProcedure:
+			chatServiceSendMessageHandler.ServeHTTP(w, r)
+		case ChatServiceSubscribeProcedure:
+			chatServiceSubscribeHandler.ServeHTTP(w, r)
+		case ChatServiceListMessagesProcedure:
+			chatServiceListMessagesHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedChatServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedChatServiceHandler struct{}
+
+func (UnimplementedChatServiceHandler) SendMessage(context.Context, *connect.Request[v1.SendMessageRequest]) (*connect.Response[v1.SendMessageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cityio.service.v1.ChatService.SendMessage is not implemented"))
+}
+
+func (UnimplementedChatServiceHandler) Subscribe(context.Context, *connect.Request[v1.SubscribeRequest], *connect.ServerStream[v1.SubscribeResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("cityio.service.v1.ChatService.Subscribe is not implemented"))
+}
+
+func (UnimplementedChatServiceHandler) ListMessages(context.Context, *connect.Request[v1.ListMessagesRequest]) (*connect.Response[v1.ListMessagesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cityio.service.v1.ChatService.ListMessages is not implemented"))
+}
@@ -39,6 +39,20 @@ const (
 	CityServiceCreateCityProcedure = "/cityio.service.v1.CityService/CreateCity"
 	// CityServiceListCitiesProcedure is the fully-qualified name of the CityService's ListCities RPC.
 	CityServiceListCitiesProcedure = "/cityio.service.v1.CityService/ListCities"
+	// CityServiceSetImportPriorityProcedure is the fully-qualified name of the CityService's
+	// SetImportPriority RPC.
+	CityServiceSetImportPriorityProcedure = "/cityio.service.v1.CityService/SetImportPriority"
+	// CityServiceSetTaxRateProcedure is the fully-qualified name of the CityService's SetTaxRate RPC.
+	CityServiceSetTaxRateProcedure = "/cityio.service.v1.CityService/SetTaxRate"
+	// CityServiceEnqueueConstructionProcedure is the fully-qualified name of the CityService's
+	// EnqueueConstruction RPC.
+	CityServiceEnqueueConstructionProcedure = "/cityio.service.v1.CityService/EnqueueConstruction"
+	// CityServiceReorderConstructionQueueProcedure is the fully-qualified name of the CityService's
+	// ReorderConstructionQueue RPC.
+	CityServiceReorderConstructionQueueProcedure = "/cityio.service.v1.CityService/ReorderConstructionQueue"
+	// CityServiceCancelConstructionOrderProcedure is the fully-qualified name of the CityService's
+	// CancelConstructionOrder RPC.
+	CityServiceCancelConstructionOrderProcedure = "/cityio.service.v1.CityService/CancelConstructionOrder"
 )
 
 // CityServiceClient is a client for the cityio.service.v1.CityService service.
@@ -46,6 +60,19 @@ type CityServiceClient interface {
 	GetCity(context.Context, *connect.Request[v1.GetCityRequest]) (*connect.Response[v1.GetCityResponse], error)
 	CreateCity(context.Context, *connect.Request[v1.CreateCityRequest]) (*connect.Response[v1.CreateCityResponse], error)
 	ListCities(context.Context, *connect.Request[v1.ListCitiesRequest]) (*connect.Response[v1.ListCitiesResponse], error)
+	// SetImportPriority orders the city's claim on the owner's food pool under
+	// the priority allocation policy.
+	SetImportPriority(context.Context, *connect.Request[v1.SetImportPriorityRequest]) (*connect.Response[v1.SetImportPriorityResponse], error)
+	// SetTaxRate sets the share of the city's population turned into gold each
+	// tick. Higher rates slow population growth.
+	SetTaxRate(context.Context, *connect.Request[v1.SetTaxRateRequest]) (*connect.Response[v1.SetTaxRateResponse], error)
+	// EnqueueConstruction adds a new building or an upgrade to the city's build
+	// queue. Orders start automatically as construction slots free up.
+	EnqueueConstruction(context.Context, *connect.Request[v1.EnqueueConstructionRequest]) (*connect.Response[v1.EnqueueConstructionResponse], error)
+	ReorderConstructionQueue(context.Context, *connect.Request[v1.ReorderConstructionQueueRequest]) (*connect.Response[v1.ReorderConstructionQueueResponse], error)
+	// CancelConstructionOrder drops a waiting order, refunding anything paid
+	// for it.
+	CancelConstructionOrder(context.Context, *connect.Request[v1.CancelConstructionOrderRequest]) (*connect.Response[v1.CancelConstructionOrderResponse], error)
 }
 
 // NewCityServiceClient constructs a client for the cityio.service.v1.CityService service. By
@@ -77,14 +104,49 @@ func NewCityServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(cityServiceMethods.ByName("ListCities")),
 			connect.WithClientOptions(opts...),
 		),
+		setImportPriority: connect.NewClient[v1.SetImportPriorityRequest, v1.SetImportPriorityResponse](
+			httpClient,
+			baseURL+CityServiceSetImportPriorityProcedure,
+			connect.WithSchema(cityServiceMethods.ByName("SetImportPriority")),
+			connect.WithClientOptions(opts...),
+		),
+		setTaxRate: connect.NewClient[v1.SetTaxRateRequest, v1.SetTaxRateResponse](
+			httpClient,
+			baseURL+CityServiceSetTaxRateProcedure,
+			connect.WithSchema(cityServiceMethods.ByName("SetTaxRate")),
+			connect.WithClientOptions(opts...),
+		),
+		enqueueConstruction: connect.NewClient[v1.EnqueueConstructionRequest, v1.EnqueueConstructionResponse](
+			httpClient,
+			baseURL+CityServiceEnqueueConstructionProcedure,
+			connect.WithSchema(cityServiceMethods.ByName("EnqueueConstruction")),
+			connect.WithClientOptions(opts...),
+		),
+		reorderConstructionQueue: connect.NewClient[v1.ReorderConstructionQueueRequest, v1.ReorderConstructionQueueResponse](
+			httpClient,
+			baseURL+CityServiceReorderConstructionQueueProcedure,
+			connect.WithSchema(cityServiceMethods.ByName("ReorderConstructionQueue")),
+			connect.WithClientOptions(opts...),
+		),
+		cancelConstructionOrder: connect.NewClient[v1.CancelConstructionOrderRequest, v1.CancelConstructionOrderResponse](
+			httpClient,
+			baseURL+CityServiceCancelConstructionOrderProcedure,
+			connect.WithSchema(cityServiceMethods.ByName("CancelConstructionOrder")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // cityServiceClient implements CityServiceClient.
 type cityServiceClient struct {
-	getCity    *connect.Client[v1.GetCityRequest, v1.GetCityResponse]
-	createCity *connect.Client[v1.CreateCityRequest, v1.CreateCityResponse]
-	listCities *connect.Client[v1.ListCitiesRequest, v1.ListCitiesResponse]
+	getCity                  *connect.Client[v1.GetCityRequest, v1.GetCityResponse]
+	createCity               *connect.Client[v1.CreateCityRequest, v1.CreateCityResponse]
+	listCities               *connect.Client[v1.ListCitiesRequest, v1.ListCitiesResponse]
+	setImportPriority        *connect.Client[v1.SetImportPriorityRequest, v1.SetImportPriorityResponse]
+	setTaxRate               *connect.Client[v1.SetTaxRateRequest, v1.SetTaxRateResponse]
+	enqueueConstruction      *connect.Client[v1.EnqueueConstructionRequest, v1.EnqueueConstructionResponse]
+	reorderConstructionQueue *connect.Client[v1.ReorderConstructionQueueRequest, v1.ReorderConstructionQueueResponse]
+	cancelConstructionOrder  *connect.Client[v1.CancelConstructionOrderRequest, v1.CancelConstructionOrderResponse]
 }
 
 // GetCity calls cityio.service.v1.CityService.GetCity.
@@ -102,11 +164,49 @@ func (c *cityServiceClient) ListCities(ctx context.Context, req *connect.Request
 	return c.listCities.CallUnary(ctx, req)
 }
 
+// SetImportPriority calls cityio.service.v1.CityService.SetImportPriority.
+func (c *cityServiceClient) SetImportPriority(ctx context.Context, req *connect.Request[v1.SetImportPriorityRequest]) (*connect.Response[v1.SetImportPriorityResponse], error) {
+	return c.setImportPriority.CallUnary(ctx, req)
+}
+
+// SetTaxRate calls cityio.service.v1.CityService.SetTaxRate.
+func (c *cityServiceClient) SetTaxRate(ctx context.Context, req *connect.Request[v1.SetTaxRateRequest]) (*connect.Response[v1.SetTaxRateResponse], error) {
+	return c.setTaxRate.CallUnary(ctx, req)
+}
+
+// EnqueueConstruction calls cityio.service.v1.CityService.EnqueueConstruction.
+func (c *cityServiceClient) EnqueueConstruction(ctx context.Context, req *connect.Request[v1.EnqueueConstructionRequest]) (*connect.Response[v1.EnqueueConstructionResponse], error) {
+	return c.enqueueConstruction.CallUnary(ctx, req)
+}
+
+// ReorderConstructionQueue calls cityio.service.v1.CityService.ReorderConstructionQueue.
+func (c *cityServiceClient) ReorderConstructionQueue(ctx context.Context, req *connect.Request[v1.ReorderConstructionQueueRequest]) (*connect.Response[v1.ReorderConstructionQueueResponse], error) {
+	return c.reorderConstructionQueue.CallUnary(ctx, req)
+}
+
+// CancelConstructionOrder calls cityio.service.v1.CityService.CancelConstructionOrder.
+func (c *cityServiceClient) CancelConstructionOrder(ctx context.Context, req *connect.Request[v1.CancelConstructionOrderRequest]) (*connect.Response[v1.CancelConstructionOrderResponse], error) {
+	return c.cancelConstructionOrder.CallUnary(ctx, req)
+}
+
 // CityServiceHandler is an implementation of the cityio.service.v1.CityService service.
 type CityServiceHandler interface {
 	GetCity(context.Context, *connect.Request[v1.GetCityRequest]) (*connect.Response[v1.GetCityResponse], error)
 	CreateCity(context.Context, *connect.Request[v1.CreateCityRequest]) (*connect.Response[v1.CreateCityResponse], error)
 	ListCities(context.Context, *connect.Request[v1.ListCitiesRequest]) (*connect.Response[v1.ListCitiesResponse], error)
+	// SetImportPriority orders the city's claim on the owner's food pool under
+	// the priority allocation policy.
+	SetImportPriority(context.Context, *connect.Request[v1.SetImportPriorityRequest]) (*connect.Response[v1.SetImportPriorityResponse], error)
+	// SetTaxRate sets the share of the city's population turned into gold each
+	// tick. Higher rates slow population growth.
+	SetTaxRate(context.Context, *connect.Request[v1.SetTaxRateRequest]) (*connect.Response[v1.SetTaxRateResponse], error)
+	// EnqueueConstruction adds a new building or an upgrade to the city's build
+	// queue. Orders start automatically as construction slots free up.
+	EnqueueConstruction(context.Context, *connect.Request[v1.EnqueueConstructionRequest]) (*connect.Response[v1.EnqueueConstructionResponse], error)
+	ReorderConstructionQueue(context.Context, *connect.Request[v1.ReorderConstructionQueueRequest]) (*connect.Response[v1.ReorderConstructionQueueResponse], error)
+	// CancelConstructionOrder drops a waiting order, refunding anything paid
+	// for it.
+	CancelConstructionOrder(context.Context, *connect.Request[v1.CancelConstructionOrderRequest]) (*connect.Response[v1.CancelConstructionOrderResponse], error)
 }
 
 // NewCityServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -134,6 +234,36 @@ func NewCityServiceHandler(svc CityServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(cityServiceMethods.ByName("ListCities")),
 		connect.WithHandlerOptions(opts...),
 	)
+	cityServiceSetImportPriorityHandler := connect.NewUnaryHandler(
+		CityServiceSetImportPriorityProcedure,
+		svc.SetImportPriority,
+		connect.WithSchema(cityServiceMethods.ByName("SetImportPriority")),
+		connect.WithHandlerOptions(opts...),
+	)
+	cityServiceSetTaxRateHandler := connect.NewUnaryHandler(
+		CityServiceSetTaxRateProcedure,
+		svc.SetTaxRate,
+		connect.WithSchema(cityServiceMethods.ByName("SetTaxRate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	cityServiceEnqueueConstructionHandler := connect.NewUnaryHandler(
+		CityServiceEnqueueConstructionProcedure,
+		svc.EnqueueConstruction,
+		connect.WithSchema(cityServiceMethods.ByName("EnqueueConstruction")),
+		connect.WithHandlerOptions(opts...),
+	)
+	cityServiceReorderConstructionQueueHandler := connect.NewUnaryHandler(
+		CityServiceReorderConstructionQueueProcedure,
+		svc.ReorderConstructionQueue,
+		connect.WithSchema(cityServiceMethods.ByName("ReorderConstructionQueue")),
+		connect.WithHandlerOptions(opts...),
+	)
+	cityServiceCancelConstructionOrderHandler := connect.NewUnaryHandler(
+		CityServiceCancelConstructionOrderProcedure,
+		svc.CancelConstructionOrder,
+		connect.WithSchema(cityServiceMethods.ByName("CancelConstructionOrder")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/cityio.service.v1.CityService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case CityServiceGetCityProcedure:
@@ -142,6 +272,16 @@ func NewCityServiceHandler(svc CityServiceHandler, opts ...connect.HandlerOption
 			cityServiceCreateCityHandler.ServeHTTP(w, r)
 		case CityServiceListCitiesProcedure:
 			cityServiceListCitiesHandler.ServeHTTP(w, r)
+		case CityServiceSetImportPriorityProcedure:
+			cityServiceSetImportPriorityHandler.ServeHTTP(w, r)
+		case CityServiceSetTaxRateProcedure:
+			cityServiceSetTaxRateHandler.ServeHTTP(w, r)
+		case CityServiceEnqueueConstructionProcedure:
+			cityServiceEnqueueConstructionHandler.ServeHTTP(w, r)
+		case CityServiceReorderConstructionQueueProcedure:
+			cityServiceReorderConstructionQueueHandler.ServeHTTP(w, r)
+		case CityServiceCancelConstructionOrderProcedure:
+			cityServiceCancelConstructionOrderHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -162,3 +302,23 @@ func (UnimplementedCityServiceHandler) CreateCity(context.Context, *connect.Requ
 func (UnimplementedCityServiceHandler) ListCities(context.Context, *connect.Request[v1.ListCitiesRequest]) (*connect.Response[v1.ListCitiesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cityio.service.v1.CityService.ListCities is not implemented"))
 }
+
+func (UnimplementedCityServiceHandler) SetImportPriority(context.Context, *connect.Request[v1.SetImportPriorityRequest]) (*connect.Response[v1.SetImportPriorityResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cityio.service.v1.CityService.SetImportPriority is not implemented"))
+}
+
+func (UnimplementedCityServiceHandler) SetTaxRate(context.Context, *connect.Request[v1.SetTaxRateRequest]) (*connect.Response[v1.SetTaxRateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cityio.service.v1.CityService.SetTaxRate is not implemented"))
+}
+
+func (UnimplementedCityServiceHandler) EnqueueConstruction(context.Context, *connect.Request[v1.EnqueueConstructionRequest]) (*connect.Response[v1.EnqueueConstructionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cityio.service.v1.CityService.EnqueueConstruction is not implemented"))
+}
+
+func (UnimplementedCityServiceHandler) ReorderConstructionQueue(context.Context, *connect.Request[v1.ReorderConstructionQueueRequest]) (*connect.Response[v1.ReorderConstructionQueueResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cityio.service.v1.CityService.ReorderConstructionQueue is not implemented"))
+}
+
+func (UnimplementedCityServiceHandler) CancelConstructionOrder(context.Context, *connect.Request[v1.CancelConstructionOrderRequest]) (*connect.Response[v1.CancelConstructionOrderResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cityio.service.v1.CityService.CancelConstructionOrder is not implemented"))
+}
@@ -0,0 +1,305 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: cityio/service/v1/diplomacy.proto
+
+package servicev1connect
+
+import (
+	v1 "cityio/internal/gen/cityio/service/v1"
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// DiplomacyServiceName is the fully-qualified name of the DiplomacyService service.
+	DiplomacyServiceName = "cityio.service.v1.DiplomacyService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// DiplomacyServiceDeclareWarProcedure is the fully-qualified name of the DiplomacyService's
+	// DeclareWar RPC.
+	DiplomacyServiceDeclareWarProcedure = "/cityio.service.v1.DiplomacyService/DeclareWar"
+	// DiplomacyServiceProposeTreatyProcedure is the fully-qualified name of the DiplomacyService's
+	// ProposeTreaty RPC.
+	DiplomacyServiceProposeTreatyProcedure = "/cityio.service.v1.DiplomacyService/ProposeTreaty"
+	// DiplomacyServiceAcceptTreatyProcedure is the fully-qualified name of the DiplomacyService's
+	// AcceptTreaty RPC.
+	DiplomacyServiceAcceptTreatyProcedure = "/cityio.service.v1.DiplomacyService/AcceptTreaty"
+	// DiplomacyServiceDeclineTreatyProcedure is the fully-qualified name of the DiplomacyService's
+	// DeclineTreaty RPC.
+	DiplomacyServiceDeclineTreatyProcedure = "/cityio.service.v1.DiplomacyService/DeclineTreaty"
+	// DiplomacyServiceCancelTreatyProcedure is the fully-qualified name of the DiplomacyService's
+	// CancelTreaty RPC.
+	DiplomacyServiceCancelTreatyProcedure = "/cityio.service.v1.DiplomacyService/CancelTreaty"
+	// DiplomacyServiceGetRelationProcedure is the fully-qualified name of the DiplomacyService's
+	// GetRelation RPC.
+	DiplomacyServiceGetRelationProcedure = "/cityio.service.v1.DiplomacyService/GetRelation"
+	// DiplomacyServiceListRelationsProcedure is the fully-qualified name of the DiplomacyService's
+	// ListRelations RPC.
+	DiplomacyServiceListRelationsProcedure = "/cityio.service.v1.DiplomacyService/ListRelations"
+)
+
+// DiplomacyServiceClient is a client for the cityio.service.v1.DiplomacyService service.
+type DiplomacyServiceClient interface {
+	// DeclareWar starts a war with a neutral player after the configured
+	// declaration delay. Allies cannot go to war with each other, and treaties
+	// must be cancelled first.
+	DeclareWar(context.Context, *connect.Request[v1.DeclareWarRequest]) (*connect.Response[v1.DeclareWarResponse], error)
+	// ProposeTreaty puts peace or a non-aggression pact on the table. Proposing
+	// the treaty the other player already proposed accepts it.
+	ProposeTreaty(context.Context, *connect.Request[v1.ProposeTreatyRequest]) (*connect.Response[v1.ProposeTreatyResponse], error)
+	// AcceptTreaty accepts the other player's proposal. It takes effect at
+	// once, calling off a declared war that hasn't started.
+	AcceptTreaty(context.Context, *connect.Request[v1.AcceptTreatyRequest]) (*connect.Response[v1.AcceptTreatyResponse], error)
+	// DeclineTreaty withdraws the caller's proposal or turns down the other
+	// player's.
+	DeclineTreaty(context.Context, *connect.Request[v1.DeclineTreatyRequest]) (*connect.Response[v1.DeclineTreatyResponse], error)
+	// CancelTreaty ends the treaty in force. The pair falls back to neutral
+	// after the configured cancellation delay.
+	CancelTreaty(context.Context, *connect.Request[v1.CancelTreatyRequest]) (*connect.Response[v1.CancelTreatyResponse], error)
+	GetRelation(context.Context, *connect.Request[v1.GetRelationRequest]) (*connect.Response[v1.GetRelationResponse], error)
+	ListRelations(context.Context, *connect.Request[v1.ListRelationsRequest]) (*connect.Response[v1.ListRelationsResponse], error)
+}
+
+// NewDiplomacyServiceClient constructs a client for the cityio.service.v1.DiplomacyService service.
+// By default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped
+// responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewDiplomacyServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) DiplomacyServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	diplomacyServiceMethods := v1.File_cityio_service_v1_diplomacy_proto.Services().ByName("DiplomacyService").Methods()
+	return &diplomacyServiceClient{
+		declareWar: connect.NewClient[v1.DeclareWarRequest, v1.DeclareWarResponse](
+			httpClient,
+			baseURL+DiplomacyServiceDeclareWarProcedure,
+			connect.WithSchema(diplomacyServiceMethods.ByName("DeclareWar")),
+			connect.WithClientOptions(opts...),
+		),
+		proposeTreaty: connect.NewClient[v1.ProposeTreatyRequest, v1.ProposeTreatyResponse](
+			httpClient,
+			baseURL+DiplomacyServiceProposeTreatyProcedure,
+			connect.WithSchema(diplomacyServiceMethods.ByName("ProposeTreaty")),
+			connect.WithClientOptions(opts...),
+		),
+		acceptTreaty: connect.NewClient[v1.AcceptTreatyRequest, v1.AcceptTreatyResponse](
+			httpClient,
+			baseURL+DiplomacyServiceAcceptTreatyProcedure,
+			connect.WithSchema(diplomacyServiceMethods.ByName("AcceptTreaty")),
+			connect.WithClientOptions(opts...),
+		),
+		declineTreaty: connect.NewClient[v1.DeclineTreatyRequest, v1.DeclineTreatyResponse](
+			httpClient,
+			baseURL+DiplomacyServiceDeclineTreatyProcedure,
+			connect.WithSchema(diplomacyServiceMethods.ByName("DeclineTreaty")),
+			connect.WithClientOptions(opts...),
+		),
+		cancelTreaty: connect.NewClient[v1.CancelTreatyRequest, v1.CancelTreatyResponse](
+			httpClient,
+			baseURL+DiplomacyServiceCancelTreatyProcedure,
+			connect.WithSchema(diplomacyServiceMethods.ByName("CancelTreaty")),
+			connect.WithClientOptions(opts...),
+		),
+		getRelation: connect.NewClient[v1.GetRelationRequest, v1.GetRelationResponse](
+			httpClient,
+			baseURL+DiplomacyServiceGetRelationProcedure,
+			connect.WithSchema(diplomacyServiceMethods.ByName("GetRelation")),
+			connect.WithClientOptions(opts...),
+		),
+		listRelations: connect.NewClient[v1.ListRelationsRequest, v1.ListRelationsResponse](
+			httpClient,
+			baseURL+DiplomacyServiceListRelationsProcedure,
+			connect.WithSchema(diplomacyServiceMethods.ByName("ListRelations")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// diplomacyServiceClient implements DiplomacyServiceClient.
+type diplomacyServiceClient struct {
+	declareWar    *connect.Client[v1.DeclareWarRequest, v1.DeclareWarResponse]
+	proposeTreaty *connect.Client[v1.ProposeTreatyRequest, v1.ProposeTreatyResponse]
+	acceptTreaty  *connect.Client[v1.AcceptTreatyRequest, v1.AcceptTreatyResponse]
+	declineTreaty *connect.Client[v1.DeclineTreatyRequest, v1.DeclineTreatyResponse]
+	cancelTreaty  *connect.Client[v1.CancelTreatyRequest, v1.CancelTreatyResponse]
+	getRelation   *connect.Client[v1.GetRelationRequest, v1.GetRelationResponse]
+	listRelations *connect.Client[v1.ListRelationsRequest, v1.ListRelationsResponse]
+}
+
+// DeclareWar calls cityio.service.v1.DiplomacyService.DeclareWar.
+func (c *diplomacyServiceClient) DeclareWar(ctx context.Context, req *connect.Request[v1.DeclareWarRequest]) (*connect.Response[v1.DeclareWarResponse], error) {
+	return c.declareWar.CallUnary(ctx, req)
+}
+
+// ProposeTreaty calls cityio.service.v1.DiplomacyService.ProposeTreaty.
+func (c *diplomacyServiceClient) ProposeTreaty(ctx context.Context, req *connect.Request[v1.ProposeTreatyRequest]) (*connect.Response[v1.ProposeTreatyResponse], error) {
+	return c.proposeTreaty.CallUnary(ctx, req)
+}
+
+// AcceptTreaty calls cityio.service.v1.DiplomacyService.AcceptTreaty.
+func (c *diplomacyServiceClient) AcceptTreaty(ctx context.Context, req *connect.Request[v1.AcceptTreatyRequest]) (*connect.Response[v1.AcceptTreatyResponse], error) {
+	return c.acceptTreaty.CallUnary(ctx, req)
+}
+
+// DeclineTreaty calls cityio.service.v1.DiplomacyService.DeclineTreaty.
+func (c *diplomacyServiceClient) DeclineTreaty(ctx context.Context, req *connect.Request[v1.DeclineTreatyRequest]) (*connect.Response[v1.DeclineTreatyResponse], error) {
+	return c.declineTreaty.CallUnary(ctx, req)
+}
+
+// CancelTreaty calls cityio.service.v1.DiplomacyService.CancelTreaty.
+func (c *diplomacyServiceClient) CancelTreaty(ctx context.Context, req *connect.Request[v1.CancelTreatyRequest]) (*connect.Response[v1.CancelTreatyResponse], error) {
+	return c.cancelTreaty.CallUnary(ctx, req)
+}
+
+// GetRelation calls cityio.service.v1.DiplomacyService.GetRelation.
+func (c *diplomacyServiceClient) GetRelation(ctx context.Context, req *connect.Request[v1.GetRelationRequest]) (*connect.Response[v1.GetRelationResponse], error) {
+	return c.getRelation.CallUnary(ctx, req)
+}
+
+// ListRelations calls cityio.service.v1.DiplomacyService.ListRelations.
+func (c *diplomacyServiceClient) ListRelations(ctx context.Context, req *connect.Request[v1.ListRelationsRequest]) (*connect.Response[v1.ListRelationsResponse], error) {
+	return c.listRelations.CallUnary(ctx, req)
+}
+
+// DiplomacyServiceHandler is an implementation of the cityio.service.v1.DiplomacyService service.
+type DiplomacyServiceHandler interface {
+	// DeclareWar starts a war with a neutral player after the configured
+	// declaration delay. Allies cannot go to war with each other, and treaties
+	// must be cancelled first.
+	DeclareWar(context.Context, *connect.Request[v1.DeclareWarRequest]) (*connect.Response[v1.DeclareWarResponse], error)
+	// ProposeTreaty puts peace or a non-aggression pact on the table. Proposing
+	// the treaty the other player already proposed accepts it.
+	ProposeTreaty(context.Context, *connect.Request[v1.ProposeTreatyRequest]) (*connect.Response[v1.ProposeTreatyResponse], error)
+	// AcceptTreaty accepts the other player's proposal. It takes effect at
+	// once, calling off a declared war that hasn't started.
+	AcceptTreaty(context.Context, *connect.Request[v1.AcceptTreatyRequest]) (*connect.Response[v1.AcceptTreatyResponse], error)
+	// DeclineTreaty withdraws the caller's proposal or turns down the other
+	// player's.
+	DeclineTreaty(context.Context, *connect.Request[v1.DeclineTreatyRequest]) (*connect.Response[v1.DeclineTreatyResponse], error)
+	// CancelTreaty ends the treaty in force. The pair falls back to neutral
+	// after the configured cancellation delay.
+	CancelTreaty(context.Context, *connect.Request[v1.CancelTreatyRequest]) (*connect.Response[v1.CancelTreatyResponse], error)
+	GetRelation(context.Context, *connect.Request[v1.GetRelationRequest]) (*connect.Response[v1.GetRelationResponse], error)
+	ListRelations(context.Context, *connect.Request[v1.ListRelationsRequest]) (*connect.Response[v1.ListRelationsResponse], error)
+}
+
+// NewDiplomacyServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewDiplomacyServiceHandler(svc DiplomacyServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	diplomacyServiceMethods := v1.File_cityio_service_v1_diplomacy_proto.Services().ByName("DiplomacyService").Methods()
+	diplomacyServiceDeclareWarHandler := connect.NewUnaryHandler(
+		DiplomacyServiceDeclareWarProcedure,
+		svc.DeclareWar,
+		connect.WithSchema(diplomacyServiceMethods.ByName("DeclareWar")),
+		connect.WithHandlerOptions(opts...),
+	)
+	diplomacyServiceProposeTreatyHandler := connect.NewUnaryHandler(
+		DiplomacyServiceProposeTreatyProcedure,
+		svc.ProposeTreaty,
+		connect.WithSchema(diplomacyServiceMethods.ByName("ProposeTreaty")),
+		connect.WithHandlerOptions(opts...),
+	)
+	diplomacyServiceAcceptTreatyHandler := connect.NewUnaryHandler(
+		DiplomacyServiceAcceptTreatyProcedure,
+		svc.AcceptTreaty,
+		connect.WithSchema(diplomacyServiceMethods.ByName("AcceptTreaty")),
+		connect.WithHandlerOptions(opts...),
+	)
+	diplomacyServiceDeclineTreatyHandler := connect.NewUnaryHandler(
+		DiplomacyServiceDeclineTreatyProcedure,
+		svc.DeclineTreaty,
+		connect.WithSchema(diplomacyServiceMethods.ByName("DeclineTreaty")),
+		connect.WithHandlerOptions(opts...),
+	)
+	diplomacyServiceCancelTreatyHandler := connect.NewUnaryHandler(
+		DiplomacyServiceCancelTreatyProcedure,
+		svc.CancelTreaty,
+		connect.WithSchema(diplomacyServiceMethods.ByName("CancelTreaty")),
+		connect.WithHandlerOptions(opts...),
+	)
+	diplomacyServiceGetRelationHandler := connect.NewUnaryHandler(
+		DiplomacyServiceGetRelationProcedure,
+		svc.GetRelation,
+		connect.WithSchema(diplomacyServiceMethods.ByName("GetRelation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	diplomacyServiceListRelationsHandler := connect.NewUnaryHandler(
+		DiplomacyServiceListRelationsProcedure,
+		svc.ListRelations,
+		connect.WithSchema(diplomacyServiceMethods.ByName("ListRelations")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/cityio.service.v1.DiplomacyService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case DiplomacyServiceDeclareWarProcedure:
+			diplomacyServiceDeclareWarHandler.ServeHTTP(w, r)
+		case DiplomacyServiceProposeTreatyProcedure:
+			diplomacyServiceProposeTreatyHandler.ServeHTTP(w, r)
+		case DiplomacyServiceAcceptTreatyProcedure:
+			diplomacyServiceAcceptTreatyHandler.ServeHTTP(w, r)
+		case DiplomacyServiceDeclineTreatyProcedure:
+			diplomacyServiceDeclineTreatyHandler.ServeHTTP(w, r)
+		case DiplomacyServiceCancelTreatyProcedure:
+			diplomacyServiceCancelTreatyHandler.ServeHTTP(w, r)
+		case DiplomacyServiceGetRelationProcedure:
+			diplomacyServiceGetRelationHandler.ServeHTTP(w, r)
+		case DiplomacyServiceListRelationsProcedure:
+			diplomacyServiceListRelationsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedDiplomacyServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedDiplomacyServiceHandler struct{}
+
+func (UnimplementedDiplomacyServiceHandler) DeclareWar(context.Context, *connect.Request[v1.DeclareWarRequest]) (*connect.Response[v1.DeclareWarResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cityio.service.v1.DiplomacyService.DeclareWar is not implemented"))
+}
+
+func (UnimplementedDiplomacyServiceHandler) ProposeTreaty(context.Context, *connect.Request[v1.ProposeTreatyRequest]) (*connect.Response[v1.ProposeTreatyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cityio.service.v1.DiplomacyService.ProposeTreaty is not implemented"))
+}
+
+func (UnimplementedDiplomacyServiceHandler) AcceptTreaty(context.Context, *connect.Request[v1.AcceptTreatyRequest]) (*connect.Response[v1.AcceptTreatyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cityio.service.v1.DiplomacyService.AcceptTreaty is not implemented"))
+}
+
+func (UnimplementedDiplomacyServiceHandler) DeclineTreaty(context.Context, *connect.Request[v1.DeclineTreatyRequest]) (*connect.Response[v1.DeclineTreatyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cityio.service.v1.DiplomacyService.DeclineTreaty is not implemented"))
+}
+
+func (UnimplementedDiplomacyServiceHandler) CancelTreaty(context.Context, *connect.Request[v1.CancelTreatyRequest]) (*connect.Response[v1.CancelTreatyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cityio.service.v1.DiplomacyService.CancelTreaty is not implemented"))
+}
+
+func (UnimplementedDiplomacyServiceHandler) GetRelation(context.Context, *connect.Request[v1.GetRelationRequest]) (*connect.Response[v1.GetRelationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cityio.service.v1.DiplomacyService.GetRelation is not implemented"))
+}
+
+func (UnimplementedDiplomacyServiceHandler) ListRelations(context.Context, *connect.Request[v1.ListRelationsRequest]) (*connect.Response[v1.ListRelationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cityio.service.v1.DiplomacyService.ListRelations is not implemented"))
+}
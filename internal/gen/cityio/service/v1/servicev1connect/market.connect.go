@@ -0,0 +1,204 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: cityio/service/v1/market.proto
+
+package servicev1connect
+
+import (
+	v1 "cityio/internal/gen/cityio/service/v1"
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// MarketServiceName is the fully-qualified name of the MarketService service.
+	MarketServiceName = "cityio.service.v1.MarketService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// MarketServicePlaceOrderProcedure is the fully-qualified name of the MarketService's PlaceOrder
+	// RPC.
+	MarketServicePlaceOrderProcedure = "/cityio.service.v1.MarketService/PlaceOrder"
+	// MarketServiceCancelOrderProcedure is the fully-qualified name of the MarketService's CancelOrder
+	// RPC.
+	MarketServiceCancelOrderProcedure = "/cityio.service.v1.MarketService/CancelOrder"
+	// MarketServiceListOrdersProcedure is the fully-qualified name of the MarketService's ListOrders
+	// RPC.
+	MarketServiceListOrdersProcedure = "/cityio.service.v1.MarketService/ListOrders"
+	// MarketServiceStreamTickerProcedure is the fully-qualified name of the MarketService's
+	// StreamTicker RPC.
+	MarketServiceStreamTickerProcedure = "/cityio.service.v1.MarketService/StreamTicker"
+)
+
+// MarketServiceClient is a client for the cityio.service.v1.MarketService service.
+type MarketServiceClient interface {
+	// PlaceOrder matches the order against the book and rests what is left.
+	PlaceOrder(context.Context, *connect.Request[v1.PlaceOrderRequest]) (*connect.Response[v1.PlaceOrderResponse], error)
+	CancelOrder(context.Context, *connect.Request[v1.CancelOrderRequest]) (*connect.Response[v1.CancelOrderResponse], error)
+	// ListOrders returns the caller's open orders.
+	ListOrders(context.Context, *connect.Request[v1.ListOrdersRequest]) (*connect.Response[v1.ListOrdersResponse], error)
+	// StreamTicker sends the market's ticker, then an update after every
+	// change to its book.
+	StreamTicker(context.Context, *connect.Request[v1.StreamTickerRequest]) (*connect.ServerStreamForClient[v1.StreamTickerResponse], error)
+}
+
+// NewMarketServiceClient constructs a client for the cityio.service.v1.MarketService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewMarketServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) MarketServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	marketServiceMethods := v1.File_cityio_service_v1_market_proto.Services().ByName("MarketService").Methods()
+	return &marketServiceClient{
+		placeOrder: connect.NewClient[v1.PlaceOrderRequest, v1.PlaceOrderResponse](
+			httpClient,
+			baseURL+MarketServicePlaceOrderProcedure,
+			connect.WithSchema(marketServiceMethods.ByName("PlaceOrder")),
+			connect.WithClientOptions(opts...),
+		),
+		cancelOrder: connect.NewClient[v1.CancelOrderRequest, v1.CancelOrderResponse](
+			httpClient,
+			baseURL+MarketServiceCancelOrderProcedure,
+			connect.WithSchema(marketServiceMethods.ByName("CancelOrder")),
+			connect.WithClientOptions(opts...),
+		),
+		listOrders: connect.NewClient[v1.ListOrdersRequest, v1.ListOrdersResponse](
+			httpClient,
+			baseURL+MarketServiceListOrdersProcedure,
+			connect.WithSchema(marketServiceMethods.ByName("ListOrders")),
+			connect.WithClientOptions(opts...),
+		),
+		streamTicker: connect.NewClient[v1.StreamTickerRequest, v1.StreamTickerResponse](
+			httpClient,
+			baseURL+MarketServiceStreamTickerProcedure,
+			connect.WithSchema(marketServiceMethods.ByName("StreamTicker")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// marketServiceClient implements MarketServiceClient.
+type marketServiceClient struct {
+	placeOrder   *connect.Client[v1.PlaceOrderRequest, v1.PlaceOrderResponse]
+	cancelOrder  *connect.Client[v1.CancelOrderRequest, v1.CancelOrderResponse]
+	listOrders   *connect.Client[v1.ListOrdersRequest, v1.ListOrdersResponse]
+	streamTicker *connect.Client[v1.StreamTickerRequest, v1.StreamTickerResponse]
+}
+
+// PlaceOrder calls cityio.service.v1.MarketService.PlaceOrder.
+func (c *marketServiceClient) PlaceOrder(ctx context.Context, req *connect.Request[v1.PlaceOrderRequest]) (*connect.Response[v1.PlaceOrderResponse], error) {
+	return c.placeOrder.CallUnary(ctx, req)
+}
+
+// CancelOrder calls cityio.service.v1.MarketService.CancelOrder.
+func (c *marketServiceClient) CancelOrder(ctx context.Context, req *connect.Request[v1.CancelOrderRequest]) (*connect.Response[v1.CancelOrderResponse], error) {
+	return c.cancelOrder.CallUnary(ctx, req)
+}
+
+// ListOrders calls cityio.service.v1.MarketService.ListOrders.
+func (c *marketServiceClient) ListOrders(ctx context.Context, req *connect.Request[v1.ListOrdersRequest]) (*connect.Response[v1.ListOrdersResponse], error) {
+	return c.listOrders.CallUnary(ctx, req)
+}
+
+// StreamTicker calls cityio.service.v1.MarketService.StreamTicker.
+func (c *marketServiceClient) StreamTicker(ctx context.Context, req *connect.Request[v1.StreamTickerRequest]) (*connect.ServerStreamForClient[v1.StreamTickerResponse], error) {
+	return c.streamTicker.CallServerStream(ctx, req)
+}
+
+// MarketServiceHandler is an implementation of the cityio.service.v1.MarketService service.
+type MarketServiceHandler interface {
+	// PlaceOrder matches the order against the book and rests what is left.
+	PlaceOrder(context.Context, *connect.Request[v1.PlaceOrderRequest]) (*connect.Response[v1.PlaceOrderResponse], error)
+	CancelOrder(context.Context, *connect.Request[v1.CancelOrderRequest]) (*connect.Response[v1.CancelOrderResponse], error)
+	// ListOrders returns the caller's open orders.
+	ListOrders(context.Context, *connect.Request[v1.ListOrdersRequest]) (*connect.Response[v1.ListOrdersResponse], error)
+	// StreamTicker sends the market's ticker, then an update after every
+	// change to its book.
+	StreamTicker(context.Context, *connect.Request[v1.StreamTickerRequest], *connect.ServerStream[v1.StreamTickerResponse]) error
+}
+
+// NewMarketServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewMarketServiceHandler(svc MarketServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	marketServiceMethods := v1.File_cityio_service_v1_market_proto.Services().ByName("MarketService").Methods()
+	marketServicePlaceOrderHandler := connect.NewUnaryHandler(
+		MarketServicePlaceOrderProcedure,
+		svc.PlaceOrder,
+		connect.WithSchema(marketServiceMethods.ByName("PlaceOrder")),
+		connect.WithHandlerOptions(opts...),
+	)
+	marketServiceCancelOrderHandler := connect.NewUnaryHandler(
+		MarketServiceCancelOrderProcedure,
+		svc.CancelOrder,
+		connect.WithSchema(marketServiceMethods.ByName("CancelOrder")),
+		connect.WithHandlerOptions(opts...),
+	)
+	marketServiceListOrdersHandler := connect.NewUnaryHandler(
+		MarketServiceListOrdersProcedure,
+		svc.ListOrders,
+		connect.WithSchema(marketServiceMethods.ByName("ListOrders")),
+		connect.WithHandlerOptions(opts...),
+	)
+	marketServiceStreamTickerHandler := connect.NewServerStreamHandler(
+		MarketServiceStreamTickerProcedure,
+		svc.StreamTicker,
+		connect.WithSchema(marketServiceMethods.ByName("StreamTicker")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/cityio.service.v1.MarketService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case MarketServicePlaceOrderProcedure:
+			marketServicePlaceOrderHandler.ServeHTTP(w, r)
+		case MarketServiceCancelOrderProcedure:
+			marketServiceCancelOrderHandler.ServeHTTP(w, r)
+		case MarketServiceListOrdersProcedure:
+			marketServiceListOrdersHandler.ServeHTTP(w, r)
+		case MarketServiceStreamTickerProcedure:
+			marketServiceStreamTickerHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedMarketServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedMarketServiceHandler struct{}
+
+func (UnimplementedMarketServiceHandler) PlaceOrder(context.Context, *connect.Request[v1.PlaceOrderRequest]) (*connect.Response[v1.PlaceOrderResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cityio.service.v1.MarketService.PlaceOrder is not implemented"))
+}
+
+func (UnimplementedMarketServiceHandler) CancelOrder(context.Context, *connect.Request[v1.CancelOrderRequest]) (*connect.Response[v1.CancelOrderResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cityio.service.v1.MarketService.CancelOrder is not implemented"))
+}
+
+func (UnimplementedMarketServiceHandler) ListOrders(context.Context, *connect.Request[v1.ListOrdersRequest]) (*connect.Response[v1.ListOrdersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cityio.service.v1.MarketService.ListOrders is not implemented"))
+}
+
+func (UnimplementedMarketServiceHandler) StreamTicker(context.Context, *connect.Request[v1.StreamTickerRequest], *connect.ServerStream[v1.StreamTickerResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("cityio.service.v1.MarketService.StreamTicker is not implemented"))
+}
@@ -0,0 +1,171 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: cityio/service/v1/research.proto
+
+package servicev1connect
+
+import (
+	v1 "cityio/internal/gen/cityio/service/v1"
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ResearchServiceName is the fully-qualified name of the ResearchService service.
+	ResearchServiceName = "cityio.service.v1.ResearchService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ResearchServiceGetResearchProcedure is the fully-qualified name of the ResearchService's
+	// GetResearch RPC.
+	ResearchServiceGetResearchProcedure = "/cityio.service.v1.ResearchService/GetResearch"
+	// ResearchServiceStartResearchProcedure is the fully-qualified name of the ResearchService's
+	// StartResearch RPC.
+	ResearchServiceStartResearchProcedure = "/cityio.service.v1.ResearchService/StartResearch"
+	// ResearchServiceCancelResearchProcedure is the fully-qualified name of the ResearchService's
+	// CancelResearch RPC.
+	ResearchServiceCancelResearchProcedure = "/cityio.service.v1.ResearchService/CancelResearch"
+)
+
+// ResearchServiceClient is a client for the cityio.service.v1.ResearchService service.
+type ResearchServiceClient interface {
+	GetResearch(context.Context, *connect.Request[v1.GetResearchRequest]) (*connect.Response[v1.GetResearchResponse], error)
+	// StartResearch charges the tech's cost and starts researching it. Only
+	// one tech is researched at a time.
+	StartResearch(context.Context, *connect.Request[v1.StartResearchRequest]) (*connect.Response[v1.StartResearchResponse], error)
+	CancelResearch(context.Context, *connect.Request[v1.CancelResearchRequest]) (*connect.Response[v1.CancelResearchResponse], error)
+}
+
+// NewResearchServiceClient constructs a client for the cityio.service.v1.ResearchService service.
+// By default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped
+// responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewResearchServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ResearchServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	researchServiceMethods := v1.File_cityio_service_v1_research_proto.Services().ByName("ResearchService").Methods()
+	return &researchServiceClient{
+		getResearch: connect.NewClient[v1.GetResearchRequest, v1.GetResearchResponse](
+			httpClient,
+			baseURL+ResearchServiceGetResearchProcedure,
+			connect.WithSchema(researchServiceMethods.ByName("GetResearch")),
+			connect.WithClientOptions(opts...),
+		),
+		startResearch: connect.NewClient[v1.StartResearchRequest, v1.StartResearchResponse](
+			httpClient,
+			baseURL+ResearchServiceStartResearchProcedure,
+			connect.WithSchema(researchServiceMethods.ByName("StartResearch")),
+			connect.WithClientOptions(opts...),
+		),
+		cancelResearch: connect.NewClient[v1.CancelResearchRequest, v1.CancelResearchResponse](
+			httpClient,
+			baseURL+ResearchServiceCancelResearchProcedure,
+			connect.WithSchema(researchServiceMethods.ByName("CancelResearch")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// researchServiceClient implements ResearchServiceClient.
+type researchServiceClient struct {
+	getResearch    *connect.Client[v1.GetResearchRequest, v1.GetResearchResponse]
+	startResearch  *connect.Client[v1.StartResearchRequest, v1.StartResearchResponse]
+	cancelResearch *connect.Client[v1.CancelResearchRequest, v1.CancelResearchResponse]
+}
+
+// GetResearch calls cityio.service.v1.ResearchService.GetResearch.
+func (c *researchServiceClient) GetResearch(ctx context.Context, req *connect.Request[v1.GetResearchRequest]) (*connect.Response[v1.GetResearchResponse], error) {
+	return c.getResearch.CallUnary(ctx, req)
+}
+
+// StartResearch calls cityio.service.v1.ResearchService.StartResearch.
+func (c *researchServiceClient) StartResearch(ctx context.Context, req *connect.Request[v1.StartResearchRequest]) (*connect.Response[v1.StartResearchResponse], error) {
+	return c.startResearch.CallUnary(ctx, req)
+}
+
+// CancelResearch calls cityio.service.v1.ResearchService.CancelResearch.
+func (c *researchServiceClient) CancelResearch(ctx context.Context, req *connect.Request[v1.CancelResearchRequest]) (*connect.Response[v1.CancelResearchResponse], error) {
+	return c.cancelResearch.CallUnary(ctx, req)
+}
+
+// ResearchServiceHandler is an implementation of the cityio.service.v1.ResearchService service.
+type ResearchServiceHandler interface {
+	GetResearch(context.Context, *connect.Request[v1.GetResearchRequest]) (*connect.Response[v1.GetResearchResponse], error)
+	// StartResearch charges the tech's cost and starts researching it. Only
+	// one tech is researched at a time.
+	StartResearch(context.Context, *connect.Request[v1.StartResearchRequest]) (*connect.Response[v1.StartResearchResponse], error)
+	CancelResearch(context.Context, *connect.Request[v1.CancelResearchRequest]) (*connect.Response[v1.CancelResearchResponse], error)
+}
+
+// NewResearchServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewResearchServiceHandler(svc ResearchServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	researchServiceMethods := v1.File_cityio_service_v1_research_proto.Services().ByName("ResearchService").Methods()
+	researchServiceGetResearchHandler := connect.NewUnaryHandler(
+		ResearchServiceGetResearchProcedure,
+		svc.GetResearch,
+		connect.WithSchema(researchServiceMethods.ByName("GetResearch")),
+		connect.WithHandlerOptions(opts...),
+	)
+	researchServiceStartResearchHandler := connect.NewUnaryHandler(
+		ResearchServiceStartResearchProcedure,
+		svc.StartResearch,
+		connect.WithSchema(researchServiceMethods.ByName("StartResearch")),
+		connect.WithHandlerOptions(opts...),
+	)
+	researchServiceCancelResearchHandler := connect.NewUnaryHandler(
+		ResearchServiceCancelResearchProcedure,
+		svc.CancelResearch,
+		connect.WithSchema(researchServiceMethods.ByName("CancelResearch")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/cityio.service.v1.ResearchService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ResearchServiceGetResearchProcedure:
+			researchServiceGetResearchHandler.ServeHTTP(w, r)
+		case ResearchServiceStartResearchProcedure:
+			researchServiceStartResearchHandler.ServeHTTP(w, r)
+		case ResearchServiceCancelResearchProcedure:
+			researchServiceCancelResearchHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedResearchServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedResearchServiceHandler struct{}
+
+func (UnimplementedResearchServiceHandler) GetResearch(context.Context, *connect.Request[v1.GetResearchRequest]) (*connect.Response[v1.GetResearchResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cityio.service.v1.ResearchService.GetResearch is not implemented"))
+}
+
+func (UnimplementedResearchServiceHandler) StartResearch(context.Context, *connect.Request[v1.StartResearchRequest]) (*connect.Response[v1.StartResearchResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cityio.service.v1.ResearchService.StartResearch is not implemented"))
+}
+
+func (UnimplementedResearchServiceHandler) CancelResearch(context.Context, *connect.Request[v1.CancelResearchRequest]) (*connect.Response[v1.CancelResearchResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cityio.service.v1.ResearchService.CancelResearch is not implemented"))
+}
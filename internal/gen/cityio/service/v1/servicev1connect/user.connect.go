@@ -41,6 +41,9 @@ const (
 	UserServiceGetUserProcedure = "/cityio.service.v1.UserService/GetUser"
 	// UserServiceDeleteUserProcedure is the fully-qualified name of the UserService's DeleteUser RPC.
 	UserServiceDeleteUserProcedure = "/cityio.service.v1.UserService/DeleteUser"
+	// UserServiceSetFoodAllocationPolicyProcedure is the fully-qualified name of the UserService's
+	// SetFoodAllocationPolicy RPC.
+	UserServiceSetFoodAllocationPolicyProcedure = "/cityio.service.v1.UserService/SetFoodAllocationPolicy"
 	// UserServiceStreamStateProcedure is the fully-qualified name of the UserService's StreamState RPC.
 	UserServiceStreamStateProcedure = "/cityio.service.v1.UserService/StreamState"
 )
@@ -51,6 +54,9 @@ type UserServiceClient interface {
 	Login(context.Context, *connect.Request[v1.LoginRequest]) (*connect.Response[v1.LoginResponse], error)
 	GetUser(context.Context, *connect.Request[v1.GetUserRequest]) (*connect.Response[v1.GetUserResponse], error)
 	DeleteUser(context.Context, *connect.Request[v1.DeleteUserRequest]) (*connect.Response[v1.DeleteUserResponse], error)
+	// SetFoodAllocationPolicy changes how the caller's food pool is split
+	// between their cities.
+	SetFoodAllocationPolicy(context.Context, *connect.Request[v1.SetFoodAllocationPolicyRequest]) (*connect.Response[v1.SetFoodAllocationPolicyResponse], error)
 	StreamState(context.Context, *connect.Request[v1.StreamStateRequest]) (*connect.ServerStreamForClient[v1.StreamStateResponse], error)
 }
 
@@ -89,6 +95,12 @@ func NewUserServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(userServiceMethods.ByName("DeleteUser")),
 			connect.WithClientOptions(opts...),
 		),
+		setFoodAllocationPolicy: connect.NewClient[v1.SetFoodAllocationPolicyRequest, v1.SetFoodAllocationPolicyResponse](
+			httpClient,
+			baseURL+UserServiceSetFoodAllocationPolicyProcedure,
+			connect.WithSchema(userServiceMethods.ByName("SetFoodAllocationPolicy")),
+			connect.WithClientOptions(opts...),
+		),
 		streamState: connect.NewClient[v1.StreamStateRequest, v1.StreamStateResponse](
 			httpClient,
 			baseURL+UserServiceStreamStateProcedure,
@@ -100,11 +112,12 @@ func NewUserServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 
 // userServiceClient implements UserServiceClient.
 type userServiceClient struct {
-	register    *connect.Client[v1.RegisterRequest, v1.RegisterResponse]
-	login       *connect.Client[v1.LoginRequest, v1.LoginResponse]
-	getUser     *connect.Client[v1.GetUserRequest, v1.GetUserResponse]
-	deleteUser  *connect.Client[v1.DeleteUserRequest, v1.DeleteUserResponse]
-	streamState *connect.Client[v1.StreamStateRequest, v1.StreamStateResponse]
+	register                *connect.Client[v1.RegisterRequest, v1.RegisterResponse]
+	login                   *connect.Client[v1.LoginRequest, v1.LoginResponse]
+	getUser                 *connect.Client[v1.GetUserRequest, v1.GetUserResponse]
+	deleteUser              *connect.Client[v1.DeleteUserRequest, v1.DeleteUserResponse]
+	setFoodAllocationPolicy *connect.Client[v1.SetFoodAllocationPolicyRequest, v1.SetFoodAllocationPolicyResponse]
+	streamState             *connect.Client[v1.StreamStateRequest, v1.StreamStateResponse]
 }
 
 // Register calls cityio.service.v1.UserService.Register.
@@ -127,6 +140,11 @@ func (c *userServiceClient) DeleteUser(ctx context.Context, req *connect.Request
 	return c.deleteUser.CallUnary(ctx, req)
 }
 
+// SetFoodAllocationPolicy calls cityio.service.v1.UserService.SetFoodAllocationPolicy.
+func (c *userServiceClient) SetFoodAllocationPolicy(ctx context.Context, req *connect.Request[v1.SetFoodAllocationPolicyRequest]) (*connect.Response[v1.SetFoodAllocationPolicyResponse], error) {
+	return c.setFoodAllocationPolicy.CallUnary(ctx, req)
+}
+
 // StreamState calls cityio.service.v1.UserService.StreamState.
 func (c *userServiceClient) StreamState(ctx context.Context, req *connect.Request[v1.StreamStateRequest]) (*connect.ServerStreamForClient[v1.StreamStateResponse], error) {
 	return c.streamState.CallServerStream(ctx, req)
@@ -138,6 +156,9 @@ type UserServiceHandler interface {
 	Login(context.Context, *connect.Request[v1.LoginRequest]) (*connect.Response[v1.LoginResponse], error)
 	GetUser(context.Context, *connect.Request[v1.GetUserRequest]) (*connect.Response[v1.GetUserResponse], error)
 	DeleteUser(context.Context, *connect.Request[v1.DeleteUserRequest]) (*connect.Response[v1.DeleteUserResponse], error)
+	// SetFoodAllocationPolicy changes how the caller's food pool is split
+	// between their cities.
+	SetFoodAllocationPolicy(context.Context, *connect.Request[v1.SetFoodAllocationPolicyRequest]) (*connect.Response[v1.SetFoodAllocationPolicyResponse], error)
 	StreamState(context.Context, *connect.Request[v1.StreamStateRequest], *connect.ServerStream[v1.StreamStateResponse]) error
 }
 
@@ -172,6 +193,12 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(userServiceMethods.ByName("DeleteUser")),
 		connect.WithHandlerOptions(opts...),
 	)
+	userServiceSetFoodAllocationPolicyHandler := connect.NewUnaryHandler(
+		UserServiceSetFoodAllocationPolicyProcedure,
+		svc.SetFoodAllocationPolicy,
+		connect.WithSchema(userServiceMethods.ByName("SetFoodAllocationPolicy")),
+		connect.WithHandlerOptions(opts...),
+	)
 	userServiceStreamStateHandler := connect.NewServerStreamHandler(
 		UserServiceStreamStateProcedure,
 		svc.StreamState,
@@ -188,6 +215,8 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 			userServiceGetUserHandler.ServeHTTP(w, r)
 		case UserServiceDeleteUserProcedure:
 			userServiceDeleteUserHandler.ServeHTTP(w, r)
+		case UserServiceSetFoodAllocationPolicyProcedure:
+			userServiceSetFoodAllocationPolicyHandler.ServeHTTP(w, r)
 		case UserServiceStreamStateProcedure:
 			userServiceStreamStateHandler.ServeHTTP(w, r)
 		default:
@@ -215,6 +244,10 @@ func (UnimplementedUserServiceHandler) DeleteUser(context.Context, *connect.Requ
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cityio.service.v1.UserService.DeleteUser is not implemented"))
 }
 
+func (UnimplementedUserServiceHandler) SetFoodAllocationPolicy(context.Context, *connect.Request[v1.SetFoodAllocationPolicyRequest]) (*connect.Response[v1.SetFoodAllocationPolicyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cityio.service.v1.UserService.SetFoodAllocationPolicy is not implemented"))
+}
+
 func (UnimplementedUserServiceHandler) StreamState(context.Context, *connect.Request[v1.StreamStateRequest], *connect.ServerStream[v1.StreamStateResponse]) error {
 	return connect.NewError(connect.CodeUnimplemented, errors.New("cityio.service.v1.UserService.StreamState is not implemented"))
 }
@@ -0,0 +1,166 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: cityio/service/v1/world.proto
+
+package servicev1connect
+
+import (
+	v1 "cityio/internal/gen/cityio/service/v1"
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// WorldServiceName is the fully-qualified name of the WorldService service.
+	WorldServiceName = "cityio.service.v1.WorldService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// WorldServiceGetWorldProcedure is the fully-qualified name of the WorldService's GetWorld RPC.
+	WorldServiceGetWorldProcedure = "/cityio.service.v1.WorldService/GetWorld"
+	// WorldServiceListSeasonsProcedure is the fully-qualified name of the WorldService's ListSeasons
+	// RPC.
+	WorldServiceListSeasonsProcedure = "/cityio.service.v1.WorldService/ListSeasons"
+	// WorldServiceGetSeasonStandingsProcedure is the fully-qualified name of the WorldService's
+	// GetSeasonStandings RPC.
+	WorldServiceGetSeasonStandingsProcedure = "/cityio.service.v1.WorldService/GetSeasonStandings"
+)
+
+// WorldServiceClient is a client for the cityio.service.v1.WorldService service.
+type WorldServiceClient interface {
+	GetWorld(context.Context, *connect.Request[v1.GetWorldRequest]) (*connect.Response[v1.GetWorldResponse], error)
+	ListSeasons(context.Context, *connect.Request[v1.ListSeasonsRequest]) (*connect.Response[v1.ListSeasonsResponse], error)
+	GetSeasonStandings(context.Context, *connect.Request[v1.GetSeasonStandingsRequest]) (*connect.Response[v1.GetSeasonStandingsResponse], error)
+}
+
+// NewWorldServiceClient constructs a client for the cityio.service.v1.WorldService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewWorldServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) WorldServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	worldServiceMethods := v1.File_cityio_service_v1_world_proto.Services().ByName("WorldService").Methods()
+	return &worldServiceClient{
+		getWorld: connect.NewClient[v1.GetWorldRequest, v1.GetWorldResponse](
+			httpClient,
+			baseURL+WorldServiceGetWorldProcedure,
+			connect.WithSchema(worldServiceMethods.ByName("GetWorld")),
+			connect.WithClientOptions(opts...),
+		),
+		listSeasons: connect.NewClient[v1.ListSeasonsRequest, v1.ListSeasonsResponse](
+			httpClient,
+			baseURL+WorldServiceListSeasonsProcedure,
+			connect.WithSchema(worldServiceMethods.ByName("ListSeasons")),
+			connect.WithClientOptions(opts...),
+		),
+		getSeasonStandings: connect.NewClient[v1.GetSeasonStandingsRequest, v1.GetSeasonStandingsResponse](
+			httpClient,
+			baseURL+WorldServiceGetSeasonStandingsProcedure,
+			connect.WithSchema(worldServiceMethods.ByName("GetSeasonStandings")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// worldServiceClient implements WorldServiceClient.
+type worldServiceClient struct {
+	getWorld           *connect.Client[v1.GetWorldRequest, v1.GetWorldResponse]
+	listSeasons        *connect.Client[v1.ListSeasonsRequest, v1.ListSeasonsResponse]
+	getSeasonStandings *connect.Client[v1.GetSeasonStandingsRequest, v1.GetSeasonStandingsResponse]
+}
+
+// GetWorld calls cityio.service.v1.WorldService.GetWorld.
+func (c *worldServiceClient) GetWorld(ctx context.Context, req *connect.Request[v1.GetWorldRequest]) (*connect.Response[v1.GetWorldResponse], error) {
+	return c.getWorld.CallUnary(ctx, req)
+}
+
+// ListSeasons calls cityio.service.v1.WorldService.ListSeasons.
+func (c *worldServiceClient) ListSeasons(ctx context.Context, req *connect.Request[v1.ListSeasonsRequest]) (*connect.Response[v1.ListSeasonsResponse], error) {
+	return c.listSeasons.CallUnary(ctx, req)
+}
+
+// GetSeasonStandings calls cityio.service.v1.WorldService.GetSeasonStandings.
+func (c *worldServiceClient) GetSeasonStandings(ctx context.Context, req *connect.Request[v1.GetSeasonStandingsRequest]) (*connect.Response[v1.GetSeasonStandingsResponse], error) {
+	return c.getSeasonStandings.CallUnary(ctx, req)
+}
+
+// WorldServiceHandler is an implementation of the cityio.service.v1.WorldService service.
+type WorldServiceHandler interface {
+	GetWorld(context.Context, *connect.Request[v1.GetWorldRequest]) (*connect.Response[v1.GetWorldResponse], error)
+	ListSeasons(context.Context, *connect.Request[v1.ListSeasonsRequest]) (*connect.Response[v1.ListSeasonsResponse], error)
+	GetSeasonStandings(context.Context, *connect.Request[v1.GetSeasonStandingsRequest]) (*connect.Response[v1.GetSeasonStandingsResponse], error)
+}
+
+// NewWorldServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewWorldServiceHandler(svc WorldServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	worldServiceMethods := v1.File_cityio_service_v1_world_proto.Services().ByName("WorldService").Methods()
+	worldServiceGetWorldHandler := connect.NewUnaryHandler(
+		WorldServiceGetWorldProcedure,
+		svc.GetWorld,
+		connect.WithSchema(worldServiceMethods.ByName("GetWorld")),
+		connect.WithHandlerOptions(opts...),
+	)
+	worldServiceListSeasonsHandler := connect.NewUnaryHandler(
+		WorldServiceListSeasonsProcedure,
+		svc.ListSeasons,
+		connect.WithSchema(worldServiceMethods.ByName("ListSeasons")),
+		connect.WithHandlerOptions(opts...),
+	)
+	worldServiceGetSeasonStandingsHandler := connect.NewUnaryHandler(
+		WorldServiceGetSeasonStandingsProcedure,
+		svc.GetSeasonStandings,
+		connect.WithSchema(worldServiceMethods.ByName("GetSeasonStandings")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/cityio.service.v1.WorldService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case WorldServiceGetWorldProcedure:
+			worldServiceGetWorldHandler.ServeHTTP(w, r)
+		case WorldServiceListSeasonsProcedure:
+			worldServiceListSeasonsHandler.ServeHTTP(w, r)
+		case WorldServiceGetSeasonStandingsProcedure:
+			worldServiceGetSeasonStandingsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedWorldServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedWorldServiceHandler struct{}
+
+func (UnimplementedWorldServiceHandler) GetWorld(context.Context, *connect.Request[v1.GetWorldRequest]) (*connect.Response[v1.GetWorldResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cityio.service.v1.WorldService.GetWorld is not implemented"))
+}
+
+func (UnimplementedWorldServiceHandler) ListSeasons(context.Context, *connect.Request[v1.ListSeasonsRequest]) (*connect.Response[v1.ListSeasonsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cityio.service.v1.WorldService.ListSeasons is not implemented"))
+}
+
+func (UnimplementedWorldServiceHandler) GetSeasonStandings(context.Context, *connect.Request[v1.GetSeasonStandingsRequest]) (*connect.Response[v1.GetSeasonStandingsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cityio.service.v1.WorldService.GetSeasonStandings is not implemented"))
+}
@@ -406,6 +406,86 @@ func (*DeleteUserResponse) Descriptor() ([]byte, []int) {
 	return file_cityio_service_v1_user_proto_rawDescGZIP(), []int{7}
 }
 
+type SetFoodAllocationPolicyRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Policy        v1.FoodAllocationPolicy `protobuf:"varint,1,opt,name=policy,proto3,enum=cityio.entity.v1.FoodAllocationPolicy" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFoodAllocationPolicyRequest) Reset() {
+	*x = SetFoodAllocationPolicyRequest{}
+	mi := &file_cityio_service_v1_user_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFoodAllocationPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFoodAllocationPolicyRequest) ProtoMessage() {}
+
+func (x *SetFoodAllocationPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_user_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFoodAllocationPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetFoodAllocationPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_user_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SetFoodAllocationPolicyRequest) GetPolicy() v1.FoodAllocationPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return v1.FoodAllocationPolicy(0)
+}
+
+type SetFoodAllocationPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFoodAllocationPolicyResponse) Reset() {
+	*x = SetFoodAllocationPolicyResponse{}
+	mi := &file_cityio_service_v1_user_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFoodAllocationPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFoodAllocationPolicyResponse) ProtoMessage() {}
+
+func (x *SetFoodAllocationPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_user_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFoodAllocationPolicyResponse.ProtoReflect.Descriptor instead.
+func (*SetFoodAllocationPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_user_proto_rawDescGZIP(), []int{9}
+}
+
 type StreamStateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -414,7 +494,7 @@ type StreamStateRequest struct {
 
 func (x *StreamStateRequest) Reset() {
 	*x = StreamStateRequest{}
-	mi := &file_cityio_service_v1_user_proto_msgTypes[8]
+	mi := &file_cityio_service_v1_user_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -426,7 +506,7 @@ func (x *StreamStateRequest) String() string {
 func (*StreamStateRequest) ProtoMessage() {}
 
 func (x *StreamStateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cityio_service_v1_user_proto_msgTypes[8]
+	mi := &file_cityio_service_v1_user_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -439,7 +519,7 @@ func (x *StreamStateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StreamStateRequest.ProtoReflect.Descriptor instead.
 func (*StreamStateRequest) Descriptor() ([]byte, []int) {
-	return file_cityio_service_v1_user_proto_rawDescGZIP(), []int{8}
+	return file_cityio_service_v1_user_proto_rawDescGZIP(), []int{10}
 }
 
 // StreamStateResponse wraps an EntityBag pushed to a client whenever state changes.
@@ -452,7 +532,7 @@ type StreamStateResponse struct {
 
 func (x *StreamStateResponse) Reset() {
 	*x = StreamStateResponse{}
-	mi := &file_cityio_service_v1_user_proto_msgTypes[9]
+	mi := &file_cityio_service_v1_user_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -464,7 +544,7 @@ func (x *StreamStateResponse) String() string {
 func (*StreamStateResponse) ProtoMessage() {}
 
 func (x *StreamStateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cityio_service_v1_user_proto_msgTypes[9]
+	mi := &file_cityio_service_v1_user_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -477,7 +557,7 @@ func (x *StreamStateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StreamStateResponse.ProtoReflect.Descriptor instead.
 func (*StreamStateResponse) Descriptor() ([]byte, []int) {
-	return file_cityio_service_v1_user_proto_rawDescGZIP(), []int{9}
+	return file_cityio_service_v1_user_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *StreamStateResponse) GetEntities() *v1.EntityBag {
@@ -513,16 +593,20 @@ const file_cityio_service_v1_user_proto_rawDesc = "" +
 	"\x04user\x18\x01 \x01(\v2\x16.cityio.entity.v1.UserR\x04user\"F\n" +
 	"\x11DeleteUserRequest\x121\n" +
 	"\auser_id\x18\x01 \x01(\v2\x18.cityio.entity.v1.UserIdR\x06userId\"\x14\n" +
-	"\x12DeleteUserResponse\"\x14\n" +
+	"\x12DeleteUserResponse\"`\n" +
+	"\x1eSetFoodAllocationPolicyRequest\x12>\n" +
+	"\x06policy\x18\x01 \x01(\x0e2&.cityio.entity.v1.FoodAllocationPolicyR\x06policy\"!\n" +
+	"\x1fSetFoodAllocationPolicyResponse\"\x14\n" +
 	"\x12StreamStateRequest\"N\n" +
 	"\x13StreamStateResponse\x127\n" +
-	"\bentities\x18\x01 \x01(\v2\x1b.cityio.entity.v1.EntityBagR\bentities2\xbb\x03\n" +
+	"\bentities\x18\x01 \x01(\v2\x1b.cityio.entity.v1.EntityBagR\bentities2\xbe\x04\n" +
 	"\vUserService\x12S\n" +
 	"\bRegister\x12\".cityio.service.v1.RegisterRequest\x1a#.cityio.service.v1.RegisterResponse\x12J\n" +
 	"\x05Login\x12\x1f.cityio.service.v1.LoginRequest\x1a .cityio.service.v1.LoginResponse\x12P\n" +
 	"\aGetUser\x12!.cityio.service.v1.GetUserRequest\x1a\".cityio.service.v1.GetUserResponse\x12Y\n" +
 	"\n" +
-	"DeleteUser\x12$.cityio.service.v1.DeleteUserRequest\x1a%.cityio.service.v1.DeleteUserResponse\x12^\n" +
+	"DeleteUser\x12$.cityio.service.v1.DeleteUserRequest\x1a%.cityio.service.v1.DeleteUserResponse\x12\x80\x01\n" +
+	"\x17SetFoodAllocationPolicy\x121.cityio.service.v1.SetFoodAllocationPolicyRequest\x1a2.cityio.service.v1.SetFoodAllocationPolicyResponse\x12^\n" +
 	"\vStreamState\x12%.cityio.service.v1.StreamStateRequest\x1a&.cityio.service.v1.StreamStateResponse0\x01B\xb9\x01\n" +
 	"\x15com.cityio.service.v1B\tUserProtoP\x01Z/cityio/internal/gen/cityio/service/v1;servicev1\xa2\x02\x03CSX\xaa\x02\x11Cityio.Service.V1\xca\x02\x11Cityio\\Service\\V1\xe2\x02\x1dCityio\\Service\\V1\\GPBMetadata\xea\x02\x13Cityio::Service::V1b\x06proto3"
 
@@ -538,44 +622,50 @@ func file_cityio_service_v1_user_proto_rawDescGZIP() []byte {
 	return file_cityio_service_v1_user_proto_rawDescData
 }
 
-var file_cityio_service_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_cityio_service_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_cityio_service_v1_user_proto_goTypes = []any{
-	(*RegisterRequest)(nil),     // 0: cityio.service.v1.RegisterRequest
-	(*RegisterResponse)(nil),    // 1: cityio.service.v1.RegisterResponse
-	(*LoginRequest)(nil),        // 2: cityio.service.v1.LoginRequest
-	(*LoginResponse)(nil),       // 3: cityio.service.v1.LoginResponse
-	(*GetUserRequest)(nil),      // 4: cityio.service.v1.GetUserRequest
-	(*GetUserResponse)(nil),     // 5: cityio.service.v1.GetUserResponse
-	(*DeleteUserRequest)(nil),   // 6: cityio.service.v1.DeleteUserRequest
-	(*DeleteUserResponse)(nil),  // 7: cityio.service.v1.DeleteUserResponse
-	(*StreamStateRequest)(nil),  // 8: cityio.service.v1.StreamStateRequest
-	(*StreamStateResponse)(nil), // 9: cityio.service.v1.StreamStateResponse
-	(*v1.UserId)(nil),           // 10: cityio.entity.v1.UserId
-	(*v1.User)(nil),             // 11: cityio.entity.v1.User
-	(*v1.EntityBag)(nil),        // 12: cityio.entity.v1.EntityBag
+	(*RegisterRequest)(nil),                 // 0: cityio.service.v1.RegisterRequest
+	(*RegisterResponse)(nil),                // 1: cityio.service.v1.RegisterResponse
+	(*LoginRequest)(nil),                    // 2: cityio.service.v1.LoginRequest
+	(*LoginResponse)(nil),                   // 3: cityio.service.v1.LoginResponse
+	(*GetUserRequest)(nil),                  // 4: cityio.service.v1.GetUserRequest
+	(*GetUserResponse)(nil),                 // 5: cityio.service.v1.GetUserResponse
+	(*DeleteUserRequest)(nil),               // 6: cityio.service.v1.DeleteUserRequest
+	(*DeleteUserResponse)(nil),              // 7: cityio.service.v1.DeleteUserResponse
+	(*SetFoodAllocationPolicyRequest)(nil),  // 8: cityio.service.v1.SetFoodAllocationPolicyRequest
+	(*SetFoodAllocationPolicyResponse)(nil), // 9: cityio.service.v1.SetFoodAllocationPolicyResponse
+	(*StreamStateRequest)(nil),              // 10: cityio.service.v1.StreamStateRequest
+	(*StreamStateResponse)(nil),             // 11: cityio.service.v1.StreamStateResponse
+	(*v1.UserId)(nil),                       // 12: cityio.entity.v1.UserId
+	(*v1.User)(nil),                         // 13: cityio.entity.v1.User
+	(v1.FoodAllocationPolicy)(0),            // 14: cityio.entity.v1.FoodAllocationPolicy
+	(*v1.EntityBag)(nil),                    // 15: cityio.entity.v1.EntityBag
 }
 var file_cityio_service_v1_user_proto_depIdxs = []int32{
-	10, // 0: cityio.service.v1.RegisterResponse.user_id:type_name -> cityio.entity.v1.UserId
-	11, // 1: cityio.service.v1.LoginResponse.user:type_name -> cityio.entity.v1.User
-	10, // 2: cityio.service.v1.GetUserRequest.user_id:type_name -> cityio.entity.v1.UserId
-	11, // 3: cityio.service.v1.GetUserResponse.user:type_name -> cityio.entity.v1.User
-	10, // 4: cityio.service.v1.DeleteUserRequest.user_id:type_name -> cityio.entity.v1.UserId
-	12, // 5: cityio.service.v1.StreamStateResponse.entities:type_name -> cityio.entity.v1.EntityBag
-	0,  // 6: cityio.service.v1.UserService.Register:input_type -> cityio.service.v1.RegisterRequest
-	2,  // 7: cityio.service.v1.UserService.Login:input_type -> cityio.service.v1.LoginRequest
-	4,  // 8: cityio.service.v1.UserService.GetUser:input_type -> cityio.service.v1.GetUserRequest
-	6,  // 9: cityio.service.v1.UserService.DeleteUser:input_type -> cityio.service.v1.DeleteUserRequest
-	8,  // 10: cityio.service.v1.UserService.StreamState:input_type -> cityio.service.v1.StreamStateRequest
-	1,  // 11: cityio.service.v1.UserService.Register:output_type -> cityio.service.v1.RegisterResponse
-	3,  // 12: cityio.service.v1.UserService.Login:output_type -> cityio.service.v1.LoginResponse
-	5,  // 13: cityio.service.v1.UserService.GetUser:output_type -> cityio.service.v1.GetUserResponse
-	7,  // 14: cityio.service.v1.UserService.DeleteUser:output_type -> cityio.service.v1.DeleteUserResponse
-	9,  // 15: cityio.service.v1.UserService.StreamState:output_type -> cityio.service.v1.StreamStateResponse
-	11, // [11:16] is the sub-list for method output_type
-	6,  // [6:11] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	12, // 0: cityio.service.v1.RegisterResponse.user_id:type_name -> cityio.entity.v1.UserId
+	13, // 1: cityio.service.v1.LoginResponse.user:type_name -> cityio.entity.v1.User
+	12, // 2: cityio.service.v1.GetUserRequest.user_id:type_name -> cityio.entity.v1.UserId
+	13, // 3: cityio.service.v1.GetUserResponse.user:type_name -> cityio.entity.v1.User
+	12, // 4: cityio.service.v1.DeleteUserRequest.user_id:type_name -> cityio.entity.v1.UserId
+	14, // 5: cityio.service.v1.SetFoodAllocationPolicyRequest.policy:type_name -> cityio.entity.v1.FoodAllocationPolicy
+	15, // 6: cityio.service.v1.StreamStateResponse.entities:type_name -> cityio.entity.v1.EntityBag
+	0,  // 7: cityio.service.v1.UserService.Register:input_type -> cityio.service.v1.RegisterRequest
+	2,  // 8: cityio.service.v1.UserService.Login:input_type -> cityio.service.v1.LoginRequest
+	4,  // 9: cityio.service.v1.UserService.GetUser:input_type -> cityio.service.v1.GetUserRequest
+	6,  // 10: cityio.service.v1.UserService.DeleteUser:input_type -> cityio.service.v1.DeleteUserRequest
+	8,  // 11: cityio.service.v1.UserService.SetFoodAllocationPolicy:input_type -> cityio.service.v1.SetFoodAllocationPolicyRequest
+	10, // 12: cityio.service.v1.UserService.StreamState:input_type -> cityio.service.v1.StreamStateRequest
+	1,  // 13: cityio.service.v1.UserService.Register:output_type -> cityio.service.v1.RegisterResponse
+	3,  // 14: cityio.service.v1.UserService.Login:output_type -> cityio.service.v1.LoginResponse
+	5,  // 15: cityio.service.v1.UserService.GetUser:output_type -> cityio.service.v1.GetUserResponse
+	7,  // 16: cityio.service.v1.UserService.DeleteUser:output_type -> cityio.service.v1.DeleteUserResponse
+	9,  // 17: cityio.service.v1.UserService.SetFoodAllocationPolicy:output_type -> cityio.service.v1.SetFoodAllocationPolicyResponse
+	11, // 18: cityio.service.v1.UserService.StreamState:output_type -> cityio.service.v1.StreamStateResponse
+	13, // [13:19] is the sub-list for method output_type
+	7,  // [7:13] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_cityio_service_v1_user_proto_init() }
@@ -589,7 +679,7 @@ func file_cityio_service_v1_user_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cityio_service_v1_user_proto_rawDesc), len(file_cityio_service_v1_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,370 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: cityio/service/v1/world.proto
+
+package servicev1
+
+import (
+	v1 "cityio/internal/gen/cityio/entity/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetWorldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorldRequest) Reset() {
+	*x = GetWorldRequest{}
+	mi := &file_cityio_service_v1_world_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorldRequest) ProtoMessage() {}
+
+func (x *GetWorldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_world_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorldRequest.ProtoReflect.Descriptor instead.
+func (*GetWorldRequest) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_world_proto_rawDescGZIP(), []int{0}
+}
+
+type GetWorldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	World         *v1.World              `protobuf:"bytes,1,opt,name=world,proto3" json:"world,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorldResponse) Reset() {
+	*x = GetWorldResponse{}
+	mi := &file_cityio_service_v1_world_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorldResponse) ProtoMessage() {}
+
+func (x *GetWorldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_world_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorldResponse.ProtoReflect.Descriptor instead.
+func (*GetWorldResponse) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_world_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetWorldResponse) GetWorld() *v1.World {
+	if x != nil {
+		return x.World
+	}
+	return nil
+}
+
+// ListSeasonsRequest returns every archived season, newest first.
+type ListSeasonsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSeasonsRequest) Reset() {
+	*x = ListSeasonsRequest{}
+	mi := &file_cityio_service_v1_world_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSeasonsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSeasonsRequest) ProtoMessage() {}
+
+func (x *ListSeasonsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_world_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSeasonsRequest.ProtoReflect.Descriptor instead.
+func (*ListSeasonsRequest) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_world_proto_rawDescGZIP(), []int{2}
+}
+
+type ListSeasonsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Seasons       []*v1.Season           `protobuf:"bytes,1,rep,name=seasons,proto3" json:"seasons,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSeasonsResponse) Reset() {
+	*x = ListSeasonsResponse{}
+	mi := &file_cityio_service_v1_world_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSeasonsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSeasonsResponse) ProtoMessage() {}
+
+func (x *ListSeasonsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_world_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSeasonsResponse.ProtoReflect.Descriptor instead.
+func (*ListSeasonsResponse) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_world_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListSeasonsResponse) GetSeasons() []*v1.Season {
+	if x != nil {
+		return x.Seasons
+	}
+	return nil
+}
+
+// GetSeasonStandingsRequest returns an archived season's final standings,
+// best first. limit defaults to 50 and is capped at 200.
+type GetSeasonStandingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Season        int32                  `protobuf:"varint,1,opt,name=season,proto3" json:"season,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSeasonStandingsRequest) Reset() {
+	*x = GetSeasonStandingsRequest{}
+	mi := &file_cityio_service_v1_world_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSeasonStandingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSeasonStandingsRequest) ProtoMessage() {}
+
+func (x *GetSeasonStandingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_world_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSeasonStandingsRequest.ProtoReflect.Descriptor instead.
+func (*GetSeasonStandingsRequest) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_world_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetSeasonStandingsRequest) GetSeason() int32 {
+	if x != nil {
+		return x.Season
+	}
+	return 0
+}
+
+func (x *GetSeasonStandingsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetSeasonStandingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Standings     []*v1.SeasonStanding   `protobuf:"bytes,1,rep,name=standings,proto3" json:"standings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSeasonStandingsResponse) Reset() {
+	*x = GetSeasonStandingsResponse{}
+	mi := &file_cityio_service_v1_world_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSeasonStandingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSeasonStandingsResponse) ProtoMessage() {}
+
+func (x *GetSeasonStandingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cityio_service_v1_world_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSeasonStandingsResponse.ProtoReflect.Descriptor instead.
+func (*GetSeasonStandingsResponse) Descriptor() ([]byte, []int) {
+	return file_cityio_service_v1_world_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetSeasonStandingsResponse) GetStandings() []*v1.SeasonStanding {
+	if x != nil {
+		return x.Standings
+	}
+	return nil
+}
+
+var File_cityio_service_v1_world_proto protoreflect.FileDescriptor
+
+const file_cityio_service_v1_world_proto_rawDesc = "" +
+	"\n" +
+	"\x1dcityio/service/v1/world.proto\x12\x11cityio.service.v1\x1a\x1ccityio/entity/v1/world.proto\"\x11\n" +
+	"\x0fGetWorldRequest\"A\n" +
+	"\x10GetWorldResponse\x12-\n" +
+	"\x05world\x18\x01 \x01(\v2\x17.cityio.entity.v1.WorldR\x05world\"\x14\n" +
+	"\x12ListSeasonsRequest\"I\n" +
+	"\x13ListSeasonsResponse\x122\n" +
+	"\aseasons\x18\x01 \x03(\v2\x18.cityio.entity.v1.SeasonR\aseasons\"I\n" +
+	"\x19GetSeasonStandingsRequest\x12\x16\n" +
+	"\x06season\x18\x01 \x01(\x05R\x06season\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\\\n" +
+	"\x1aGetSeasonStandingsResponse\x12>\n" +
+	"\tstandings\x18\x01 \x03(\v2 .cityio.entity.v1.SeasonStandingR\tstandings2\xb4\x02\n" +
+	"\fWorldService\x12S\n" +
+	"\bGetWorld\x12\".cityio.service.v1.GetWorldRequest\x1a#.cityio.service.v1.GetWorldResponse\x12\\\n" +
+	"\vListSeasons\x12%.cityio.service.v1.ListSeasonsRequest\x1a&.cityio.service.v1.ListSeasonsResponse\x12q\n" +
+	"\x12GetSeasonStandings\x12,.cityio.service.v1.GetSeasonStandingsRequest\x1a-.cityio.service.v1.GetSeasonStandingsResponseB\xba\x01\n" +
+	"\x15com.cityio.service.v1B\n" +
+	"WorldProtoP\x01Z/cityio/internal/gen/cityio/service/v1;servicev1\xa2\x02\x03CSX\xaa\x02\x11Cityio.Service.V1\xca\x02\x11Cityio\\Service\\V1\xe2\x02\x1dCityio\\Service\\V1\\GPBMetadata\xea\x02\x13Cityio::Service::V1b\x06proto3"
+
+var (
+	file_cityio_service_v1_world_proto_rawDescOnce sync.Once
+	file_cityio_service_v1_world_proto_rawDescData []byte
+)
+
+func file_cityio_service_v1_world_proto_rawDescGZIP() []byte {
+	file_cityio_service_v1_world_proto_rawDescOnce.Do(func() {
+		file_cityio_service_v1_world_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cityio_service_v1_world_proto_rawDesc), len(file_cityio_service_v1_world_proto_rawDesc)))
+	})
+	return file_cityio_service_v1_world_proto_rawDescData
+}
+
+var file_cityio_service_v1_world_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_cityio_service_v1_world_proto_goTypes = []any{
+	(*GetWorldRequest)(nil),            // 0: cityio.service.v1.GetWorldRequest
+	(*GetWorldResponse)(nil),           // 1: cityio.service.v1.GetWorldResponse
+	(*ListSeasonsRequest)(nil),         // 2: cityio.service.v1.ListSeasonsRequest
+	(*ListSeasonsResponse)(nil),        // 3: cityio.service.v1.ListSeasonsResponse
+	(*GetSeasonStandingsRequest)(nil),  // 4: cityio.service.v1.GetSeasonStandingsRequest
+	(*GetSeasonStandingsResponse)(nil), // 5: cityio.service.v1.GetSeasonStandingsResponse
+	(*v1.World)(nil),                   // 6: cityio.entity.v1.World
+	(*v1.Season)(nil),                  // 7: cityio.entity.v1.Season
+	(*v1.SeasonStanding)(nil),          // 8: cityio.entity.v1.SeasonStanding
+}
+var file_cityio_service_v1_world_proto_depIdxs = []int32{
+	6, // 0: cityio.service.v1.GetWorldResponse.world:type_name -> cityio.entity.v1.World
+	7, // 1: cityio.service.v1.ListSeasonsResponse.seasons:type_name -> cityio.entity.v1.Season
+	8, // 2: cityio.service.v1.GetSeasonStandingsResponse.standings:type_name -> cityio.entity.v1.SeasonStanding
+	0, // 3: cityio.service.v1.WorldService.GetWorld:input_type -> cityio.service.v1.GetWorldRequest
+	2, // 4: cityio.service.v1.WorldService.ListSeasons:input_type -> cityio.service.v1.ListSeasonsRequest
+	4, // 5: cityio.service.v1.WorldService.GetSeasonStandings:input_type -> cityio.service.v1.GetSeasonStandingsRequest
+	1, // 6: cityio.service.v1.WorldService.GetWorld:output_type -> cityio.service.v1.GetWorldResponse
+	3, // 7: cityio.service.v1.WorldService.ListSeasons:output_type -> cityio.service.v1.ListSeasonsResponse
+	5, // 8: cityio.service.v1.WorldService.GetSeasonStandings:output_type -> cityio.service.v1.GetSeasonStandingsResponse
+	6, // [6:9] is the sub-list for method output_type
+	3, // [3:6] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_cityio_service_v1_world_proto_init() }
+func file_cityio_service_v1_world_proto_init() {
+	if File_cityio_service_v1_world_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cityio_service_v1_world_proto_rawDesc), len(file_cityio_service_v1_world_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_cityio_service_v1_world_proto_goTypes,
+		DependencyIndexes: file_cityio_service_v1_world_proto_depIdxs,
+		MessageInfos:      file_cityio_service_v1_world_proto_msgTypes,
+	}.Build()
+	File_cityio_service_v1_world_proto = out.File
+	file_cityio_service_v1_world_proto_goTypes = nil
+	file_cityio_service_v1_world_proto_depIdxs = nil
+}
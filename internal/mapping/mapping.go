@@ -10,6 +10,7 @@ import (
 	servicev1 "cityio/internal/gen/cityio/service/v1"
 
 	"cityio/internal/domain"
+	"cityio/internal/domain/combat"
 )
 
 var cityTypeToProto = map[domain.CityType]entityv1.CityType{
@@ -40,7 +41,95 @@ var buildingTypeFromProto = map[entityv1.BuildingType]domain.BuildingType{
 	entityv1.BuildingType_BUILDING_TYPE_MINE:        domain.BuildingTypeMine,
 }
 
+var foodPolicyToProto = map[domain.FoodAllocationPolicy]entityv1.FoodAllocationPolicy{
+	domain.FoodPolicyCapitalFirst: entityv1.FoodAllocationPolicy_FOOD_ALLOCATION_POLICY_CAPITAL_FIRST,
+	domain.FoodPolicyProportional: entityv1.FoodAllocationPolicy_FOOD_ALLOCATION_POLICY_PROPORTIONAL,
+	domain.FoodPolicyPriority:     entityv1.FoodAllocationPolicy_FOOD_ALLOCATION_POLICY_PRIORITY,
+}
+
+var foodPolicyFromProto = map[entityv1.FoodAllocationPolicy]domain.FoodAllocationPolicy{
+	entityv1.FoodAllocationPolicy_FOOD_ALLOCATION_POLICY_CAPITAL_FIRST: domain.FoodPolicyCapitalFirst,
+	entityv1.FoodAllocationPolicy_FOOD_ALLOCATION_POLICY_PROPORTIONAL:  domain.FoodPolicyProportional,
+	entityv1.FoodAllocationPolicy_FOOD_ALLOCATION_POLICY_PRIORITY:      domain.FoodPolicyPriority,
+}
+
+var battleOutcomeToProto = map[string]entityv1.BattleOutcome{
+	string(combat.OutcomeAttackerVictory): entityv1.BattleOutcome_BATTLE_OUTCOME_ATTACKER_VICTORY,
+	string(combat.OutcomeDefenderVictory): entityv1.BattleOutcome_BATTLE_OUTCOME_DEFENDER_VICTORY,
+}
+
 // ToUserId wraps a raw string into a typed proto ID.
+var terrainToProto = map[domain.Terrain]entityv1.Terrain{
+	domain.TerrainGrassland: entityv1.Terrain_TERRAIN_GRASSLAND,
+	domain.TerrainForest:    entityv1.Terrain_TERRAIN_FOREST,
+	domain.TerrainHills:     entityv1.Terrain_TERRAIN_HILLS,
+	domain.TerrainMountains: entityv1.Terrain_TERRAIN_MOUNTAINS,
+	domain.TerrainWater:     entityv1.Terrain_TERRAIN_WATER,
+}
+
+var depositKindToProto = map[domain.DepositKind]entityv1.DepositKind{
+	domain.DepositGoldVein:    entityv1.DepositKind_DEPOSIT_KIND_GOLD_VEIN,
+	domain.DepositFertileSoil: entityv1.DepositKind_DEPOSIT_KIND_FERTILE_SOIL,
+}
+
+var worldStateToProto = map[domain.WorldState]entityv1.WorldState{
+	domain.WorldStateActive: entityv1.WorldState_WORLD_STATE_ACTIVE,
+	domain.WorldStateEnding: entityv1.WorldState_WORLD_STATE_ENDING,
+}
+
+var seasonEndReasonToProto = map[domain.SeasonEndReason]entityv1.SeasonEndReason{
+	domain.SeasonEndAdmin:   entityv1.SeasonEndReason_SEASON_END_REASON_ADMIN,
+	domain.SeasonEndExpired: entityv1.SeasonEndReason_SEASON_END_REASON_EXPIRED,
+}
+
+var orderSideToProto = map[domain.OrderSide]entityv1.OrderSide{
+	domain.OrderSideBuy:  entityv1.OrderSide_ORDER_SIDE_BUY,
+	domain.OrderSideSell: entityv1.OrderSide_ORDER_SIDE_SELL,
+}
+
+var orderSideFromProto = map[entityv1.OrderSide]domain.OrderSide{
+	entityv1.OrderSide_ORDER_SIDE_BUY:  domain.OrderSideBuy,
+	entityv1.OrderSide_ORDER_SIDE_SELL: domain.OrderSideSell,
+}
+
+var allianceRoleToProto = map[domain.AllianceRole]entityv1.AllianceRole{
+	domain.AllianceRoleLeader:  entityv1.AllianceRole_ALLIANCE_ROLE_LEADER,
+	domain.AllianceRoleOfficer: entityv1.AllianceRole_ALLIANCE_ROLE_OFFICER,
+	domain.AllianceRoleMember:  entityv1.AllianceRole_ALLIANCE_ROLE_MEMBER,
+}
+
+var allianceRoleFromProto = map[entityv1.AllianceRole]domain.AllianceRole{
+	entityv1.AllianceRole_ALLIANCE_ROLE_LEADER:  domain.AllianceRoleLeader,
+	entityv1.AllianceRole_ALLIANCE_ROLE_OFFICER: domain.AllianceRoleOfficer,
+	entityv1.AllianceRole_ALLIANCE_ROLE_MEMBER:  domain.AllianceRoleMember,
+}
+
+var relationStateToProto = map[domain.RelationState]entityv1.RelationState{
+	domain.RelationNeutral:       entityv1.RelationState_RELATION_STATE_NEUTRAL,
+	domain.RelationPeace:         entityv1.RelationState_RELATION_STATE_PEACE,
+	domain.RelationNonAggression: entityv1.RelationState_RELATION_STATE_NON_AGGRESSION,
+	domain.RelationWar:           entityv1.RelationState_RELATION_STATE_WAR,
+}
+
+var relationStateFromProto = map[entityv1.RelationState]domain.RelationState{
+	entityv1.RelationState_RELATION_STATE_NEUTRAL:        domain.RelationNeutral,
+	entityv1.RelationState_RELATION_STATE_PEACE:          domain.RelationPeace,
+	entityv1.RelationState_RELATION_STATE_NON_AGGRESSION: domain.RelationNonAggression,
+	entityv1.RelationState_RELATION_STATE_WAR:            domain.RelationWar,
+}
+
+var chatChannelKindToProto = map[domain.ChatChannelKind]entityv1.ChatChannelKind{
+	domain.ChatChannelGlobal:   entityv1.ChatChannelKind_CHAT_CHANNEL_KIND_GLOBAL,
+	domain.ChatChannelAlliance: entityv1.ChatChannelKind_CHAT_CHANNEL_KIND_ALLIANCE,
+	domain.ChatChannelDirect:   entityv1.ChatChannelKind_CHAT_CHANNEL_KIND_DIRECT,
+}
+
+var chatChannelKindFromProto = map[entityv1.ChatChannelKind]domain.ChatChannelKind{
+	entityv1.ChatChannelKind_CHAT_CHANNEL_KIND_GLOBAL:   domain.ChatChannelGlobal,
+	entityv1.ChatChannelKind_CHAT_CHANNEL_KIND_ALLIANCE: domain.ChatChannelAlliance,
+	entityv1.ChatChannelKind_CHAT_CHANNEL_KIND_DIRECT:   domain.ChatChannelDirect,
+}
+
 func ToUserId(id string) *entityv1.UserId {
 	return &entityv1.UserId{Value: id}
 }
@@ -55,6 +144,29 @@ func ToBuildingId(id string) *entityv1.BuildingId {
 	return &entityv1.BuildingId{Value: id}
 }
 
+// ToArmyId wraps a raw string into a typed proto ID.
+func ToArmyId(id string) *entityv1.ArmyId {
+	return &entityv1.ArmyId{Value: id}
+}
+
+// ToBattleReportId wraps a raw string into a typed proto ID.
+// ToCaravanId wraps a raw string into a typed proto ID.
+func ToCaravanId(id string) *entityv1.CaravanId {
+	return &entityv1.CaravanId{Value: id}
+}
+
+func ToAllianceId(id string) *entityv1.AllianceId {
+	return &entityv1.AllianceId{Value: id}
+}
+
+func ToBattleReportId(id string) *entityv1.BattleReportId {
+	return &entityv1.BattleReportId{Value: id}
+}
+
+func ToChatMessageId(id string) *entityv1.ChatMessageId {
+	return &entityv1.ChatMessageId{Value: id}
+}
+
 // CityTypeToProto maps a domain city type to its proto enum.
 func CityTypeToProto(t domain.CityType) entityv1.CityType {
 	return cityTypeToProto[t]
@@ -65,7 +177,9 @@ func CityTypeFromProto(t entityv1.CityType) domain.CityType {
 	return cityTypeFromProto[t]
 }
 
-// BuildingTypeToProto maps a domain building type to its proto enum.
+// BuildingTypeToProto maps a domain building type to its proto enum. Types
+// loaded from the building definitions that the enum doesn't name map to
+// UNSPECIFIED; their type_id fields carry them.
 func BuildingTypeToProto(t domain.BuildingType) entityv1.BuildingType {
 	return buildingTypeToProto[t]
 }
@@ -75,6 +189,26 @@ func BuildingTypeFromProto(t entityv1.BuildingType) domain.BuildingType {
 	return buildingTypeFromProto[t]
 }
 
+// BuildingTypeFromRequest resolves a request's building type: typeID when
+// set, the enum otherwise.
+func BuildingTypeFromRequest(t entityv1.BuildingType, typeID string) domain.BuildingType {
+	if typeID != "" {
+		return domain.BuildingType(typeID)
+	}
+	return BuildingTypeFromProto(t)
+}
+
+// FoodPolicyToProto maps a domain food allocation policy to its proto enum.
+func FoodPolicyToProto(p domain.FoodAllocationPolicy) entityv1.FoodAllocationPolicy {
+	return foodPolicyToProto[p]
+}
+
+// FoodPolicyFromProto maps a proto food allocation policy enum to its domain
+// value. Unknown values map to the empty policy, which is not Valid.
+func FoodPolicyFromProto(p entityv1.FoodAllocationPolicy) domain.FoodAllocationPolicy {
+	return foodPolicyFromProto[p]
+}
+
 // RatePerHour wraps a per-hour amount as a Rate proto with scale=3600.
 func RatePerHour(perHour int64) *entityv1.Rate {
 	return &entityv1.Rate{Value: perHour, Scale: 3600}
@@ -91,6 +225,32 @@ func UserToProto(u domain.User) *entityv1.User {
 		Food:       u.Food,
 		FoodIncome: RatePerHour(u.FoodIncomeRate),
 		FoodUpkeep: RatePerHour(u.FoodUpkeepRate),
+
+		FoodAllocationPolicy: FoodPolicyToProto(u.FoodPolicy),
+
+		Techs:    TechIDsToStrings(u.Techs),
+		Research: ResearchToProto(u.Researching),
+	}
+}
+
+// TechIDsToStrings converts tech IDs to their wire form.
+func TechIDsToStrings(techs []domain.TechID) []string {
+	out := make([]string, len(techs))
+	for i, t := range techs {
+		out[i] = string(t)
+	}
+	return out
+}
+
+// ResearchToProto converts the research in progress, nil when there is none.
+func ResearchToProto(r *domain.Research) *entityv1.Research {
+	if r == nil {
+		return nil
+	}
+	return &entityv1.Research{
+		Tech:          string(r.Tech),
+		ResearchStart: timestamppb.New(r.ResearchStart),
+		ResearchEnd:   timestamppb.New(r.ResearchEnd),
 	}
 }
 
@@ -104,38 +264,113 @@ func CityToProto(c domain.City) *entityv1.City {
 		PopulationCap:    c.PopulationCap,
 		Start:            &entityv1.Coordinates{X: int32(c.StartX), Y: int32(c.StartY)},
 		Size:             int32(c.Size),
+		Capital:          c.Capital,
 		FoodProduction:   RatePerHour(c.FoodProductionRate),
 		FoodUpkeep:       RatePerHour(c.FoodUpkeep),
 		NetFoodFlow:      RatePerHour(c.NetFoodFlow),
+		TaxRate:          int32(c.TaxRate),
+		TaxIncome:        RatePerHour(c.TaxIncome),
+		Morale:           c.Morale,
+		MoraleFactors:    MoraleFactorsToProto(c.MoraleFactors),
+		Unrest:           c.Unrest,
 		Starving:         c.Starving,
 		PopulationGrowth: RatePerHour(c.PopulationGrowthRate),
+		Troops:           c.Troops,
+		ImportPriority:   int32(c.ImportPriority),
+		FoodStore:        c.FoodStore,
+
+		ConstructionSlots: int32(c.ConstructionSlots),
 	}
 	if c.Owner != nil {
 		out.Owner = ToUserId(*c.Owner)
 	}
+	for _, o := range c.ConstructionQueue {
+		out.ConstructionQueue = append(out.ConstructionQueue, ConstructionOrderToProto(o))
+	}
+	return out
+}
+
+// MoraleFactorsToProto converts a city's morale breakdown.
+func MoraleFactorsToProto(f domain.MoraleFactors) *entityv1.MoraleFactors {
+	return &entityv1.MoraleFactors{
+		Base:      f.Base,
+		Food:      f.Food,
+		Tax:       f.Tax,
+		Crowding:  f.Crowding,
+		Amenities: f.Amenities,
+	}
+}
+
+// ConstructionOrderToProto converts a queued construction order to its proto
+// representation.
+func ConstructionOrderToProto(o domain.ConstructionOrder) *entityv1.ConstructionOrder {
+	out := &entityv1.ConstructionOrder{
+		OrderId: o.OrderID,
+		Type:    BuildingTypeToProto(o.BuildingType),
+		Coords:  &entityv1.Coordinates{X: int32(o.X), Y: int32(o.Y)},
+		Paid:    o.Paid,
+		TypeId:  string(o.BuildingType),
+	}
+	if o.BuildingID != nil {
+		out.BuildingId = ToBuildingId(*o.BuildingID)
+	}
 	return out
 }
 
-// HidePrivateCityFields blanks the production/upkeep rate fields on a city
-// proto. Call this when the viewer is not the city's owner: only the owner
-// gets to see economic intel (food_production, food_upkeep, net_food_flow).
-// Public fields (identity, location, population, population_cap, starving)
-// stay untouched. See the visibility note on the City proto.
+// HidePrivateCityFields blanks the production/upkeep rate fields and the
+// garrison on a city proto. Call this when the viewer is not the city's owner:
+// only the owner gets to see economic and military intel (food_production,
+// food_upkeep, net_food_flow, tax_rate, tax_income, morale, morale_factors,
+// troops, import_priority, construction_queue, construction_slots).
+// Public fields (identity, location, population, population_cap, starving,
+// unrest) stay untouched. See the visibility note on the City proto.
 func HidePrivateCityFields(c *entityv1.City) {
 	c.FoodProduction = nil
 	c.FoodUpkeep = nil
 	c.NetFoodFlow = nil
+	c.TaxRate = 0
+	c.TaxIncome = nil
+	c.Morale = 0
+	c.MoraleFactors = nil
+	c.Troops = 0
+	c.ImportPriority = 0
+	c.ConstructionQueue = nil
+	c.ConstructionSlots = 0
+	c.FoodStore = 0
+}
+
+func TerrainToProto(t domain.Terrain) entityv1.Terrain {
+	return terrainToProto[t]
+}
+
+func DepositKindToProto(k domain.DepositKind) entityv1.DepositKind {
+	return depositKindToProto[k]
+}
+
+// DepositToProto converts a tile's deposit, nil when it has none.
+func DepositToProto(d *domain.Deposit) *entityv1.Deposit {
+	if d == nil {
+		return nil
+	}
+	return &entityv1.Deposit{Kind: DepositKindToProto(d.Kind), Richness: d.Richness}
 }
 
-// TileToProto builds a proto Tile from raw occupancy data.
-func TileToProto(cityID, buildingID *string, x, y int) *servicev1.Tile {
-	t := &servicev1.Tile{X: int32(x), Y: int32(y)}
+// TileToProto builds a proto Tile from its terrain, deposit and raw occupancy
+// data.
+func TileToProto(terrain domain.Terrain, deposit *domain.Deposit, cityID, buildingID *string, armyIDs, caravanIDs []string, x, y int) *servicev1.Tile {
+	t := &servicev1.Tile{X: int32(x), Y: int32(y), Terrain: TerrainToProto(terrain), Deposit: DepositToProto(deposit)}
 	if cityID != nil {
 		t.CityId = ToCityId(*cityID)
 	}
 	if buildingID != nil {
 		t.BuildingId = ToBuildingId(*buildingID)
 	}
+	for _, id := range armyIDs {
+		t.ArmyIds = append(t.ArmyIds, ToArmyId(id))
+	}
+	for _, id := range caravanIDs {
+		t.CaravanIds = append(t.CaravanIds, ToCaravanId(id))
+	}
 	return t
 }
 
@@ -148,6 +383,7 @@ func BuildingToProto(b domain.Building) *entityv1.Building {
 		Level:       int32(b.Level),
 		TargetLevel: int32(b.TargetLevel),
 		Coords:      &entityv1.Coordinates{X: int32(b.X), Y: int32(b.Y)},
+		TypeId:      string(b.BuildingType()),
 	}
 	if b.ConstructionStart.Time != nil {
 		out.ConstructionStart = timestamppb.New(*b.ConstructionStart.Time)
@@ -155,10 +391,128 @@ func BuildingToProto(b domain.Building) *entityv1.Building {
 	if b.ConstructionEnd.Time != nil {
 		out.ConstructionEnd = timestamppb.New(*b.ConstructionEnd.Time)
 	}
+	for _, t := range b.TrainingQueue {
+		out.TrainingQueue = append(out.TrainingQueue, TrainingToProto(t))
+	}
+	for _, bonus := range b.Bonuses {
+		out.AdjacencyBonuses = append(out.AdjacencyBonuses, &entityv1.AdjacencyBonus{
+			Rule:       bonus.Rule,
+			Resource:   bonus.Resource,
+			Percent:    bonus.Percent,
+			Neighbours: int32(bonus.Neighbours),
+		})
+	}
+	return out
+}
+
+// HidePrivateBuildingFields blanks the training queue on a building proto.
+// Call this when the viewer is not the building's owner.
+func HidePrivateBuildingFields(b *entityv1.Building) {
+	b.TrainingQueue = nil
+}
+
+func TrainingToProto(t domain.Training) *entityv1.TroopTraining {
+	out := &entityv1.TroopTraining{
+		TrainingId: t.TrainingID,
+		Troops:     t.Troops,
+	}
+	if t.TrainingStart.Time != nil {
+		out.TrainingStart = timestamppb.New(*t.TrainingStart.Time)
+	}
+	if t.TrainingEnd.Time != nil {
+		out.TrainingEnd = timestamppb.New(*t.TrainingEnd.Time)
+	}
+	return out
+}
+
+// ArmyToProto converts a domain army to its proto representation.
+func ArmyToProto(a domain.Army) *entityv1.Army {
+	out := &entityv1.Army{
+		ArmyId:      ToArmyId(a.ArmyID),
+		Owner:       ToUserId(a.Owner),
+		CityId:      ToCityId(a.CityID),
+		Troops:      a.Troops,
+		Coords:      &entityv1.Coordinates{X: int32(a.X), Y: int32(a.Y)},
+		Destination: &entityv1.Coordinates{X: int32(a.DestinationX), Y: int32(a.DestinationY)},
+	}
+	if a.NextStepAt.Time != nil {
+		out.NextStepAt = timestamppb.New(*a.NextStepAt.Time)
+	}
+	return out
+}
+
+func CaravanToProto(c domain.Caravan) *entityv1.Caravan {
+	out := &entityv1.Caravan{
+		CaravanId:         ToCaravanId(c.CaravanID),
+		Owner:             ToUserId(c.Owner),
+		SourceCityId:      ToCityId(c.SourceCityID),
+		DestinationCityId: ToCityId(c.DestinationCityID),
+		Gold:              c.Gold,
+		Food:              c.Food,
+		Coords:            &entityv1.Coordinates{X: int32(c.X), Y: int32(c.Y)},
+		Destination:       &entityv1.Coordinates{X: int32(c.DestinationX), Y: int32(c.DestinationY)},
+	}
+	if c.NextStepAt.Time != nil {
+		out.NextStepAt = timestamppb.New(*c.NextStepAt.Time)
+	}
 	return out
 }
 
 // EntitiesToBag builds an EntityBag from slices of domain entities.
+func BattleReportToProto(r domain.BattleReport) *entityv1.BattleReport {
+	out := &entityv1.BattleReport{
+		ReportId:        ToBattleReportId(r.ReportID),
+		ArmyId:          ToArmyId(r.ArmyID),
+		Attacker:        ToUserId(r.Attacker),
+		CityId:          ToCityId(r.CityID),
+		Coords:          &entityv1.Coordinates{X: int32(r.X), Y: int32(r.Y)},
+		AttackerTroops:  r.AttackerTroops,
+		DefenderTroops:  r.DefenderTroops,
+		AttackerLosses:  r.AttackerLosses,
+		DefenderLosses:  r.DefenderLosses,
+		Outcome:         battleOutcomeToProto[r.Outcome],
+		Rounds:          int32(r.Rounds),
+		Seed:            r.Seed,
+		CityCenterLevel: int32(r.CityCenterLevel),
+		TerrainDefense:  r.TerrainDefense,
+		FoughtAt:        timestamppb.New(r.FoughtAt),
+	}
+	if r.Defender != nil {
+		out.Defender = ToUserId(*r.Defender)
+	}
+	return out
+}
+
+func WorldToProto(w domain.World) *entityv1.World {
+	return &entityv1.World{
+		Season:          int32(w.Season),
+		State:           worldStateToProto[w.State],
+		SeasonStartedAt: timestamppb.New(w.SeasonStartedAt),
+		SeasonEndsAt:    timestamppb.New(w.SeasonEndsAt),
+	}
+}
+
+func SeasonToProto(s domain.Season) *entityv1.Season {
+	return &entityv1.Season{
+		Season:    int32(s.Season),
+		Seed:      s.Seed,
+		EndReason: seasonEndReasonToProto[s.EndReason],
+		StartedAt: timestamppb.New(s.StartedAt),
+		EndedAt:   timestamppb.New(s.EndedAt),
+	}
+}
+
+func SeasonStandingToProto(s domain.SeasonStanding) *entityv1.SeasonStanding {
+	return &entityv1.SeasonStanding{
+		Rank:       int32(s.Rank),
+		UserId:     ToUserId(s.UserID),
+		Username:   s.Username,
+		Cities:     int32(s.Cities),
+		Population: s.Population,
+		Gold:       s.Gold,
+	}
+}
+
 func EntitiesToBag(users []domain.User, cities []domain.City, buildings []domain.Building) *entityv1.EntityBag {
 	bag := &entityv1.EntityBag{}
 	for _, u := range users {
@@ -172,3 +526,150 @@ func EntitiesToBag(users []domain.User, cities []domain.City, buildings []domain
 	}
 	return bag
 }
+
+// OrderSideFromProto maps a proto order side to its domain value. Unknown
+// values map to the empty side, which is not Valid.
+func OrderSideFromProto(s entityv1.OrderSide) domain.OrderSide {
+	return orderSideFromProto[s]
+}
+
+// MarketOrderToProto converts a domain market order to its proto
+// representation.
+func MarketOrderToProto(o domain.MarketOrder) *entityv1.MarketOrder {
+	return &entityv1.MarketOrder{
+		OrderId:   o.OrderID,
+		MarketId:  string(o.MarketID),
+		UserId:    ToUserId(o.UserID),
+		Side:      orderSideToProto[o.Side],
+		Price:     o.Price,
+		Quantity:  o.Quantity,
+		Remaining: o.Remaining,
+		CreatedAt: timestamppb.New(o.CreatedAt),
+	}
+}
+
+// TradeToProto converts a domain trade to its proto representation. The
+// order IDs stay server-side: they would tie a trade to the players' orders.
+func TradeToProto(t domain.Trade) *entityv1.Trade {
+	return &entityv1.Trade{
+		TradeId:    t.TradeID,
+		MarketId:   string(t.MarketID),
+		Buyer:      ToUserId(t.Buyer),
+		Seller:     ToUserId(t.Seller),
+		Price:      t.Price,
+		Quantity:   t.Quantity,
+		ExecutedAt: timestamppb.New(t.ExecutedAt),
+	}
+}
+
+// MarketTickerToProto converts a market ticker to its proto representation.
+func MarketTickerToProto(t domain.MarketTicker) *entityv1.MarketTicker {
+	out := &entityv1.MarketTicker{
+		MarketId:  string(t.MarketID),
+		BestBid:   t.BestBid,
+		BestAsk:   t.BestAsk,
+		LastPrice: t.LastPrice,
+	}
+	for _, tr := range t.RecentTrades {
+		out.RecentTrades = append(out.RecentTrades, TradeToProto(tr))
+	}
+	return out
+}
+
+// AllianceRoleFromProto maps a proto alliance role to its domain value.
+// Unknown values map to the empty role, which is not Valid.
+func AllianceRoleFromProto(r entityv1.AllianceRole) domain.AllianceRole {
+	return allianceRoleFromProto[r]
+}
+
+// AllianceToProto converts a domain alliance and its roster to its proto
+// representation.
+func AllianceToProto(a domain.Alliance) *entityv1.Alliance {
+	out := &entityv1.Alliance{
+		AllianceId: ToAllianceId(a.AllianceID),
+		Name:       a.Name,
+		CreatedAt:  timestamppb.New(a.CreatedAt),
+	}
+	for _, m := range a.Members {
+		out.Members = append(out.Members, &entityv1.AllianceMember{
+			UserId:   ToUserId(m.UserID),
+			Username: m.Username,
+			Role:     allianceRoleToProto[m.Role],
+			JoinedAt: timestamppb.New(m.JoinedAt),
+		})
+	}
+	return out
+}
+
+// AllianceInviteToProto converts a domain alliance invite to its proto
+// representation.
+func AllianceInviteToProto(i domain.AllianceInvite) *entityv1.AllianceInvite {
+	return &entityv1.AllianceInvite{
+		AllianceId:   ToAllianceId(i.AllianceID),
+		AllianceName: i.AllianceName,
+		UserId:       ToUserId(i.UserID),
+		InvitedBy:    ToUserId(i.InvitedBy),
+		CreatedAt:    timestamppb.New(i.CreatedAt),
+	}
+}
+
+// RelationStateFromProto maps a proto relation state to its domain value.
+// Unknown values map to the empty state, which is not Valid.
+func RelationStateFromProto(s entityv1.RelationState) domain.RelationState {
+	return relationStateFromProto[s]
+}
+
+// RelationToProto converts a domain relation to its proto representation as
+// seen by userID, one side of it.
+func RelationToProto(r domain.Relation, userID string) *entityv1.Relation {
+	out := &entityv1.Relation{
+		UserId:        ToUserId(userID),
+		OtherUserId:   ToUserId(r.Other(userID)),
+		State:         relationStateToProto[r.State],
+		PendingState:  relationStateToProto[r.PendingState],
+		ProposedState: relationStateToProto[r.ProposedState],
+	}
+	if r.EffectiveAt.Time != nil {
+		out.EffectiveAt = timestamppb.New(*r.EffectiveAt.Time)
+	}
+	if r.ProposedBy != "" {
+		out.ProposedBy = ToUserId(r.ProposedBy)
+	}
+	if !r.UpdatedAt.IsZero() {
+		out.UpdatedAt = timestamppb.New(r.UpdatedAt)
+	}
+	return out
+}
+
+// ChatChannelKindFromProto maps a proto chat channel kind to its domain
+// value. Unknown values map to the empty kind.
+func ChatChannelKindFromProto(k entityv1.ChatChannelKind) domain.ChatChannelKind {
+	return chatChannelKindFromProto[k]
+}
+
+// ChatMessageToProto converts a domain chat message to its proto
+// representation as seen by userID. A direct channel is named by the other
+// player, so each side sees the conversation under the player they talk to.
+func ChatMessageToProto(m domain.ChatMessage, userID string) *entityv1.ChatMessage {
+	channel := &entityv1.ChatChannel{Kind: chatChannelKindToProto[m.Channel.Kind]}
+	switch m.Channel.Kind {
+	case domain.ChatChannelAlliance:
+		channel.AllianceId = ToAllianceId(m.Channel.ID)
+	case domain.ChatChannelDirect:
+		if a, b, ok := m.Channel.Participants(); ok {
+			other := a
+			if a == userID {
+				other = b
+			}
+			channel.UserId = ToUserId(other)
+		}
+	}
+	return &entityv1.ChatMessage{
+		MessageId:  ToChatMessageId(m.MessageID),
+		Channel:    channel,
+		SenderId:   ToUserId(m.Sender),
+		SenderName: m.SenderName,
+		Body:       m.Body,
+		SentAt:     timestamppb.New(m.SentAt),
+	}
+}
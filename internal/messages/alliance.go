@@ -0,0 +1,134 @@
+package messages
+
+import (
+	"fmt"
+
+	"cityio/internal/domain"
+)
+
+// CreateAllianceMessage founds an alliance under the receiving actor's ID,
+// with LeaderID as its only member.
+type CreateAllianceMessage struct {
+	Name     string
+	LeaderID string
+}
+
+type CreateAllianceResponse struct {
+	Alliance domain.Alliance
+}
+
+type GetAllianceMessage struct{}
+
+type GetAllianceResponse struct {
+	Alliance domain.Alliance
+	Invites  []domain.AllianceInvite
+}
+
+// InviteAllianceMemberMessage invites InviteeID on behalf of UserID, who must
+// be the alliance's leader or an officer.
+type InviteAllianceMemberMessage struct {
+	UserID    string
+	InviteeID string
+}
+
+type InviteAllianceMemberResponse struct {
+	Invite domain.AllianceInvite
+}
+
+// AcceptAllianceInviteMessage joins UserID to the alliance on a standing
+// invite. Their other invites are dropped.
+type AcceptAllianceInviteMessage struct {
+	UserID string
+}
+
+type AcceptAllianceInviteResponse struct {
+	Alliance domain.Alliance
+}
+
+// LeaveAllianceMessage takes UserID out of the alliance. A leader who leaves
+// hands over to their successor; the last member to leave disbands it. The
+// alliance responds Ack.
+type LeaveAllianceMessage struct {
+	UserID string
+}
+
+// KickAllianceMemberMessage removes MemberID on behalf of UserID. The
+// alliance responds Ack.
+type KickAllianceMemberMessage struct {
+	UserID   string
+	MemberID string
+}
+
+// SetAllianceRoleMessage changes MemberID's role on behalf of UserID, who
+// must be the leader. Making someone leader hands the lead over, and the old
+// leader becomes an officer. The alliance responds Ack.
+type SetAllianceRoleMessage struct {
+	UserID   string
+	MemberID string
+	Role     domain.AllianceRole
+}
+
+// Errors
+type UnknownAllianceError struct {
+	AllianceID string
+}
+
+func (e *UnknownAllianceError) Error() string {
+	return fmt.Sprintf("Unknown alliance: %s", e.AllianceID)
+}
+
+type InvalidAllianceError struct {
+	Reason string
+}
+
+func (e *InvalidAllianceError) Error() string {
+	return fmt.Sprintf("Invalid alliance request: %s", e.Reason)
+}
+
+type AllianceNameTakenError struct {
+	Name string
+}
+
+func (e *AllianceNameTakenError) Error() string {
+	return fmt.Sprintf("Alliance name already taken: %s", e.Name)
+}
+
+type AlreadyInAllianceError struct {
+	UserID string
+}
+
+func (e *AlreadyInAllianceError) Error() string {
+	return fmt.Sprintf("User %s is already in an alliance", e.UserID)
+}
+
+type NotAllianceMemberError struct {
+	UserID string
+}
+
+func (e *NotAllianceMemberError) Error() string {
+	return fmt.Sprintf("User %s is not a member of the alliance", e.UserID)
+}
+
+type AlliancePermissionError struct {
+	Role domain.AllianceRole
+}
+
+func (e *AlliancePermissionError) Error() string {
+	return fmt.Sprintf("Not allowed for alliance role %s", e.Role)
+}
+
+type AllianceInviteNotFoundError struct {
+	AllianceID string
+}
+
+func (e *AllianceInviteNotFoundError) Error() string {
+	return fmt.Sprintf("No invite to alliance %s", e.AllianceID)
+}
+
+type AllianceFullError struct {
+	Limit int
+}
+
+func (e *AllianceFullError) Error() string {
+	return fmt.Sprintf("Alliance is full: limit is %d members", e.Limit)
+}
@@ -1 +1,46 @@
 package messages
+
+import (
+	"fmt"
+
+	"cityio/internal/domain"
+)
+
+type CreateArmyMessage struct {
+	Army    domain.Army
+	Restore bool
+}
+
+// MoveArmyMessage points an army at a new destination. The army re-plans from
+// its current tile and keeps stepping on its own one-shot timer.
+type MoveArmyMessage struct {
+	X int
+	Y int
+}
+
+type GetArmyMessage struct{}
+type GetArmyResponseMessage struct {
+	Army domain.Army
+}
+
+type DeleteArmyMessage struct {
+	ArmyID string
+}
+
+// Errors
+type ArmyNotFoundError struct {
+	ArmyID string
+}
+
+func (e *ArmyNotFoundError) Error() string {
+	return fmt.Sprintf("Army not found: %s", e.ArmyID)
+}
+
+type OutOfBoundsError struct {
+	X int
+	Y int
+}
+
+func (e *OutOfBoundsError) Error() string {
+	return fmt.Sprintf("Coordinates out of bounds: (%d, %d)", e.X, e.Y)
+}
@@ -2,6 +2,7 @@ package messages
 
 import (
 	"fmt"
+	"time"
 
 	"cityio/internal/domain"
 )
@@ -10,8 +11,41 @@ type CreateBuildingMessage struct {
 	Building  domain.Building
 	Restore   bool
 	Construct bool
+	// Techs are the owner's researched techs, timing a new construction.
+	// Restored buildings get theirs from the city.
+	Techs []domain.TechID
+}
+
+// UpgradeBuildingMessage starts the next level. Prepaid is gold the city
+// already took for a queued upgrade; the building then skips charging, and if
+// it can't start it tells the city ConstructionOrderRejectedMessage so the
+// gold is refunded.
+type UpgradeBuildingMessage struct {
+	Prepaid int64
+}
+
+// CancelConstructionMessage abandons the building's active construction. The
+// building responds CancelConstructionResponseMessage or
+// NoConstructionInProgressError.
+type CancelConstructionMessage struct{}
+type CancelConstructionResponseMessage struct {
+	Refund int64
+	// Removed is true when the building was still being built for the first
+	// time and has been removed.
+	Removed bool
+}
+
+// SpeedUpConstructionMessage buys off Skip of the building's remaining
+// construction time, or all of it when Skip is zero or covers the rest. The
+// building charges its city's owner and responds
+// SpeedUpConstructionResponseMessage or the error that stopped it.
+type SpeedUpConstructionMessage struct {
+	Skip time.Duration
+}
+type SpeedUpConstructionResponseMessage struct {
+	Cost     int64
+	Building domain.Building
 }
-type UpgradeBuildingMessage struct{}
 
 type GetBuildingMessage struct{}
 
@@ -19,15 +53,15 @@ type DeleteBuildingMessage struct {
 	BuildingID string
 }
 
-// type TrainTroopsMessage struct {
-// 	Training domain.Training
-// }
-// type RestoreTrainingMessage struct {
-// 	Training domain.Training
-// }
-// type DeleteTrainingMessage struct {
-// 	BarracksId string
-// }
+// TrainTroopsMessage orders a batch of troops at a barracks. The barracks
+// charges its city's owner and responds Ack, or the error that stopped it.
+type TrainTroopsMessage struct {
+	Troops int64
+}
+
+// RepublishBuildingMessage asks a building to send its current state to its
+// city again, e.g. so a city's new owner receives it.
+type RepublishBuildingMessage struct{}
 
 type GetBuildingResponseMessage struct {
 	Building domain.Building
@@ -40,6 +74,12 @@ type BuildingStateChangedMessage struct {
 	Building domain.Building
 }
 
+// SetAdjacencyBonusesMessage is told by a city to a building whose adjacency
+// bonuses changed. The building reports its new state back.
+type SetAdjacencyBonusesMessage struct {
+	Bonuses []domain.AdjacencyBonus
+}
+
 // // Errors
 // type BuildingTypeNotFoundError struct {
 // 	BuildingType string
@@ -49,21 +89,83 @@ type BuildingStateChangedMessage struct {
 // 	return fmt.Sprintf("Building type not found: %s", e.BuildingType)
 // }
 
-// type BuildingNotFoundError struct {
-// 	BuildingId string
-// }
+type BuildingNotFoundError struct {
+	BuildingId string
+}
 
-// func (e *BuildingNotFoundError) Error() string {
-// 	return fmt.Sprintf("Building not found: %s", e.BuildingId)
-// }
+func (e *BuildingNotFoundError) Error() string {
+	return fmt.Sprintf("Building not found: %s", e.BuildingId)
+}
 
-// type TrainingAlreadyExistsError struct {
-// 	BarracksId string
-// }
+type InvalidBuildingTypeError struct {
+	BuildingType domain.BuildingType
+}
 
-// func (e *TrainingAlreadyExistsError) Error() string {
-// 	return fmt.Sprintf("Training already exists for barracks: %s", e.BarracksId)
-// }
+func (e *InvalidBuildingTypeError) Error() string {
+	return fmt.Sprintf("Building type cannot be constructed: %q", e.BuildingType)
+}
+
+type TileOccupiedError struct {
+	X          int
+	Y          int
+	BuildingID string
+}
+
+func (e *TileOccupiedError) Error() string {
+	return fmt.Sprintf("Tile (%d, %d) is occupied by building: %s", e.X, e.Y, e.BuildingID)
+}
+
+type OutOfCityBoundsError struct {
+	CityID string
+	X      int
+	Y      int
+}
+
+func (e *OutOfCityBoundsError) Error() string {
+	return fmt.Sprintf("Tile (%d, %d) is outside city: %s", e.X, e.Y, e.CityID)
+}
+
+// TerrainNotAllowedError rejects a building whose type can't stand on the
+// tile's terrain, e.g. a farm on mountains.
+type TerrainNotAllowedError struct {
+	X            int
+	Y            int
+	Terrain      domain.Terrain
+	BuildingType domain.BuildingType
+}
+
+func (e *TerrainNotAllowedError) Error() string {
+	return fmt.Sprintf("Cannot build %s on %s at (%d, %d)", e.BuildingType, e.Terrain, e.X, e.Y)
+}
+
+// MissingDepositError rejects a mine or farm placed on a tile without the
+// deposit it works.
+type MissingDepositError struct {
+	X            int
+	Y            int
+	BuildingType domain.BuildingType
+	Required     domain.DepositKind
+}
+
+func (e *MissingDepositError) Error() string {
+	return fmt.Sprintf("Cannot build %s at (%d, %d) without %s", e.BuildingType, e.X, e.Y, e.Required)
+}
+
+type TrainingQueueFullError struct {
+	BarracksID string
+}
+
+func (e *TrainingQueueFullError) Error() string {
+	return fmt.Sprintf("Training queue full for barracks: %s", e.BarracksID)
+}
+
+type BuildingNotReadyError struct {
+	BuildingID string
+}
+
+func (e *BuildingNotReadyError) Error() string {
+	return fmt.Sprintf("Building not yet constructed: %s", e.BuildingID)
+}
 
 type ConstructionInProgressError struct {
 	BuildingID string
@@ -73,6 +175,14 @@ func (e *ConstructionInProgressError) Error() string {
 	return fmt.Sprintf("Construction already active for building: %s", e.BuildingID)
 }
 
+type NoConstructionInProgressError struct {
+	BuildingID string
+}
+
+func (e *NoConstructionInProgressError) Error() string {
+	return fmt.Sprintf("No construction in progress for building: %s", e.BuildingID)
+}
+
 type MaxLevelReachedError struct {
 	BuildingID string
 }
@@ -80,3 +190,38 @@ type MaxLevelReachedError struct {
 func (e *MaxLevelReachedError) Error() string {
 	return fmt.Sprintf("Max level reached for building: %s", e.BuildingID)
 }
+
+// PrerequisiteNotMetError rejects a building whose city lacks a building it
+// requires, e.g. barracks before the center reaches level 2.
+type PrerequisiteNotMetError struct {
+	BuildingType domain.BuildingType
+	Requires     domain.BuildingType
+	Level        int
+}
+
+func (e *PrerequisiteNotMetError) Error() string {
+	return fmt.Sprintf("%s requires %s level %d", e.BuildingType, e.Requires, e.Level)
+}
+
+// CenterLevelTooLowError rejects an upgrade past the level of the city's
+// center.
+type CenterLevelTooLowError struct {
+	BuildingType domain.BuildingType
+	Level        int
+	CenterLevel  int
+}
+
+func (e *CenterLevelTooLowError) Error() string {
+	return fmt.Sprintf("Cannot take %s to level %d with a level %d center", e.BuildingType, e.Level, e.CenterLevel)
+}
+
+// BuildingLimitReachedError rejects a building once the city holds as many
+// of its type as its center level allows.
+type BuildingLimitReachedError struct {
+	BuildingType domain.BuildingType
+	Limit        int
+}
+
+func (e *BuildingLimitReachedError) Error() string {
+	return fmt.Sprintf("City already has the maximum of %d %s", e.Limit, e.BuildingType)
+}
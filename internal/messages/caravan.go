@@ -0,0 +1,63 @@
+package messages
+
+import (
+	"fmt"
+
+	"cityio/internal/domain"
+)
+
+type CreateCaravanMessage struct {
+	Caravan domain.Caravan
+	Restore bool
+}
+
+type GetCaravanMessage struct{}
+type GetCaravanResponseMessage struct {
+	Caravan domain.Caravan
+}
+
+// InterceptCaravanMessage is told by an army entering (X, Y) to every caravan
+// on that tile. A caravan still there whose owner is at war with the army's
+// is captured, its cargo going to the army's owner.
+type InterceptCaravanMessage struct {
+	ArmyID string
+	Owner  string
+	X      int
+	Y      int
+}
+
+// LoadCaravanMessage takes a new caravan's cargo at its source city: food
+// from the city's FoodStore and gold from the city's owner. The city responds
+// Ack, InsufficientStoredFoodError or InsufficientGoldError, taking nothing
+// unless both are covered.
+type LoadCaravanMessage struct {
+	Gold int64
+	Food int64
+}
+
+// UnloadCaravanMessage hands a caravan's cargo to the city it arrived at.
+// Food goes into the city's FoodStore, gold to the city's owner. The city
+// responds Ack.
+type UnloadCaravanMessage struct {
+	CaravanID string
+	Gold      int64
+	Food      int64
+}
+
+// Errors
+type InvalidCaravanError struct {
+	Reason string
+}
+
+func (e *InvalidCaravanError) Error() string {
+	return fmt.Sprintf("Invalid caravan: %s", e.Reason)
+}
+
+type InsufficientStoredFoodError struct {
+	CityID  string
+	Missing int64
+}
+
+func (e *InsufficientStoredFoodError) Error() string {
+	return fmt.Sprintf("City %s has insufficient stored food: %d", e.CityID, e.Missing)
+}
@@ -0,0 +1,59 @@
+package messages
+
+import (
+	"fmt"
+	"time"
+
+	"cityio/internal/domain"
+)
+
+// Chat is sent through the sender's user actor, which holds their mute and
+// rate limit. Mutes are written through so they survive a restart.
+
+// SendChatMessage posts Body to Channel on behalf of the receiving user. For
+// an alliance channel the user must be a member; the RPC layer has already
+// checked that the other side of a direct channel exists.
+type SendChatMessage struct {
+	Channel domain.ChatChannel
+	Body    string
+}
+
+type SendChatResponse struct {
+	Message domain.ChatMessage
+}
+
+// MuteUserMessage bars the receiving user from chat until Until, replacing
+// any mute they already have. The user responds Ack.
+type MuteUserMessage struct {
+	Until   time.Time
+	Reason  string
+	MutedBy string
+}
+
+// UnmuteUserMessage lifts the receiving user's mute. The user responds Ack.
+type UnmuteUserMessage struct{}
+
+// Errors
+type ChatMutedError struct {
+	Until time.Time
+}
+
+func (e *ChatMutedError) Error() string {
+	return fmt.Sprintf("Muted until %s", e.Until.UTC().Format(time.RFC3339))
+}
+
+type ChatRateLimitedError struct {
+	RetryAfter time.Duration // whole seconds
+}
+
+func (e *ChatRateLimitedError) Error() string {
+	return fmt.Sprintf("Sending too fast, retry in %s", e.RetryAfter)
+}
+
+type InvalidChatMessageError struct {
+	Reason string
+}
+
+func (e *InvalidChatMessageError) Error() string {
+	return fmt.Sprintf("Invalid chat message: %s", e.Reason)
+}
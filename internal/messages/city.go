@@ -11,6 +11,13 @@ type CreateCityMessage struct {
 	Restore bool
 }
 
+// CatchUpMessage replays the ticks a restored city missed while the server was
+// down, then starts its ticker. Sent once all of the city's buildings have been
+// restored; the city responds Ack.
+type CatchUpMessage struct{}
+
+// UpdateCityOwnerMessage transfers a city to a new owner, e.g. on conquest.
+// The city responds Ack when asked with a Request.
 type UpdateCityOwnerMessage struct {
 	Owner *string
 }
@@ -31,10 +38,114 @@ type CreditProductionMessage struct {
 	Food int64
 }
 
-// DeductOwnerGoldMessage asks a city to deduct gold from its owner (e.g. for a
-// building upgrade), relaying the owner's Ack or InsufficientGoldError.
+// DeductOwnerGoldMessage asks a city to deduct gold, and optionally food, from
+// its owner (e.g. for a building upgrade or troop training), relaying the
+// owner's Ack, InsufficientGoldError or InsufficientFoodError. Nothing is
+// deducted unless both amounts are covered.
 type DeductOwnerGoldMessage struct {
 	Amount int64
+	Food   int64
+}
+
+// StartUpgradeMessage asks a city to start a direct (unqueued) upgrade of one
+// of its buildings to Level. The city checks its building rules and for a free
+// construction slot, charges its owner and holds the slot until the building
+// reports the construction, responding StartUpgradeResponseMessage with the
+// gold charged or the error that stopped it.
+type StartUpgradeMessage struct {
+	BuildingID   string
+	BuildingType domain.BuildingType
+	Level        int
+}
+type StartUpgradeResponseMessage struct {
+	Charged int64
+}
+
+// PlaceBuildingMessage asks a city to construct a new level-1 building inside
+// its block. The city checks the tile, charges its owner, and starts the
+// building, responding PlaceBuildingResponseMessage or the error that stopped
+// it. Placements in one city are serialized, so two can't claim the same tile.
+type PlaceBuildingMessage struct {
+	Building domain.Building
+}
+type PlaceBuildingResponseMessage struct {
+	Building domain.Building
+}
+
+// EnqueueConstructionMessage adds an order to the city's build queue: an
+// upgrade of BuildingID, or a new building of BuildingType at (X, Y) when
+// BuildingID is nil. The city responds EnqueueConstructionResponseMessage or
+// the error that rejected the order.
+type EnqueueConstructionMessage struct {
+	BuildingID   *string
+	BuildingType domain.BuildingType
+	X            int
+	Y            int
+}
+type EnqueueConstructionResponseMessage struct {
+	Order domain.ConstructionOrder
+}
+
+// ReorderConstructionMessage rearranges the build queue. OrderIDs must name
+// every queued order exactly once. Responds Ack or InvalidQueueOrderError.
+type ReorderConstructionMessage struct {
+	OrderIDs []string
+}
+
+// CancelConstructionOrderMessage drops a queued order, refunding anything
+// paid for it. Responds Ack or ConstructionOrderNotFoundError.
+type CancelConstructionOrderMessage struct {
+	OrderID string
+}
+
+// ConstructionOrderRejectedMessage is told by a building that could not start
+// a queued upgrade, so the city refunds Refund to its owner.
+type ConstructionOrderRejectedMessage struct {
+	BuildingID string
+	Refund     int64
+}
+
+// RefundOwnerGoldMessage returns gold to the city's owner, e.g. for a
+// cancelled construction.
+type RefundOwnerGoldMessage struct {
+	Amount int64
+}
+
+// WithdrawTroopsMessage takes troops out of a city's garrison to raise an
+// army. The city responds Ack or InsufficientTroopsError.
+type WithdrawTroopsMessage struct {
+	Amount int64
+}
+
+// DepositTroopsMessage adds troops to a city's garrison, e.g. an army
+// returning home.
+type DepositTroopsMessage struct {
+	Amount int64
+}
+
+// GarrisonCasualtiesMessage removes troops lost defending the city. Losses
+// beyond the current garrison are ignored.
+type GarrisonCasualtiesMessage struct {
+	Amount int64
+}
+
+// FoodPoolGrantMessage answers a RequestFoodFromPoolMessage once the owner's
+// allocation window closes. Granted < Requested means the pool ran short.
+type FoodPoolGrantMessage struct {
+	Requested int64
+	Granted   int64
+}
+
+// SetImportPriorityMessage sets the city's claim order on its owner's food
+// pool under the priority policy.
+type SetImportPriorityMessage struct {
+	Priority int
+}
+
+// SetTaxRateMessage sets the city's tax rate, in percent. The rate is
+// validated by the caller.
+type SetTaxRateMessage struct {
+	Rate int
 }
 
 type BuildingDestroyedMessage struct {
@@ -58,3 +169,43 @@ type CityNotFoundError struct {
 func (e *CityNotFoundError) Error() string {
 	return fmt.Sprintf("City not found: %s", e.CityId)
 }
+
+type InsufficientTroopsError struct {
+	Missing int64
+}
+
+func (e *InsufficientTroopsError) Error() string {
+	return fmt.Sprintf("City has insufficient troops: %d", e.Missing)
+}
+
+type ConstructionQueueFullError struct {
+	CityID string
+}
+
+func (e *ConstructionQueueFullError) Error() string {
+	return fmt.Sprintf("Construction queue full for city: %s", e.CityID)
+}
+
+type NoFreeConstructionSlotError struct {
+	CityID string
+}
+
+func (e *NoFreeConstructionSlotError) Error() string {
+	return fmt.Sprintf("No free construction slot in city: %s", e.CityID)
+}
+
+type ConstructionOrderNotFoundError struct {
+	OrderID string
+}
+
+func (e *ConstructionOrderNotFoundError) Error() string {
+	return fmt.Sprintf("Construction order not found: %s", e.OrderID)
+}
+
+type InvalidQueueOrderError struct {
+	CityID string
+}
+
+func (e *InvalidQueueOrderError) Error() string {
+	return fmt.Sprintf("Order IDs must list every queued order once for city: %s", e.CityID)
+}
@@ -0,0 +1,79 @@
+package messages
+
+import (
+	"fmt"
+
+	"cityio/internal/domain"
+)
+
+// PlaceMarketOrderMessage escrows the order's funds from the user and matches
+// it against the book. Whatever doesn't fill rests in the book.
+type PlaceMarketOrderMessage struct {
+	UserID   string
+	Side     domain.OrderSide
+	Price    int64
+	Quantity int64
+}
+
+type PlaceMarketOrderResponse struct {
+	Order  domain.MarketOrder
+	Trades []domain.Trade
+}
+
+// CancelMarketOrderMessage takes a user's resting order out of the book and
+// returns the escrow for its unfilled part.
+type CancelMarketOrderMessage struct {
+	UserID  string
+	OrderID string
+}
+
+type CancelMarketOrderResponse struct {
+	Order domain.MarketOrder
+}
+
+type ListMarketOrdersMessage struct {
+	UserID string
+}
+
+type ListMarketOrdersResponse struct {
+	Orders []domain.MarketOrder
+}
+
+type GetMarketTickerMessage struct{}
+
+type GetMarketTickerResponse struct {
+	Ticker domain.MarketTicker
+}
+
+// Errors
+type UnknownMarketError struct {
+	MarketID domain.MarketID
+}
+
+func (e *UnknownMarketError) Error() string {
+	return fmt.Sprintf("Unknown market: %s", e.MarketID)
+}
+
+type InvalidMarketOrderError struct {
+	Reason string
+}
+
+func (e *InvalidMarketOrderError) Error() string {
+	return fmt.Sprintf("Invalid market order: %s", e.Reason)
+}
+
+type MarketOrderNotFoundError struct {
+	OrderID string
+}
+
+func (e *MarketOrderNotFoundError) Error() string {
+	return fmt.Sprintf("Market order not found: %s", e.OrderID)
+}
+
+type TooManyMarketOrdersError struct {
+	Limit int
+}
+
+func (e *TooManyMarketOrdersError) Error() string {
+	return fmt.Sprintf("Too many open market orders: limit is %d", e.Limit)
+}
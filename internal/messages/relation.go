@@ -0,0 +1,92 @@
+package messages
+
+import (
+	"fmt"
+
+	"cityio/internal/domain"
+)
+
+// Relation actors are keyed by domain.RelationID. Every change below is made
+// on behalf of UserID, who must be one side of the relation, and answered
+// with a RelationResponse.
+
+type GetRelationMessage struct{}
+
+// RestoreRelationMessage activates a relation after a restart so the timer
+// of its pending change is armed again. The relation responds Ack.
+type RestoreRelationMessage struct{}
+
+// RelationChangeDueMessage is sent by a relation to itself when its pending
+// change is due.
+type RelationChangeDueMessage struct{}
+
+// DeclareWarMessage starts a war after the declaration delay. Only a neutral
+// relation between players who aren't allied can go to war.
+type DeclareWarMessage struct {
+	UserID string
+}
+
+// ProposeTreatyMessage puts State, peace or a non-aggression pact, on the
+// table. Proposing what the other side already proposed accepts it.
+type ProposeTreatyMessage struct {
+	UserID string
+	State  domain.RelationState
+}
+
+// AcceptTreatyMessage accepts the other side's proposal. The treaty is in
+// force at once and calls off any pending war.
+type AcceptTreatyMessage struct {
+	UserID string
+}
+
+// DeclineTreatyMessage clears the proposal on the table, whoever made it.
+type DeclineTreatyMessage struct {
+	UserID string
+}
+
+// CancelTreatyMessage ends the treaty in force after its cancellation delay.
+type CancelTreatyMessage struct {
+	UserID string
+}
+
+type RelationResponse struct {
+	Relation domain.Relation
+}
+
+// Errors
+type InvalidRelationError struct {
+	Reason string
+}
+
+func (e *InvalidRelationError) Error() string {
+	return fmt.Sprintf("Invalid diplomacy request: %s", e.Reason)
+}
+
+type RelationStateError struct {
+	Action string
+	State  domain.RelationState
+}
+
+func (e *RelationStateError) Error() string {
+	return fmt.Sprintf("Cannot %s while relation is %s", e.Action, e.State)
+}
+
+type RelationChangePendingError struct {
+	State domain.RelationState
+}
+
+func (e *RelationChangePendingError) Error() string {
+	return fmt.Sprintf("A change to %s is already pending", e.State)
+}
+
+type NoTreatyProposalError struct{}
+
+func (e *NoTreatyProposalError) Error() string {
+	return "No treaty proposal on the table"
+}
+
+type AlliedPlayersError struct{}
+
+func (e *AlliedPlayersError) Error() string {
+	return "Players in the same alliance cannot go to war"
+}
@@ -0,0 +1,80 @@
+package messages
+
+import (
+	"fmt"
+
+	"cityio/internal/domain"
+)
+
+// StartResearchMessage asks a user to start researching a tech, charging its
+// cost. Answered with StartResearchResponseMessage or an error.
+type StartResearchMessage struct {
+	Tech domain.TechID
+}
+type StartResearchResponseMessage struct {
+	Research domain.Research
+}
+
+// CancelResearchMessage abandons the research in progress, refunding the
+// balance's research.cancel_refund share of its cost prorated by the time
+// remaining.
+type CancelResearchMessage struct{}
+type CancelResearchResponseMessage struct {
+	Refund int64
+}
+
+// ResearchCompleteMessage is sent by a user's one-shot research timer.
+type ResearchCompleteMessage struct{}
+
+// SyncTechsMessage is told to a user by one of its cities; the user answers
+// by telling the city SetTechsMessage.
+type SyncTechsMessage struct {
+	CityID string
+}
+
+// SetTechsMessage carries an owner's researched techs from the user to its
+// cities, and from a city to its buildings, which apply them to costs,
+// construction times and production.
+type SetTechsMessage struct {
+	Techs []domain.TechID
+}
+
+// Errors
+type UnknownTechError struct {
+	Tech domain.TechID
+}
+
+func (e *UnknownTechError) Error() string {
+	return fmt.Sprintf("Unknown tech: %s", e.Tech)
+}
+
+type TechAlreadyResearchedError struct {
+	Tech domain.TechID
+}
+
+func (e *TechAlreadyResearchedError) Error() string {
+	return fmt.Sprintf("Tech already researched: %s", e.Tech)
+}
+
+type ResearchInProgressError struct {
+	Tech domain.TechID
+}
+
+func (e *ResearchInProgressError) Error() string {
+	return fmt.Sprintf("Research already in progress: %s", e.Tech)
+}
+
+type MissingPrerequisiteError struct {
+	Tech    domain.TechID
+	Missing domain.TechID
+}
+
+func (e *MissingPrerequisiteError) Error() string {
+	return fmt.Sprintf("Tech %s requires %s", e.Tech, e.Missing)
+}
+
+type NoResearchInProgressError struct{}
+
+func (e *NoResearchInProgressError) Error() string {
+	return "No research in progress"
+}
@@ -1,5 +1,7 @@
 package messages
 
+import "cityio/internal/domain"
+
 type UpdateTileCityMessage struct {
 	CityID string
 }
@@ -7,12 +9,37 @@ type UpdateTileBuildingMessage struct {
 	BuildingID *string
 }
 
+// UpdateTileArmyMessage records an army entering (Present) or leaving a tile.
+// Keyed by army so resends are idempotent.
+type UpdateTileArmyMessage struct {
+	ArmyID  string
+	Present bool
+}
+
+// UpdateTileCaravanMessage records a caravan entering (Present) or leaving a
+// tile, like UpdateTileArmyMessage.
+type UpdateTileCaravanMessage struct {
+	CaravanID string
+	Present   bool
+}
+
 // ReconcileTilesMessage asks an entity to re-emit its authoritative tile-index
 // updates, repairing any drift in the derived tile occupancy index.
 type ReconcileTilesMessage struct{}
 
+// SetTileTerrainMessage hands a tile its terrain and deposit from the stored
+// map. The tile responds Ack.
+type SetTileTerrainMessage struct {
+	Terrain domain.Terrain
+	Deposit *domain.Deposit
+}
+
 type GetTileMessage struct{}
 type GetTileResponseMessage struct {
+	Terrain    domain.Terrain
+	Deposit    *domain.Deposit
 	CityID     *string
 	BuildingID *string
+	ArmyIDs    []string
+	CaravanIDs []string
 }
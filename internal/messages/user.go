@@ -23,21 +23,28 @@ type DepositFoodMessage struct {
 	Amount int64
 }
 
-// RequestFoodFromPoolMessage is sent by a deficit city; the user grants up to
-// the pool balance, withdrawing it from User.Food and accumulating it toward
-// FoodUpkeepRate.
+// RequestFoodFromPoolMessage is told by a deficit city. The user collects
+// requests for one allocation window, splits the pool between them under the
+// user's FoodPolicy, and answers each city with a FoodPoolGrantMessage.
 type RequestFoodFromPoolMessage struct {
-	Amount int64
+	CityID     string
+	Amount     int64
+	Capital    bool
+	Population float64
+	Priority   int
 }
 
-// RequestFoodFromPoolResponse reports how much of the request the pool could
-// cover. Granted < Amount means the city is starving.
-type RequestFoodFromPoolResponse struct {
-	Granted int64
+// AllocateFoodPoolMessage closes the user's current allocation window.
+type AllocateFoodPoolMessage struct{}
+
+// SetFoodPolicyMessage changes how the user's pool is shared between cities.
+type SetFoodPolicyMessage struct {
+	Policy domain.FoodAllocationPolicy
 }
 
 type CheckAndDeductGoldMessage struct {
 	Amount int64
+	Food   int64
 }
 
 type GetUserMessage struct{}
@@ -80,6 +87,14 @@ func (e *UserCreationError) Error() string {
 	return fmt.Sprintf("Error creating user: %s", e.UserID)
 }
 
+type InvalidFoodPolicyError struct {
+	Policy domain.FoodAllocationPolicy
+}
+
+func (e *InvalidFoodPolicyError) Error() string {
+	return fmt.Sprintf("Invalid food allocation policy: %s", e.Policy)
+}
+
 type InsufficientGoldError struct {
 	Missing int64
 }
@@ -87,3 +102,11 @@ type InsufficientGoldError struct {
 func (e *InsufficientGoldError) Error() string {
 	return fmt.Sprintf("User has insufficient gold: %d", e.Missing)
 }
+
+type InsufficientFoodError struct {
+	Missing int64
+}
+
+func (e *InsufficientFoodError) Error() string {
+	return fmt.Sprintf("User has insufficient food: %d", e.Missing)
+}
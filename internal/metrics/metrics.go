@@ -253,6 +253,67 @@ var (
 		Help:      "Construction wall-clock duration.",
 		Buckets:   []float64{1, 5, 10, 30, 60, 120, 300, 600, 1800, 3600},
 	}, []string{"building_type"})
+
+	// ConstructionCancelsTotal counts constructions abandoned by the player,
+	// labelled by building type.
+	ConstructionCancelsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "construction_cancels_total",
+		Help:      "Constructions cancelled by the player.",
+	}, []string{"building_type"})
+
+	// ConstructionSpeedUpGoldTotal sums the gold spent skipping construction
+	// time, labelled by building type.
+	ConstructionSpeedUpGoldTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "construction_speed_up_gold_total",
+		Help:      "Gold spent speeding up construction.",
+	}, []string{"building_type"})
+
+	// ConstructionOrdersTotal counts city build-queue orders by what became of
+	// them: queued, started, cancelled by the player, or dropped because they
+	// could no longer start (tile taken, building gone, max level).
+	ConstructionOrdersTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "construction_orders_total",
+		Help:      "City build-queue order outcomes.",
+	}, []string{"outcome"})
+
+	// ArmiesRaisedTotal counts armies raised from a city garrison.
+	ArmiesRaisedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "armies_raised_total",
+		Help:      "Armies raised from city garrisons.",
+	})
+
+	// ArmyStepsTotal counts tiles crossed by marching armies.
+	ArmyStepsTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "army_steps_total",
+		Help:      "Tiles crossed by marching armies.",
+	})
+
+	// TroopsTrainedTotal counts troops that finished training at a barracks
+	// and joined their city's garrison.
+	TroopsTrainedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "troops_trained_total",
+		Help:      "Troops that finished barracks training.",
+	})
+
+	// BattlesFoughtTotal counts resolved battles, labelled by outcome.
+	BattlesFoughtTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "battles_fought_total",
+		Help:      "Battles resolved between armies and cities.",
+	}, []string{"outcome"})
+
+	// CitiesCapturedTotal counts cities changing hands, labelled by city type.
+	CitiesCapturedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "cities_captured_total",
+		Help:      "Cities that changed owner through conquest.",
+	}, []string{"city_type"})
 )
 
 // --- Actor / runtime --------------------------------------------------------
@@ -266,4 +327,113 @@ var (
 		Help:      "Duration of one city tick (food loop + population).",
 		Buckets:   prometheus.DefBuckets,
 	})
+
+	// CatchUpTicksTotal counts city ticks replayed on restore for downtime.
+	CatchUpTicksTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "actor",
+		Name:      "catch_up_ticks_total",
+		Help:      "City ticks replayed on restore to cover server downtime.",
+	})
+
+	// CityRevoltsTotal counts cities that went neutral after sustained unrest.
+	CityRevoltsTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "actor",
+		Name:      "city_revolts_total",
+		Help:      "Cities set neutral by a revolt after sustained low morale.",
+	})
+
+	// ResearchStartedTotal counts research started by players, labelled by
+	// tech.
+	ResearchStartedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "research_started_total",
+		Help:      "Techs whose research was started.",
+	}, []string{"tech"})
+
+	// ResearchCompletedTotal counts finished research, labelled by tech.
+	ResearchCompletedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "research_completed_total",
+		Help:      "Techs whose research finished.",
+	}, []string{"tech"})
+
+	// MarketOrdersPlacedTotal counts orders placed on the market, labelled by
+	// market and side.
+	MarketOrdersPlacedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "market_orders_placed_total",
+		Help:      "Limit orders placed on a market.",
+	}, []string{"market", "side"})
+
+	// MarketTradesTotal counts matched trades, labelled by market.
+	MarketTradesTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "market_trades_total",
+		Help:      "Trades matched on a market.",
+	}, []string{"market"})
+
+	// MarketVolumeTotal is the quantity of the resource traded, labelled by
+	// market.
+	MarketVolumeTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "market_volume_total",
+		Help:      "Units of resource traded on a market.",
+	}, []string{"market"})
+
+	// CaravansSentTotal counts caravans sent out from a city.
+	CaravansSentTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "caravans_sent_total",
+		Help:      "Caravans sent out from a city.",
+	})
+
+	// CaravansFinishedTotal counts caravans that left the map, labelled by
+	// outcome: delivered, intercepted or returned.
+	CaravansFinishedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "caravans_finished_total",
+		Help:      "Caravans that reached their end, by outcome.",
+	}, []string{"outcome"})
+
+	// CaravanStepsTotal counts tiles crossed by caravans.
+	CaravanStepsTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "caravan_steps_total",
+		Help:      "Tiles crossed by caravans.",
+	})
+
+	// AllianceMembershipChangesTotal counts changes to alliance rosters,
+	// labelled by change: founded, joined, left, kicked or disbanded.
+	AllianceMembershipChangesTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "alliance_membership_changes_total",
+		Help:      "Changes to alliance rosters, by kind of change.",
+	}, []string{"change"})
+
+	// DiplomacyChangesTotal counts changes to relations between players,
+	// labelled by change: war_declared, war_started, treaty_proposed,
+	// treaty_signed, treaty_declined, treaty_cancelled or treaty_lapsed.
+	DiplomacyChangesTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "diplomacy_changes_total",
+		Help:      "Changes to relations between players, by kind of change.",
+	}, []string{"change"})
+
+	// ChatMessagesTotal counts chat messages sent, labelled by channel kind:
+	// global, alliance or direct.
+	ChatMessagesTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "chat_messages_total",
+		Help:      "Chat messages sent, by channel kind.",
+	}, []string{"channel"})
+
+	// ChatMessagesRejectedTotal counts chat messages refused, labelled by
+	// reason: muted or rate_limited.
+	ChatMessagesRejectedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "chat_messages_rejected_total",
+		Help:      "Chat messages refused, by reason.",
+	}, []string{"reason"})
 )
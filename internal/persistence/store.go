@@ -35,6 +35,10 @@ type Store struct {
 	userBuffer     map[string]domain.User
 	cityBuffer     map[string]domain.City
 	buildingBuffer map[string]domain.Building
+	armyBuffer     map[string]domain.Army
+	caravanBuffer  map[string]domain.Caravan
+	trainingBuffer map[string]domain.Training
+	orderBuffer    map[string]domain.ConstructionOrder
 
 	ticker       *time.Ticker
 	stopTickerCh chan struct{}
@@ -47,6 +51,10 @@ func New(db database.Querier) *Store {
 		userBuffer:     make(map[string]domain.User),
 		cityBuffer:     make(map[string]domain.City),
 		buildingBuffer: make(map[string]domain.Building),
+		armyBuffer:     make(map[string]domain.Army),
+		caravanBuffer:  make(map[string]domain.Caravan),
+		trainingBuffer: make(map[string]domain.Training),
+		orderBuffer:    make(map[string]domain.ConstructionOrder),
 		stopTickerCh:   make(chan struct{}),
 	}
 }
@@ -81,9 +89,10 @@ func (s *Store) Stop(ctx context.Context) {
 
 func (s *Store) FindEmptyCityBlock(ctx context.Context, size int) (domain.Coordinates, error) {
 	row, err := s.db.FindEmptyCityBlock(ctx, database.FindEmptyCityBlockParams{
-		MapWidth:  constants.MapSize,
-		MapHeight: constants.MapSize,
-		Size:      int32(size),
+		MapWidth:       constants.MapSize,
+		MapHeight:      constants.MapSize,
+		Size:           int32(size),
+		StarterDeposit: string(constants.GetRequiredDeposit(domain.BuildingTypeFarm)),
 	})
 	if err != nil {
 		return domain.Coordinates{}, err
@@ -162,6 +171,329 @@ func (s *Store) GetBuildingsByCity(ctx context.Context, cityID string) ([]domain
 	return buildings, nil
 }
 
+func (s *Store) GetAllArmies(ctx context.Context) ([]domain.Army, error) {
+	rows, err := s.db.GetAllArmies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	armies := make([]domain.Army, 0, len(rows))
+	for _, a := range rows {
+		armies = append(armies, *a.ToModel())
+	}
+	return armies, nil
+}
+
+func (s *Store) GetArmiesByOwner(ctx context.Context, owner string) ([]domain.Army, error) {
+	rows, err := s.db.GetArmiesByOwner(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+	armies := make([]domain.Army, 0, len(rows))
+	for _, a := range rows {
+		armies = append(armies, *a.ToModel())
+	}
+	return armies, nil
+}
+
+func (s *Store) GetAllCaravans(ctx context.Context) ([]domain.Caravan, error) {
+	rows, err := s.db.GetAllCaravans(ctx)
+	if err != nil {
+		return nil, err
+	}
+	caravans := make([]domain.Caravan, 0, len(rows))
+	for _, c := range rows {
+		caravans = append(caravans, *c.ToModel())
+	}
+	return caravans, nil
+}
+
+func (s *Store) GetCaravansByOwner(ctx context.Context, owner string) ([]domain.Caravan, error) {
+	rows, err := s.db.GetCaravansByOwner(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+	caravans := make([]domain.Caravan, 0, len(rows))
+	for _, c := range rows {
+		caravans = append(caravans, *c.ToModel())
+	}
+	return caravans, nil
+}
+
+func (s *Store) GetTrainingsByBarracks(ctx context.Context, barracksID string) ([]domain.Training, error) {
+	rows, err := s.db.GetTrainingsByBarracks(ctx, barracksID)
+	if err != nil {
+		return nil, err
+	}
+	trainings := make([]domain.Training, 0, len(rows))
+	for _, t := range rows {
+		trainings = append(trainings, *t.ToModel())
+	}
+	return trainings, nil
+}
+
+func (s *Store) GetResearchByUser(ctx context.Context, userID string) ([]domain.Research, error) {
+	rows, err := s.db.GetResearchByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	research := make([]domain.Research, 0, len(rows))
+	for _, r := range rows {
+		research = append(research, *r.ToModel())
+	}
+	return research, nil
+}
+
+func (s *Store) GetConstructionOrdersByCity(ctx context.Context, cityID string) ([]domain.ConstructionOrder, error) {
+	rows, err := s.db.GetConstructionOrdersByCity(ctx, cityID)
+	if err != nil {
+		return nil, err
+	}
+	orders := make([]domain.ConstructionOrder, 0, len(rows))
+	for _, o := range rows {
+		orders = append(orders, *o.ToModel())
+	}
+	return orders, nil
+}
+
+func (s *Store) GetBattleReport(ctx context.Context, reportID string) (*domain.BattleReport, error) {
+	row, err := s.db.GetBattleReport(ctx, reportID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return row.ToModel(), nil
+}
+
+func (s *Store) GetBattleReportsByUser(ctx context.Context, userID string, limit int) ([]domain.BattleReport, error) {
+	rows, err := s.db.GetBattleReportsByUser(ctx, database.GetBattleReportsByUserParams{
+		UserID:     userID,
+		MaxReports: int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+	reports := make([]domain.BattleReport, 0, len(rows))
+	for _, r := range rows {
+		reports = append(reports, *r.ToModel())
+	}
+	return reports, nil
+}
+
+func (s *Store) GetMarketOrders(ctx context.Context, marketID domain.MarketID) ([]domain.MarketOrder, error) {
+	rows, err := s.db.GetMarketOrders(ctx, string(marketID))
+	if err != nil {
+		return nil, err
+	}
+	orders := make([]domain.MarketOrder, 0, len(rows))
+	for _, r := range rows {
+		orders = append(orders, *r.ToModel())
+	}
+	return orders, nil
+}
+
+func (s *Store) GetRecentTrades(ctx context.Context, marketID domain.MarketID, limit int) ([]domain.Trade, error) {
+	rows, err := s.db.GetRecentTrades(ctx, database.GetRecentTradesParams{
+		MarketID:  string(marketID),
+		MaxTrades: int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+	trades := make([]domain.Trade, 0, len(rows))
+	for _, r := range rows {
+		trades = append(trades, *r.ToModel())
+	}
+	return trades, nil
+}
+
+func (s *Store) GetAlliance(ctx context.Context, allianceID string) (*domain.Alliance, error) {
+	row, err := s.db.GetAlliance(ctx, allianceID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	members, err := s.db.GetAllianceMembers(ctx, allianceID)
+	if err != nil {
+		return nil, err
+	}
+	alliance := row.ToModel()
+	for _, m := range members {
+		alliance.Members = append(alliance.Members, *m.ToModel())
+	}
+	return alliance, nil
+}
+
+func (s *Store) AllianceNameTaken(ctx context.Context, name string) (bool, error) {
+	return s.db.AllianceNameTaken(ctx, name)
+}
+
+func (s *Store) GetAllianceMembership(ctx context.Context, userID string) (*domain.AllianceMember, error) {
+	row, err := s.db.GetAllianceMembership(ctx, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return row.ToModel(), nil
+}
+
+func (s *Store) GetAllianceCities(ctx context.Context, allianceID string) ([]domain.City, error) {
+	rows, err := s.db.GetAllianceCities(ctx, allianceID)
+	if err != nil {
+		return nil, err
+	}
+	cities := make([]domain.City, 0, len(rows))
+	for _, c := range rows {
+		cities = append(cities, *c.ToModel())
+	}
+	return cities, nil
+}
+
+func (s *Store) GetAllianceInvites(ctx context.Context, allianceID string) ([]domain.AllianceInvite, error) {
+	rows, err := s.db.GetAllianceInvites(ctx, allianceID)
+	if err != nil {
+		return nil, err
+	}
+	invites := make([]domain.AllianceInvite, 0, len(rows))
+	for _, i := range rows {
+		invites = append(invites, *i.ToModel())
+	}
+	return invites, nil
+}
+
+func (s *Store) GetAllianceInvitesByUser(ctx context.Context, userID string) ([]domain.AllianceInvite, error) {
+	rows, err := s.db.GetAllianceInvitesByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	invites := make([]domain.AllianceInvite, 0, len(rows))
+	for _, i := range rows {
+		invites = append(invites, *i.ToModel())
+	}
+	return invites, nil
+}
+
+func (s *Store) GetRelation(ctx context.Context, userID, otherID string) (*domain.Relation, error) {
+	pair := domain.NewRelation(userID, otherID)
+	row, err := s.db.GetRelation(ctx, database.GetRelationParams{
+		UserA: pair.UserA,
+		UserB: pair.UserB,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return row.ToModel(), nil
+}
+
+func (s *Store) GetRelationsByUser(ctx context.Context, userID string) ([]domain.Relation, error) {
+	rows, err := s.db.GetRelationsByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	relations := make([]domain.Relation, 0, len(rows))
+	for _, r := range rows {
+		relations = append(relations, *r.ToModel())
+	}
+	return relations, nil
+}
+
+func (s *Store) GetPendingRelations(ctx context.Context) ([]domain.Relation, error) {
+	rows, err := s.db.GetPendingRelations(ctx)
+	if err != nil {
+		return nil, err
+	}
+	relations := make([]domain.Relation, 0, len(rows))
+	for _, r := range rows {
+		relations = append(relations, *r.ToModel())
+	}
+	return relations, nil
+}
+
+func (s *Store) GetChatMessages(ctx context.Context, channel domain.ChatChannel, beforeID string, limit int) ([]domain.ChatMessage, error) {
+	rows, err := s.db.GetChatMessages(ctx, database.GetChatMessagesParams{
+		Channel:     channel.Key(),
+		BeforeID:    beforeID,
+		MaxMessages: int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+	chatMessages := make([]domain.ChatMessage, 0, len(rows))
+	for _, m := range rows {
+		chatMessages = append(chatMessages, *m.ToModel())
+	}
+	return chatMessages, nil
+}
+
+func (s *Store) GetChatMute(ctx context.Context, userID string) (*domain.ChatMute, error) {
+	row, err := s.db.GetChatMute(ctx, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return row.ToModel(), nil
+}
+
+func (s *Store) GetAllTiles(ctx context.Context) ([]domain.Tile, error) {
+	rows, err := s.db.GetAllTiles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tiles := make([]domain.Tile, 0, len(rows))
+	for _, r := range rows {
+		tiles = append(tiles, *r.ToModel())
+	}
+	return tiles, nil
+}
+
+func (s *Store) GetWorld(ctx context.Context) (*domain.World, error) {
+	row, err := s.db.GetWorld(ctx)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return row.ToModel(), nil
+}
+
+func (s *Store) GetSeasons(ctx context.Context) ([]domain.Season, error) {
+	rows, err := s.db.GetSeasons(ctx)
+	if err != nil {
+		return nil, err
+	}
+	seasons := make([]domain.Season, 0, len(rows))
+	for _, r := range rows {
+		seasons = append(seasons, *r.ToModel())
+	}
+	return seasons, nil
+}
+
+func (s *Store) GetSeasonStandings(ctx context.Context, season, limit int) ([]domain.SeasonStanding, error) {
+	rows, err := s.db.GetSeasonStandings(ctx, database.GetSeasonStandingsParams{
+		Season: int32(season),
+		Limit:  int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+	standings := make([]domain.SeasonStanding, 0, len(rows))
+	for _, r := range rows {
+		standings = append(standings, *r.ToModel())
+	}
+	return standings, nil
+}
+
 func (s *Store) CreateUser(ctx context.Context, user domain.User) error {
 	return s.db.CreateUser(ctx, database.CreateUserParams{
 		UserID:   user.UserID,
@@ -182,6 +514,7 @@ func (s *Store) CreateCity(ctx context.Context, city domain.City) error {
 		StartX:        int32(city.StartX),
 		StartY:        int32(city.StartY),
 		Size:          int32(city.Size),
+		Capital:       city.Capital,
 	})
 }
 
@@ -198,6 +531,146 @@ func (s *Store) CreateBuilding(ctx context.Context, building domain.Building) er
 	})
 }
 
+func (s *Store) CreateArmy(ctx context.Context, army domain.Army) error {
+	return s.db.CreateArmy(ctx, database.CreateArmyParams{
+		ArmyID:       army.ArmyID,
+		Owner:        army.Owner,
+		CityID:       army.CityID,
+		Troops:       army.Troops,
+		X:            int32(army.X),
+		Y:            int32(army.Y),
+		DestinationX: int32(army.DestinationX),
+		DestinationY: int32(army.DestinationY),
+	})
+}
+
+func (s *Store) CreateCaravan(ctx context.Context, caravan domain.Caravan) error {
+	return s.db.CreateCaravan(ctx, database.CreateCaravanParams{
+		CaravanID:         caravan.CaravanID,
+		Owner:             caravan.Owner,
+		SourceCityID:      caravan.SourceCityID,
+		DestinationCityID: caravan.DestinationCityID,
+		Gold:              caravan.Gold,
+		Food:              caravan.Food,
+		X:                 int32(caravan.X),
+		Y:                 int32(caravan.Y),
+		DestinationX:      int32(caravan.DestinationX),
+		DestinationY:      int32(caravan.DestinationY),
+	})
+}
+
+func (s *Store) CreateTraining(ctx context.Context, training domain.Training) error {
+	return s.db.CreateTraining(ctx, database.CreateTrainingParams{
+		TrainingID:    training.TrainingID,
+		BarracksID:    training.BarracksID,
+		Troops:        training.Troops,
+		TrainingStart: database.ToPGTimestamp(training.TrainingStart.Time),
+		TrainingEnd:   database.ToPGTimestamp(training.TrainingEnd.Time),
+	})
+}
+
+func (s *Store) CreateResearch(ctx context.Context, research domain.Research) error {
+	return s.db.CreateResearch(ctx, database.CreateResearchParams{
+		UserID:        research.UserID,
+		Tech:          string(research.Tech),
+		ResearchStart: database.ToPGTimestamp(&research.ResearchStart),
+		ResearchEnd:   database.ToPGTimestamp(&research.ResearchEnd),
+	})
+}
+
+func (s *Store) CreateMarketOrder(ctx context.Context, order domain.MarketOrder) error {
+	return s.db.CreateMarketOrder(ctx, database.CreateMarketOrderParams{
+		OrderID:   order.OrderID,
+		MarketID:  string(order.MarketID),
+		UserID:    order.UserID,
+		Side:      string(order.Side),
+		Price:     order.Price,
+		Quantity:  order.Quantity,
+		CreatedAt: database.ToPGTimestamp(&order.CreatedAt),
+	})
+}
+
+func (s *Store) CreateAlliance(ctx context.Context, alliance domain.Alliance) error {
+	return s.db.CreateAlliance(ctx, database.CreateAllianceParams{
+		AllianceID: alliance.AllianceID,
+		Name:       alliance.Name,
+		CreatedAt:  database.ToPGTimestamp(&alliance.CreatedAt),
+	})
+}
+
+func (s *Store) AddAllianceMember(ctx context.Context, member domain.AllianceMember) error {
+	return s.db.AddAllianceMember(ctx, database.AddAllianceMemberParams{
+		UserID:     member.UserID,
+		AllianceID: member.AllianceID,
+		Role:       string(member.Role),
+		JoinedAt:   database.ToPGTimestamp(&member.JoinedAt),
+	})
+}
+
+func (s *Store) CreateAllianceInvite(ctx context.Context, invite domain.AllianceInvite) error {
+	return s.db.CreateAllianceInvite(ctx, database.CreateAllianceInviteParams{
+		AllianceID: invite.AllianceID,
+		UserID:     invite.UserID,
+		InvitedBy:  invite.InvitedBy,
+		CreatedAt:  database.ToPGTimestamp(&invite.CreatedAt),
+	})
+}
+
+func (s *Store) CreateChatMessage(ctx context.Context, message domain.ChatMessage) error {
+	return s.db.CreateChatMessage(ctx, database.CreateChatMessageParams{
+		MessageID: message.MessageID,
+		Channel:   message.Channel.Key(),
+		Sender:    message.Sender,
+		Body:      message.Body,
+		SentAt:    database.ToPGTimestamp(&message.SentAt),
+	})
+}
+
+func (s *Store) CreateChatMute(ctx context.Context, mute domain.ChatMute) error {
+	return s.db.UpsertChatMute(ctx, database.UpsertChatMuteParams{
+		UserID:     mute.UserID,
+		MutedUntil: database.ToPGTimestamp(&mute.MutedUntil),
+		Reason:     mute.Reason,
+		MutedBy:    mute.MutedBy,
+		CreatedAt:  database.ToPGTimestamp(&mute.CreatedAt),
+	})
+}
+
+func (s *Store) CreateConstructionOrder(ctx context.Context, order domain.ConstructionOrder) error {
+	return s.db.CreateConstructionOrder(ctx, database.CreateConstructionOrderParams{
+		OrderID:      order.OrderID,
+		CityID:       order.CityID,
+		BuildingID:   order.BuildingID,
+		BuildingType: string(order.BuildingType),
+		X:            int32(order.X),
+		Y:            int32(order.Y),
+		Position:     int32(order.Position),
+		Paid:         order.Paid,
+	})
+}
+
+func (s *Store) CreateBattleReport(ctx context.Context, report domain.BattleReport) error {
+	return s.db.CreateBattleReport(ctx, database.CreateBattleReportParams{
+		ReportID:        report.ReportID,
+		Seed:            report.Seed,
+		ArmyID:          report.ArmyID,
+		Attacker:        report.Attacker,
+		Defender:        report.Defender,
+		CityID:          report.CityID,
+		X:               int32(report.X),
+		Y:               int32(report.Y),
+		CityCenterLevel: int32(report.CityCenterLevel),
+		TerrainDefense:  report.TerrainDefense,
+		AttackerTroops:  report.AttackerTroops,
+		DefenderTroops:  report.DefenderTroops,
+		AttackerLosses:  report.AttackerLosses,
+		DefenderLosses:  report.DefenderLosses,
+		Outcome:         report.Outcome,
+		Rounds:          int32(report.Rounds),
+		FoughtAt:        database.ToPGTimestamp(&report.FoughtAt),
+	})
+}
+
 func (s *Store) DeleteUser(ctx context.Context, userID string) error {
 	s.mu.Lock()
 	delete(s.userBuffer, userID)
@@ -219,6 +692,144 @@ func (s *Store) DeleteBuilding(ctx context.Context, buildingID string) error {
 	return s.db.DeleteBuilding(ctx, buildingID)
 }
 
+func (s *Store) DeleteArmy(ctx context.Context, armyID string) error {
+	s.mu.Lock()
+	delete(s.armyBuffer, armyID)
+	s.mu.Unlock()
+	return s.db.DeleteArmy(ctx, armyID)
+}
+
+func (s *Store) DeleteCaravan(ctx context.Context, caravanID string) error {
+	s.mu.Lock()
+	delete(s.caravanBuffer, caravanID)
+	s.mu.Unlock()
+	return s.db.DeleteCaravan(ctx, caravanID)
+}
+
+func (s *Store) DeleteConstructionOrder(ctx context.Context, orderID string) error {
+	s.mu.Lock()
+	delete(s.orderBuffer, orderID)
+	s.mu.Unlock()
+	return s.db.DeleteConstructionOrder(ctx, orderID)
+}
+
+func (s *Store) DeleteTraining(ctx context.Context, trainingID string) error {
+	s.mu.Lock()
+	delete(s.trainingBuffer, trainingID)
+	s.mu.Unlock()
+	return s.db.DeleteTraining(ctx, trainingID)
+}
+
+func (s *Store) DeleteResearch(ctx context.Context, userID string, tech domain.TechID) error {
+	return s.db.DeleteResearch(ctx, database.DeleteResearchParams{
+		UserID: userID,
+		Tech:   string(tech),
+	})
+}
+
+func (s *Store) DeleteMarketOrder(ctx context.Context, orderID string) error {
+	return s.db.DeleteMarketOrder(ctx, orderID)
+}
+
+func (s *Store) DeleteAlliance(ctx context.Context, allianceID string) error {
+	return s.db.DeleteAlliance(ctx, allianceID)
+}
+
+func (s *Store) DeleteAllianceMember(ctx context.Context, userID string) error {
+	return s.db.DeleteAllianceMember(ctx, userID)
+}
+
+func (s *Store) DeleteAllianceInvite(ctx context.Context, allianceID, userID string) error {
+	return s.db.DeleteAllianceInvite(ctx, database.DeleteAllianceInviteParams{
+		AllianceID: allianceID,
+		UserID:     userID,
+	})
+}
+
+func (s *Store) DeleteAllianceInvitesByUser(ctx context.Context, userID string) error {
+	return s.db.DeleteAllianceInvitesByUser(ctx, userID)
+}
+
+func (s *Store) DeleteChatMute(ctx context.Context, userID string) error {
+	return s.db.DeleteChatMute(ctx, userID)
+}
+
+// CompleteResearch marks a tech researched, written through at once: it
+// happens once per tech and must survive a restart that follows it.
+func (s *Store) CompleteResearch(ctx context.Context, userID string, tech domain.TechID) error {
+	return s.db.CompleteResearch(ctx, database.CompleteResearchParams{
+		UserID: userID,
+		Tech:   string(tech),
+	})
+}
+
+// RecordTrade stores a trade and takes its quantity off both orders in one
+// statement, written through at once: the trade moves escrowed funds and must
+// not be lost or half-applied.
+func (s *Store) RecordTrade(ctx context.Context, trade domain.Trade) error {
+	return s.db.RecordTrade(ctx, database.RecordTradeParams{
+		Quantity:    trade.Quantity,
+		BuyOrderID:  trade.BuyOrderID,
+		SellOrderID: trade.SellOrderID,
+		TradeID:     trade.TradeID,
+		MarketID:    string(trade.MarketID),
+		Buyer:       trade.Buyer,
+		Seller:      trade.Seller,
+		Price:       trade.Price,
+		ExecutedAt:  database.ToPGTimestamp(&trade.ExecutedAt),
+	})
+}
+
+// UpdateAllianceMemberRole writes a member's role through at once, like the
+// rest of the alliance's membership.
+func (s *Store) UpdateAllianceMemberRole(ctx context.Context, userID string, role domain.AllianceRole) error {
+	return s.db.UpdateAllianceMemberRole(ctx, database.UpdateAllianceMemberRoleParams{
+		Role:   string(role),
+		UserID: userID,
+	})
+}
+
+// UpdateRelation writes a relation through at once, creating its row on the
+// first change. Hostility checks read relations from the database.
+func (s *Store) UpdateRelation(ctx context.Context, relation domain.Relation) error {
+	return s.db.UpsertRelation(ctx, database.UpsertRelationParams{
+		UserA:         relation.UserA,
+		UserB:         relation.UserB,
+		State:         string(relation.State),
+		PendingState:  database.ToNullString(string(relation.PendingState)),
+		EffectiveAt:   database.ToPGTimestamp(relation.EffectiveAt.Time),
+		ProposedState: database.ToNullString(string(relation.ProposedState)),
+		ProposedBy:    database.ToNullString(relation.ProposedBy),
+		UpdatedAt:     database.ToPGTimestamp(&relation.UpdatedAt),
+	})
+}
+
+// UpdateCityOwner writes the owner straight to the database and patches any
+// buffered snapshot of the city, so a pending flush cannot revert it.
+func (s *Store) UpdateCityOwner(ctx context.Context, cityID string, owner *string) error {
+	s.mu.Lock()
+	if city, ok := s.cityBuffer[cityID]; ok {
+		city.Owner = owner
+		s.cityBuffer[cityID] = city
+	}
+	s.mu.Unlock()
+	return s.db.UpdateCityOwner(ctx, database.UpdateCityOwnerParams{
+		Owner:  owner,
+		CityID: cityID,
+	})
+}
+
+// EndSeason marks the active season as ending, reporting false if it had
+// already been ended. The world rolls over on the next boot.
+func (s *Store) EndSeason(ctx context.Context, reason domain.SeasonEndReason) (bool, error) {
+	r := string(reason)
+	n, err := s.db.EndSeason(ctx, &r)
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
 func (s *Store) EnqueueUser(user domain.User) {
 	s.mu.Lock()
 	s.userBuffer[user.UserID] = user
@@ -243,6 +854,38 @@ func (s *Store) EnqueueBuilding(building domain.Building) {
 	metrics.PersistenceBufferSize.WithLabelValues("building").Set(float64(size))
 }
 
+func (s *Store) EnqueueArmy(army domain.Army) {
+	s.mu.Lock()
+	s.armyBuffer[army.ArmyID] = army
+	size := len(s.armyBuffer)
+	s.mu.Unlock()
+	metrics.PersistenceBufferSize.WithLabelValues("army").Set(float64(size))
+}
+
+func (s *Store) EnqueueCaravan(caravan domain.Caravan) {
+	s.mu.Lock()
+	s.caravanBuffer[caravan.CaravanID] = caravan
+	size := len(s.caravanBuffer)
+	s.mu.Unlock()
+	metrics.PersistenceBufferSize.WithLabelValues("caravan").Set(float64(size))
+}
+
+func (s *Store) EnqueueTraining(training domain.Training) {
+	s.mu.Lock()
+	s.trainingBuffer[training.TrainingID] = training
+	size := len(s.trainingBuffer)
+	s.mu.Unlock()
+	metrics.PersistenceBufferSize.WithLabelValues("training").Set(float64(size))
+}
+
+func (s *Store) EnqueueConstructionOrder(order domain.ConstructionOrder) {
+	s.mu.Lock()
+	s.orderBuffer[order.OrderID] = order
+	size := len(s.orderBuffer)
+	s.mu.Unlock()
+	metrics.PersistenceBufferSize.WithLabelValues("construction_order").Set(float64(size))
+}
+
 // flush swaps out the pending buffers under the lock, then writes the snapshots
 // without holding it so enqueues continue while a flush is in flight.
 func (s *Store) flush(ctx context.Context) {
@@ -250,19 +893,35 @@ func (s *Store) flush(ctx context.Context) {
 	users := s.userBuffer
 	cities := s.cityBuffer
 	buildings := s.buildingBuffer
+	armies := s.armyBuffer
+	caravans := s.caravanBuffer
+	trainings := s.trainingBuffer
+	orders := s.orderBuffer
 	s.userBuffer = make(map[string]domain.User)
 	s.cityBuffer = make(map[string]domain.City)
 	s.buildingBuffer = make(map[string]domain.Building)
+	s.armyBuffer = make(map[string]domain.Army)
+	s.caravanBuffer = make(map[string]domain.Caravan)
+	s.trainingBuffer = make(map[string]domain.Training)
+	s.orderBuffer = make(map[string]domain.ConstructionOrder)
 	s.mu.Unlock()
 	// Reset the buffer-size gauges now that we've swapped the maps; enqueues
 	// during the flush bump them again from zero.
 	metrics.PersistenceBufferSize.WithLabelValues("user").Set(0)
 	metrics.PersistenceBufferSize.WithLabelValues("city").Set(0)
 	metrics.PersistenceBufferSize.WithLabelValues("building").Set(0)
+	metrics.PersistenceBufferSize.WithLabelValues("army").Set(0)
+	metrics.PersistenceBufferSize.WithLabelValues("caravan").Set(0)
+	metrics.PersistenceBufferSize.WithLabelValues("training").Set(0)
+	metrics.PersistenceBufferSize.WithLabelValues("construction_order").Set(0)
 
 	s.flushCities(ctx, cities)
 	s.flushUsers(ctx, users)
 	s.flushBuildings(ctx, buildings)
+	s.flushArmies(ctx, armies)
+	s.flushCaravans(ctx, caravans)
+	s.flushTrainings(ctx, trainings)
+	s.flushConstructionOrders(ctx, orders)
 }
 
 func (s *Store) flushCities(ctx context.Context, buffer map[string]domain.City) {
@@ -289,6 +948,14 @@ func (s *Store) flushCities(ctx context.Context, buffer map[string]domain.City)
 			StartXs:        make([]int32, 0, len(chunk)),
 			StartYs:        make([]int32, 0, len(chunk)),
 			Sizes:          make([]int32, 0, len(chunk)),
+			Troops:         make([]int64, 0, len(chunk)),
+
+			ImportPriorities: make([]int32, 0, len(chunk)),
+			TaxRates:         make([]int32, 0, len(chunk)),
+			Morales:          make([]float64, 0, len(chunk)),
+			UnrestTicks:      make([]int32, 0, len(chunk)),
+			FoodStores:       make([]int64, 0, len(chunk)),
+			LastTickAts:      make([]pgtype.Timestamp, 0, len(chunk)),
 		}
 
 		for _, city := range chunk {
@@ -308,6 +975,17 @@ func (s *Store) flushCities(ctx context.Context, buffer map[string]domain.City)
 			params.StartXs = append(params.StartXs, int32(city.StartX))
 			params.StartYs = append(params.StartYs, int32(city.StartY))
 			params.Sizes = append(params.Sizes, int32(city.Size))
+			params.Troops = append(params.Troops, city.Troops)
+			params.ImportPriorities = append(params.ImportPriorities, int32(city.ImportPriority))
+			params.TaxRates = append(params.TaxRates, int32(city.TaxRate))
+			params.Morales = append(params.Morales, city.Morale)
+			params.UnrestTicks = append(params.UnrestTicks, int32(city.UnrestTicks))
+			params.FoodStores = append(params.FoodStores, city.FoodStore)
+			if city.LastTickAt.IsZero() {
+				params.LastTickAts = append(params.LastTickAts, pgtype.Timestamp{})
+			} else {
+				params.LastTickAts = append(params.LastTickAts, database.ToPGTimestamp(&city.LastTickAt))
+			}
 		}
 
 		if err := s.db.BatchUpdateCities(ctx, params); err != nil {
@@ -332,15 +1010,17 @@ func (s *Store) flushUsers(ctx context.Context, buffer map[string]domain.User) {
 		chunk := users[i:end]
 
 		params := database.BatchUpdateUsersParams{
-			UserIds: make([]string, 0, len(chunk)),
-			Foods:   make([]int64, 0, len(chunk)),
-			Golds:   make([]int64, 0, len(chunk)),
+			UserIds:      make([]string, 0, len(chunk)),
+			Foods:        make([]int64, 0, len(chunk)),
+			Golds:        make([]int64, 0, len(chunk)),
+			FoodPolicies: make([]string, 0, len(chunk)),
 		}
 
 		for _, user := range chunk {
 			params.UserIds = append(params.UserIds, user.UserID)
 			params.Foods = append(params.Foods, user.Food)
 			params.Golds = append(params.Golds, user.Gold)
+			params.FoodPolicies = append(params.FoodPolicies, string(user.FoodPolicy))
 		}
 
 		if err := s.db.BatchUpdateUsers(ctx, params); err != nil {
@@ -392,3 +1072,141 @@ func (s *Store) flushBuildings(ctx context.Context, buffer map[string]domain.Bui
 		}
 	}
 }
+
+func (s *Store) flushArmies(ctx context.Context, buffer map[string]domain.Army) {
+	start := time.Now()
+	defer func() {
+		metrics.PersistenceFlushDurationSeconds.WithLabelValues("army").Observe(time.Since(start).Seconds())
+		metrics.PersistenceFlushRowsWritten.WithLabelValues("army").Observe(float64(len(buffer)))
+	}()
+	armies := make([]domain.Army, 0, len(buffer))
+	for _, a := range buffer {
+		armies = append(armies, a)
+	}
+	for i := 0; i < len(armies); i += batchSize {
+		end := min(i+batchSize, len(armies))
+		chunk := armies[i:end]
+
+		params := database.BatchUpdateArmiesParams{
+			ArmyIds:       make([]string, 0, len(chunk)),
+			CityIds:       make([]string, 0, len(chunk)),
+			Troops:        make([]int64, 0, len(chunk)),
+			Xs:            make([]int32, 0, len(chunk)),
+			Ys:            make([]int32, 0, len(chunk)),
+			DestinationXs: make([]int32, 0, len(chunk)),
+			DestinationYs: make([]int32, 0, len(chunk)),
+		}
+
+		for _, a := range chunk {
+			params.ArmyIds = append(params.ArmyIds, a.ArmyID)
+			params.CityIds = append(params.CityIds, a.CityID)
+			params.Troops = append(params.Troops, a.Troops)
+			params.Xs = append(params.Xs, int32(a.X))
+			params.Ys = append(params.Ys, int32(a.Y))
+			params.DestinationXs = append(params.DestinationXs, int32(a.DestinationX))
+			params.DestinationYs = append(params.DestinationYs, int32(a.DestinationY))
+		}
+
+		if err := s.db.BatchUpdateArmies(ctx, params); err != nil {
+			slog.ErrorContext(ctx, "error batch updating armies", "idx", i, "error", err)
+			metrics.PersistenceFlushErrorsTotal.WithLabelValues("army").Inc()
+		}
+	}
+}
+
+func (s *Store) flushCaravans(ctx context.Context, buffer map[string]domain.Caravan) {
+	start := time.Now()
+	defer func() {
+		metrics.PersistenceFlushDurationSeconds.WithLabelValues("caravan").Observe(time.Since(start).Seconds())
+		metrics.PersistenceFlushRowsWritten.WithLabelValues("caravan").Observe(float64(len(buffer)))
+	}()
+	caravans := make([]domain.Caravan, 0, len(buffer))
+	for _, c := range buffer {
+		caravans = append(caravans, c)
+	}
+	for i := 0; i < len(caravans); i += batchSize {
+		end := min(i+batchSize, len(caravans))
+		chunk := caravans[i:end]
+
+		params := database.BatchUpdateCaravansParams{
+			CaravanIds: make([]string, 0, len(chunk)),
+			Xs:         make([]int32, 0, len(chunk)),
+			Ys:         make([]int32, 0, len(chunk)),
+		}
+
+		for _, c := range chunk {
+			params.CaravanIds = append(params.CaravanIds, c.CaravanID)
+			params.Xs = append(params.Xs, int32(c.X))
+			params.Ys = append(params.Ys, int32(c.Y))
+		}
+
+		if err := s.db.BatchUpdateCaravans(ctx, params); err != nil {
+			slog.ErrorContext(ctx, "error batch updating caravans", "idx", i, "error", err)
+			metrics.PersistenceFlushErrorsTotal.WithLabelValues("caravan").Inc()
+		}
+	}
+}
+
+func (s *Store) flushTrainings(ctx context.Context, buffer map[string]domain.Training) {
+	start := time.Now()
+	defer func() {
+		metrics.PersistenceFlushDurationSeconds.WithLabelValues("training").Observe(time.Since(start).Seconds())
+		metrics.PersistenceFlushRowsWritten.WithLabelValues("training").Observe(float64(len(buffer)))
+	}()
+	trainings := make([]domain.Training, 0, len(buffer))
+	for _, t := range buffer {
+		trainings = append(trainings, t)
+	}
+	for i := 0; i < len(trainings); i += batchSize {
+		end := min(i+batchSize, len(trainings))
+		chunk := trainings[i:end]
+
+		params := database.BatchUpdateTrainingsParams{
+			TrainingIds:    make([]string, 0, len(chunk)),
+			TrainingStarts: make([]pgtype.Timestamp, 0, len(chunk)),
+			TrainingEnds:   make([]pgtype.Timestamp, 0, len(chunk)),
+		}
+
+		for _, t := range chunk {
+			params.TrainingIds = append(params.TrainingIds, t.TrainingID)
+			params.TrainingStarts = append(params.TrainingStarts, database.ToPGTimestamp(t.TrainingStart.Time))
+			params.TrainingEnds = append(params.TrainingEnds, database.ToPGTimestamp(t.TrainingEnd.Time))
+		}
+
+		if err := s.db.BatchUpdateTrainings(ctx, params); err != nil {
+			slog.ErrorContext(ctx, "error batch updating trainings", "idx", i, "error", err)
+			metrics.PersistenceFlushErrorsTotal.WithLabelValues("training").Inc()
+		}
+	}
+}
+
+func (s *Store) flushConstructionOrders(ctx context.Context, buffer map[string]domain.ConstructionOrder) {
+	start := time.Now()
+	defer func() {
+		metrics.PersistenceFlushDurationSeconds.WithLabelValues("construction_order").Observe(time.Since(start).Seconds())
+		metrics.PersistenceFlushRowsWritten.WithLabelValues("construction_order").Observe(float64(len(buffer)))
+	}()
+	orders := make([]domain.ConstructionOrder, 0, len(buffer))
+	for _, o := range buffer {
+		orders = append(orders, o)
+	}
+	for i := 0; i < len(orders); i += batchSize {
+		end := min(i+batchSize, len(orders))
+		chunk := orders[i:end]
+
+		params := database.BatchUpdateConstructionOrdersParams{
+			OrderIds:  make([]string, 0, len(chunk)),
+			Positions: make([]int32, 0, len(chunk)),
+		}
+
+		for _, o := range chunk {
+			params.OrderIds = append(params.OrderIds, o.OrderID)
+			params.Positions = append(params.Positions, int32(o.Position))
+		}
+
+		if err := s.db.BatchUpdateConstructionOrders(ctx, params); err != nil {
+			slog.ErrorContext(ctx, "error batch updating construction orders", "idx", i, "error", err)
+			metrics.PersistenceFlushErrorsTotal.WithLabelValues("construction_order").Inc()
+		}
+	}
+}
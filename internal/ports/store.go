@@ -18,16 +18,101 @@ type Store interface {
 	GetAllBuildings(ctx context.Context) ([]domain.Building, error)
 	GetCitiesByOwner(ctx context.Context, owner string) ([]domain.City, error)
 	GetBuildingsByCity(ctx context.Context, cityID string) ([]domain.Building, error)
+	GetAllArmies(ctx context.Context) ([]domain.Army, error)
+	GetArmiesByOwner(ctx context.Context, owner string) ([]domain.Army, error)
+	GetAllCaravans(ctx context.Context) ([]domain.Caravan, error)
+	GetCaravansByOwner(ctx context.Context, owner string) ([]domain.Caravan, error)
+	GetTrainingsByBarracks(ctx context.Context, barracksID string) ([]domain.Training, error)
+	GetConstructionOrdersByCity(ctx context.Context, cityID string) ([]domain.ConstructionOrder, error)
+	GetResearchByUser(ctx context.Context, userID string) ([]domain.Research, error)
+	GetBattleReport(ctx context.Context, reportID string) (*domain.BattleReport, error)
+	GetBattleReportsByUser(ctx context.Context, userID string, limit int) ([]domain.BattleReport, error)
+	GetMarketOrders(ctx context.Context, marketID domain.MarketID) ([]domain.MarketOrder, error)
+	GetRecentTrades(ctx context.Context, marketID domain.MarketID, limit int) ([]domain.Trade, error)
+	GetAlliance(ctx context.Context, allianceID string) (*domain.Alliance, error)
+	AllianceNameTaken(ctx context.Context, name string) (bool, error)
+	GetAllianceMembership(ctx context.Context, userID string) (*domain.AllianceMember, error)
+	GetAllianceCities(ctx context.Context, allianceID string) ([]domain.City, error)
+	GetAllianceInvites(ctx context.Context, allianceID string) ([]domain.AllianceInvite, error)
+	GetAllianceInvitesByUser(ctx context.Context, userID string) ([]domain.AllianceInvite, error)
+	// GetRelation returns persistence.ErrNotFound for a pair that has never
+	// left neutral.
+	GetRelation(ctx context.Context, userID, otherID string) (*domain.Relation, error)
+	GetRelationsByUser(ctx context.Context, userID string) ([]domain.Relation, error)
+	GetPendingRelations(ctx context.Context) ([]domain.Relation, error)
+	// GetChatMessages returns up to limit messages of a channel, newest
+	// first, starting before the message beforeID (or at the newest if it is
+	// empty).
+	GetChatMessages(ctx context.Context, channel domain.ChatChannel, beforeID string, limit int) ([]domain.ChatMessage, error)
+	GetChatMute(ctx context.Context, userID string) (*domain.ChatMute, error)
+	GetAllTiles(ctx context.Context) ([]domain.Tile, error)
+	GetWorld(ctx context.Context) (*domain.World, error)
+	GetSeasons(ctx context.Context) ([]domain.Season, error)
+	GetSeasonStandings(ctx context.Context, season, limit int) ([]domain.SeasonStanding, error)
 
 	CreateUser(ctx context.Context, user domain.User) error
 	CreateCity(ctx context.Context, city domain.City) error
 	CreateBuilding(ctx context.Context, building domain.Building) error
+	CreateArmy(ctx context.Context, army domain.Army) error
+	CreateCaravan(ctx context.Context, caravan domain.Caravan) error
+	CreateTraining(ctx context.Context, training domain.Training) error
+	CreateConstructionOrder(ctx context.Context, order domain.ConstructionOrder) error
+	CreateResearch(ctx context.Context, research domain.Research) error
+	CreateMarketOrder(ctx context.Context, order domain.MarketOrder) error
+	CreateAlliance(ctx context.Context, alliance domain.Alliance) error
+	AddAllianceMember(ctx context.Context, member domain.AllianceMember) error
+	CreateAllianceInvite(ctx context.Context, invite domain.AllianceInvite) error
+	CreateChatMessage(ctx context.Context, message domain.ChatMessage) error
+	// CreateChatMute replaces any mute the player already has.
+	CreateChatMute(ctx context.Context, mute domain.ChatMute) error
+	CreateBattleReport(ctx context.Context, report domain.BattleReport) error
 
 	DeleteUser(ctx context.Context, userID string) error
 	DeleteCity(ctx context.Context, cityID string) error
 	DeleteBuilding(ctx context.Context, buildingID string) error
+	DeleteArmy(ctx context.Context, armyID string) error
+	DeleteCaravan(ctx context.Context, caravanID string) error
+	DeleteTraining(ctx context.Context, trainingID string) error
+	DeleteConstructionOrder(ctx context.Context, orderID string) error
+	DeleteResearch(ctx context.Context, userID string, tech domain.TechID) error
+	DeleteMarketOrder(ctx context.Context, orderID string) error
+	DeleteAlliance(ctx context.Context, allianceID string) error
+	DeleteAllianceMember(ctx context.Context, userID string) error
+	DeleteAllianceInvite(ctx context.Context, allianceID, userID string) error
+	DeleteAllianceInvitesByUser(ctx context.Context, userID string) error
+	DeleteChatMute(ctx context.Context, userID string) error
+
+	// UpdateCityOwner writes a city's owner through immediately rather than
+	// via the batched flush, so ownership checks see a capture at once.
+	UpdateCityOwner(ctx context.Context, cityID string, owner *string) error
+
+	// CompleteResearch marks a user's tech researched, written through
+	// immediately.
+	CompleteResearch(ctx context.Context, userID string, tech domain.TechID) error
+
+	// RecordTrade stores a trade and fills both its orders, written through
+	// immediately in one statement.
+	RecordTrade(ctx context.Context, trade domain.Trade) error
+
+	// UpdateAllianceMemberRole writes a member's role through immediately.
+	// Alliance membership is never buffered: vision checks read it straight
+	// from the database.
+	UpdateAllianceMemberRole(ctx context.Context, userID string, role domain.AllianceRole) error
+
+	// UpdateRelation writes a relation through immediately, creating it on
+	// its first change. Combat and caravan interception read relations
+	// straight from the database.
+	UpdateRelation(ctx context.Context, relation domain.Relation) error
+
+	// EndSeason marks the active season as ending, written through at once.
+	// It reports false when the season had already been ended.
+	EndSeason(ctx context.Context, reason domain.SeasonEndReason) (bool, error)
 
 	EnqueueUser(user domain.User)
 	EnqueueCity(city domain.City)
 	EnqueueBuilding(building domain.Building)
+	EnqueueArmy(army domain.Army)
+	EnqueueCaravan(caravan domain.Caravan)
+	EnqueueTraining(training domain.Training)
+	EnqueueConstructionOrder(order domain.ConstructionOrder)
 }
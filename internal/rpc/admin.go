@@ -0,0 +1,141 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+	"unicode/utf8"
+
+	"connectrpc.com/connect"
+
+	"cityio/internal/auth"
+	"cityio/internal/constants"
+	"cityio/internal/domain"
+	servicev1 "cityio/internal/gen/cityio/service/v1"
+	"cityio/internal/mapping"
+	"cityio/internal/messages"
+)
+
+type adminHandler struct {
+	srv *Server
+}
+
+// requireAdmin rejects callers whose username is not configured as an
+// administrator.
+func (h *adminHandler) requireAdmin(ctx context.Context) (auth.Claims, error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return auth.Claims{}, connect.NewError(connect.CodeUnauthenticated, errors.New("missing claims"))
+	}
+	if _, ok := h.srv.admins[claims.Username]; !ok {
+		return auth.Claims{}, connect.NewError(connect.CodePermissionDenied, errors.New("admin only"))
+	}
+	return claims, nil
+}
+
+func (h *adminHandler) EndSeason(ctx context.Context, _ *connect.Request[servicev1.EndSeasonRequest]) (*connect.Response[servicev1.EndSeasonResponse], error) {
+	claims, err := h.requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ended, err := h.srv.store.EndSeason(ctx, domain.SeasonEndAdmin)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if !ended {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("season already ended"))
+	}
+	world, err := h.srv.store.GetWorld(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	slog.InfoContext(ctx, "season ended by admin", "season", world.Season, "admin", claims.Username)
+	h.srv.endSeason()
+	return connect.NewResponse(&servicev1.EndSeasonResponse{World: mapping.WorldToProto(*world)}), nil
+}
+
+func (h *adminHandler) ReloadBalance(ctx context.Context, _ *connect.Request[servicev1.ReloadBalanceRequest]) (*connect.Response[servicev1.ReloadBalanceResponse], error) {
+	claims, err := h.requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	version, err := h.srv.reloadBalance()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	slog.InfoContext(ctx, "balance reloaded by admin", "version", version, "admin", claims.Username)
+	return connect.NewResponse(&servicev1.ReloadBalanceResponse{Version: version}), nil
+}
+
+func (h *adminHandler) MuteUser(ctx context.Context, req *connect.Request[servicev1.MuteUserRequest]) (*connect.Response[servicev1.MuteUserResponse], error) {
+	claims, err := h.requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	duration := req.Msg.GetDuration().AsDuration()
+	if duration <= 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("duration must be positive"))
+	}
+	if utf8.RuneCountInString(req.Msg.GetReason()) > constants.MaxMuteReasonLength {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("reason must be at most %d characters", constants.MaxMuteReasonLength))
+	}
+	userID := req.Msg.GetUserId().GetValue()
+	if err := h.requireUser(userID); err != nil {
+		return nil, err
+	}
+	until := time.Now().Add(duration)
+	if err := h.ack(userID, messages.MuteUserMessage{Until: until, Reason: req.Msg.GetReason(), MutedBy: claims.UserID}); err != nil {
+		return nil, err
+	}
+	slog.InfoContext(ctx, "user muted by admin", "user_id", userID, "until", until, "admin", claims.Username)
+	return connect.NewResponse(&servicev1.MuteUserResponse{}), nil
+}
+
+func (h *adminHandler) UnmuteUser(ctx context.Context, req *connect.Request[servicev1.UnmuteUserRequest]) (*connect.Response[servicev1.UnmuteUserResponse], error) {
+	claims, err := h.requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID := req.Msg.GetUserId().GetValue()
+	if err := h.requireUser(userID); err != nil {
+		return nil, err
+	}
+	if err := h.ack(userID, messages.UnmuteUserMessage{}); err != nil {
+		return nil, err
+	}
+	slog.InfoContext(ctx, "user unmuted by admin", "user_id", userID, "admin", claims.Username)
+	return connect.NewResponse(&servicev1.UnmuteUserResponse{}), nil
+}
+
+// requireUser rejects a user ID that names no player.
+func (h *adminHandler) requireUser(userID string) error {
+	if userID == "" {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("user_id is required"))
+	}
+	res, err := h.srv.cluster.Request("user", userID, messages.GetUserMessage{})
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	if resp, ok := res.(*messages.GetUserResponseMessage); !ok || resp.User.UserID == "" {
+		return connect.NewError(connect.CodeNotFound, errors.New("user not found"))
+	}
+	return nil
+}
+
+// ack sends msg to a user actor that answers Ack or an error.
+func (h *adminHandler) ack(userID string, msg any) error {
+	res, err := h.srv.cluster.Request("user", userID, msg)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	switch v := res.(type) {
+	case messages.Ack:
+		return nil
+	case error:
+		return connect.NewError(connect.CodeInternal, v)
+	default:
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("unexpected response: %T", res))
+	}
+}
@@ -0,0 +1,242 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+
+	"cityio/internal/auth"
+	entityv1 "cityio/internal/gen/cityio/entity/v1"
+	servicev1 "cityio/internal/gen/cityio/service/v1"
+	"cityio/internal/mapping"
+	"cityio/internal/messages"
+	"cityio/internal/persistence"
+	"cityio/internal/services"
+)
+
+type allianceHandler struct {
+	srv *Server
+}
+
+// allianceOf returns the ID of the alliance userID belongs to.
+func (h *allianceHandler) allianceOf(ctx context.Context, userID string) (string, error) {
+	member, err := h.srv.store.GetAllianceMembership(ctx, userID)
+	if errors.Is(err, persistence.ErrNotFound) {
+		return "", connect.NewError(connect.CodeFailedPrecondition, errors.New("not in an alliance"))
+	}
+	if err != nil {
+		return "", connect.NewError(connect.CodeInternal, err)
+	}
+	return member.AllianceID, nil
+}
+
+func (h *allianceHandler) CreateAlliance(ctx context.Context, req *connect.Request[servicev1.CreateAllianceRequest]) (*connect.Response[servicev1.CreateAllianceResponse], error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing claims"))
+	}
+	alliance, err := services.CreateAlliance(ctx, h.srv.cluster, claims.UserID, req.Msg.GetName())
+	if err != nil {
+		return nil, allianceError(err)
+	}
+	return connect.NewResponse(&servicev1.CreateAllianceResponse{Alliance: mapping.AllianceToProto(*alliance)}), nil
+}
+
+func (h *allianceHandler) GetAlliance(ctx context.Context, req *connect.Request[servicev1.GetAllianceRequest]) (*connect.Response[servicev1.GetAllianceResponse], error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing claims"))
+	}
+	allianceID := req.Msg.GetAllianceId().GetValue()
+	if allianceID == "" {
+		id, err := h.allianceOf(ctx, claims.UserID)
+		if err != nil {
+			return nil, err
+		}
+		allianceID = id
+	}
+	res, err := h.srv.cluster.Request("alliance", allianceID, messages.GetAllianceMessage{})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	switch v := res.(type) {
+	case *messages.GetAllianceResponse:
+		resp := &servicev1.GetAllianceResponse{Alliance: mapping.AllianceToProto(v.Alliance)}
+		if _, member := v.Alliance.Member(claims.UserID); member {
+			for _, i := range v.Invites {
+				resp.Invites = append(resp.Invites, mapping.AllianceInviteToProto(i))
+			}
+		}
+		return connect.NewResponse(resp), nil
+	case error:
+		return nil, allianceError(v)
+	default:
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unexpected response: %T", res))
+	}
+}
+
+func (h *allianceHandler) InviteToAlliance(ctx context.Context, req *connect.Request[servicev1.InviteToAllianceRequest]) (*connect.Response[servicev1.InviteToAllianceResponse], error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing claims"))
+	}
+	allianceID, err := h.allianceOf(ctx, claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+	inviteeID := req.Msg.GetUserId().GetValue()
+	res, err := h.srv.cluster.Request("user", inviteeID, messages.GetUserMessage{})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if resp, ok := res.(*messages.GetUserResponseMessage); !ok || resp.User.UserID == "" {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("user not found"))
+	}
+
+	res, err = h.srv.cluster.Request("alliance", allianceID, messages.InviteAllianceMemberMessage{
+		UserID:    claims.UserID,
+		InviteeID: inviteeID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	switch v := res.(type) {
+	case *messages.InviteAllianceMemberResponse:
+		return connect.NewResponse(&servicev1.InviteToAllianceResponse{Invite: mapping.AllianceInviteToProto(v.Invite)}), nil
+	case error:
+		return nil, allianceError(v)
+	default:
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unexpected response: %T", res))
+	}
+}
+
+func (h *allianceHandler) ListAllianceInvites(ctx context.Context, _ *connect.Request[servicev1.ListAllianceInvitesRequest]) (*connect.Response[servicev1.ListAllianceInvitesResponse], error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing claims"))
+	}
+	inviteList, err := h.srv.store.GetAllianceInvitesByUser(ctx, claims.UserID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	invites := make([]*entityv1.AllianceInvite, 0, len(inviteList))
+	for _, i := range inviteList {
+		invites = append(invites, mapping.AllianceInviteToProto(i))
+	}
+	return connect.NewResponse(&servicev1.ListAllianceInvitesResponse{Invites: invites}), nil
+}
+
+func (h *allianceHandler) AcceptAllianceInvite(ctx context.Context, req *connect.Request[servicev1.AcceptAllianceInviteRequest]) (*connect.Response[servicev1.AcceptAllianceInviteResponse], error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing claims"))
+	}
+	res, err := h.srv.cluster.Request("alliance", req.Msg.GetAllianceId().GetValue(), messages.AcceptAllianceInviteMessage{
+		UserID: claims.UserID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	switch v := res.(type) {
+	case *messages.AcceptAllianceInviteResponse:
+		return connect.NewResponse(&servicev1.AcceptAllianceInviteResponse{Alliance: mapping.AllianceToProto(v.Alliance)}), nil
+	case error:
+		return nil, allianceError(v)
+	default:
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unexpected response: %T", res))
+	}
+}
+
+func (h *allianceHandler) LeaveAlliance(ctx context.Context, _ *connect.Request[servicev1.LeaveAllianceRequest]) (*connect.Response[servicev1.LeaveAllianceResponse], error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing claims"))
+	}
+	allianceID, err := h.allianceOf(ctx, claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.requestAck(allianceID, messages.LeaveAllianceMessage{UserID: claims.UserID}); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&servicev1.LeaveAllianceResponse{}), nil
+}
+
+func (h *allianceHandler) KickAllianceMember(ctx context.Context, req *connect.Request[servicev1.KickAllianceMemberRequest]) (*connect.Response[servicev1.KickAllianceMemberResponse], error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing claims"))
+	}
+	allianceID, err := h.allianceOf(ctx, claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.requestAck(allianceID, messages.KickAllianceMemberMessage{
+		UserID:   claims.UserID,
+		MemberID: req.Msg.GetUserId().GetValue(),
+	}); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&servicev1.KickAllianceMemberResponse{}), nil
+}
+
+func (h *allianceHandler) SetAllianceRole(ctx context.Context, req *connect.Request[servicev1.SetAllianceRoleRequest]) (*connect.Response[servicev1.SetAllianceRoleResponse], error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing claims"))
+	}
+	allianceID, err := h.allianceOf(ctx, claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.requestAck(allianceID, messages.SetAllianceRoleMessage{
+		UserID:   claims.UserID,
+		MemberID: req.Msg.GetUserId().GetValue(),
+		Role:     mapping.AllianceRoleFromProto(req.Msg.GetRole()),
+	}); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&servicev1.SetAllianceRoleResponse{}), nil
+}
+
+// requestAck sends a roster change to the alliance actor, which answers Ack
+// or an error.
+func (h *allianceHandler) requestAck(allianceID string, msg any) error {
+	res, err := h.srv.cluster.Request("alliance", allianceID, msg)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	switch v := res.(type) {
+	case messages.Ack:
+		return nil
+	case error:
+		return allianceError(v)
+	default:
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("unexpected response: %T", res))
+	}
+}
+
+func allianceError(err error) error {
+	switch v := err.(type) {
+	case *messages.UnknownAllianceError:
+		return connect.NewError(connect.CodeNotFound, v)
+	case *messages.InvalidAllianceError:
+		return connect.NewError(connect.CodeInvalidArgument, v)
+	case *messages.AllianceNameTakenError:
+		return connect.NewError(connect.CodeAlreadyExists, v)
+	case *messages.AlreadyInAllianceError:
+		return connect.NewError(connect.CodeFailedPrecondition, v)
+	case *messages.NotAllianceMemberError:
+		return connect.NewError(connect.CodeNotFound, v)
+	case *messages.AlliancePermissionError:
+		return connect.NewError(connect.CodePermissionDenied, v)
+	case *messages.AllianceInviteNotFoundError:
+		return connect.NewError(connect.CodeNotFound, v)
+	case *messages.AllianceFullError:
+		return connect.NewError(connect.CodeResourceExhausted, v)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
@@ -0,0 +1,197 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+
+	"cityio/internal/auth"
+	"cityio/internal/constants"
+	"cityio/internal/domain"
+	entityv1 "cityio/internal/gen/cityio/entity/v1"
+	servicev1 "cityio/internal/gen/cityio/service/v1"
+	"cityio/internal/mapping"
+	"cityio/internal/messages"
+	"cityio/internal/services"
+	"cityio/internal/utils"
+)
+
+type armyHandler struct {
+	srv *Server
+}
+
+// getArmy reads an army's live state from its actor.
+func (h *armyHandler) getArmy(armyID string) (*domain.Army, error) {
+	res, err := h.srv.cluster.Request("army", armyID, messages.GetArmyMessage{})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	resp, ok := res.(*messages.GetArmyResponseMessage)
+	if !ok || resp.Army.ArmyID == "" {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("army not found"))
+	}
+	return &resp.Army, nil
+}
+
+// checkDestination refuses to march on another player's city unless the
+// caller is at war with them. Neutral towns and open ground are always fair
+// game; an army already under way when peace breaks out holds outside the
+// city instead.
+func (h *armyHandler) checkDestination(ctx context.Context, userID string, x, y int) error {
+	res, err := h.srv.cluster.Request("tile", utils.GetTileIndex(x, y), messages.GetTileMessage{})
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	tile, ok := res.(messages.GetTileResponseMessage)
+	if !ok || tile.CityID == nil {
+		return nil
+	}
+	res, err = h.srv.cluster.Request("city", *tile.CityID, messages.GetCityMessage{})
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	city, ok := res.(*messages.GetCityResponseMessage)
+	if !ok || city.City.Owner == nil || *city.City.Owner == userID {
+		return nil
+	}
+	war, err := h.srv.atWar(ctx, userID, *city.City.Owner)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	if !war {
+		return connect.NewError(connect.CodeFailedPrecondition, errors.New("not at war with the city's owner"))
+	}
+	return nil
+}
+
+func (h *armyHandler) MoveArmy(ctx context.Context, req *connect.Request[servicev1.MoveArmyRequest]) (*connect.Response[servicev1.MoveArmyResponse], error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing claims"))
+	}
+	destX := int(req.Msg.GetDestination().GetX())
+	destY := int(req.Msg.GetDestination().GetY())
+	if destX < 0 || destY < 0 || destX >= constants.MapSize || destY >= constants.MapSize {
+		return nil, connect.NewError(connect.CodeInvalidArgument, &messages.OutOfBoundsError{X: destX, Y: destY})
+	}
+	if err := h.checkDestination(ctx, claims.UserID, destX, destY); err != nil {
+		return nil, err
+	}
+
+	if req.Msg.ArmyId != nil {
+		army, err := h.getArmy(req.Msg.GetArmyId().GetValue())
+		if err != nil {
+			return nil, err
+		}
+		if army.Owner != claims.UserID {
+			return nil, connect.NewError(connect.CodePermissionDenied, errors.New("army not owned by caller"))
+		}
+		res, err := h.srv.cluster.Request("army", army.ArmyID, messages.MoveArmyMessage{X: destX, Y: destY})
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		switch v := res.(type) {
+		case messages.Ack:
+		case *messages.OutOfBoundsError:
+			return nil, connect.NewError(connect.CodeInvalidArgument, v)
+		case error:
+			return nil, connect.NewError(connect.CodeInternal, v)
+		default:
+			return nil, connect.NewError(connect.CodeInternal, errors.New("unexpected move response"))
+		}
+		army, err = h.getArmy(army.ArmyID)
+		if err != nil {
+			return nil, err
+		}
+		return connect.NewResponse(&servicev1.MoveArmyResponse{Army: mapping.ArmyToProto(*army)}), nil
+	}
+
+	if req.Msg.CityId == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("army_id or city_id is required"))
+	}
+	if req.Msg.GetTroops() <= 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("troops must be positive"))
+	}
+	owned, err := h.srv.ownedCities(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	var source *domain.City
+	for i := range owned {
+		if owned[i].CityID == req.Msg.GetCityId().GetValue() {
+			source = &owned[i]
+			break
+		}
+	}
+	if source == nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("city not owned by caller"))
+	}
+
+	army, err := services.CreateArmy(ctx, h.srv.cluster, &services.ArmyInput{
+		Owner:        claims.UserID,
+		CityID:       source.CityID,
+		Troops:       req.Msg.GetTroops(),
+		X:            source.StartX + source.Size/2,
+		Y:            source.StartY + source.Size/2,
+		DestinationX: destX,
+		DestinationY: destY,
+	})
+	var insufficient *messages.InsufficientTroopsError
+	if errors.As(err, &insufficient) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, insufficient)
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&servicev1.MoveArmyResponse{Army: mapping.ArmyToProto(*army)}), nil
+}
+
+func (h *armyHandler) GetArmy(ctx context.Context, req *connect.Request[servicev1.GetArmyRequest]) (*connect.Response[servicev1.GetArmyResponse], error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing claims"))
+	}
+	army, err := h.getArmy(req.Msg.GetArmyId().GetValue())
+	if err != nil {
+		return nil, err
+	}
+
+	if army.Owner != claims.UserID {
+		visible, err := h.srv.alliedCities(ctx)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		if !domain.PointVisible(visible, army.X, army.Y, constants.VisionRadius) {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("army not found"))
+		}
+	}
+	return connect.NewResponse(&servicev1.GetArmyResponse{Army: mapping.ArmyToProto(*army)}), nil
+}
+
+func (h *armyHandler) ListArmies(ctx context.Context, req *connect.Request[servicev1.ListArmiesRequest]) (*connect.Response[servicev1.ListArmiesResponse], error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing claims"))
+	}
+	armyList, err := h.srv.store.GetArmiesByOwner(ctx, claims.UserID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	// Positions in the store lag by up to TroopMovementBackupFrequency steps;
+	// prefer each actor's live state.
+	armyIds := make([]*entityv1.ArmyId, 0, len(armyList))
+	bag := &entityv1.EntityBag{}
+	for _, a := range armyList {
+		if live, err := h.getArmy(a.ArmyID); err == nil {
+			a = *live
+		}
+		armyIds = append(armyIds, mapping.ToArmyId(a.ArmyID))
+		bag.Armies = append(bag.Armies, mapping.ArmyToProto(a))
+	}
+	return connect.NewResponse(&servicev1.ListArmiesResponse{
+		ArmyIds:  armyIds,
+		Entities: bag,
+	}), nil
+}
@@ -0,0 +1,64 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+
+	"cityio/internal/auth"
+	entityv1 "cityio/internal/gen/cityio/entity/v1"
+	servicev1 "cityio/internal/gen/cityio/service/v1"
+	"cityio/internal/mapping"
+	"cityio/internal/persistence"
+)
+
+const (
+	defaultBattleReportLimit = 50
+	maxBattleReportLimit     = 200
+)
+
+type battleHandler struct {
+	srv *Server
+}
+
+func (h *battleHandler) GetBattleReport(ctx context.Context, req *connect.Request[servicev1.GetBattleReportRequest]) (*connect.Response[servicev1.GetBattleReportResponse], error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing claims"))
+	}
+	report, err := h.srv.store.GetBattleReport(ctx, req.Msg.GetReportId().GetValue())
+	if errors.Is(err, persistence.ErrNotFound) {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("battle report not found"))
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	// Only the two sides of a battle may read its report.
+	if report.Attacker != claims.UserID && (report.Defender == nil || *report.Defender != claims.UserID) {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("battle report not found"))
+	}
+	return connect.NewResponse(&servicev1.GetBattleReportResponse{Report: mapping.BattleReportToProto(*report)}), nil
+}
+
+func (h *battleHandler) ListBattleReports(ctx context.Context, req *connect.Request[servicev1.ListBattleReportsRequest]) (*connect.Response[servicev1.ListBattleReportsResponse], error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing claims"))
+	}
+	limit := int(req.Msg.GetLimit())
+	if limit <= 0 {
+		limit = defaultBattleReportLimit
+	}
+	limit = min(limit, maxBattleReportLimit)
+
+	reportList, err := h.srv.store.GetBattleReportsByUser(ctx, claims.UserID, limit)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	reports := make([]*entityv1.BattleReport, 0, len(reportList))
+	for _, r := range reportList {
+		reports = append(reports, mapping.BattleReportToProto(r))
+	}
+	return connect.NewResponse(&servicev1.ListBattleReportsResponse{Reports: reports}), nil
+}
@@ -3,9 +3,12 @@ package rpc
 import (
 	"context"
 	"errors"
+	"fmt"
+	"slices"
 
 	"connectrpc.com/connect"
 
+	"cityio/internal/auth"
 	"cityio/internal/constants"
 	"cityio/internal/domain"
 	entityv1 "cityio/internal/gen/cityio/entity/v1"
@@ -19,23 +22,23 @@ type buildingHandler struct {
 	srv *Server
 }
 
-func (h *buildingHandler) requireBuildingOwnership(ctx context.Context, buildingID string) error {
+func (h *buildingHandler) requireBuildingOwnership(ctx context.Context, buildingID string) (*domain.Building, error) {
 	res, err := h.srv.cluster.Request("building", buildingID, messages.GetBuildingMessage{})
 	if err != nil {
-		return connect.NewError(connect.CodeInternal, err)
+		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 	resp, ok := res.(*messages.GetBuildingResponseMessage)
 	if !ok {
-		return connect.NewError(connect.CodeNotFound, errors.New("building not found"))
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("building not found"))
 	}
 	owns, err := h.srv.ownsCity(ctx, resp.Building.CityID)
 	if err != nil {
-		return connect.NewError(connect.CodeInternal, err)
+		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 	if !owns {
-		return connect.NewError(connect.CodePermissionDenied, errors.New("building not owned by caller"))
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("building not owned by caller"))
 	}
-	return nil
+	return &resp.Building, nil
 }
 
 func (h *buildingHandler) CreateBuilding(ctx context.Context, req *connect.Request[servicev1.CreateBuildingRequest]) (*connect.Response[servicev1.CreateBuildingResponse], error) {
@@ -49,12 +52,12 @@ func (h *buildingHandler) CreateBuilding(ctx context.Context, req *connect.Reque
 	}
 	building, err := services.CreateBuilding(ctx, h.srv.cluster, &services.BuildingInput{
 		CityID: cityID,
-		Type:   mapping.BuildingTypeFromProto(req.Msg.GetType()),
+		Type:   mapping.BuildingTypeFromRequest(req.Msg.GetType(), req.Msg.GetTypeId()),
 		X:      int(req.Msg.GetCoords().GetX()),
 		Y:      int(req.Msg.GetCoords().GetY()),
 	})
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, constructionError(err)
 	}
 	return connect.NewResponse(&servicev1.CreateBuildingResponse{Building: mapping.BuildingToProto(*building)}), nil
 }
@@ -69,20 +72,28 @@ func (h *buildingHandler) GetBuilding(ctx context.Context, req *connect.Request[
 		return nil, connect.NewError(connect.CodeNotFound, errors.New("building not found"))
 	}
 
-	owned, err := h.srv.ownedCities(ctx)
+	visible, err := h.srv.alliedCities(ctx)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
-	if !domain.PointVisible(owned, resp.Building.X, resp.Building.Y, constants.VisionRadius) {
+	if !domain.PointVisible(visible, resp.Building.X, resp.Building.Y, constants.VisionRadius) {
 		return nil, connect.NewError(connect.CodeNotFound, errors.New("building not found"))
 	}
 
-	return connect.NewResponse(&servicev1.GetBuildingResponse{Building: mapping.BuildingToProto(resp.Building)}), nil
+	// Allies share vision, not economy intel: private fields stay owner-only.
+	claims, _ := auth.ClaimsFromContext(ctx)
+	building := mapping.BuildingToProto(resp.Building)
+	if !slices.ContainsFunc(visible, func(c domain.City) bool {
+		return c.CityID == resp.Building.CityID && c.Owner != nil && *c.Owner == claims.UserID
+	}) {
+		mapping.HidePrivateBuildingFields(building)
+	}
+	return connect.NewResponse(&servicev1.GetBuildingResponse{Building: building}), nil
 }
 
 func (h *buildingHandler) UpgradeBuilding(ctx context.Context, req *connect.Request[servicev1.UpgradeBuildingRequest]) (*connect.Response[servicev1.UpgradeBuildingResponse], error) {
 	bid := req.Msg.GetBuildingId().GetValue()
-	if err := h.requireBuildingOwnership(ctx, bid); err != nil {
+	if _, err := h.requireBuildingOwnership(ctx, bid); err != nil {
 		return nil, err
 	}
 	res, err := h.srv.cluster.Request("building", bid, messages.UpgradeBuildingMessage{})
@@ -94,21 +105,78 @@ func (h *buildingHandler) UpgradeBuilding(ctx context.Context, req *connect.Requ
 	case messages.Ack:
 		return connect.NewResponse(&servicev1.UpgradeBuildingResponse{}), nil
 	case *messages.InsufficientGoldError:
-		return nil, connect.NewError(connect.CodeFailedPrecondition, v)
+		return nil, insufficientGoldError(v)
 	case *messages.ConstructionInProgressError:
 		return nil, connect.NewError(connect.CodeFailedPrecondition, v)
 	case *messages.MaxLevelReachedError:
 		return nil, connect.NewError(connect.CodeFailedPrecondition, v)
 	case error:
-		return nil, connect.NewError(connect.CodeInternal, v)
+		return nil, constructionError(v)
 	default:
 		return nil, connect.NewError(connect.CodeInternal, errors.New("unexpected upgrade response"))
 	}
 }
 
+func (h *buildingHandler) CancelConstruction(ctx context.Context, req *connect.Request[servicev1.CancelConstructionRequest]) (*connect.Response[servicev1.CancelConstructionResponse], error) {
+	bid := req.Msg.GetBuildingId().GetValue()
+	if _, err := h.requireBuildingOwnership(ctx, bid); err != nil {
+		return nil, err
+	}
+	res, err := h.srv.cluster.Request("building", bid, messages.CancelConstructionMessage{})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	switch v := res.(type) {
+	case *messages.CancelConstructionResponseMessage:
+		return connect.NewResponse(&servicev1.CancelConstructionResponse{
+			Refund:  v.Refund,
+			Removed: v.Removed,
+		}), nil
+	case *messages.NoConstructionInProgressError:
+		return nil, connect.NewError(connect.CodeFailedPrecondition, v)
+	case error:
+		return nil, connect.NewError(connect.CodeInternal, v)
+	default:
+		return nil, connect.NewError(connect.CodeInternal, errors.New("unexpected cancel response"))
+	}
+}
+
+func (h *buildingHandler) SpeedUpConstruction(ctx context.Context, req *connect.Request[servicev1.SpeedUpConstructionRequest]) (*connect.Response[servicev1.SpeedUpConstructionResponse], error) {
+	if req.Msg.GetSkip().AsDuration() < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("skip must not be negative"))
+	}
+	bid := req.Msg.GetBuildingId().GetValue()
+	if _, err := h.requireBuildingOwnership(ctx, bid); err != nil {
+		return nil, err
+	}
+	res, err := h.srv.cluster.Request("building", bid, messages.SpeedUpConstructionMessage{
+		Skip: req.Msg.GetSkip().AsDuration(),
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	switch v := res.(type) {
+	case *messages.SpeedUpConstructionResponseMessage:
+		return connect.NewResponse(&servicev1.SpeedUpConstructionResponse{
+			Cost:     v.Cost,
+			Building: mapping.BuildingToProto(v.Building),
+		}), nil
+	case *messages.InsufficientGoldError:
+		return nil, insufficientGoldError(v)
+	case *messages.NoConstructionInProgressError:
+		return nil, connect.NewError(connect.CodeFailedPrecondition, v)
+	case error:
+		return nil, connect.NewError(connect.CodeInternal, v)
+	default:
+		return nil, connect.NewError(connect.CodeInternal, errors.New("unexpected speed-up response"))
+	}
+}
+
 func (h *buildingHandler) DeleteBuilding(ctx context.Context, req *connect.Request[servicev1.DeleteBuildingRequest]) (*connect.Response[servicev1.DeleteBuildingResponse], error) {
 	bid := req.Msg.GetBuildingId().GetValue()
-	if err := h.requireBuildingOwnership(ctx, bid); err != nil {
+	if _, err := h.requireBuildingOwnership(ctx, bid); err != nil {
 		return nil, err
 	}
 	if err := h.srv.cluster.Tell("building", bid, messages.DeleteBuildingMessage{BuildingID: bid}); err != nil {
@@ -117,17 +185,62 @@ func (h *buildingHandler) DeleteBuilding(ctx context.Context, req *connect.Reque
 	return connect.NewResponse(&servicev1.DeleteBuildingResponse{}), nil
 }
 
+func (h *buildingHandler) TrainTroops(ctx context.Context, req *connect.Request[servicev1.TrainTroopsRequest]) (*connect.Response[servicev1.TrainTroopsResponse], error) {
+	troops := req.Msg.GetTroops()
+	if troops <= 0 || troops > constants.MaxTroopsPerTraining {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("troops must be between 1 and %d", constants.MaxTroopsPerTraining))
+	}
+	bid := req.Msg.GetBuildingId().GetValue()
+	building, err := h.requireBuildingOwnership(ctx, bid)
+	if err != nil {
+		return nil, err
+	}
+	if !constants.CanTrain(building.BuildingType()) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("building does not train troops"))
+	}
+	res, err := h.srv.cluster.Request("building", bid, messages.TrainTroopsMessage{Troops: troops})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	switch v := res.(type) {
+	case messages.Ack:
+	case *messages.InsufficientGoldError:
+		return nil, insufficientGoldError(v)
+	case *messages.InsufficientFoodError:
+		return nil, insufficientFoodError(v)
+	case *messages.BuildingNotReadyError:
+		return nil, connect.NewError(connect.CodeFailedPrecondition, v)
+	case *messages.TrainingQueueFullError:
+		return nil, connect.NewError(connect.CodeResourceExhausted, v)
+	case error:
+		return nil, connect.NewError(connect.CodeInternal, v)
+	default:
+		return nil, connect.NewError(connect.CodeInternal, errors.New("unexpected train response"))
+	}
+
+	res, err = h.srv.cluster.Request("building", bid, messages.GetBuildingMessage{})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	resp, ok := res.(*messages.GetBuildingResponseMessage)
+	if !ok {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("building not found"))
+	}
+	return connect.NewResponse(&servicev1.TrainTroopsResponse{Building: mapping.BuildingToProto(resp.Building)}), nil
+}
+
 func (h *buildingHandler) ListBuildings(ctx context.Context, req *connect.Request[servicev1.ListBuildingsRequest]) (*connect.Response[servicev1.ListBuildingsResponse], error) {
 	buildingList, err := h.srv.store.GetBuildingsByCity(ctx, req.Msg.GetCityId().GetValue())
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
-	owned, err := h.srv.ownedCities(ctx)
+	visible, err := h.srv.alliedCities(ctx)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
-	buildingList = domain.FilterBuildings(owned, buildingList, constants.VisionRadius)
+	buildingList = domain.FilterBuildings(visible, buildingList, constants.VisionRadius)
 
 	buildings := make([]*entityv1.Building, 0, len(buildingList))
 	for _, b := range buildingList {
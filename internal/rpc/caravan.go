@@ -0,0 +1,150 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+
+	"cityio/internal/auth"
+	"cityio/internal/constants"
+	"cityio/internal/domain"
+	entityv1 "cityio/internal/gen/cityio/entity/v1"
+	servicev1 "cityio/internal/gen/cityio/service/v1"
+	"cityio/internal/mapping"
+	"cityio/internal/messages"
+	"cityio/internal/services"
+)
+
+type caravanHandler struct {
+	srv *Server
+}
+
+// getCaravan reads a caravan's live state from its actor.
+func (h *caravanHandler) getCaravan(caravanID string) (*domain.Caravan, error) {
+	res, err := h.srv.cluster.Request("caravan", caravanID, messages.GetCaravanMessage{})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	resp, ok := res.(*messages.GetCaravanResponseMessage)
+	if !ok || resp.Caravan.CaravanID == "" {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("caravan not found"))
+	}
+	return &resp.Caravan, nil
+}
+
+func (h *caravanHandler) SendCaravan(ctx context.Context, req *connect.Request[servicev1.SendCaravanRequest]) (*connect.Response[servicev1.SendCaravanResponse], error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing claims"))
+	}
+	gold, food := req.Msg.GetGold(), req.Msg.GetFood()
+	if gold < 0 || food < 0 || gold+food <= 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, &messages.InvalidCaravanError{Reason: "cargo must be positive"})
+	}
+	if capacity := constants.GetBalance().Caravans.Capacity; gold+food > capacity {
+		return nil, connect.NewError(connect.CodeInvalidArgument, &messages.InvalidCaravanError{Reason: fmt.Sprintf("cargo exceeds capacity of %d", capacity)})
+	}
+	sourceID := req.Msg.GetCityId().GetValue()
+	destinationID := req.Msg.GetDestinationCityId().GetValue()
+	if sourceID == destinationID {
+		return nil, connect.NewError(connect.CodeInvalidArgument, &messages.InvalidCaravanError{Reason: "destination is the source city"})
+	}
+
+	// Caravans leave from the caller's own cities but may deliver to an
+	// ally's as a gift.
+	cities, err := h.srv.alliedCities(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	var source, destination *domain.City
+	for i := range cities {
+		switch cities[i].CityID {
+		case sourceID:
+			if cities[i].Owner != nil && *cities[i].Owner == claims.UserID {
+				source = &cities[i]
+			}
+		case destinationID:
+			destination = &cities[i]
+		}
+	}
+	if source == nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("city not owned by caller"))
+	}
+	if destination == nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("destination city not owned by caller or an ally"))
+	}
+
+	caravan, err := services.CreateCaravan(ctx, h.srv.cluster, &services.CaravanInput{
+		Owner:             claims.UserID,
+		SourceCityID:      source.CityID,
+		DestinationCityID: destination.CityID,
+		Gold:              gold,
+		Food:              food,
+		X:                 source.StartX + source.Size/2,
+		Y:                 source.StartY + source.Size/2,
+		DestinationX:      destination.StartX + destination.Size/2,
+		DestinationY:      destination.StartY + destination.Size/2,
+	})
+	var insufficientGold *messages.InsufficientGoldError
+	if errors.As(err, &insufficientGold) {
+		return nil, insufficientGoldError(insufficientGold)
+	}
+	var insufficientFood *messages.InsufficientStoredFoodError
+	if errors.As(err, &insufficientFood) {
+		return nil, insufficientStoredFoodError(insufficientFood)
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&servicev1.SendCaravanResponse{Caravan: mapping.CaravanToProto(*caravan)}), nil
+}
+
+func (h *caravanHandler) GetCaravan(ctx context.Context, req *connect.Request[servicev1.GetCaravanRequest]) (*connect.Response[servicev1.GetCaravanResponse], error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing claims"))
+	}
+	caravan, err := h.getCaravan(req.Msg.GetCaravanId().GetValue())
+	if err != nil {
+		return nil, err
+	}
+
+	if caravan.Owner != claims.UserID {
+		visible, err := h.srv.alliedCities(ctx)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		if !domain.PointVisible(visible, caravan.X, caravan.Y, constants.VisionRadius) {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("caravan not found"))
+		}
+	}
+	return connect.NewResponse(&servicev1.GetCaravanResponse{Caravan: mapping.CaravanToProto(*caravan)}), nil
+}
+
+func (h *caravanHandler) ListCaravans(ctx context.Context, _ *connect.Request[servicev1.ListCaravansRequest]) (*connect.Response[servicev1.ListCaravansResponse], error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing claims"))
+	}
+	caravanList, err := h.srv.store.GetCaravansByOwner(ctx, claims.UserID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	// Positions in the store lag like army positions; prefer live state.
+	caravanIds := make([]*entityv1.CaravanId, 0, len(caravanList))
+	bag := &entityv1.EntityBag{}
+	for _, c := range caravanList {
+		if live, err := h.getCaravan(c.CaravanID); err == nil {
+			c = *live
+		}
+		caravanIds = append(caravanIds, mapping.ToCaravanId(c.CaravanID))
+		bag.Caravans = append(bag.Caravans, mapping.CaravanToProto(c))
+	}
+	return connect.NewResponse(&servicev1.ListCaravansResponse{
+		CaravanIds: caravanIds,
+		Entities:   bag,
+	}), nil
+}
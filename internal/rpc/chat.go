@@ -0,0 +1,170 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"connectrpc.com/connect"
+
+	"cityio/internal/auth"
+	"cityio/internal/domain"
+	entityv1 "cityio/internal/gen/cityio/entity/v1"
+	servicev1 "cityio/internal/gen/cityio/service/v1"
+	"cityio/internal/mapping"
+	"cityio/internal/messages"
+	"cityio/internal/persistence"
+	"cityio/internal/stream"
+)
+
+const (
+	defaultChatHistoryLimit = 50
+	maxChatHistoryLimit     = 200
+)
+
+type chatHandler struct {
+	srv *Server
+}
+
+func (h *chatHandler) SendMessage(ctx context.Context, req *connect.Request[servicev1.SendMessageRequest]) (*connect.Response[servicev1.SendMessageResponse], error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing claims"))
+	}
+	channel, err := h.channel(ctx, claims.UserID, req.Msg.GetChannel())
+	if err != nil {
+		return nil, err
+	}
+	res, err := h.srv.cluster.Request("user", claims.UserID, messages.SendChatMessage{
+		Channel: channel,
+		Body:    req.Msg.GetBody(),
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	switch v := res.(type) {
+	case *messages.SendChatResponse:
+		return connect.NewResponse(&servicev1.SendMessageResponse{Message: mapping.ChatMessageToProto(v.Message, claims.UserID)}), nil
+	case error:
+		return nil, chatError(v)
+	default:
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unexpected response: %T", res))
+	}
+}
+
+func (h *chatHandler) Subscribe(ctx context.Context, req *connect.Request[servicev1.SubscribeRequest], out *connect.ServerStream[servicev1.SubscribeResponse]) error {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return connect.NewError(connect.CodeUnauthenticated, errors.New("missing claims"))
+	}
+	var kinds []domain.ChatChannelKind
+	for _, k := range req.Msg.GetKinds() {
+		kinds = append(kinds, mapping.ChatChannelKindFromProto(k))
+	}
+
+	ch, unsubscribe := stream.SubscribeChat(claims.UserID)
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-h.srv.shutdownCtx.Done():
+			return connect.NewError(connect.CodeUnavailable, errors.New("server shutting down"))
+		case message, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			if len(kinds) > 0 && !slices.Contains(kinds, message.Channel.Kind) {
+				continue
+			}
+			if err := out.Send(&servicev1.SubscribeResponse{Message: mapping.ChatMessageToProto(message, claims.UserID)}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (h *chatHandler) ListMessages(ctx context.Context, req *connect.Request[servicev1.ListMessagesRequest]) (*connect.Response[servicev1.ListMessagesResponse], error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing claims"))
+	}
+	channel, err := h.channel(ctx, claims.UserID, req.Msg.GetChannel())
+	if err != nil {
+		return nil, err
+	}
+	limit := int(req.Msg.GetLimit())
+	if limit <= 0 {
+		limit = defaultChatHistoryLimit
+	}
+	limit = min(limit, maxChatHistoryLimit)
+
+	messageList, err := h.srv.store.GetChatMessages(ctx, channel, req.Msg.GetPageToken(), limit)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	resp := &servicev1.ListMessagesResponse{
+		Messages: make([]*entityv1.ChatMessage, 0, len(messageList)),
+	}
+	for _, m := range messageList {
+		resp.Messages = append(resp.Messages, mapping.ChatMessageToProto(m, claims.UserID))
+	}
+	// A short page is the last one.
+	if len(messageList) == limit {
+		resp.NextPageToken = messageList[len(messageList)-1].MessageID
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// channel resolves the channel a request names, checking the caller may use
+// it: the alliance channel is the caller's own alliance, and a direct channel
+// needs another player who exists.
+func (h *chatHandler) channel(ctx context.Context, userID string, c *entityv1.ChatChannel) (domain.ChatChannel, error) {
+	switch mapping.ChatChannelKindFromProto(c.GetKind()) {
+	case domain.ChatChannelGlobal:
+		return domain.GlobalChatChannel(), nil
+
+	case domain.ChatChannelAlliance:
+		member, err := h.srv.store.GetAllianceMembership(ctx, userID)
+		if errors.Is(err, persistence.ErrNotFound) {
+			return domain.ChatChannel{}, connect.NewError(connect.CodeFailedPrecondition, errors.New("not in an alliance"))
+		}
+		if err != nil {
+			return domain.ChatChannel{}, connect.NewError(connect.CodeInternal, err)
+		}
+		if id := c.GetAllianceId().GetValue(); id != "" && id != member.AllianceID {
+			return domain.ChatChannel{}, connect.NewError(connect.CodePermissionDenied, errors.New("not a member of this alliance"))
+		}
+		return domain.AllianceChatChannel(member.AllianceID), nil
+
+	case domain.ChatChannelDirect:
+		otherID := c.GetUserId().GetValue()
+		if otherID == "" || otherID == userID {
+			return domain.ChatChannel{}, connect.NewError(connect.CodeInvalidArgument, errors.New("user_id must name another player"))
+		}
+		res, err := h.srv.cluster.Request("user", otherID, messages.GetUserMessage{})
+		if err != nil {
+			return domain.ChatChannel{}, connect.NewError(connect.CodeInternal, err)
+		}
+		if resp, ok := res.(*messages.GetUserResponseMessage); !ok || resp.User.UserID == "" {
+			return domain.ChatChannel{}, connect.NewError(connect.CodeNotFound, errors.New("user not found"))
+		}
+		return domain.DirectChatChannel(userID, otherID), nil
+	}
+	return domain.ChatChannel{}, connect.NewError(connect.CodeInvalidArgument, errors.New("channel kind is required"))
+}
+
+func chatError(err error) error {
+	switch v := err.(type) {
+	case *messages.ChatMutedError:
+		return connect.NewError(connect.CodePermissionDenied, v)
+	case *messages.ChatRateLimitedError:
+		return connect.NewError(connect.CodeResourceExhausted, v)
+	case *messages.InvalidChatMessageError:
+		return connect.NewError(connect.CodeInvalidArgument, v)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
@@ -3,6 +3,7 @@ package rpc
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"connectrpc.com/connect"
 
@@ -30,11 +31,11 @@ func (h *cityHandler) GetCity(ctx context.Context, req *connect.Request[servicev
 		return nil, connect.NewError(connect.CodeNotFound, errors.New("city not found"))
 	}
 
-	owned, err := h.srv.ownedCities(ctx)
+	visible, err := h.srv.alliedCities(ctx)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
-	if !domain.CityVisible(owned, resp.City, constants.VisionRadius) {
+	if !domain.CityVisible(visible, resp.City, constants.VisionRadius) {
 		return nil, connect.NewError(connect.CodeNotFound, errors.New("city not found"))
 	}
 
@@ -84,3 +85,114 @@ func (h *cityHandler) ListCities(ctx context.Context, req *connect.Request[servi
 		Entities: mapping.EntitiesToBag(nil, cityList, nil),
 	}), nil
 }
+
+func (h *cityHandler) SetImportPriority(ctx context.Context, req *connect.Request[servicev1.SetImportPriorityRequest]) (*connect.Response[servicev1.SetImportPriorityResponse], error) {
+	cityID := req.Msg.GetCityId().GetValue()
+	owns, err := h.srv.ownsCity(ctx, cityID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if !owns {
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("city not owned by caller"))
+	}
+	if _, err := h.srv.cluster.Request("city", cityID, messages.SetImportPriorityMessage{
+		Priority: int(req.Msg.GetPriority()),
+	}); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&servicev1.SetImportPriorityResponse{}), nil
+}
+
+func (h *cityHandler) SetTaxRate(ctx context.Context, req *connect.Request[servicev1.SetTaxRateRequest]) (*connect.Response[servicev1.SetTaxRateResponse], error) {
+	cityID := req.Msg.GetCityId().GetValue()
+	rate := int(req.Msg.GetTaxRate())
+	if maxRate := constants.GetBalance().Tax.MaxRate; rate < 0 || rate > maxRate {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("tax rate must be between 0 and %d", maxRate))
+	}
+	owns, err := h.srv.ownsCity(ctx, cityID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if !owns {
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("city not owned by caller"))
+	}
+	if _, err := h.srv.cluster.Request("city", cityID, messages.SetTaxRateMessage{Rate: rate}); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&servicev1.SetTaxRateResponse{}), nil
+}
+
+func (h *cityHandler) EnqueueConstruction(ctx context.Context, req *connect.Request[servicev1.EnqueueConstructionRequest]) (*connect.Response[servicev1.EnqueueConstructionResponse], error) {
+	cityID := req.Msg.GetCityId().GetValue()
+	owns, err := h.srv.ownsCity(ctx, cityID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if !owns {
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("city not owned by caller"))
+	}
+
+	var msg messages.EnqueueConstructionMessage
+	switch order := req.Msg.GetOrder().(type) {
+	case *servicev1.EnqueueConstructionRequest_Build:
+		msg.BuildingType = mapping.BuildingTypeFromRequest(order.Build.GetType(), order.Build.GetTypeId())
+		msg.X = int(order.Build.GetCoords().GetX())
+		msg.Y = int(order.Build.GetCoords().GetY())
+	case *servicev1.EnqueueConstructionRequest_Upgrade:
+		id := order.Upgrade.GetValue()
+		msg.BuildingID = &id
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("build or upgrade is required"))
+	}
+
+	res, err := h.srv.cluster.Request("city", cityID, msg)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	switch v := res.(type) {
+	case *messages.EnqueueConstructionResponseMessage:
+		return connect.NewResponse(&servicev1.EnqueueConstructionResponse{Order: mapping.ConstructionOrderToProto(v.Order)}), nil
+	case error:
+		return nil, constructionError(v)
+	default:
+		return nil, connect.NewError(connect.CodeInternal, errors.New("unexpected enqueue response"))
+	}
+}
+
+func (h *cityHandler) ReorderConstructionQueue(ctx context.Context, req *connect.Request[servicev1.ReorderConstructionQueueRequest]) (*connect.Response[servicev1.ReorderConstructionQueueResponse], error) {
+	cityID := req.Msg.GetCityId().GetValue()
+	owns, err := h.srv.ownsCity(ctx, cityID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if !owns {
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("city not owned by caller"))
+	}
+	res, err := h.srv.cluster.Request("city", cityID, messages.ReorderConstructionMessage{OrderIDs: req.Msg.GetOrderIds()})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if e, ok := res.(error); ok {
+		return nil, constructionError(e)
+	}
+	return connect.NewResponse(&servicev1.ReorderConstructionQueueResponse{}), nil
+}
+
+func (h *cityHandler) CancelConstructionOrder(ctx context.Context, req *connect.Request[servicev1.CancelConstructionOrderRequest]) (*connect.Response[servicev1.CancelConstructionOrderResponse], error) {
+	cityID := req.Msg.GetCityId().GetValue()
+	owns, err := h.srv.ownsCity(ctx, cityID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if !owns {
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("city not owned by caller"))
+	}
+	res, err := h.srv.cluster.Request("city", cityID, messages.CancelConstructionOrderMessage{OrderID: req.Msg.GetOrderId()})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if e, ok := res.(error); ok {
+		return nil, constructionError(e)
+	}
+	return connect.NewResponse(&servicev1.CancelConstructionOrderResponse{}), nil
+}
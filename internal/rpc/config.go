@@ -2,12 +2,15 @@ package rpc
 
 import (
 	"context"
+	"maps"
+	"slices"
 	"time"
 
 	"connectrpc.com/connect"
 	"google.golang.org/protobuf/types/known/durationpb"
 
 	"cityio/internal/constants"
+	entityv1 "cityio/internal/gen/cityio/entity/v1"
 	servicev1 "cityio/internal/gen/cityio/service/v1"
 	"cityio/internal/mapping"
 )
@@ -16,39 +19,119 @@ type configHandler struct {
 	srv *Server
 }
 
+// GetGameConfig serves the balance snapshot in effect. Its version changes on
+// every reload.
 func (h *configHandler) GetGameConfig(_ context.Context, _ *connect.Request[servicev1.GetGameConfigRequest]) (*connect.Response[servicev1.GetGameConfigResponse], error) {
+	snap := constants.Current()
+	balance := snap.Balance
 	return connect.NewResponse(&servicev1.GetGameConfigResponse{
 		MapSize:      constants.MapSize,
 		CitySize:     constants.CitySize,
 		VisionRadius: constants.VisionRadius,
 		BuildingTick: durationpb.New(constants.BuildingTickInterval * time.Second),
 		CityTick:     durationpb.New(constants.CityTickInterval * time.Second),
-		Buildings:    buildBuildingConfigs(),
+		Buildings:    buildBuildingConfigs(snap),
+		TroopCost: []*servicev1.ResourceAmount{
+			{Resource: "gold", Amount: balance.Troops.GoldCost},
+			{Resource: "food", Amount: balance.Troops.FoodCost},
+		},
+		SpeedUp: &servicev1.SpeedUpPricing{
+			GoldPerSecond: balance.Construction.SpeedUpGoldPerSecond,
+			MinimumCost:   balance.Construction.SpeedUpMinimumCost,
+		},
+		Techs:            buildTechConfigs(),
+		BuildingsVersion: int32(snap.Buildings.Version),
+		Version:          snap.Version,
+		Balance:          buildBalanceConfig(balance),
+		Adjacency:        buildAdjacencyRules(snap),
 	}), nil
 }
 
-func buildBuildingConfigs() []*servicev1.BuildingConfig {
-	var configs []*servicev1.BuildingConfig
-	for _, bt := range constants.AllBuildingTypes() {
-		costs := constants.GetBuildingCosts(bt)
-		times := constants.GetBuildingConstructionTimes(bt)
-		pops := constants.GetBuildingPopulations(bt)
-		prodEntries := constants.GetBuildingProductionEntries(bt)
+func buildAdjacencyRules(snap *constants.Snapshot) []*servicev1.AdjacencyRuleConfig {
+	var rules []*servicev1.AdjacencyRuleConfig
+	for _, rule := range snap.Buildings.Adjacency {
+		rules = append(rules, &servicev1.AdjacencyRuleConfig{
+			Id:              rule.ID,
+			Building:        mapping.BuildingTypeToProto(rule.Building),
+			BuildingTypeId:  string(rule.Building),
+			Neighbour:       mapping.BuildingTypeToProto(rule.Neighbour),
+			NeighbourTypeId: string(rule.Neighbour),
+			Resource:        rule.Resource,
+			Percent:         rule.Percent,
+			MaxStacks:       int32(rule.MaxStacks),
+		})
+	}
+	return rules
+}
+
+func buildBalanceConfig(b constants.Balance) *servicev1.BalanceConfig {
+	return &servicev1.BalanceConfig{
+		PopulationGrowthRate:     b.Population.GrowthRate,
+		SurplusGrowthBonus:       b.Population.SurplusGrowthBonus,
+		StarvationDeclineRate:    b.Population.StarvationDeclineRate,
+		FoodPerPopPerHour:        b.Population.FoodPerPopPerHour,
+		MaxTaxRate:               int32(b.Tax.MaxRate),
+		TaxGoldPerPopPerHour:     b.Tax.GoldPerPopPerHour,
+		TaxGrowthPenalty:         b.Tax.GrowthPenalty,
+		MoraleBase:               constants.MoraleBase,
+		MoraleNeutral:            b.Morale.Neutral,
+		MoraleDrift:              b.Morale.Drift,
+		MoraleFoodSurplus:        b.Morale.FoodSurplus,
+		MoraleFoodDeficit:        b.Morale.FoodDeficit,
+		MoralePerTaxPoint:        b.Morale.PerTaxPoint,
+		MoraleCrowdingStart:      b.Morale.CrowdingStart,
+		MoraleCrowdingPenalty:    b.Morale.CrowdingPenalty,
+		MoraleOutputSwing:        b.Morale.OutputSwing,
+		MoraleGrowthSwing:        b.Morale.GrowthSwing,
+		UnrestMorale:             b.Morale.Unrest,
+		RevoltTicks:              int32(b.Morale.RevoltTicks),
+		TroopTrainingTime:        durationpb.New(time.Duration(b.Troops.TrainingSeconds) * time.Second),
+		TroopMovementTime:        durationpb.New(time.Duration(b.Troops.MovementSeconds) * time.Second),
+		ConstructionCancelRefund: b.Construction.CancelRefund,
+		ResearchCancelRefund:     b.Research.CancelRefund,
+		CaravanMovementTime:      durationpb.New(time.Duration(b.Caravans.MovementSeconds) * time.Second),
+		CaravanCapacity:          b.Caravans.Capacity,
+		CaravanStoreCapacity:     b.Caravans.StoreCapacity,
+		WarDeclarationDelay:      durationpb.New(time.Duration(b.Diplomacy.WarDeclarationSeconds) * time.Second),
+		PactCancelDelay:          durationpb.New(time.Duration(b.Diplomacy.PactCancelSeconds) * time.Second),
+		PeaceCancelDelay:         durationpb.New(time.Duration(b.Diplomacy.PeaceCancelSeconds) * time.Second),
+		TerrainDefense:           buildTerrainDefense(b.Combat),
+	}
+}
 
+func buildTerrainDefense(c constants.CombatBalance) []*servicev1.TerrainDefense {
+	out := make([]*servicev1.TerrainDefense, 0, len(c.TerrainDefense))
+	for _, t := range slices.Sorted(maps.Keys(c.TerrainDefense)) {
+		out = append(out, &servicev1.TerrainDefense{
+			Terrain:    mapping.TerrainToProto(t),
+			Multiplier: c.TerrainDefense[t],
+		})
+	}
+	return out
+}
+
+func buildBuildingConfigs(snap *constants.Snapshot) []*servicev1.BuildingConfig {
+	var configs []*servicev1.BuildingConfig
+	for _, def := range snap.Buildings.Buildings {
+		bt := def.Type
 		levels := make([]*servicev1.BuildingLevelStats, constants.MAX_BUILDING_LEVEL)
 		for i := range constants.MAX_BUILDING_LEVEL {
-			level := &servicev1.BuildingLevelStats{Level: int32(i + 1)}
-
-			if costs != nil {
-				level.Cost = []*servicev1.ResourceAmount{{Resource: "gold", Amount: costs[i]}}
+			level := &servicev1.BuildingLevelStats{
+				Level:            int32(i + 1),
+				Cost:             []*servicev1.ResourceAmount{{Resource: "gold", Amount: def.Cost[i]}},
+				ConstructionTime: durationpb.New(time.Duration(def.ConstructionTime[i]) * time.Second),
+			}
+			if def.Housing {
+				level.Population = def.Population[i]
 			}
-			if times != nil {
-				level.ConstructionTime = durationpb.New(time.Duration(times[i]) * time.Second)
+			if def.Trains {
+				level.TrainingSlots = int32(def.TrainingSlots[i])
+				level.TroopTrainingTime = durationpb.New(constants.GetTroopTrainingTime(bt, i+1))
 			}
-			if pops != nil {
-				level.Population = pops[i]
+			if def.Center {
+				level.ConstructionSlots = int32(constants.GetConstructionSlots(i + 1))
 			}
-			for _, entry := range prodEntries {
+			for _, entry := range def.Production {
 				level.Production = append(level.Production, &servicev1.ResourceRate{
 					Resource: entry.Resource,
 					Rate:     mapping.RatePerHour(entry.Amounts[i]),
@@ -58,10 +141,73 @@ func buildBuildingConfigs() []*servicev1.BuildingConfig {
 			levels[i] = level
 		}
 
+		var prerequisites []*servicev1.BuildingPrerequisite
+		for _, pre := range def.Prerequisites {
+			prerequisites = append(prerequisites, &servicev1.BuildingPrerequisite{
+				Type:   mapping.BuildingTypeToProto(pre.BuildingType),
+				Level:  int32(pre.Level),
+				TypeId: string(pre.BuildingType),
+			})
+		}
+		var maxCount []int32
+		for _, n := range def.MaxCount {
+			maxCount = append(maxCount, int32(n))
+		}
+		var forbiddenTerrain []entityv1.Terrain
+		for _, t := range def.ForbiddenTerrain {
+			forbiddenTerrain = append(forbiddenTerrain, mapping.TerrainToProto(t))
+		}
+
 		configs = append(configs, &servicev1.BuildingConfig{
-			Type:   mapping.BuildingTypeToProto(bt),
-			Levels: levels,
+			Type:             mapping.BuildingTypeToProto(bt),
+			Levels:           levels,
+			MaxLevel:         int32(constants.GetMaxBuildingLevel(bt, nil)),
+			Prerequisites:    prerequisites,
+			MaxCount:         maxCount,
+			CappedByCenter:   constants.CenterCapsLevel(bt),
+			TypeId:           string(bt),
+			Center:           def.Center,
+			Produces:         def.Produces,
+			Housing:          def.Housing,
+			Trains:           def.Trains,
+			Deposit:          mapping.DepositKindToProto(def.Deposit),
+			ForbiddenTerrain: forbiddenTerrain,
 		})
 	}
 	return configs
 }
+
+var techEffectKindToProto = map[constants.TechEffectKind]servicev1.TechEffectKind{
+	constants.TechEffectProduction:       servicev1.TechEffectKind_TECH_EFFECT_KIND_PRODUCTION,
+	constants.TechEffectCost:             servicev1.TechEffectKind_TECH_EFFECT_KIND_COST,
+	constants.TechEffectConstructionTime: servicev1.TechEffectKind_TECH_EFFECT_KIND_CONSTRUCTION_TIME,
+	constants.TechEffectMaxLevel:         servicev1.TechEffectKind_TECH_EFFECT_KIND_MAX_LEVEL,
+}
+
+func buildTechConfigs() []*servicev1.TechConfig {
+	var configs []*servicev1.TechConfig
+	for _, tech := range constants.AllTechs() {
+		cfg := &servicev1.TechConfig{
+			Id:            string(tech.ID),
+			Name:          tech.Name,
+			Cost:          []*servicev1.ResourceAmount{{Resource: "gold", Amount: tech.Cost}},
+			Duration:      durationpb.New(time.Duration(tech.Duration) * time.Second),
+			Prerequisites: mapping.TechIDsToStrings(tech.Prerequisites),
+		}
+		for _, e := range tech.Effects {
+			effect := &servicev1.TechEffect{
+				Kind:     techEffectKindToProto[e.Kind],
+				Resource: e.Resource,
+				Percent:  e.Percent,
+				MaxLevel: int32(e.MaxLevel),
+			}
+			if e.BuildingType != "" {
+				bt := mapping.BuildingTypeToProto(e.BuildingType)
+				effect.BuildingType = &bt
+			}
+			cfg.Effects = append(cfg.Effects, effect)
+		}
+		configs = append(configs, cfg)
+	}
+	return configs
+}
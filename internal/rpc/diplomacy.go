@@ -0,0 +1,203 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+
+	"cityio/internal/auth"
+	"cityio/internal/domain"
+	entityv1 "cityio/internal/gen/cityio/entity/v1"
+	servicev1 "cityio/internal/gen/cityio/service/v1"
+	"cityio/internal/mapping"
+	"cityio/internal/messages"
+	"cityio/internal/persistence"
+)
+
+type diplomacyHandler struct {
+	srv *Server
+}
+
+func (h *diplomacyHandler) DeclareWar(ctx context.Context, req *connect.Request[servicev1.DeclareWarRequest]) (*connect.Response[servicev1.DeclareWarResponse], error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing claims"))
+	}
+	relation, err := h.request(claims.UserID, req.Msg.GetUserId().GetValue(), messages.DeclareWarMessage{UserID: claims.UserID})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&servicev1.DeclareWarResponse{Relation: mapping.RelationToProto(*relation, claims.UserID)}), nil
+}
+
+func (h *diplomacyHandler) ProposeTreaty(ctx context.Context, req *connect.Request[servicev1.ProposeTreatyRequest]) (*connect.Response[servicev1.ProposeTreatyResponse], error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing claims"))
+	}
+	relation, err := h.request(claims.UserID, req.Msg.GetUserId().GetValue(), messages.ProposeTreatyMessage{
+		UserID: claims.UserID,
+		State:  mapping.RelationStateFromProto(req.Msg.GetState()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&servicev1.ProposeTreatyResponse{Relation: mapping.RelationToProto(*relation, claims.UserID)}), nil
+}
+
+func (h *diplomacyHandler) AcceptTreaty(ctx context.Context, req *connect.Request[servicev1.AcceptTreatyRequest]) (*connect.Response[servicev1.AcceptTreatyResponse], error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing claims"))
+	}
+	relation, err := h.request(claims.UserID, req.Msg.GetUserId().GetValue(), messages.AcceptTreatyMessage{UserID: claims.UserID})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&servicev1.AcceptTreatyResponse{Relation: mapping.RelationToProto(*relation, claims.UserID)}), nil
+}
+
+func (h *diplomacyHandler) DeclineTreaty(ctx context.Context, req *connect.Request[servicev1.DeclineTreatyRequest]) (*connect.Response[servicev1.DeclineTreatyResponse], error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing claims"))
+	}
+	relation, err := h.request(claims.UserID, req.Msg.GetUserId().GetValue(), messages.DeclineTreatyMessage{UserID: claims.UserID})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&servicev1.DeclineTreatyResponse{Relation: mapping.RelationToProto(*relation, claims.UserID)}), nil
+}
+
+func (h *diplomacyHandler) CancelTreaty(ctx context.Context, req *connect.Request[servicev1.CancelTreatyRequest]) (*connect.Response[servicev1.CancelTreatyResponse], error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing claims"))
+	}
+	relation, err := h.request(claims.UserID, req.Msg.GetUserId().GetValue(), messages.CancelTreatyMessage{UserID: claims.UserID})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&servicev1.CancelTreatyResponse{Relation: mapping.RelationToProto(*relation, claims.UserID)}), nil
+}
+
+func (h *diplomacyHandler) GetRelation(ctx context.Context, req *connect.Request[servicev1.GetRelationRequest]) (*connect.Response[servicev1.GetRelationResponse], error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing claims"))
+	}
+	relation, err := h.request(claims.UserID, req.Msg.GetUserId().GetValue(), messages.GetRelationMessage{})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&servicev1.GetRelationResponse{Relation: mapping.RelationToProto(*relation, claims.UserID)}), nil
+}
+
+func (h *diplomacyHandler) ListRelations(ctx context.Context, _ *connect.Request[servicev1.ListRelationsRequest]) (*connect.Response[servicev1.ListRelationsResponse], error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing claims"))
+	}
+	relationList, err := h.srv.store.GetRelationsByUser(ctx, claims.UserID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	// A change whose timer is about to fire is shown as already made.
+	now := time.Now()
+	relations := make([]*entityv1.Relation, 0, len(relationList))
+	for _, r := range relationList {
+		r.Settle(now)
+		if r.State == domain.RelationNeutral && !r.Pending() && r.ProposedState == "" {
+			continue
+		}
+		relations = append(relations, mapping.RelationToProto(r, claims.UserID))
+	}
+	return connect.NewResponse(&servicev1.ListRelationsResponse{Relations: relations}), nil
+}
+
+// request sends msg to the relation between the caller and otherID, which
+// answers with the relation or an error.
+func (h *diplomacyHandler) request(userID, otherID string, msg any) (*domain.Relation, error) {
+	if otherID == "" || otherID == userID {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("user_id must name another player"))
+	}
+	res, err := h.srv.cluster.Request("user", otherID, messages.GetUserMessage{})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if resp, ok := res.(*messages.GetUserResponseMessage); !ok || resp.User.UserID == "" {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("user not found"))
+	}
+
+	res, err = h.srv.cluster.Request("relation", domain.RelationID(userID, otherID), msg)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	switch v := res.(type) {
+	case *messages.RelationResponse:
+		return &v.Relation, nil
+	case error:
+		return nil, diplomacyError(v)
+	default:
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unexpected response: %T", res))
+	}
+}
+
+// atWar reports whether a war between two players is in force. See
+// baseActor.atWar, which armies use for the same decision.
+func (s *Server) atWar(ctx context.Context, userID, otherID string) (bool, error) {
+	r, err := s.store.GetRelation(ctx, userID, otherID)
+	if errors.Is(err, persistence.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if r.Current(time.Now()) != domain.RelationWar {
+		return false, nil
+	}
+	allied, err := s.allied(ctx, userID, otherID)
+	if err != nil {
+		return false, err
+	}
+	return !allied, nil
+}
+
+// allied reports whether two players are members of the same alliance.
+func (s *Server) allied(ctx context.Context, userID, otherID string) (bool, error) {
+	a, err := s.store.GetAllianceMembership(ctx, userID)
+	if errors.Is(err, persistence.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	o, err := s.store.GetAllianceMembership(ctx, otherID)
+	if errors.Is(err, persistence.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return a.AllianceID == o.AllianceID, nil
+}
+
+func diplomacyError(err error) error {
+	switch v := err.(type) {
+	case *messages.InvalidRelationError:
+		return connect.NewError(connect.CodeInvalidArgument, v)
+	case *messages.RelationStateError:
+		return connect.NewError(connect.CodeFailedPrecondition, v)
+	case *messages.RelationChangePendingError:
+		return connect.NewError(connect.CodeFailedPrecondition, v)
+	case *messages.NoTreatyProposalError:
+		return connect.NewError(connect.CodeNotFound, v)
+	case *messages.AlliedPlayersError:
+		return connect.NewError(connect.CodeFailedPrecondition, v)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
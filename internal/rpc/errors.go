@@ -0,0 +1,113 @@
+package rpc
+
+import (
+	"log/slog"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+
+	entityv1 "cityio/internal/gen/cityio/entity/v1"
+	"cityio/internal/mapping"
+	"cityio/internal/messages"
+)
+
+// withDetail wraps err in a Connect error carrying detail. The error still
+// goes out if the detail can't be encoded; it just arrives without it.
+func withDetail(code connect.Code, err error, detail proto.Message) *connect.Error {
+	cerr := connect.NewError(code, err)
+	d, derr := connect.NewErrorDetail(detail)
+	if derr != nil {
+		slog.Error("failed to encode error detail", "error", derr)
+		return cerr
+	}
+	cerr.AddDetail(d)
+	return cerr
+}
+
+func insufficientGoldError(e *messages.InsufficientGoldError) *connect.Error {
+	return withDetail(connect.CodeFailedPrecondition, e, &entityv1.InsufficientResources{MissingGold: e.Missing})
+}
+
+func insufficientFoodError(e *messages.InsufficientFoodError) *connect.Error {
+	return withDetail(connect.CodeFailedPrecondition, e, &entityv1.InsufficientResources{MissingFood: e.Missing})
+}
+
+func insufficientStoredFoodError(e *messages.InsufficientStoredFoodError) *connect.Error {
+	return withDetail(connect.CodeFailedPrecondition, e, &entityv1.InsufficientResources{MissingFood: e.Missing})
+}
+
+func tileOccupiedError(e *messages.TileOccupiedError) *connect.Error {
+	return withDetail(connect.CodeAlreadyExists, e, &entityv1.TileOccupied{
+		Coords:     &entityv1.Coordinates{X: int32(e.X), Y: int32(e.Y)},
+		BuildingId: mapping.ToBuildingId(e.BuildingID),
+	})
+}
+
+func outOfCityBoundsError(e *messages.OutOfCityBoundsError) *connect.Error {
+	return withDetail(connect.CodeInvalidArgument, e, &entityv1.OutOfCityBounds{
+		CityId: mapping.ToCityId(e.CityID),
+		Coords: &entityv1.Coordinates{X: int32(e.X), Y: int32(e.Y)},
+	})
+}
+
+func terrainNotAllowedError(e *messages.TerrainNotAllowedError) *connect.Error {
+	return withDetail(connect.CodeFailedPrecondition, e, &entityv1.TerrainNotAllowed{
+		Coords:       &entityv1.Coordinates{X: int32(e.X), Y: int32(e.Y)},
+		Terrain:      mapping.TerrainToProto(e.Terrain),
+		BuildingType: mapping.BuildingTypeToProto(e.BuildingType),
+	})
+}
+
+func missingDepositError(e *messages.MissingDepositError) *connect.Error {
+	return withDetail(connect.CodeFailedPrecondition, e, &entityv1.MissingDeposit{
+		Coords:       &entityv1.Coordinates{X: int32(e.X), Y: int32(e.Y)},
+		BuildingType: mapping.BuildingTypeToProto(e.BuildingType),
+		Required:     mapping.DepositKindToProto(e.Required),
+	})
+}
+
+func missingPrerequisiteError(e *messages.MissingPrerequisiteError) *connect.Error {
+	return withDetail(connect.CodeFailedPrecondition, e, &entityv1.MissingPrerequisite{
+		Tech:    string(e.Tech),
+		Missing: string(e.Missing),
+	})
+}
+
+// constructionError maps the typed rejections shared by building placement
+// and the build queue to Connect errors.
+func constructionError(err error) error {
+	switch v := err.(type) {
+	case *messages.InvalidBuildingTypeError:
+		return connect.NewError(connect.CodeInvalidArgument, v)
+	case *messages.OutOfCityBoundsError:
+		return outOfCityBoundsError(v)
+	case *messages.TileOccupiedError:
+		return tileOccupiedError(v)
+	case *messages.TerrainNotAllowedError:
+		return terrainNotAllowedError(v)
+	case *messages.MissingDepositError:
+		return missingDepositError(v)
+	case *messages.InsufficientGoldError:
+		return insufficientGoldError(v)
+	case *messages.BuildingNotFoundError:
+		return connect.NewError(connect.CodeNotFound, v)
+	case *messages.MaxLevelReachedError:
+		return connect.NewError(connect.CodeFailedPrecondition, v)
+	case *messages.PrerequisiteNotMetError:
+		return connect.NewError(connect.CodeFailedPrecondition, v)
+	case *messages.CenterLevelTooLowError:
+		return connect.NewError(connect.CodeFailedPrecondition, v)
+	case *messages.BuildingLimitReachedError:
+		return connect.NewError(connect.CodeFailedPrecondition, v)
+	case *messages.ConstructionQueueFullError:
+		return connect.NewError(connect.CodeResourceExhausted, v)
+	case *messages.NoFreeConstructionSlotError:
+		return connect.NewError(connect.CodeResourceExhausted, v)
+	case *messages.ConstructionOrderNotFoundError:
+		return connect.NewError(connect.CodeNotFound, v)
+	case *messages.InvalidQueueOrderError:
+		return connect.NewError(connect.CodeInvalidArgument, v)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
@@ -21,7 +21,7 @@ type mapHandler struct {
 }
 
 func (h *mapHandler) GetMap(ctx context.Context, req *connect.Request[servicev1.GetMapRequest]) (*connect.Response[servicev1.GetMapResponse], error) {
-	owned, err := h.srv.ownedCities(ctx)
+	visible, err := h.srv.alliedCities(ctx)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
@@ -34,9 +34,13 @@ func (h *mapHandler) GetMap(ctx context.Context, req *connect.Request[servicev1.
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
+	tileList, err := h.srv.store.GetAllTiles(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
 
-	cityList = domain.FilterCities(owned, cityList, constants.VisionRadius)
-	buildingList = domain.FilterBuildings(owned, buildingList, constants.VisionRadius)
+	cityList = domain.FilterCities(visible, cityList, constants.VisionRadius)
+	buildingList = domain.FilterBuildings(visible, buildingList, constants.VisionRadius)
 
 	cityIds := make([]*entityv1.CityId, 0, len(cityList))
 	for _, c := range cityList {
@@ -47,6 +51,13 @@ func (h *mapHandler) GetMap(ctx context.Context, req *connect.Request[servicev1.
 		buildingIds = append(buildingIds, mapping.ToBuildingId(b.BuildingID))
 	}
 
+	tiles := make([]*servicev1.Tile, 0, len(tileList))
+	for _, t := range tileList {
+		if domain.PointVisible(visible, t.X, t.Y, constants.VisionRadius) {
+			tiles = append(tiles, mapping.TileToProto(t.Terrain, t.Deposit, nil, nil, nil, nil, t.X, t.Y))
+		}
+	}
+
 	bag := mapping.EntitiesToBag(nil, cityList, buildingList)
 	// Strip owner-only fields (production/upkeep rates) from any city the caller
 	// doesn't own. Population, cap, and starving stay public.
@@ -61,6 +72,7 @@ func (h *mapHandler) GetMap(ctx context.Context, req *connect.Request[servicev1.
 		CityIds:     cityIds,
 		BuildingIds: buildingIds,
 		Entities:    bag,
+		Tiles:       tiles,
 	}), nil
 }
 
@@ -68,11 +80,11 @@ func (h *mapHandler) GetTile(ctx context.Context, req *connect.Request[servicev1
 	x := int(req.Msg.GetCoords().GetX())
 	y := int(req.Msg.GetCoords().GetY())
 
-	owned, err := h.srv.ownedCities(ctx)
+	visible, err := h.srv.alliedCities(ctx)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
-	if !domain.PointVisible(owned, x, y, constants.VisionRadius) {
+	if !domain.PointVisible(visible, x, y, constants.VisionRadius) {
 		return nil, connect.NewError(connect.CodeNotFound, errors.New("tile not found"))
 	}
 
@@ -85,6 +97,6 @@ func (h *mapHandler) GetTile(ctx context.Context, req *connect.Request[servicev1
 		return nil, connect.NewError(connect.CodeNotFound, errors.New("tile not found"))
 	}
 	return connect.NewResponse(&servicev1.GetTileResponse{
-		Tile: mapping.TileToProto(resp.CityID, resp.BuildingID, x, y),
+		Tile: mapping.TileToProto(resp.Terrain, resp.Deposit, resp.CityID, resp.BuildingID, resp.ArmyIDs, resp.CaravanIDs, x, y),
 	}), nil
 }
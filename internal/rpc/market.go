@@ -0,0 +1,184 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+
+	"cityio/internal/auth"
+	"cityio/internal/constants"
+	"cityio/internal/domain"
+	servicev1 "cityio/internal/gen/cityio/service/v1"
+	"cityio/internal/mapping"
+	"cityio/internal/messages"
+	"cityio/internal/stream"
+)
+
+type marketHandler struct {
+	srv *Server
+}
+
+// market resolves a request's market ID, so unknown IDs never activate a
+// market actor.
+func market(id string) (domain.MarketID, error) {
+	m, ok := constants.GetMarket(domain.MarketID(id))
+	if !ok {
+		return "", connect.NewError(connect.CodeNotFound, &messages.UnknownMarketError{MarketID: domain.MarketID(id)})
+	}
+	return m.ID, nil
+}
+
+func (h *marketHandler) PlaceOrder(ctx context.Context, req *connect.Request[servicev1.PlaceOrderRequest]) (*connect.Response[servicev1.PlaceOrderResponse], error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing claims"))
+	}
+	marketID, err := market(req.Msg.GetMarketId())
+	if err != nil {
+		return nil, err
+	}
+	res, err := h.srv.cluster.Request("market", string(marketID), messages.PlaceMarketOrderMessage{
+		UserID:   claims.UserID,
+		Side:     mapping.OrderSideFromProto(req.Msg.GetSide()),
+		Price:    req.Msg.GetPrice(),
+		Quantity: req.Msg.GetQuantity(),
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	switch v := res.(type) {
+	case *messages.PlaceMarketOrderResponse:
+		resp := &servicev1.PlaceOrderResponse{Order: mapping.MarketOrderToProto(v.Order)}
+		for _, t := range v.Trades {
+			resp.Trades = append(resp.Trades, mapping.TradeToProto(t))
+		}
+		return connect.NewResponse(resp), nil
+	case error:
+		return nil, marketError(v)
+	default:
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unexpected response: %T", res))
+	}
+}
+
+func (h *marketHandler) CancelOrder(ctx context.Context, req *connect.Request[servicev1.CancelOrderRequest]) (*connect.Response[servicev1.CancelOrderResponse], error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing claims"))
+	}
+	marketID, err := market(req.Msg.GetMarketId())
+	if err != nil {
+		return nil, err
+	}
+	res, err := h.srv.cluster.Request("market", string(marketID), messages.CancelMarketOrderMessage{
+		UserID:  claims.UserID,
+		OrderID: req.Msg.GetOrderId(),
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	switch v := res.(type) {
+	case *messages.CancelMarketOrderResponse:
+		return connect.NewResponse(&servicev1.CancelOrderResponse{Order: mapping.MarketOrderToProto(v.Order)}), nil
+	case error:
+		return nil, marketError(v)
+	default:
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unexpected response: %T", res))
+	}
+}
+
+func (h *marketHandler) ListOrders(ctx context.Context, req *connect.Request[servicev1.ListOrdersRequest]) (*connect.Response[servicev1.ListOrdersResponse], error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing claims"))
+	}
+	var marketIDs []domain.MarketID
+	if req.Msg.GetMarketId() != "" {
+		marketID, err := market(req.Msg.GetMarketId())
+		if err != nil {
+			return nil, err
+		}
+		marketIDs = append(marketIDs, marketID)
+	} else {
+		for _, m := range constants.AllMarkets() {
+			marketIDs = append(marketIDs, m.ID)
+		}
+	}
+
+	resp := &servicev1.ListOrdersResponse{}
+	for _, marketID := range marketIDs {
+		res, err := h.srv.cluster.Request("market", string(marketID), messages.ListMarketOrdersMessage{UserID: claims.UserID})
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		list, ok := res.(*messages.ListMarketOrdersResponse)
+		if !ok {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unexpected response: %T", res))
+		}
+		for _, o := range list.Orders {
+			resp.Orders = append(resp.Orders, mapping.MarketOrderToProto(o))
+		}
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (h *marketHandler) StreamTicker(ctx context.Context, req *connect.Request[servicev1.StreamTickerRequest], out *connect.ServerStream[servicev1.StreamTickerResponse]) error {
+	if _, ok := auth.ClaimsFromContext(ctx); !ok {
+		return connect.NewError(connect.CodeUnauthenticated, errors.New("missing claims"))
+	}
+	marketID, err := market(req.Msg.GetMarketId())
+	if err != nil {
+		return err
+	}
+
+	// Subscribe before the snapshot so no update falls between the two.
+	ch, unsubscribe := stream.SubscribeMarket(marketID)
+	defer unsubscribe()
+
+	res, err := h.srv.cluster.Request("market", string(marketID), messages.GetMarketTickerMessage{})
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	if v, ok := res.(*messages.GetMarketTickerResponse); ok {
+		if err := out.Send(&servicev1.StreamTickerResponse{Ticker: mapping.MarketTickerToProto(v.Ticker)}); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-h.srv.shutdownCtx.Done():
+			return connect.NewError(connect.CodeUnavailable, errors.New("server shutting down"))
+		case ticker, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			if err := out.Send(&servicev1.StreamTickerResponse{Ticker: mapping.MarketTickerToProto(ticker)}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// marketError maps the market actor's rejections to Connect errors.
+func marketError(err error) error {
+	switch v := err.(type) {
+	case *messages.UnknownMarketError:
+		return connect.NewError(connect.CodeNotFound, v)
+	case *messages.InvalidMarketOrderError:
+		return connect.NewError(connect.CodeInvalidArgument, v)
+	case *messages.MarketOrderNotFoundError:
+		return connect.NewError(connect.CodeNotFound, v)
+	case *messages.TooManyMarketOrdersError:
+		return connect.NewError(connect.CodeResourceExhausted, v)
+	case *messages.InsufficientGoldError:
+		return insufficientGoldError(v)
+	case *messages.InsufficientFoodError:
+		return insufficientFoodError(v)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
@@ -0,0 +1,98 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+
+	"cityio/internal/auth"
+	"cityio/internal/domain"
+	servicev1 "cityio/internal/gen/cityio/service/v1"
+	"cityio/internal/mapping"
+	"cityio/internal/messages"
+)
+
+type researchHandler struct {
+	srv *Server
+}
+
+func (h *researchHandler) GetResearch(ctx context.Context, _ *connect.Request[servicev1.GetResearchRequest]) (*connect.Response[servicev1.GetResearchResponse], error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing claims"))
+	}
+	res, err := h.srv.cluster.Request("user", claims.UserID, messages.GetUserMessage{})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	resp, ok := res.(*messages.GetUserResponseMessage)
+	if !ok {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("user not found"))
+	}
+	return connect.NewResponse(&servicev1.GetResearchResponse{
+		Techs:    mapping.TechIDsToStrings(resp.User.Techs),
+		Research: mapping.ResearchToProto(resp.User.Researching),
+	}), nil
+}
+
+func (h *researchHandler) StartResearch(ctx context.Context, req *connect.Request[servicev1.StartResearchRequest]) (*connect.Response[servicev1.StartResearchResponse], error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing claims"))
+	}
+	res, err := h.srv.cluster.Request("user", claims.UserID, messages.StartResearchMessage{
+		Tech: domain.TechID(req.Msg.GetTech()),
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	switch v := res.(type) {
+	case *messages.StartResearchResponseMessage:
+		return connect.NewResponse(&servicev1.StartResearchResponse{Research: mapping.ResearchToProto(&v.Research)}), nil
+	case error:
+		return nil, researchError(v)
+	default:
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unexpected response: %T", res))
+	}
+}
+
+func (h *researchHandler) CancelResearch(ctx context.Context, _ *connect.Request[servicev1.CancelResearchRequest]) (*connect.Response[servicev1.CancelResearchResponse], error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing claims"))
+	}
+	res, err := h.srv.cluster.Request("user", claims.UserID, messages.CancelResearchMessage{})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	switch v := res.(type) {
+	case *messages.CancelResearchResponseMessage:
+		return connect.NewResponse(&servicev1.CancelResearchResponse{Refund: v.Refund}), nil
+	case error:
+		return nil, researchError(v)
+	default:
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unexpected response: %T", res))
+	}
+}
+
+// researchError maps the user actor's research rejections to Connect errors.
+func researchError(err error) error {
+	switch v := err.(type) {
+	case *messages.UnknownTechError:
+		return connect.NewError(connect.CodeInvalidArgument, v)
+	case *messages.TechAlreadyResearchedError:
+		return connect.NewError(connect.CodeAlreadyExists, v)
+	case *messages.ResearchInProgressError:
+		return connect.NewError(connect.CodeFailedPrecondition, v)
+	case *messages.MissingPrerequisiteError:
+		return missingPrerequisiteError(v)
+	case *messages.NoResearchInProgressError:
+		return connect.NewError(connect.CodeFailedPrecondition, v)
+	case *messages.InsufficientGoldError:
+		return insufficientGoldError(v)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
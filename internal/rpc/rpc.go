@@ -15,6 +15,7 @@ import (
 	"cityio/internal/domain"
 	"cityio/internal/gen/cityio/service/v1/servicev1connect"
 	"cityio/internal/metrics"
+	"cityio/internal/persistence"
 	"cityio/internal/ports"
 )
 
@@ -24,6 +25,15 @@ type Server struct {
 	store     ports.Store
 	jwtSecret string
 
+	// admins is the set of usernames allowed to call AdminService.
+	admins map[string]struct{}
+	// endSeason shuts the process down once a season has been marked ending,
+	// so the next start rolls the world over.
+	endSeason func()
+	// reloadBalance rereads the balance files and returns the new snapshot
+	// version.
+	reloadBalance func() (string, error)
+
 	// shutdownCtx is cancelled when the process is shutting down. Long-lived
 	// handlers (StreamState) select on it and return Unauthenticated so clients
 	// take their "session ended, log in again" path instead of seeing a
@@ -33,9 +43,15 @@ type Server struct {
 
 // NewServer constructs an RPC server backed by the given cluster and store.
 // shutdownCtx is cancelled by main on SIGINT/SIGTERM; streaming handlers
-// observe it and close their streams.
-func NewServer(shutdownCtx context.Context, cluster ports.ClusterProvider, store ports.Store, jwtSecret string) *Server {
-	return &Server{cluster: cluster, store: store, jwtSecret: jwtSecret, shutdownCtx: shutdownCtx}
+// observe it and close their streams. endSeason is called after an admin ends
+// the season and should shut the process down. reloadBalance backs the
+// ReloadBalance admin call.
+func NewServer(shutdownCtx context.Context, cluster ports.ClusterProvider, store ports.Store, jwtSecret string, admins []string, endSeason func(), reloadBalance func() (string, error)) *Server {
+	adminSet := make(map[string]struct{}, len(admins))
+	for _, a := range admins {
+		adminSet[a] = struct{}{}
+	}
+	return &Server{cluster: cluster, store: store, jwtSecret: jwtSecret, admins: adminSet, endSeason: endSeason, reloadBalance: reloadBalance, shutdownCtx: shutdownCtx}
 }
 
 func (s *Server) ownedCities(ctx context.Context) ([]domain.City, error) {
@@ -59,6 +75,24 @@ func (s *Server) ownsCity(ctx context.Context, cityID string) (bool, error) {
 	return false, nil
 }
 
+// alliedCities returns the caller's cities together with those of the other
+// members of their alliance. Allies share vision, so visibility checks use
+// these rather than ownedCities.
+func (s *Server) alliedCities(ctx context.Context) ([]domain.City, error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, errors.New("missing claims")
+	}
+	member, err := s.store.GetAllianceMembership(ctx, claims.UserID)
+	if errors.Is(err, persistence.ErrNotFound) {
+		return s.store.GetCitiesByOwner(ctx, claims.UserID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return s.store.GetAllianceCities(ctx, member.AllianceID)
+}
+
 // Handler returns the HTTP handler serving every Connect service with the
 // metrics + auth interceptors applied (metrics is outermost so it captures
 // auth failures and timing for them). /metrics and /healthz share the same
@@ -73,6 +107,16 @@ func (s *Server) Handler() http.Handler {
 	mux.Handle(servicev1connect.NewBuildingServiceHandler(&buildingHandler{s}, opts))
 	mux.Handle(servicev1connect.NewMapServiceHandler(&mapHandler{s}, opts))
 	mux.Handle(servicev1connect.NewConfigServiceHandler(&configHandler{s}, opts))
+	mux.Handle(servicev1connect.NewArmyServiceHandler(&armyHandler{s}, opts))
+	mux.Handle(servicev1connect.NewCaravanServiceHandler(&caravanHandler{s}, opts))
+	mux.Handle(servicev1connect.NewBattleServiceHandler(&battleHandler{s}, opts))
+	mux.Handle(servicev1connect.NewWorldServiceHandler(&worldHandler{s}, opts))
+	mux.Handle(servicev1connect.NewResearchServiceHandler(&researchHandler{s}, opts))
+	mux.Handle(servicev1connect.NewMarketServiceHandler(&marketHandler{s}, opts))
+	mux.Handle(servicev1connect.NewAllianceServiceHandler(&allianceHandler{s}, opts))
+	mux.Handle(servicev1connect.NewDiplomacyServiceHandler(&diplomacyHandler{s}, opts))
+	mux.Handle(servicev1connect.NewChatServiceHandler(&chatHandler{s}, opts))
+	mux.Handle(servicev1connect.NewAdminServiceHandler(&adminHandler{s}, opts))
 	mux.Handle("/metrics", promhttp.Handler())
 	mux.HandleFunc("/healthz", func(w http.ResponseWriter, _ *http.Request) {
 		w.WriteHeader(http.StatusOK)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"strings"
+	"time"
 
 	"connectrpc.com/connect"
 	"golang.org/x/crypto/bcrypt"
@@ -140,6 +141,23 @@ func (h *userHandler) DeleteUser(ctx context.Context, req *connect.Request[servi
 	return connect.NewResponse(&servicev1.DeleteUserResponse{}), nil
 }
 
+func (h *userHandler) SetFoodAllocationPolicy(ctx context.Context, req *connect.Request[servicev1.SetFoodAllocationPolicyRequest]) (*connect.Response[servicev1.SetFoodAllocationPolicyResponse], error) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing claims"))
+	}
+	res, err := h.srv.cluster.Request("user", claims.UserID, messages.SetFoodPolicyMessage{
+		Policy: mapping.FoodPolicyFromProto(req.Msg.GetPolicy()),
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if e, ok := res.(*messages.InvalidFoodPolicyError); ok {
+		return nil, connect.NewError(connect.CodeInvalidArgument, e)
+	}
+	return connect.NewResponse(&servicev1.SetFoodAllocationPolicyResponse{}), nil
+}
+
 func (h *userHandler) StreamState(ctx context.Context, req *connect.Request[servicev1.StreamStateRequest], out *connect.ServerStream[servicev1.StreamStateResponse]) error {
 	claims, ok := auth.ClaimsFromContext(ctx)
 	if !ok {
@@ -149,7 +167,8 @@ func (h *userHandler) StreamState(ctx context.Context, req *connect.Request[serv
 	ch, unsubscribe := stream.Subscribe(claims.UserID)
 	defer unsubscribe()
 
-	// Send initial snapshot: user, owned cities, and their buildings.
+	// Send initial snapshot: user, owned cities, their buildings, armies,
+	// caravans, alliance, standing alliance invites and relations.
 	if res, err := h.srv.cluster.Request("user", claims.UserID, messages.GetUserMessage{}); err == nil {
 		if resp, ok := res.(*messages.GetUserResponseMessage); ok {
 			bag := &entityv1.EntityBag{
@@ -175,6 +194,45 @@ func (h *userHandler) StreamState(ctx context.Context, req *connect.Request[serv
 				}
 			}
 
+			if dbArmies, err := h.srv.store.GetArmiesByOwner(ctx, claims.UserID); err == nil {
+				for _, da := range dbArmies {
+					if res, err := h.srv.cluster.Request("army", da.ArmyID, messages.GetArmyMessage{}); err == nil {
+						if ar, ok := res.(*messages.GetArmyResponseMessage); ok {
+							bag.Armies = append(bag.Armies, mapping.ArmyToProto(ar.Army))
+						}
+					}
+				}
+			}
+
+			if dbCaravans, err := h.srv.store.GetCaravansByOwner(ctx, claims.UserID); err == nil {
+				for _, dc := range dbCaravans {
+					if res, err := h.srv.cluster.Request("caravan", dc.CaravanID, messages.GetCaravanMessage{}); err == nil {
+						if cr, ok := res.(*messages.GetCaravanResponseMessage); ok {
+							bag.Caravans = append(bag.Caravans, mapping.CaravanToProto(cr.Caravan))
+						}
+					}
+				}
+			}
+
+			if member, err := h.srv.store.GetAllianceMembership(ctx, claims.UserID); err == nil {
+				if res, err := h.srv.cluster.Request("alliance", member.AllianceID, messages.GetAllianceMessage{}); err == nil {
+					if ar, ok := res.(*messages.GetAllianceResponse); ok {
+						bag.Alliances = append(bag.Alliances, mapping.AllianceToProto(ar.Alliance))
+					}
+				}
+			}
+			if invites, err := h.srv.store.GetAllianceInvitesByUser(ctx, claims.UserID); err == nil {
+				for _, i := range invites {
+					bag.AllianceInvites = append(bag.AllianceInvites, mapping.AllianceInviteToProto(i))
+				}
+			}
+			if relations, err := h.srv.store.GetRelationsByUser(ctx, claims.UserID); err == nil {
+				for _, r := range relations {
+					r.Settle(time.Now())
+					bag.Relations = append(bag.Relations, mapping.RelationToProto(r, claims.UserID))
+				}
+			}
+
 			if err := out.Send(&servicev1.StreamStateResponse{Entities: bag}); err != nil {
 				return err
 			}
@@ -207,6 +265,36 @@ func (h *userHandler) StreamState(ctx context.Context, req *connect.Request[serv
 			if update.DeletedBuildingID != nil {
 				bag.DeletedBuildingIds = append(bag.DeletedBuildingIds, mapping.ToBuildingId(*update.DeletedBuildingID))
 			}
+			if update.Army != nil {
+				bag.Armies = append(bag.Armies, mapping.ArmyToProto(*update.Army))
+			}
+			if update.DeletedArmyID != nil {
+				bag.DeletedArmyIds = append(bag.DeletedArmyIds, mapping.ToArmyId(*update.DeletedArmyID))
+			}
+			if update.Caravan != nil {
+				bag.Caravans = append(bag.Caravans, mapping.CaravanToProto(*update.Caravan))
+			}
+			if update.DeletedCaravanID != nil {
+				bag.DeletedCaravanIds = append(bag.DeletedCaravanIds, mapping.ToCaravanId(*update.DeletedCaravanID))
+			}
+			if update.DeletedCityID != nil {
+				bag.DeletedCityIds = append(bag.DeletedCityIds, mapping.ToCityId(*update.DeletedCityID))
+			}
+			if update.BattleReport != nil {
+				bag.BattleReports = append(bag.BattleReports, mapping.BattleReportToProto(*update.BattleReport))
+			}
+			if update.Alliance != nil {
+				bag.Alliances = append(bag.Alliances, mapping.AllianceToProto(*update.Alliance))
+			}
+			if update.LeftAllianceID != nil {
+				bag.LeftAllianceIds = append(bag.LeftAllianceIds, mapping.ToAllianceId(*update.LeftAllianceID))
+			}
+			if update.AllianceInvite != nil {
+				bag.AllianceInvites = append(bag.AllianceInvites, mapping.AllianceInviteToProto(*update.AllianceInvite))
+			}
+			if update.Relation != nil {
+				bag.Relations = append(bag.Relations, mapping.RelationToProto(*update.Relation, claims.UserID))
+			}
 			if err := out.Send(&servicev1.StreamStateResponse{Entities: bag}); err != nil {
 				return err
 			}
@@ -0,0 +1,63 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+
+	entityv1 "cityio/internal/gen/cityio/entity/v1"
+	servicev1 "cityio/internal/gen/cityio/service/v1"
+	"cityio/internal/mapping"
+	"cityio/internal/persistence"
+)
+
+const (
+	defaultSeasonStandingsLimit = 50
+	maxSeasonStandingsLimit     = 200
+)
+
+type worldHandler struct {
+	srv *Server
+}
+
+func (h *worldHandler) GetWorld(ctx context.Context, _ *connect.Request[servicev1.GetWorldRequest]) (*connect.Response[servicev1.GetWorldResponse], error) {
+	world, err := h.srv.store.GetWorld(ctx)
+	if errors.Is(err, persistence.ErrNotFound) {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("world not found"))
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&servicev1.GetWorldResponse{World: mapping.WorldToProto(*world)}), nil
+}
+
+func (h *worldHandler) ListSeasons(ctx context.Context, _ *connect.Request[servicev1.ListSeasonsRequest]) (*connect.Response[servicev1.ListSeasonsResponse], error) {
+	seasonList, err := h.srv.store.GetSeasons(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	seasons := make([]*entityv1.Season, 0, len(seasonList))
+	for _, s := range seasonList {
+		seasons = append(seasons, mapping.SeasonToProto(s))
+	}
+	return connect.NewResponse(&servicev1.ListSeasonsResponse{Seasons: seasons}), nil
+}
+
+func (h *worldHandler) GetSeasonStandings(ctx context.Context, req *connect.Request[servicev1.GetSeasonStandingsRequest]) (*connect.Response[servicev1.GetSeasonStandingsResponse], error) {
+	limit := int(req.Msg.GetLimit())
+	if limit <= 0 {
+		limit = defaultSeasonStandingsLimit
+	}
+	limit = min(limit, maxSeasonStandingsLimit)
+
+	standingList, err := h.srv.store.GetSeasonStandings(ctx, int(req.Msg.GetSeason()), limit)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	standings := make([]*entityv1.SeasonStanding, 0, len(standingList))
+	for _, s := range standingList {
+		standings = append(standings, mapping.SeasonStandingToProto(s))
+	}
+	return connect.NewResponse(&servicev1.GetSeasonStandingsResponse{Standings: standings}), nil
+}
@@ -0,0 +1,36 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"cityio/internal/domain"
+	"cityio/internal/logger"
+	"cityio/internal/messages"
+	"cityio/internal/ports"
+)
+
+// CreateAlliance founds an alliance led by leaderID under a fresh ID. The
+// alliance actor's refusals (a taken name, a leader already in an alliance)
+// are returned as its error types.
+func CreateAlliance(ctx context.Context, cluster ports.ClusterProvider, leaderID, name string) (*domain.Alliance, error) {
+	allianceID := uuid.New().String()
+	ctx = logger.With(ctx, "alliance_id", allianceID)
+
+	res, err := cluster.Request("alliance", allianceID, messages.CreateAllianceMessage{Name: name, LeaderID: leaderID})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to create alliance actor", "error", err)
+		return nil, err
+	}
+	switch v := res.(type) {
+	case *messages.CreateAllianceResponse:
+		return &v.Alliance, nil
+	case error:
+		return nil, v
+	default:
+		return nil, fmt.Errorf("unexpected create response: %T", res)
+	}
+}
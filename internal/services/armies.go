@@ -0,0 +1,69 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"cityio/internal/domain"
+	"cityio/internal/logger"
+	"cityio/internal/messages"
+	"cityio/internal/metrics"
+	"cityio/internal/ports"
+)
+
+func RestoreArmy(ctx context.Context, cluster ports.ClusterProvider, army *domain.Army) error {
+	if _, err := cluster.Request("army", army.ArmyID, &messages.CreateArmyMessage{Army: *army, Restore: true}); err != nil {
+		slog.ErrorContext(ctx, "failed to restore army actor", "army_id", army.ArmyID, "error", err)
+		return err
+	}
+
+	return nil
+}
+
+// CreateArmy withdraws the troops from the source city's garrison and spawns
+// an army actor carrying them. If the actor cannot be spawned the troops are
+// returned to the garrison.
+func CreateArmy(ctx context.Context, cluster ports.ClusterProvider, army *ArmyInput) (*domain.Army, error) {
+	armyID := uuid.New().String()
+	ctx = logger.With(ctx, "army_id", armyID)
+	slog.InfoContext(ctx, "raising new army", "city_id", army.CityID, "troops", army.Troops)
+
+	res, err := cluster.Request("city", army.CityID, messages.WithdrawTroopsMessage{Amount: army.Troops})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to withdraw troops from garrison", "error", err)
+		return nil, err
+	}
+	switch v := res.(type) {
+	case messages.Ack:
+		// continue
+	case *messages.InsufficientTroopsError:
+		return nil, v
+	default:
+		return nil, fmt.Errorf("unexpected withdraw response: %T", res)
+	}
+
+	newArmy := domain.Army{
+		ArmyID:       armyID,
+		Owner:        army.Owner,
+		CityID:       army.CityID,
+		Troops:       army.Troops,
+		X:            army.X,
+		Y:            army.Y,
+		DestinationX: army.DestinationX,
+		DestinationY: army.DestinationY,
+	}
+
+	if _, err := cluster.Request("army", armyID, &messages.CreateArmyMessage{Army: newArmy, Restore: false}); err != nil {
+		slog.ErrorContext(ctx, "failed to create army actor", "error", err)
+		if tellErr := cluster.Tell("city", army.CityID, messages.DepositTroopsMessage{Amount: army.Troops}); tellErr != nil {
+			slog.ErrorContext(ctx, "failed to return troops to garrison", "error", tellErr)
+		}
+		return nil, err
+	}
+	metrics.ArmiesRaisedTotal.Inc()
+
+	return &newArmy, nil
+}
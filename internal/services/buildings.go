@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
 
 	"github.com/google/uuid"
@@ -34,10 +35,20 @@ func CreateBuilding(ctx context.Context, cluster ports.ClusterProvider, building
 		Y:          building.Y,
 	}
 
-	if _, err := cluster.Request("building", buildingID, &messages.CreateBuildingMessage{Building: newBuilding, Restore: false, Construct: true}); err != nil {
-		slog.ErrorContext(ctx, "failed to create building actor", "error", err)
+	// The city validates the placement and charges its owner before the
+	// building actor exists, so a rejected order leaves nothing behind.
+	res, err := cluster.Request("city", building.CityID, messages.PlaceBuildingMessage{Building: newBuilding})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to place building", "error", err)
 		return nil, err
 	}
-
-	return &newBuilding, nil
+	switch v := res.(type) {
+	case *messages.PlaceBuildingResponseMessage:
+		return &v.Building, nil
+	case error:
+		slog.InfoContext(ctx, "building placement rejected", "error", v)
+		return nil, v
+	default:
+		return nil, fmt.Errorf("unexpected placement response: %T", res)
+	}
 }
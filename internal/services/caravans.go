@@ -0,0 +1,76 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"cityio/internal/domain"
+	"cityio/internal/logger"
+	"cityio/internal/messages"
+	"cityio/internal/metrics"
+	"cityio/internal/ports"
+)
+
+func RestoreCaravan(ctx context.Context, cluster ports.ClusterProvider, caravan *domain.Caravan) error {
+	if _, err := cluster.Request("caravan", caravan.CaravanID, &messages.CreateCaravanMessage{Caravan: *caravan, Restore: true}); err != nil {
+		slog.ErrorContext(ctx, "failed to restore caravan actor", "caravan_id", caravan.CaravanID, "error", err)
+		return err
+	}
+
+	return nil
+}
+
+// CreateCaravan loads the cargo at the source city and spawns a caravan actor
+// carrying it. If the actor cannot be spawned the cargo is unloaded back at
+// the source city.
+func CreateCaravan(ctx context.Context, cluster ports.ClusterProvider, caravan *CaravanInput) (*domain.Caravan, error) {
+	caravanID := uuid.New().String()
+	ctx = logger.With(ctx, "caravan_id", caravanID)
+	slog.InfoContext(ctx, "sending caravan",
+		"city_id", caravan.SourceCityID,
+		"destination_city_id", caravan.DestinationCityID,
+		"gold", caravan.Gold,
+		"food", caravan.Food,
+	)
+
+	res, err := cluster.Request("city", caravan.SourceCityID, messages.LoadCaravanMessage{Gold: caravan.Gold, Food: caravan.Food})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to load caravan cargo", "error", err)
+		return nil, err
+	}
+	switch v := res.(type) {
+	case messages.Ack:
+		// continue
+	case error:
+		return nil, v
+	default:
+		return nil, fmt.Errorf("unexpected load response: %T", res)
+	}
+
+	newCaravan := domain.Caravan{
+		CaravanID:         caravanID,
+		Owner:             caravan.Owner,
+		SourceCityID:      caravan.SourceCityID,
+		DestinationCityID: caravan.DestinationCityID,
+		Gold:              caravan.Gold,
+		Food:              caravan.Food,
+		X:                 caravan.X,
+		Y:                 caravan.Y,
+		DestinationX:      caravan.DestinationX,
+		DestinationY:      caravan.DestinationY,
+	}
+
+	if _, err := cluster.Request("caravan", caravanID, &messages.CreateCaravanMessage{Caravan: newCaravan, Restore: false}); err != nil {
+		slog.ErrorContext(ctx, "failed to create caravan actor", "error", err)
+		if tellErr := cluster.Tell("city", caravan.SourceCityID, messages.UnloadCaravanMessage{CaravanID: caravanID, Gold: caravan.Gold, Food: caravan.Food}); tellErr != nil {
+			slog.ErrorContext(ctx, "failed to return caravan cargo", "error", tellErr)
+		}
+		return nil, err
+	}
+	metrics.CaravansSentTotal.Inc()
+
+	return &newCaravan, nil
+}
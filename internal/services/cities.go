@@ -22,6 +22,17 @@ func RestoreCity(ctx context.Context, cluster ports.ClusterProvider, city *domai
 	return nil
 }
 
+// CatchUpCity has a restored city replay the ticks it missed while the server
+// was down and start its ticker. Call it once the city's buildings are restored.
+func CatchUpCity(ctx context.Context, cluster ports.ClusterProvider, cityID string) error {
+	if _, err := cluster.Request("city", cityID, messages.CatchUpMessage{}); err != nil {
+		slog.ErrorContext(ctx, "failed to catch up city actor", "city_id", cityID, "error", err)
+		return err
+	}
+
+	return nil
+}
+
 func CreateCity(ctx context.Context, cluster ports.ClusterProvider, store ports.Store, city *CityInput) (*domain.City, error) {
 	cityID := uuid.New().String()
 	ctx = logger.With(ctx, "city_id", cityID)
@@ -45,6 +56,9 @@ func CreateCity(ctx context.Context, cluster ports.ClusterProvider, store ports.
 		StartX:        startX,
 		StartY:        startY,
 		Size:          city.Size,
+		Capital:       city.Capital,
+		TaxRate:       constants.DefaultTaxRate,
+		Morale:        constants.MoraleBase,
 	}
 
 	if _, err = cluster.Request("city", cityID, &messages.CreateCityMessage{City: newCity, Restore: false}); err != nil {
@@ -9,12 +9,14 @@ type CreateUserRequest struct {
 	Password string `json:"password"`
 }
 
-// CityInput is the command to create a new city.
+// CityInput is the command to create a new city. Capital is set for the city
+// a player is founded with.
 type CityInput struct {
-	Type  domain.CityType `json:"type"`
-	Owner *string         `json:"owner"`
-	Name  string          `json:"name"`
-	Size  int             `json:"size"`
+	Type    domain.CityType `json:"type"`
+	Owner   *string         `json:"owner"`
+	Name    string          `json:"name"`
+	Size    int             `json:"size"`
+	Capital bool            `json:"capital"`
 }
 
 // BuildingInput is the command to construct a new building.
@@ -24,3 +26,29 @@ type BuildingInput struct {
 	X      int                 `json:"x"`
 	Y      int                 `json:"y"`
 }
+
+// ArmyInput is the command to raise an army from a city's garrison at (X, Y)
+// and march it to the destination.
+type ArmyInput struct {
+	Owner        string `json:"owner"`
+	CityID       string `json:"city_id"`
+	Troops       int64  `json:"troops"`
+	X            int    `json:"x"`
+	Y            int    `json:"y"`
+	DestinationX int    `json:"destination_x"`
+	DestinationY int    `json:"destination_y"`
+}
+
+// CaravanInput is the command to load gold and food from the owner's pool at
+// a city at (X, Y) and carry it to the destination city.
+type CaravanInput struct {
+	Owner             string `json:"owner"`
+	SourceCityID      string `json:"source_city_id"`
+	DestinationCityID string `json:"destination_city_id"`
+	Gold              int64  `json:"gold"`
+	Food              int64  `json:"food"`
+	X                 int    `json:"x"`
+	Y                 int    `json:"y"`
+	DestinationX      int    `json:"destination_x"`
+	DestinationY      int    `json:"destination_y"`
+}
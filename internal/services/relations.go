@@ -0,0 +1,20 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+
+	"cityio/internal/messages"
+	"cityio/internal/ports"
+)
+
+// RestoreRelation activates a relation actor so the timer of its pending
+// change is armed again.
+func RestoreRelation(ctx context.Context, cluster ports.ClusterProvider, relationID string) error {
+	if _, err := cluster.Request("relation", relationID, messages.RestoreRelationMessage{}); err != nil {
+		slog.ErrorContext(ctx, "failed to restore relation actor", "relation_id", relationID, "error", err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,24 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+
+	"cityio/internal/domain"
+	"cityio/internal/messages"
+	"cityio/internal/ports"
+	"cityio/internal/utils"
+)
+
+// RestoreTile hands a tile actor its terrain and deposit from the stored map.
+func RestoreTile(ctx context.Context, cluster ports.ClusterProvider, tile *domain.Tile) error {
+	if _, err := cluster.Request("tile", utils.GetTileIndex(tile.X, tile.Y), messages.SetTileTerrainMessage{
+		Terrain: tile.Terrain,
+		Deposit: tile.Deposit,
+	}); err != nil {
+		slog.ErrorContext(ctx, "failed to restore tile actor", "x", tile.X, "y", tile.Y, "error", err)
+		return err
+	}
+
+	return nil
+}
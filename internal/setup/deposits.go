@@ -0,0 +1,77 @@
+package setup
+
+import (
+	"math"
+	"math/rand"
+
+	"cityio/internal/constants"
+	"cityio/internal/domain"
+)
+
+// depositOdds is the chance that a tile of some terrain holds a deposit of a
+// given kind.
+type depositOdds struct {
+	kind   domain.DepositKind
+	chance float64
+}
+
+// depositChances makes gold veins run through high ground and fertile soil
+// lie in the lowlands. Water holds nothing, and fertile soil never lies where
+// farms are forbidden.
+var depositChances = map[domain.Terrain][]depositOdds{
+	domain.TerrainGrassland: {{domain.DepositFertileSoil, 0.12}, {domain.DepositGoldVein, 0.01}},
+	domain.TerrainForest:    {{domain.DepositFertileSoil, 0.06}, {domain.DepositGoldVein, 0.02}},
+	domain.TerrainHills:     {{domain.DepositGoldVein, 0.10}, {domain.DepositFertileSoil, 0.03}},
+	domain.TerrainMountains: {{domain.DepositGoldVein, 0.15}},
+}
+
+const (
+	// Richness is drawn uniformly from [minRichness, maxRichness] and rounded
+	// to two decimals.
+	minRichness = 0.5
+	maxRichness = 2.0
+
+	// depositSeedOffset decorrelates deposits from the terrain layers.
+	depositSeedOffset = 0xde9051
+)
+
+// generateDeposits scatters deposits over the terrain of an n×n map, indexed
+// [x][y] with nil for tiles holding none. The same seed and terrain always
+// yield the same deposits.
+func generateDeposits(seed int64, terrain [][]domain.Terrain) [][]*domain.Deposit {
+	r := rand.New(rand.NewSource(seed + depositSeedOffset))
+
+	deposits := make([][]*domain.Deposit, len(terrain))
+	for x := range deposits {
+		deposits[x] = make([]*domain.Deposit, len(terrain[x]))
+		for y := range deposits[x] {
+			roll := r.Float64()
+			for _, odds := range depositChances[terrain[x][y]] {
+				if roll < odds.chance {
+					richness := minRichness + r.Float64()*(maxRichness-minRichness)
+					deposits[x][y] = &domain.Deposit{Kind: odds.kind, Richness: math.Round(richness*100) / 100}
+					break
+				}
+				roll -= odds.chance
+			}
+		}
+	}
+	return deposits
+}
+
+// hasStarterSoil reports whether a size×size block at (x, y) has the deposit
+// the starter farm works somewhere other than its center tile, so a capital
+// founded there can farm.
+func hasStarterSoil(deposits [][]*domain.Deposit, x, y, size int) bool {
+	for i := range size {
+		for j := range size {
+			if i == size/2 && j == size/2 {
+				continue
+			}
+			if constants.DepositSupports(domain.BuildingTypeFarm, deposits[x+i][y+j]) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,54 @@
+package setup
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"cityio/internal/constants"
+	"cityio/internal/domain"
+	"cityio/internal/logger"
+	"cityio/internal/persistence"
+	"cityio/internal/ports"
+)
+
+// WatchSeason checks every SeasonCheckInterval whether the season has run its
+// length, marks it ending when it has, and calls end so the process can shut
+// down. The world rolls over on the next start, so the server must run under a
+// restart policy. It returns when ctx is done.
+func WatchSeason(ctx context.Context, store ports.Store, end func()) {
+	ctx = logger.With(ctx, "phase", "season")
+	ticker := time.NewTicker(constants.SeasonCheckInterval * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		world, err := store.GetWorld(ctx)
+		if errors.Is(err, persistence.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to load world", "error", err)
+			continue
+		}
+		if world.State != domain.WorldStateActive || time.Now().Before(world.SeasonEndsAt) {
+			continue
+		}
+		ended, err := store.EndSeason(ctx, domain.SeasonEndExpired)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to end season", "season", world.Season, "error", err)
+			continue
+		}
+		if ended {
+			slog.InfoContext(ctx, "season expired", "season", world.Season)
+			end()
+		}
+		return
+	}
+}
@@ -3,6 +3,7 @@ package setup
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"math"
@@ -10,6 +11,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 
 	"cityio/internal/constants"
@@ -25,12 +27,30 @@ type Deps struct {
 	Cluster ports.ClusterProvider
 }
 
+// Run prepares the world and restores every actor from the database. The
+// world is only regenerated on first boot or when the previous season was
+// ended; any other boot restores the season in play untouched.
 func Run(ctx context.Context, deps *Deps) {
-	reset(ctx, deps)
+	if err := prepareWorld(ctx, deps); err != nil {
+		panic(err)
+	}
 	ctx = logger.With(ctx, "phase", "init")
 	db := deps.DB
 	cluster := deps.Cluster
 
+	tiles, err := db.GetAllTiles(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, tile := range tiles {
+		err := services.RestoreTile(ctx, cluster, tile.ToModel())
+		if err != nil {
+			panic(err)
+		}
+	}
+	slog.InfoContext(ctx, "spawned tile actors", "count", len(tiles))
+
 	users, err := db.GetAllUsers(ctx)
 	if err != nil {
 		panic(err)
@@ -70,33 +90,185 @@ func Run(ctx context.Context, deps *Deps) {
 	}
 	slog.InfoContext(ctx, "spawned building actors", "count", len(buildings))
 
+	// Cities replay their downtime only once their buildings have reported
+	// in, since production comes from the buildings' restored levels.
+	for _, city := range cities {
+		err := services.CatchUpCity(ctx, cluster, city.CityID)
+		if err != nil {
+			panic(err)
+		}
+	}
+	slog.InfoContext(ctx, "caught up city actors", "count", len(cities))
+
+	armies, err := db.GetAllArmies(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, army := range armies {
+		err := services.RestoreArmy(ctx, cluster, army.ToModel())
+		if err != nil {
+			panic(err)
+		}
+	}
+	slog.InfoContext(ctx, "spawned army actors", "count", len(armies))
+
+	caravans, err := db.GetAllCaravans(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, caravan := range caravans {
+		err := services.RestoreCaravan(ctx, cluster, caravan.ToModel())
+		if err != nil {
+			panic(err)
+		}
+	}
+	slog.InfoContext(ctx, "spawned caravan actors", "count", len(caravans))
+
+	// Only relations with a change still to come need an actor at boot, to
+	// re-arm its timer; the rest are activated on demand.
+	relations, err := db.GetPendingRelations(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, relation := range relations {
+		err := services.RestoreRelation(ctx, cluster, relation.ToModel().ID())
+		if err != nil {
+			panic(err)
+		}
+	}
+	slog.InfoContext(ctx, "spawned relation actors", "count", len(relations))
+
 	// Create the test user AFTER the bulk restore. Restoration must not see
 	// the test user's entities, otherwise the cityActor and building actors
 	// receive a second CreateCityMessage / CreateBuildingMessage and call
 	// startPeriodicOperation again — producing duplicate tickers that emit
 	// staggered credits, breaking the food loop's tick alignment.
+	// The test user survives restarts now that the world does, so it is only
+	// registered once.
 	// TODO: remove test user registration once real registration is the only
 	// path.
-	userID, err := services.CreateUser(ctx, cluster, &services.CreateUserRequest{
-		Email:    "cityio@example.com",
-		Username: "cityio",
-		Password: "cityio",
-	})
-	if err != nil {
+	if _, err := db.GetUserByIdentifier(ctx, "cityio"); errors.Is(err, pgx.ErrNoRows) {
+		userID, err := services.CreateUser(ctx, cluster, &services.CreateUserRequest{
+			Email:    "cityio@example.com",
+			Username: "cityio",
+			Password: "cityio",
+		})
+		if err != nil {
+			panic(err)
+		}
+		slog.InfoContext(ctx, "registered test user", "user_id", userID)
+	} else if err != nil {
 		panic(err)
 	}
-	slog.InfoContext(ctx, "registered test user", "user_id", userID)
 
 	slog.InfoContext(ctx, "initialization complete")
 }
 
-func reset(ctx context.Context, deps *Deps) error {
+// prepareWorld decides what this boot does to the world. A first boot
+// generates season 1; a season marked ending is archived and replaced by a
+// freshly generated world; anything else is left for restore.
+func prepareWorld(ctx context.Context, deps *Deps) error {
+	ctx = logger.With(ctx, "phase", "world")
+	db := deps.DB
+
+	world, err := db.GetWorld(ctx)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return startSeason(ctx, deps, 1)
+	}
+	if err != nil {
+		return err
+	}
+	if domain.WorldState(world.State) != domain.WorldStateEnding {
+		slog.InfoContext(ctx, "restoring world", "season", world.Season, "ends_at", world.SeasonEndsAt.Time)
+		return nil
+	}
+
+	// Archiving is idempotent, so a boot that dies partway through a
+	// rollover simply redoes it.
+	if err := db.ArchiveSeason(ctx); err != nil {
+		return err
+	}
+	if err := db.ArchiveSeasonStandings(ctx); err != nil {
+		return err
+	}
+	slog.InfoContext(ctx, "archived season", "season", world.Season)
+	return startSeason(ctx, deps, int(world.Season)+1)
+}
+
+// startSeason wipes the world, generates a new one from a fresh seed and marks
+// the season active. The world record is written last so an interrupted
+// rollover is retried on the next boot.
+//
+// Accounts, alliances and chat (history and mutes) deliberately carry over:
+// they belong to the players rather than the map, and alliances hold no
+// territory of their own. Everything tied to the map or its economy goes.
+func startSeason(ctx context.Context, deps *Deps, season int) error {
+	db := deps.DB
+	seed := time.Now().UnixNano()
+
+	if err := db.DeleteAllArmies(ctx); err != nil {
+		return err
+	}
+	if err := db.DeleteAllCaravans(ctx); err != nil {
+		return err
+	}
+	if err := db.DeleteAllBattleReports(ctx); err != nil {
+		return err
+	}
+	if err := db.DeleteAllCities(ctx); err != nil {
+		return err
+	}
+	if err := db.DeleteAllTiles(ctx); err != nil {
+		return err
+	}
+	if err := db.DeleteAllResearch(ctx); err != nil {
+		return err
+	}
+	if err := db.DeleteAllMarketOrders(ctx); err != nil {
+		return err
+	}
+	if err := db.DeleteAllTrades(ctx); err != nil {
+		return err
+	}
+	if err := db.DeleteAllRelations(ctx); err != nil {
+		return err
+	}
+	if err := reset(ctx, deps, seed); err != nil {
+		return err
+	}
+
+	endsAt := time.Now().Add(constants.SeasonLength * time.Second)
+	if err := db.StartSeason(ctx, database.StartSeasonParams{
+		Seed:         seed,
+		Season:       int32(season),
+		SeasonEndsAt: database.ToPGTimestamp(&endsAt),
+	}); err != nil {
+		return err
+	}
+	slog.InfoContext(ctx, "started season", "season", season, "seed", seed, "ends_at", endsAt)
+	return nil
+}
+
+// reset generates a new world from seed: every user gets the starting
+// resources and a fresh capital, and neutral towns are scattered across the
+// map. It expects the previous world's cities and armies to be gone.
+func reset(ctx context.Context, deps *Deps, seed int64) error {
 	ctx = logger.With(ctx, "phase", "reset")
 	db := deps.DB
 
-	src := rand.NewSource(time.Now().UnixNano())
+	src := rand.NewSource(seed)
 	r := rand.New(src)
 
+	terrain := generateTerrain(seed, constants.MapSize)
+	deposits := generateDeposits(seed, terrain)
+	if err := createTiles(ctx, db, terrain, deposits); err != nil {
+		slog.ErrorContext(ctx, "error creating tiles", "error", err)
+		return err
+	}
+
 	occupied := make([][]bool, constants.MapSize)
 	for i := range occupied {
 		occupied[i] = make([]bool, constants.MapSize)
@@ -107,22 +279,20 @@ func reset(ctx context.Context, deps *Deps) error {
 		slog.ErrorContext(ctx, "error fetching existing users", "error", err)
 	}
 
-	for _, user := range users {
-		user.Gold = constants.InitialPlayerGold
-		user.Food = constants.InitialPlayerFood
-		err := db.UpdateUserStats(ctx, database.UpdateUserStatsParams{
-			Gold: user.Gold,
-			Food: user.Food,
-		})
-		if err != nil {
-			slog.ErrorContext(ctx, "error resetting user fields", "error", err)
-		}
+	if err := db.ResetUserStats(ctx, database.ResetUserStatsParams{
+		Gold: constants.InitialPlayerGold,
+		Food: constants.InitialPlayerFood,
+	}); err != nil {
+		slog.ErrorContext(ctx, "error resetting user fields", "error", err)
+	}
 
+	for _, user := range users {
 		var startX, startY int
 		for {
 			startX = r.Intn(constants.MapSize - constants.CitySize)
 			startY = r.Intn(constants.MapSize - constants.CitySize)
-			if canPlace(occupied, startX, startY, constants.CitySize) {
+			if canPlace(occupied, startX, startY, constants.CitySize) && onLand(terrain, startX, startY, constants.CitySize) &&
+				hasStarterSoil(deposits, startX, startY, constants.CitySize) {
 				break
 			}
 		}
@@ -130,13 +300,14 @@ func reset(ctx context.Context, deps *Deps) error {
 		cityID := uuid.New().String()
 		err = db.CreateCity(ctx, database.CreateCityParams{
 			CityID:        cityID,
-			Type:          "capital",
+			Type:          string(domain.CityTypeCity),
 			Owner:         &user.UserID,
 			Name:          fmt.Sprintf("%s's City", user.Username),
 			Population:    constants.InitialPlayerCityPopulation,
 			PopulationCap: constants.InitialPlayerCityPopulation,
 			StartX:        int32(startX),
 			StartY:        int32(startY),
+			Capital:       true,
 		})
 		if err != nil {
 			slog.ErrorContext(ctx, "error creating city in db", "error", err)
@@ -170,17 +341,8 @@ func reset(ctx context.Context, deps *Deps) error {
 	cities := make([]domain.City, 0)
 	buildings := make([]domain.Building, 0)
 	for _, c := range poissonDiskPoints(r, constants.MapSize, townMinSpacing, poissonRetries) {
-		size := 0
-		rng := r.Intn(1000)
-		if rng < 30 {
-			size = 5
-		} else if rng < 100 {
-			size = 4
-		} else if rng < 500 {
-			size = 3
-		} else {
-			size = 2
-		}
+		tc := pickTownSize(r, constants.GetBalance().Towns.Sizes)
+		size := tc.Size
 
 		// c is the candidate center; derive the top-left so the town wraps it.
 		x := c[0] - size/2
@@ -188,12 +350,11 @@ func reset(ctx context.Context, deps *Deps) error {
 		if x < 0 || y < 0 || x+size > constants.MapSize || y+size > constants.MapSize {
 			continue
 		}
-		if !canPlace(occupied, x, y, size) {
+		if !canPlace(occupied, x, y, size) || !onLand(terrain, x, y, size) {
 			continue
 		}
 
 		cityID := uuid.New().String()
-		tc := constants.TownSizeConfig[size]
 		populationCap := constants.GetBuildingPopulation(domain.BuildingTypeTownCenter, tc.CenterLevel) +
 			float64(tc.HouseCount)*constants.GetBuildingPopulation(domain.BuildingTypeHouse, 1)
 
@@ -321,6 +482,34 @@ func reset(ctx context.Context, deps *Deps) error {
 	return nil
 }
 
+// createTiles stores the terrain and deposit of every map tile.
+func createTiles(ctx context.Context, db database.Querier, terrain [][]domain.Terrain, deposits [][]*domain.Deposit) error {
+	params := database.BatchCreateTilesParams{
+		Xs:         make([]int32, 0, len(terrain)*len(terrain)),
+		Ys:         make([]int32, 0, len(terrain)*len(terrain)),
+		Terrains:   make([]string, 0, len(terrain)*len(terrain)),
+		Deposits:   make([]string, 0, len(terrain)*len(terrain)),
+		Richnesses: make([]float64, 0, len(terrain)*len(terrain)),
+	}
+	for x := range terrain {
+		for y := range terrain[x] {
+			params.Xs = append(params.Xs, int32(x))
+			params.Ys = append(params.Ys, int32(y))
+			params.Terrains = append(params.Terrains, string(terrain[x][y]))
+
+			// sqlc will parse "" into NULL
+			if d := deposits[x][y]; d != nil {
+				params.Deposits = append(params.Deposits, string(d.Kind))
+				params.Richnesses = append(params.Richnesses, d.Richness)
+			} else {
+				params.Deposits = append(params.Deposits, "")
+				params.Richnesses = append(params.Richnesses, 0)
+			}
+		}
+	}
+	return db.BatchCreateTiles(ctx, params)
+}
+
 var townPrefixes = []string{
 	"Ash", "Birch", "Briar", "Cedar", "Copper", "Crow", "Dusk", "Elder",
 	"Elm", "Ember", "Fern", "Flint", "Frost", "Gold", "Granite", "Hawk",
@@ -337,6 +526,23 @@ var townSuffixes = []string{
 	"well", "wick", "wood", "worth",
 }
 
+// pickTownSize draws a town size in proportion to the weights, walking the
+// sizes in order.
+func pickTownSize(r *rand.Rand, sizes []constants.TownSize) constants.TownSize {
+	total := 0
+	for _, s := range sizes {
+		total += s.Weight
+	}
+	roll := r.Intn(total)
+	for _, s := range sizes {
+		if roll < s.Weight {
+			return s
+		}
+		roll -= s.Weight
+	}
+	return sizes[len(sizes)-1]
+}
+
 func townName(r *rand.Rand, used map[string]bool) string {
 	for {
 		name := townPrefixes[r.Intn(len(townPrefixes))] + townSuffixes[r.Intn(len(townSuffixes))]
@@ -0,0 +1,126 @@
+package setup
+
+import (
+	"math"
+	"math/rand"
+
+	"cityio/internal/domain"
+)
+
+// Terrain comes from two layers of value noise over the map: elevation picks
+// water, hills and mountains at its extremes, and moisture splits the
+// lowland that remains between forest and grassland. Thresholds are on the
+// normalized [0, 1] noise value.
+const (
+	waterLevel     = 0.32
+	hillsLevel     = 0.64
+	mountainsLevel = 0.76
+	forestMoisture = 0.56
+
+	// terrainOctaves are the lattice spacings, in tiles, of the summed noise
+	// layers, coarsest first. Each layer carries half the weight of the one
+	// before it.
+	terrainCoarsest = 16
+	terrainOctaves  = 3
+
+	// moistureSeedOffset decorrelates the moisture layer from elevation.
+	moistureSeedOffset = 0x5eed
+)
+
+// generateTerrain returns the terrain of every tile of an n×n map, indexed
+// [x][y]. The same seed always yields the same map.
+func generateTerrain(seed int64, n int) [][]domain.Terrain {
+	elevation := valueNoise(rand.New(rand.NewSource(seed)), n)
+	moisture := valueNoise(rand.New(rand.NewSource(seed+moistureSeedOffset)), n)
+
+	terrain := make([][]domain.Terrain, n)
+	for x := range terrain {
+		terrain[x] = make([]domain.Terrain, n)
+		for y := range terrain[x] {
+			e, m := elevation[x][y], moisture[x][y]
+			switch {
+			case e < waterLevel:
+				terrain[x][y] = domain.TerrainWater
+			case e >= mountainsLevel:
+				terrain[x][y] = domain.TerrainMountains
+			case e >= hillsLevel:
+				terrain[x][y] = domain.TerrainHills
+			case m >= forestMoisture:
+				terrain[x][y] = domain.TerrainForest
+			default:
+				terrain[x][y] = domain.TerrainGrassland
+			}
+		}
+	}
+	return terrain
+}
+
+// valueNoise sums terrainOctaves layers of smoothly interpolated lattice
+// noise over an n×n grid and rescales the result to [0, 1].
+func valueNoise(r *rand.Rand, n int) [][]float64 {
+	out := make([][]float64, n)
+	for x := range out {
+		out[x] = make([]float64, n)
+	}
+
+	spacing, weight := terrainCoarsest, 1.0
+	for range terrainOctaves {
+		cells := n/spacing + 2
+		lattice := make([][]float64, cells)
+		for i := range lattice {
+			lattice[i] = make([]float64, cells)
+			for j := range lattice[i] {
+				lattice[i][j] = r.Float64()
+			}
+		}
+		for x := range n {
+			for y := range n {
+				out[x][y] += weight * sampleLattice(lattice, float64(x)/float64(spacing), float64(y)/float64(spacing))
+			}
+		}
+		spacing = max(1, spacing/2)
+		weight /= 2
+	}
+
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for x := range out {
+		for y := range out[x] {
+			lo = min(lo, out[x][y])
+			hi = max(hi, out[x][y])
+		}
+	}
+	if hi > lo {
+		for x := range out {
+			for y := range out[x] {
+				out[x][y] = (out[x][y] - lo) / (hi - lo)
+			}
+		}
+	}
+	return out
+}
+
+// sampleLattice interpolates the lattice at (fx, fy) with a smoothstep so the
+// noise has no visible grid creases.
+func sampleLattice(lattice [][]float64, fx, fy float64) float64 {
+	x0, y0 := int(fx), int(fy)
+	tx, ty := smoothstep(fx-float64(x0)), smoothstep(fy-float64(y0))
+	top := lattice[x0][y0]*(1-tx) + lattice[x0+1][y0]*tx
+	bottom := lattice[x0][y0+1]*(1-tx) + lattice[x0+1][y0+1]*tx
+	return top*(1-ty) + bottom*ty
+}
+
+func smoothstep(t float64) float64 {
+	return t * t * (3 - 2*t)
+}
+
+// onLand reports whether a size×size block at (x, y) avoids water entirely.
+func onLand(terrain [][]domain.Terrain, x, y, size int) bool {
+	for i := range size {
+		for j := range size {
+			if !terrain[x+i][y+j].AllowsCity() {
+				return false
+			}
+		}
+	}
+	return true
+}
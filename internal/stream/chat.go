@@ -0,0 +1,85 @@
+package stream
+
+import (
+	"sync"
+
+	"cityio/internal/domain"
+	"cityio/internal/metrics"
+)
+
+// Chat messages are delivered per user like state pushes, but on their own
+// subscriptions so a chat client need not take the full state stream. The
+// sender decides who receives a message: everyone for the global channel,
+// the members for an alliance, both players for a direct conversation.
+
+type chatSubscriber struct {
+	id uint64
+	ch chan domain.ChatMessage
+}
+
+var (
+	chatMu     sync.Mutex
+	chatSubs   = make(map[string][]chatSubscriber)
+	nextChatID uint64
+)
+
+// SubscribeChat registers a subscriber for the chat messages a user receives
+// and returns the receive channel plus an unsubscribe function. The buffer is
+// larger than a state subscription's since every message matters, but a slow
+// client still loses the oldest pending ones rather than blocking the sender.
+func SubscribeChat(userID string) (<-chan domain.ChatMessage, func()) {
+	chatMu.Lock()
+	defer chatMu.Unlock()
+
+	nextChatID++
+	s := chatSubscriber{id: nextChatID, ch: make(chan domain.ChatMessage, 32)}
+	chatSubs[userID] = append(chatSubs[userID], s)
+	metrics.StreamSubscribers.Inc()
+
+	unsubscribe := func() {
+		chatMu.Lock()
+		defer chatMu.Unlock()
+		list := chatSubs[userID]
+		for i, existing := range list {
+			if existing.id == s.id {
+				chatSubs[userID] = append(list[:i], list[i+1:]...)
+				metrics.StreamSubscribers.Dec()
+				break
+			}
+		}
+		if len(chatSubs[userID]) == 0 {
+			delete(chatSubs, userID)
+		}
+		close(s.ch)
+	}
+
+	return s.ch, unsubscribe
+}
+
+// PublishChat delivers a chat message to every chat subscriber of the user.
+// It never blocks.
+func PublishChat(userID string, message domain.ChatMessage) {
+	metrics.StreamPublishesTotal.WithLabelValues("chat_message").Inc()
+
+	chatMu.Lock()
+	defer chatMu.Unlock()
+
+	for _, s := range chatSubs[userID] {
+		offer(s.ch, message)
+	}
+}
+
+// PublishChatAll delivers a chat message to every chat subscriber. It never
+// blocks.
+func PublishChatAll(message domain.ChatMessage) {
+	metrics.StreamPublishesTotal.WithLabelValues("chat_message").Inc()
+
+	chatMu.Lock()
+	defer chatMu.Unlock()
+
+	for _, list := range chatSubs {
+		for _, s := range list {
+			offer(s.ch, message)
+		}
+	}
+}
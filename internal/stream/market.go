@@ -0,0 +1,67 @@
+package stream
+
+import (
+	"sync"
+
+	"cityio/internal/domain"
+	"cityio/internal/metrics"
+)
+
+// Market tickers are public: every subscriber of a market receives each
+// update, unlike the per-user state pushes.
+
+type tickerSubscriber struct {
+	id uint64
+	ch chan domain.MarketTicker
+}
+
+var (
+	tickerMu     sync.Mutex
+	tickerSubs   = make(map[domain.MarketID][]tickerSubscriber)
+	nextTickerID uint64
+)
+
+// SubscribeMarket registers a subscriber for a market's ticker and returns
+// the receive channel plus an unsubscribe function. Like Subscribe, a slow
+// client loses the oldest pending tickers rather than blocking the market.
+func SubscribeMarket(marketID domain.MarketID) (<-chan domain.MarketTicker, func()) {
+	tickerMu.Lock()
+	defer tickerMu.Unlock()
+
+	nextTickerID++
+	s := tickerSubscriber{id: nextTickerID, ch: make(chan domain.MarketTicker, 8)}
+	tickerSubs[marketID] = append(tickerSubs[marketID], s)
+	metrics.StreamSubscribers.Inc()
+
+	unsubscribe := func() {
+		tickerMu.Lock()
+		defer tickerMu.Unlock()
+		list := tickerSubs[marketID]
+		for i, existing := range list {
+			if existing.id == s.id {
+				tickerSubs[marketID] = append(list[:i], list[i+1:]...)
+				metrics.StreamSubscribers.Dec()
+				break
+			}
+		}
+		if len(tickerSubs[marketID]) == 0 {
+			delete(tickerSubs, marketID)
+		}
+		close(s.ch)
+	}
+
+	return s.ch, unsubscribe
+}
+
+// PublishMarket delivers a market's ticker to every subscriber. It never
+// blocks.
+func PublishMarket(ticker domain.MarketTicker) {
+	metrics.StreamPublishesTotal.WithLabelValues("market_ticker").Inc()
+
+	tickerMu.Lock()
+	defer tickerMu.Unlock()
+
+	for _, s := range tickerSubs[ticker.MarketID] {
+		offer(s.ch, ticker)
+	}
+}
@@ -16,8 +16,20 @@ import (
 type StateUpdate struct {
 	User              *domain.User
 	City              *domain.City
+	DeletedCityID     *string
 	Building          *domain.Building
 	DeletedBuildingID *string
+	Army              *domain.Army
+	DeletedArmyID     *string
+	Caravan           *domain.Caravan
+	DeletedCaravanID  *string
+	BattleReport      *domain.BattleReport
+	Alliance          *domain.Alliance
+	// LeftAllianceID is sent to a player who left or was kicked from an
+	// alliance, or whose alliance disbanded.
+	LeftAllianceID *string
+	AllianceInvite *domain.AllianceInvite
+	Relation       *domain.Relation
 }
 
 type subscriber struct {
@@ -74,21 +86,26 @@ func Publish(userID string, state StateUpdate) {
 	defer mu.Unlock()
 
 	for _, s := range subs[userID] {
+		offer(s.ch, state)
+	}
+}
+
+// offer sends v on ch without blocking. If the buffer is full the oldest value
+// is dropped to make room, then the send is tried again; a value that still
+// doesn't fit is counted and discarded.
+func offer[T any](ch chan T, v T) {
+	select {
+	case ch <- v:
+	default:
+		metrics.StreamBufferDropsTotal.Inc()
 		select {
-		case s.ch <- state:
+		case <-ch:
+		default:
+		}
+		select {
+		case ch <- v:
 		default:
-			// Buffer full — drop oldest, then try again. If we still can't
-			// enqueue, count the drop and move on.
 			metrics.StreamBufferDropsTotal.Inc()
-			select {
-			case <-s.ch:
-			default:
-			}
-			select {
-			case s.ch <- state:
-			default:
-				metrics.StreamBufferDropsTotal.Inc()
-			}
 		}
 	}
 }
@@ -103,10 +120,40 @@ func recordPublish(state StateUpdate) {
 	if state.City != nil {
 		metrics.StreamPublishesTotal.WithLabelValues("city").Inc()
 	}
+	if state.DeletedCityID != nil {
+		metrics.StreamPublishesTotal.WithLabelValues("city_deletion").Inc()
+	}
 	if state.Building != nil {
 		metrics.StreamPublishesTotal.WithLabelValues("building").Inc()
 	}
 	if state.DeletedBuildingID != nil {
 		metrics.StreamPublishesTotal.WithLabelValues("deletion").Inc()
 	}
+	if state.Army != nil {
+		metrics.StreamPublishesTotal.WithLabelValues("army").Inc()
+	}
+	if state.DeletedArmyID != nil {
+		metrics.StreamPublishesTotal.WithLabelValues("army_deletion").Inc()
+	}
+	if state.Caravan != nil {
+		metrics.StreamPublishesTotal.WithLabelValues("caravan").Inc()
+	}
+	if state.DeletedCaravanID != nil {
+		metrics.StreamPublishesTotal.WithLabelValues("caravan_deletion").Inc()
+	}
+	if state.BattleReport != nil {
+		metrics.StreamPublishesTotal.WithLabelValues("battle_report").Inc()
+	}
+	if state.Alliance != nil {
+		metrics.StreamPublishesTotal.WithLabelValues("alliance").Inc()
+	}
+	if state.LeftAllianceID != nil {
+		metrics.StreamPublishesTotal.WithLabelValues("alliance_departure").Inc()
+	}
+	if state.AllianceInvite != nil {
+		metrics.StreamPublishesTotal.WithLabelValues("alliance_invite").Inc()
+	}
+	if state.Relation != nil {
+		metrics.StreamPublishesTotal.WithLabelValues("relation").Inc()
+	}
 }